@@ -40,6 +40,7 @@ const (
 	AttributeKeyRedeemScript     = types.AttributeKeyRedeemScript
 
 	EventTypeBindAsset      = types.EventTypeBindAsset
+	EventTypeUnbindAsset    = types.EventTypeUnbindAsset
 	AttributeKeyCreator     = types.AttributeKeyCreator
 	AttributeKeyToChainId   = types.AttributeKeyToChainId
 	AttributeKeyToAssetHash = types.AttributeKeyToAssetHash
@@ -50,17 +51,65 @@ const (
 	AttributeKeyAmount      = types.AttributeKeyAmount
 
 	EventTypeUnlock = types.EventTypeUnlock
+
+	EventTypeUpdateRedeemScript  = types.EventTypeUpdateRedeemScript
+	AttributeKeyRedeemScriptHash = types.AttributeKeyRedeemScriptHash
+	AttributeKeyOperator         = types.AttributeKeyOperator
+
+	QueryRedeemScripts    = types.QueryRedeemScripts
+	QueryRedeemScriptInfo = types.QueryRedeemScriptInfo
+	QueryWithdrawal       = types.QueryWithdrawal
+	QueryWithdrawals      = types.QueryWithdrawals
+	QueryAssetBindings    = types.QueryAssetBindings
+	QueryDenomsByCreator  = types.QueryDenomsByCreator
+
+	EventTypeConfirmWithdrawal     = types.EventTypeConfirmWithdrawal
+	AttributeKeyCrossChainId       = types.AttributeKeyCrossChainId
+	AttributeKeyBtcTxHash          = types.AttributeKeyBtcTxHash
+	WithdrawalStatusPending        = types.WithdrawalStatusPending
+	WithdrawalStatusConfirmed      = types.WithdrawalStatusConfirmed
+	ProposalTypeUpdateRedeemScript = types.ProposalTypeUpdateRedeemScript
+
+	BtcChainId           = types.BtcChainId
+	BtcNetworkMainnet    = types.BtcNetworkMainnet
+	BtcNetworkTestnet3   = types.BtcNetworkTestnet3
+	BtcNetworkRegtest    = types.BtcNetworkRegtest
+	BtcNetworkSimnet     = types.BtcNetworkSimnet
+	DefaultMinRelayTxFee = types.DefaultMinRelayTxFee
 )
 
 var (
 	// functions aliases
-	RegisterCodec = types.RegisterCodec
-	NewKeeper     = keeper.NewKeeper
-	NewQuerier    = keeper.NewQuerier
+	RegisterCodec  = types.RegisterCodec
+	NewKeeper      = keeper.NewKeeper
+	NewQuerier     = keeper.NewQuerier
+	NewQueryServer = keeper.NewQueryServer
 
-	NewMsgCreateDenom   = types.NewMsgCreateDenom
-	NewMsgBindAssetHash = types.NewMsgBindAssetHash
-	NewMsgLock          = types.NewMsgLock
+	NewMsgCreateDenom             = types.NewMsgCreateDenom
+	NewMsgBindAssetHash           = types.NewMsgBindAssetHash
+	NewMsgUnbindAssetHash         = types.NewMsgUnbindAssetHash
+	NewMsgLock                    = types.NewMsgLock
+	NewMsgUpdateRedeemScript      = types.NewMsgUpdateRedeemScript
+	NewUpdateRedeemScriptProposal = types.NewUpdateRedeemScriptProposal
+	NewQueryRedeemScriptsParam    = types.NewQueryRedeemScriptsParam
+	NewQueryRedeemScriptInfoParam = types.NewQueryRedeemScriptInfoParam
+	ParseRedeemScript             = types.ParseRedeemScript
+	NewMsgReconcileWithdrawal     = types.NewMsgReconcileWithdrawal
+	NewQueryWithdrawalParam       = types.NewQueryWithdrawalParam
+	NewQueryWithdrawalsParam      = types.NewQueryWithdrawalsParam
+	NewQueryAssetBindingsParam    = types.NewQueryAssetBindingsParam
+	NewQueryDenomsByCreatorParam  = types.NewQueryDenomsByCreatorParam
+	ErrConfirmWithdrawal          = types.ErrConfirmWithdrawal
+	ErrUpdateRedeemScript         = types.ErrUpdateRedeemScript
+	NewQueryBindingHistoryParam   = types.NewQueryBindingHistoryParam
+	NewGenesisState               = types.NewGenesisState
+	DefaultGenesisState           = types.DefaultGenesisState
+	ValidateGenesis               = types.ValidateGenesis
+	DefaultParams                 = types.DefaultParams
+	ParamKeyTable                 = types.ParamKeyTable
+	GetBtcNetParams               = types.GetBtcNetParams
+	DecodeBtcAddress              = types.DecodeBtcAddress
+	DustThreshold                 = types.DustThreshold
 
 	// variable aliases
 	ModuleCdc = types.ModuleCdc
@@ -71,15 +120,34 @@ type (
 	DenomCrossChainInfo = types.DenomCrossChainInfo
 	DenomInfo           = types.DenomInfo
 
-	MsgBindAssetHash = types.MsgBindAssetHash
-	MsgCreateDenom   = types.MsgCreateDenom
-	MsgLock          = types.MsgLock
-	CreateCoinReq    = rest.CreateCoinReq
-	BindAssetHashReq = rest.BindAssetHashReq
-	LockReq          = rest.LockReq
+	MsgBindAssetHash      = types.MsgBindAssetHash
+	MsgUnbindAssetHash    = types.MsgUnbindAssetHash
+	MsgCreateDenom        = types.MsgCreateDenom
+	MsgLock               = types.MsgLock
+	CreateCoinReq         = rest.CreateCoinReq
+	BindAssetHashReq      = rest.BindAssetHashReq
+	UnbindAssetHashReq    = rest.UnbindAssetHashReq
+	LockReq               = rest.LockReq
+	MsgUpdateRedeemScript = types.MsgUpdateRedeemScript
+	UpdateRedeemScriptReq = rest.UpdateRedeemScriptReq
 
-	ToBTCArgs = types.ToBTCArgs
-	BTCArgs   = types.BTCArgs
+	UpdateRedeemScriptProposal = types.UpdateRedeemScriptProposal
+	RedeemScripts              = types.RedeemScripts
+	RedeemScriptRecord         = types.RedeemScriptRecord
+	RedeemScriptInfo           = types.RedeemScriptInfo
+	RedeemScriptAddress        = types.RedeemScriptAddress
+
+	MsgReconcileWithdrawal = types.MsgReconcileWithdrawal
+	ReconcileWithdrawalReq = rest.ReconcileWithdrawalReq
+	BtcWithdrawal          = types.BtcWithdrawal
+
+	BindingChange = types.BindingChange
+	ToBTCArgs     = types.ToBTCArgs
+	BTCArgs       = types.BTCArgs
 
 	UnlockKeeper = exported.UnlockKeeper
+
+	GenesisState = types.GenesisState
+	Params       = types.Params
+	Binding      = types.Binding
 )
@@ -23,9 +23,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/polynetwork/cosmos-poly-module/btcx/client/common"
 	"github.com/polynetwork/cosmos-poly-module/btcx/internal/types"
+	polycommon "github.com/polynetwork/cosmos-poly-module/common"
 	"github.com/spf13/cobra"
 	"strconv"
 	"strings"
@@ -45,6 +47,14 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		flags.GetCommands(
 			GetCmdQueryDenomInfo(queryRoute, cdc),
 			GetCmdQueryDenomInfoWithChainId(queryRoute, cdc),
+			GetCmdQueryBindingHistory(queryRoute, cdc),
+			GetCmdQueryParams(queryRoute, cdc),
+			GetCmdQueryRedeemScripts(queryRoute, cdc),
+			GetCmdQueryRedeemScriptInfo(queryRoute, cdc),
+			GetCmdQueryWithdrawal(queryRoute, cdc),
+			GetCmdQueryWithdrawals(queryRoute, cdc),
+			GetCmdQueryAssetBindings(queryRoute, cdc),
+			GetCmdQueryDenomsByCreator(queryRoute, cdc),
 		)...,
 	)
 
@@ -120,3 +130,295 @@ $ %s query %s denom-cc-info btcx 2
 		},
 	}
 }
+
+const (
+	FlagDenom     = "denom"
+	FlagToChainId = "to-chain-id"
+)
+
+func GetCmdQueryBindingHistory(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "binding-history",
+		Short: "Query the history of asset hash binding changes",
+		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query every bind, rebind and unbind of asset hashes page by page, optionally filtered by denom and target chainId.
+The changes are ordered by binding, and the changes of one binding in the order they happened
+
+Example:
+$ %s query %s binding-history --denom btcx --to-chain-id 2 --page 2 --limit 50
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+			chainId, err := cmd.Flags().GetUint64(FlagToChainId)
+			if err != nil {
+				return err
+			}
+			page, limit, err := getPageFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := common.QueryBindingHistory(cliCtx, queryRoute, denom, chainId, page, limit)
+			if err != nil {
+				return err
+			}
+			var changes []types.BindingChange
+			cdc.MustUnmarshalJSON(res, &changes)
+			return cliCtx.PrintOutput(changes)
+		},
+	}
+	cmd.Flags().String(FlagDenom, "", "source asset denom, all denoms if empty")
+	cmd.Flags().Uint64(FlagToChainId, 0, "target chainId, all chains if zero")
+	addPageFlags(cmd)
+	return cmd
+}
+
+func GetCmdQueryParams(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "parameters",
+		Args:  cobra.NoArgs,
+		Short: "Query the parameters of btcx module, including the btc network and the minimum withdraw amount",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+
+Example:
+$ %s query %s parameters
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, err := common.QueryParams(cliCtx, queryRoute)
+			if err != nil {
+				return err
+			}
+			var params types.Params
+			cdc.MustUnmarshalJSON(res, &params)
+			return cliCtx.PrintOutput(params)
+		},
+	}
+}
+
+func GetCmdQueryRedeemScripts(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "redeem-scripts [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the current redeem script of denom together with all the redeem scripts it has used",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+
+Example:
+$ %s query %s redeem-scripts btcx
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, err := common.QueryRedeemScripts(cliCtx, queryRoute, args[0])
+			if err != nil {
+				return err
+			}
+			var scripts types.RedeemScripts
+			cdc.MustUnmarshalJSON(res, &scripts)
+			return cliCtx.PrintOutput(scripts)
+		},
+	}
+}
+
+func GetCmdQueryRedeemScriptInfo(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "redeem-script-info [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the type, threshold, public keys and btc addresses of the current redeem script of denom",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Parse the current redeem script of denom and show its script type, the M-of-N threshold
+and public keys of a multisig, together with the P2SH and P2WSH addresses of it on mainnet and testnet3
+
+Example:
+$ %s query %s redeem-script-info btcx
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, err := common.QueryRedeemScriptInfo(cliCtx, queryRoute, args[0])
+			if err != nil {
+				return err
+			}
+			var info types.RedeemScriptInfo
+			cdc.MustUnmarshalJSON(res, &info)
+			return cliCtx.PrintOutput(info)
+		},
+	}
+}
+
+func GetCmdQueryWithdrawal(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "withdrawal [cross_chain_id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the withdrawal to btc chain with cross chain id",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the btc address, amount and status of a withdrawal to btc chain, the status turns from
+pending to confirmed once the creator of the denom reconciles it with the btc tx paying it. The btc tx is
+not verified by this chain, the confirmed status is only the attestation of the creator
+
+Example:
+$ %s query %s withdrawal 12
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			crossChainId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			res, err := common.QueryWithdrawal(cliCtx, queryRoute, crossChainId)
+			if err != nil {
+				return err
+			}
+			var withdrawal types.BtcWithdrawal
+			cdc.MustUnmarshalJSON(res, &withdrawal)
+			return cliCtx.PrintOutput(withdrawal)
+		},
+	}
+}
+
+func GetCmdQueryWithdrawals(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdrawals [address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the withdrawals to btc chain of address page by page",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the withdrawals to btc chain of address page by page in the order they were created
+
+Example:
+$ %s query %s withdrawals cosmos1g8lsqrcvs6vpzn0gaq5yfxaxhp2vqhvtd4ajzj --page 2 --limit 50
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			address, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			page, limit, err := getPageFlags(cmd)
+			if err != nil {
+				return err
+			}
+			res, err := common.QueryWithdrawals(cliCtx, queryRoute, address, page, limit)
+			if err != nil {
+				return err
+			}
+			var withdrawals []types.BtcWithdrawal
+			cdc.MustUnmarshalJSON(res, &withdrawals)
+			return cliCtx.PrintOutput(withdrawals)
+		},
+	}
+	addPageFlags(cmd)
+	return cmd
+}
+
+func GetCmdQueryAssetBindings(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "asset-bindings [denom]",
+		Short: "Query all the asset hashes bound to denom page by page",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+
+Example:
+$ %s query %s asset-bindings btcx --page 1 --limit 50
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			page, limit, err := getPageFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := common.QueryAssetBindings(cliCtx, queryRoute, args[0], page, limit)
+			if err != nil {
+				return err
+			}
+			var bindings []types.Binding
+			cdc.MustUnmarshalJSON(res, &bindings)
+			return cliCtx.PrintOutput(bindings)
+		},
+	}
+	addPageFlags(cmd)
+	return cmd
+}
+
+func GetCmdQueryDenomsByCreator(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denoms-by-creator [creator]",
+		Short: "Query all the denoms owned by creator page by page",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+
+Example:
+$ %s query %s denoms-by-creator cosmos1ayc6faczpj42eu7wjsjkwcj7h0q2p2e4vrlkzf --page 1 --limit 50
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			creator, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			page, limit, err := getPageFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := common.QueryDenomsByCreator(cliCtx, queryRoute, creator, page, limit)
+			if err != nil {
+				return err
+			}
+			var denoms []string
+			cdc.MustUnmarshalJSON(res, &denoms)
+			return cliCtx.PrintOutput(denoms)
+		},
+	}
+	addPageFlags(cmd)
+	return cmd
+}
+
+func addPageFlags(cmd *cobra.Command) {
+	cmd.Flags().Int(flags.FlagPage, 1, "page of the results to query, starting from 1")
+	cmd.Flags().Int(flags.FlagLimit, polycommon.DefaultPageLimit, "number of results per page")
+}
+
+func getPageFlags(cmd *cobra.Command) (page, limit int, err error) {
+	if page, err = cmd.Flags().GetInt(flags.FlagPage); err != nil {
+		return
+	}
+	limit, err = cmd.Flags().GetInt(flags.FlagLimit)
+	return
+}
@@ -32,7 +32,10 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
 	"github.com/polynetwork/cosmos-poly-module/btcx/internal/types"
+	"github.com/polynetwork/cosmos-poly-module/common"
 	"math/big"
 	"strconv"
 )
@@ -49,7 +52,10 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 	txCmd.AddCommand(flags.PostCommands(
 		SendCreateCoinTxCmd(cdc),
 		SendBindAssetHashTxCmd(cdc),
+		SendUnbindAssetHashTxCmd(cdc),
 		SendLockTxCmd(cdc),
+		SendUpdateRedeemScriptTxCmd(cdc),
+		SendReconcileWithdrawalTxCmd(cdc),
 	)...)
 	return txCmd
 }
@@ -61,7 +67,7 @@ func SendCreateCoinTxCmd(cdc *codec.Codec) *cobra.Command {
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`
 Example:
-$ %s tx %s create-coin btccc 12345678 
+$ %s tx %s create-coin btccc 12345678 --decimals 8 --symbol BTC --display-name Bitcoin
 `,
 				version.ClientName, types.ModuleName,
 			),
@@ -75,13 +81,53 @@ $ %s tx %s create-coin btccc 12345678
 			denom := args[0]
 			redeemScript := args[1]
 
-			msg := types.NewMsgCreateDenom(cliCtx.GetFromAddress(), denom, redeemScript)
+			metadata, err := denomMetadataFromFlags(cmd, denom)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgCreateDenom(cliCtx.GetFromAddress(), denom, redeemScript, metadata)
 			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
+	addDenomMetadataFlags(cmd)
 	return cmd
 }
 
+const (
+	FlagDecimals        = "decimals"
+	FlagSymbol          = "symbol"
+	FlagDisplayName     = "display-name"
+	FlagOriginChainId   = "origin-chain-id"
+	FlagOriginAssetHash = "origin-asset-hash"
+)
+
+func addDenomMetadataFlags(cmd *cobra.Command) {
+	cmd.Flags().Uint8(FlagDecimals, 0, "decimals of the denom")
+	cmd.Flags().String(FlagSymbol, "", "symbol of the denom")
+	cmd.Flags().String(FlagDisplayName, "", "display name of the denom")
+	cmd.Flags().Uint64(FlagOriginChainId, 0, "poly chain id of the origin chain the denom is bridged from")
+	cmd.Flags().String(FlagOriginAssetHash, "", "asset hash in hex on the origin chain")
+}
+
+// denomMetadataFromFlags returns nil if none of the metadata flags is set
+func denomMetadataFromFlags(cmd *cobra.Command, denom string) (*common.DenomMetadata, error) {
+	if !cmd.Flags().Changed(FlagDecimals) && !cmd.Flags().Changed(FlagSymbol) && !cmd.Flags().Changed(FlagDisplayName) &&
+		!cmd.Flags().Changed(FlagOriginChainId) && !cmd.Flags().Changed(FlagOriginAssetHash) {
+		return nil, nil
+	}
+	decimals, _ := cmd.Flags().GetUint8(FlagDecimals)
+	symbol, _ := cmd.Flags().GetString(FlagSymbol)
+	displayName, _ := cmd.Flags().GetString(FlagDisplayName)
+	originChainId, _ := cmd.Flags().GetUint64(FlagOriginChainId)
+	originAssetHashStr, _ := cmd.Flags().GetString(FlagOriginAssetHash)
+	originAssetHash, err := hex.DecodeString(originAssetHashStr)
+	if err != nil {
+		return nil, fmt.Errorf("decode hex string: %s error: %v", originAssetHashStr, err)
+	}
+	md := common.NewDenomMetadata(denom, decimals, symbol, displayName, originChainId, originAssetHash)
+	return &md, nil
+}
+
 func SendBindAssetHashTxCmd(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "bind-asset-hash [source_asset_denom] [to_chainId] [to_asset_hash]",
@@ -166,3 +212,142 @@ $ %s tx %s lock btca 3 616f2a4a38396ff203ea01e6c070ae421bb8ce2d 123
 	}
 	return cmd
 }
+
+func SendUnbindAssetHashTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unbind-asset-hash [source_asset_denom] [target_chainId]",
+		Short: "remove the asset hash of source_asset_denom in target_chainId bound by the denom creator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+Example:
+$ %s tx %s unbind-asset-hash ont 3
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			toChainId, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUnbindAssetHash(cliCtx.GetFromAddress(), args[0], toChainId)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
+
+func SendUpdateRedeemScriptTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-redeem-script [denom] [redeem_script]",
+		Short: "replace the redeem script of denom used by the withdrawals to btc, only for the denom creator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`The former redeem scripts are kept in the history returned by the redeem-scripts query.
+
+Example:
+$ %s tx %s update-redeem-script btcx 5221023ac710e73e1410718530b2686ce47f12fa3c470a9eb6085976b70b01c64c9f732102c9dc4d8f419e325bbef0fe039ed6feaf2079a2ef7b27336ddb79be2ea6e334bf2102eac9273eb1d6a0b1e5cf3ba8e9e3c8b4d2d3c9e2a6e0c1f9b7a6e5d4c3b2a1f0e53ae
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			msg := types.NewMsgUpdateRedeemScript(cliCtx.GetFromAddress(), args[0], args[1])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
+
+func SendReconcileWithdrawalTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reconcile-withdrawal [cross_chain_id] [btc_tx_hash]",
+		Short: "confirm the pending withdrawal to btc paid by btc_tx_hash, only for the creator of the withdrawn denom",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Poly relays nothing back from btc chain once a withdrawal is paid, so the withdrawals stay pending until
+the denom creator confirms them by the hash of the btc tx paying them. The btc tx is not verified by this chain,
+the confirmed status is only the attestation of the creator
+
+Example:
+$ %s tx %s reconcile-withdrawal 12 5c9d1e3a4f7b2c8d0e6f1a3b5c7d9e0f2a4b6c8d0e1f3a5b7c9d1e3f5a7b9c0d
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			crossChainId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgReconcileWithdrawal(cliCtx.GetFromAddress(), crossChainId, args[1])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
+
+func GetCmdSubmitUpdateRedeemScriptProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-redeem-script [denom] [redeem_script]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a proposal to replace the redeem script of a btcx denom",
+		Long:  "Submit an update redeem script proposal along with an initial deposit.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+			from := cliCtx.GetFromAddress()
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoins(depositStr)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewUpdateRedeemScriptProposal(title, description, args[0], args[1])
+			msg := gov.NewMsgSubmitProposal(content, deposit, from)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
@@ -20,6 +20,7 @@ package common
 import (
 	"fmt"
 	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/polynetwork/cosmos-poly-module/btcx/internal/types"
 )
 
@@ -40,3 +41,75 @@ func QueryDenomCrossChainInfo(cliCtx context.CLIContext, queryRoute string, deno
 	)
 	return res, err
 }
+
+func QueryBindingHistory(cliCtx context.CLIContext, queryRoute string, denom string, chainId uint64, page, limit int) ([]byte, error) {
+
+	res, _, err := cliCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryBindingHistory),
+		cliCtx.Codec.MustMarshalJSON(types.NewQueryBindingHistoryParam(denom, chainId, page, limit)),
+	)
+	return res, err
+}
+
+func QueryParams(cliCtx context.CLIContext, queryRoute string) ([]byte, error) {
+
+	res, _, err := cliCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryParameters),
+		nil,
+	)
+	return res, err
+}
+
+func QueryRedeemScripts(cliCtx context.CLIContext, queryRoute string, denom string) ([]byte, error) {
+
+	res, _, err := cliCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryRedeemScripts),
+		cliCtx.Codec.MustMarshalJSON(types.NewQueryRedeemScriptsParam(denom)),
+	)
+	return res, err
+}
+
+func QueryRedeemScriptInfo(cliCtx context.CLIContext, queryRoute string, denom string) ([]byte, error) {
+
+	res, _, err := cliCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryRedeemScriptInfo),
+		cliCtx.Codec.MustMarshalJSON(types.NewQueryRedeemScriptInfoParam(denom)),
+	)
+	return res, err
+}
+
+func QueryWithdrawal(cliCtx context.CLIContext, queryRoute string, crossChainId uint64) ([]byte, error) {
+
+	res, _, err := cliCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryWithdrawal),
+		cliCtx.Codec.MustMarshalJSON(types.NewQueryWithdrawalParam(crossChainId)),
+	)
+	return res, err
+}
+
+func QueryWithdrawals(cliCtx context.CLIContext, queryRoute string, address sdk.AccAddress, page, limit int) ([]byte, error) {
+
+	res, _, err := cliCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryWithdrawals),
+		cliCtx.Codec.MustMarshalJSON(types.NewQueryWithdrawalsParam(address, page, limit)),
+	)
+	return res, err
+}
+
+func QueryAssetBindings(cliCtx context.CLIContext, queryRoute string, denom string, page, limit int) ([]byte, error) {
+
+	res, _, err := cliCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryAssetBindings),
+		cliCtx.Codec.MustMarshalJSON(types.NewQueryAssetBindingsParam(denom, page, limit)),
+	)
+	return res, err
+}
+
+func QueryDenomsByCreator(cliCtx context.CLIContext, queryRoute string, creator sdk.AccAddress, page, limit int) ([]byte, error) {
+
+	res, _, err := cliCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryDenomsByCreator),
+		cliCtx.Codec.MustMarshalJSON(types.NewQueryDenomsByCreatorParam(creator, page, limit)),
+	)
+	return res, err
+}
@@ -0,0 +1,96 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package rest
+
+import (
+	"fmt"
+
+	"github.com/gorilla/mux"
+
+	"github.com/polynetwork/cosmos-poly-module/btcx/internal/types"
+	"github.com/polynetwork/cosmos-poly-module/common"
+	"github.com/polynetwork/cosmos-poly-module/common/openapi"
+)
+
+func registerOpenAPIRoute(r *mux.Router) {
+	r.HandleFunc("/btcx/"+openapi.FileName, openapi.Handler("btcx REST API", openAPIRoutes())).Methods("GET")
+}
+
+// openAPIRoutes describes the routes of RegisterRoutes and the governance proposal route of btcx
+func openAPIRoutes() []openapi.Route {
+	chainIdParam := openapi.PathParam(ChainId, "integer", "poly chain id of the target chain")
+	addressParam := openapi.PathParam(Address, "string", "bech32 account address")
+	return []openapi.Route{
+		{Method: "GET", Path: fmt.Sprintf("/btcx/denom_info/{%s}", Denom), Summary: "Creator, supply and redeem script of the denom", Result: types.DenomInfo{}},
+		{
+			Method:  "GET",
+			Path:    fmt.Sprintf("/btcx/denom_cc_info/{%s}/{%s}", Denom, ChainId),
+			Summary: "Info of the denom along with its binding to the target chain",
+			Params:  []openapi.Param{chainIdParam},
+			Result:  types.DenomCrossChainInfo{},
+		},
+		{
+			Method:  "GET",
+			Path:    "/btcx/binding_history",
+			Summary: "Binding changes of the denoms, filtered by the optional parameters",
+			Params: append([]openapi.Param{
+				openapi.QueryParam(Denom, "string", "denom of the bindings"),
+				openapi.QueryParam(ChainId, "integer", "poly chain id of the target chain"),
+			}, openapi.PageParams()...),
+			Result: []common.BindingChange{},
+		},
+		{Method: "GET", Path: "/btcx/parameters", Summary: "Parameters of btcx module", Result: types.Params{}},
+		{Method: "GET", Path: fmt.Sprintf("/btcx/redeem_scripts/{%s}", Denom), Summary: "Current and former redeem scripts of the denom", Result: types.RedeemScripts{}},
+		{Method: "GET", Path: fmt.Sprintf("/btcx/redeem_script_info/{%s}", Denom), Summary: "Decoded redeem script of the denom", Result: types.RedeemScriptInfo{}},
+		{
+			Method:  "GET",
+			Path:    fmt.Sprintf("/btcx/withdrawal/{%s}", CrossChainId),
+			Summary: "Btc withdrawal created by the cross chain tx",
+			Params:  []openapi.Param{openapi.PathParam(CrossChainId, "integer", "cross chain id of the withdrawal")},
+			Result:  types.BtcWithdrawal{},
+		},
+		{
+			Method:  "GET",
+			Path:    fmt.Sprintf("/btcx/withdrawals/{%s}", Address),
+			Summary: "Btc withdrawals created by the address",
+			Params:  append([]openapi.Param{addressParam}, openapi.PageParams()...),
+			Result:  []types.BtcWithdrawal{},
+		},
+		{
+			Method:  "GET",
+			Path:    fmt.Sprintf("/btcx/asset_bindings/{%s}", Denom),
+			Summary: "Asset hashes bound to the denom per chain",
+			Params:  openapi.PageParams(),
+			Result:  []common.Binding{},
+		},
+		{
+			Method:  "GET",
+			Path:    fmt.Sprintf("/btcx/denoms_by_creator/{%s}", Creator),
+			Summary: "Denoms created by the address",
+			Params:  append([]openapi.Param{openapi.PathParam(Creator, "string", "bech32 account address")}, openapi.PageParams()...),
+			Result:  []string{},
+		},
+		{Method: "POST", Path: "/btcx/create_coin", Summary: "Create the denom of btc with its redeem script", Body: CreateCoinReq{}},
+		{Method: "POST", Path: "/btcx/bind_asset_hash", Summary: "Bind the asset hash of the target chain to a denom", Body: BindAssetHashReq{}},
+		{Method: "POST", Path: "/btcx/unbind_asset_hash", Summary: "Unbind the asset hash of the target chain from a denom", Body: UnbindAssetHashReq{}},
+		{Method: "POST", Path: "/btcx/lock", Summary: "Lock coins to cross them to the target chain", Body: LockReq{}},
+		{Method: "POST", Path: "/btcx/update_redeem_script", Summary: "Update the redeem script of a denom", Body: UpdateRedeemScriptReq{}},
+		{Method: "POST", Path: "/btcx/reconcile_withdrawal", Summary: "Confirm a btc withdrawal by its btc tx hash", Body: ReconcileWithdrawalReq{}},
+		{Method: "POST", Path: "/gov/proposals/update_redeem_script", Summary: "Submit a proposal updating the redeem script of a denom", Body: UpdateRedeemScriptProposalReq{}},
+	}
+}
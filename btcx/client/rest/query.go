@@ -20,12 +20,15 @@ package rest
 import (
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/gorilla/mux"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
 	"github.com/polynetwork/cosmos-poly-module/btcx/client/common"
+	polycommon "github.com/polynetwork/cosmos-poly-module/common"
 )
 
 func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router, queryRoute string) {
@@ -38,6 +41,46 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router, queryRoute st
 		fmt.Sprintf("/btcx/denom_cc_info/{%s}/{%s}", Denom, ChainId),
 		queryDemonWithChainIdHandlerFn(cliCtx, queryRoute),
 	).Methods("GET")
+
+	r.HandleFunc(
+		"/btcx/binding_history",
+		queryBindingHistoryHandlerFn(cliCtx, queryRoute),
+	).Methods("GET")
+
+	r.HandleFunc(
+		"/btcx/parameters",
+		queryParamsHandlerFn(cliCtx, queryRoute),
+	).Methods("GET")
+
+	r.HandleFunc(
+		fmt.Sprintf("/btcx/redeem_scripts/{%s}", Denom),
+		queryRedeemScriptsHandlerFn(cliCtx, queryRoute),
+	).Methods("GET")
+
+	r.HandleFunc(
+		fmt.Sprintf("/btcx/redeem_script_info/{%s}", Denom),
+		queryRedeemScriptInfoHandlerFn(cliCtx, queryRoute),
+	).Methods("GET")
+
+	r.HandleFunc(
+		fmt.Sprintf("/btcx/withdrawal/{%s}", CrossChainId),
+		queryWithdrawalHandlerFn(cliCtx, queryRoute),
+	).Methods("GET")
+
+	r.HandleFunc(
+		fmt.Sprintf("/btcx/withdrawals/{%s}", Address),
+		queryWithdrawalsHandlerFn(cliCtx, queryRoute),
+	).Methods("GET")
+
+	r.HandleFunc(
+		fmt.Sprintf("/btcx/asset_bindings/{%s}", Denom),
+		queryAssetBindingsHandlerFn(cliCtx, queryRoute),
+	).Methods("GET")
+
+	r.HandleFunc(
+		fmt.Sprintf("/btcx/denoms_by_creator/{%s}", Creator),
+		queryDenomsByCreatorHandlerFn(cliCtx, queryRoute),
+	).Methods("GET")
 }
 
 func queryDemonHandlerFn(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
@@ -101,3 +144,191 @@ func checkResponseQueryDenomCrossChainInfoResponse(
 
 	return res, true
 }
+
+// queryBindingHistoryHandlerFn accepts the optional denom and chain_id url query parameters as filters, along with
+// the optional page and limit
+func queryBindingHistoryHandlerFn(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		_, page, limit, err := rest.ParseHTTPArgsWithLimit(r, polycommon.DefaultPageLimit)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+		query := r.URL.Query()
+		var chainId uint64
+		if chainIdStr := query.Get(ChainId); chainIdStr != "" {
+			chainId, err = strconv.ParseUint(chainIdStr, 10, 64)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+
+		res, err := common.QueryBindingHistory(cliCtx, queryRoute, query.Get(Denom), chainId, page, limit)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func queryParamsHandlerFn(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+		res, err := common.QueryParams(cliCtx, queryRoute)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func queryRedeemScriptsHandlerFn(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+		vars := mux.Vars(r)
+
+		res, err := common.QueryRedeemScripts(cliCtx, queryRoute, vars[Denom])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func queryRedeemScriptInfoHandlerFn(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+		vars := mux.Vars(r)
+
+		res, err := common.QueryRedeemScriptInfo(cliCtx, queryRoute, vars[Denom])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func queryWithdrawalHandlerFn(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+		vars := mux.Vars(r)
+		crossChainId, err := strconv.ParseUint(vars[CrossChainId], 10, 64)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, err := common.QueryWithdrawal(cliCtx, queryRoute, crossChainId)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// queryWithdrawalsHandlerFn accepts the optional page and limit url query parameters
+func queryWithdrawalsHandlerFn(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		_, page, limit, err := rest.ParseHTTPArgsWithLimit(r, polycommon.DefaultPageLimit)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+		vars := mux.Vars(r)
+		address, err := sdk.AccAddressFromBech32(vars[Address])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, err := common.QueryWithdrawals(cliCtx, queryRoute, address, page, limit)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// queryAssetBindingsHandlerFn accepts the optional page and limit url query parameters
+func queryAssetBindingsHandlerFn(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		_, page, limit, err := rest.ParseHTTPArgsWithLimit(r, polycommon.DefaultPageLimit)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, err := common.QueryAssetBindings(cliCtx, queryRoute, mux.Vars(r)[Denom], page, limit)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// queryDenomsByCreatorHandlerFn accepts the optional page and limit url query parameters
+func queryDenomsByCreatorHandlerFn(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		_, page, limit, err := rest.ParseHTTPArgsWithLimit(r, polycommon.DefaultPageLimit)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+		creator, err := sdk.AccAddressFromBech32(mux.Vars(r)[Creator])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, err := common.QueryDenomsByCreator(cliCtx, queryRoute, creator, page, limit)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
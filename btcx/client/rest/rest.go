@@ -24,12 +24,16 @@ import (
 )
 
 const (
-	Denom   = "denom"
-	ChainId = "chain_id"
+	Creator      = "creator"
+	Denom        = "denom"
+	ChainId      = "chain_id"
+	CrossChainId = "cross_chain_id"
+	Address      = "address"
 )
 
 // RegisterRoutes registers btcx module REST handlers on the provided router.
 func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, queryRoute string) {
 	registerQueryRoutes(cliCtx, r, queryRoute)
 	registerTxRoutes(cliCtx, r)
+	registerOpenAPIRoute(r)
 }
@@ -26,22 +26,29 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
 	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
 
 	"github.com/polynetwork/cosmos-poly-module/btcx/internal/types"
+	"github.com/polynetwork/cosmos-poly-module/common"
 )
 
 // RegisterRoutes - Central function to define routes that get registered by the main application
 func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc("/btcx/create_coin", createCoinRequestHandlerFn(cliCtx)).Methods("POST")
 	r.HandleFunc("/btcx/bind_asset_hash", bindAssetHashRequestHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/btcx/unbind_asset_hash", unbindAssetHashRequestHandlerFn(cliCtx)).Methods("POST")
 	r.HandleFunc("/btcx/lock", lockRequestHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/btcx/update_redeem_script", updateRedeemScriptRequestHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/btcx/reconcile_withdrawal", reconcileWithdrawalRequestHandlerFn(cliCtx)).Methods("POST")
 
 }
 
 type CreateCoinReq struct {
-	BaseReq      rest.BaseReq `json:"base_req" yaml:"base_req"`
-	Denom        string       `json:"denom" yaml:"denom"`
-	RedeemScript string       `json:"redeem_script" yaml:"redeem_script"`
+	BaseReq      rest.BaseReq          `json:"base_req" yaml:"base_req"`
+	Denom        string                `json:"denom" yaml:"denom"`
+	RedeemScript string                `json:"redeem_script" yaml:"redeem_script"`
+	Metadata     *common.DenomMetadata `json:"metadata" yaml:"metadata"`
 }
 
 type BindAssetHashReq struct {
@@ -51,6 +58,24 @@ type BindAssetHashReq struct {
 	ToAssetHash []byte       `json:"to_asset_hash" yaml:"to_asset_hash"`
 }
 
+type UnbindAssetHashReq struct {
+	BaseReq   rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Denom     string       `json:"denom" yaml:"denom"`
+	ToChainId uint64       `json:"to_chain_id" yaml:"to_chain_id"`
+}
+
+type UpdateRedeemScriptReq struct {
+	BaseReq      rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Denom        string       `json:"denom" yaml:"denom"`
+	RedeemScript string       `json:"redeem_script" yaml:"redeem_script"`
+}
+
+type ReconcileWithdrawalReq struct {
+	BaseReq      rest.BaseReq `json:"base_req" yaml:"base_req"`
+	CrossChainId uint64       `json:"cross_chain_id" yaml:"cross_chain_id"`
+	BtcTxHash    string       `json:"btc_tx_hash" yaml:"btc_tx_hash"`
+}
+
 type LockReq struct {
 	BaseReq          rest.BaseReq `json:"base_req" yaml:"base_req"`
 	SourceAssetDenom string       `json:"source_asset_denom" yaml:"source_asset_denom"`
@@ -75,7 +100,7 @@ func createCoinRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
-		msg := types.NewMsgCreateDenom(fromAddr, req.Denom, req.RedeemScript)
+		msg := types.NewMsgCreateDenom(fromAddr, req.Denom, req.RedeemScript, req.Metadata)
 		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
 	}
 }
@@ -121,3 +146,107 @@ func lockRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
 	}
 }
+
+func unbindAssetHashRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req UnbindAssetHashReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		msg := types.NewMsgUnbindAssetHash(fromAddr, req.Denom, req.ToChainId)
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func updateRedeemScriptRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req UpdateRedeemScriptReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		msg := types.NewMsgUpdateRedeemScript(fromAddr, req.Denom, req.RedeemScript)
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func reconcileWithdrawalRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req ReconcileWithdrawalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		msg := types.NewMsgReconcileWithdrawal(fromAddr, req.CrossChainId, req.BtcTxHash)
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+// UpdateRedeemScriptProposalReq defines the properties of an update redeem script proposal request's body.
+type UpdateRedeemScriptProposalReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title        string         `json:"title" yaml:"title"`
+	Description  string         `json:"description" yaml:"description"`
+	Denom        string         `json:"denom" yaml:"denom"`
+	RedeemScript string         `json:"redeem_script" yaml:"redeem_script"`
+	Proposer     sdk.AccAddress `json:"proposer" yaml:"proposer"`
+	Deposit      sdk.Coins      `json:"deposit" yaml:"deposit"`
+}
+
+// UpdateRedeemScriptProposalRESTHandler returns a ProposalRESTHandler that exposes the update redeem script REST handler with a given sub-route.
+func UpdateRedeemScriptProposalRESTHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "update_redeem_script",
+		Handler:  postUpdateRedeemScriptProposalHandlerFn(cliCtx),
+	}
+}
+
+func postUpdateRedeemScriptProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req UpdateRedeemScriptProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewUpdateRedeemScriptProposal(req.Title, req.Description, req.Denom, req.RedeemScript)
+		msg := gov.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
@@ -33,8 +33,14 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			return handleMsgCreateDenom(ctx, k, msg)
 		case types.MsgBindAssetHash:
 			return handleMsgBindAssetHash(ctx, k, msg)
+		case types.MsgUnbindAssetHash:
+			return handleMsgUnbindAssetHash(ctx, k, msg)
 		case types.MsgLock:
 			return handleMsgLock(ctx, k, msg)
+		case types.MsgUpdateRedeemScript:
+			return handleMsgUpdateRedeemScript(ctx, k, msg)
+		case types.MsgReconcileWithdrawal:
+			return handleMsgReconcileWithdrawal(ctx, k, msg)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", types.ModuleName, msg)
 		}
@@ -43,7 +49,7 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 
 func handleMsgCreateDenom(ctx sdk.Context, k keeper.Keeper, msg types.MsgCreateDenom) (*sdk.Result, error) {
 
-	err := k.CreateDenom(ctx, msg.Creator, msg.Denom, msg.RedeemScript)
+	err := k.CreateDenom(ctx, msg.Creator, msg.Denom, msg.RedeemScript, msg.Metadata)
 	if err != nil {
 		return nil, err
 	}
@@ -76,6 +82,20 @@ func handleMsgBindAssetHash(ctx sdk.Context, k keeper.Keeper, msg types.MsgBindA
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
 
+func handleMsgUnbindAssetHash(ctx sdk.Context, k keeper.Keeper, msg types.MsgUnbindAssetHash) (*sdk.Result, error) {
+	if err := k.UnbindAssetHash(ctx, msg.Creator, msg.SourceAssetDenom, msg.ToChainId); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
 func handleMsgLock(ctx sdk.Context, k keeper.Keeper, msg types.MsgLock) (*sdk.Result, error) {
 
 	err := k.Lock(ctx, msg.FromAddress, msg.SourceAssetDenom, msg.ToChainId, msg.ToAddressBs, msg.Value)
@@ -91,3 +111,31 @@ func handleMsgLock(ctx sdk.Context, k keeper.Keeper, msg types.MsgLock) (*sdk.Re
 
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
+
+func handleMsgUpdateRedeemScript(ctx sdk.Context, k keeper.Keeper, msg types.MsgUpdateRedeemScript) (*sdk.Result, error) {
+	if err := k.UpdateRedeemScript(ctx, msg.Creator, msg.Denom, msg.RedeemScript); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgReconcileWithdrawal(ctx sdk.Context, k keeper.Keeper, msg types.MsgReconcileWithdrawal) (*sdk.Result, error) {
+	if err := k.ReconcileWithdrawal(ctx, msg.Creator, msg.CrossChainId, msg.BtcTxHash); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
@@ -0,0 +1,116 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/polynetwork/cosmos-poly-module/btcx/internal/types"
+	btcxpb "github.com/polynetwork/cosmos-poly-module/btcx/pb"
+	"github.com/polynetwork/cosmos-poly-module/common"
+)
+
+type queryServer struct {
+	k Keeper
+}
+
+var _ btcxpb.QueryServer = queryServer{}
+
+// NewQueryServer returns the gRPC query service of btcx module, it serves the legacy queries with typed messages
+func NewQueryServer(k Keeper) btcxpb.QueryServer {
+	return queryServer{k}
+}
+
+func (q queryServer) DenomInfo(goCtx context.Context, req *btcxpb.QueryDenomInfoRequest) (*btcxpb.QueryDenomInfoResponse, error) {
+	ctx := common.UnwrapSDKContext(goCtx)
+	return &btcxpb.QueryDenomInfoResponse{Info: types.DenomInfoToProto(*q.k.GetDenomInfo(ctx, req.Denom))}, nil
+}
+
+func (q queryServer) BindingHistory(goCtx context.Context, req *btcxpb.QueryBindingHistoryRequest) (*btcxpb.QueryBindingHistoryResponse, error) {
+	ctx := common.UnwrapSDKContext(goCtx)
+	page, limit := common.PageFromProto(req.Pagination)
+	changes := q.k.GetBindingHistory(ctx, req.Denom, req.ChainId, page, limit)
+	return &btcxpb.QueryBindingHistoryResponse{Changes: common.BindingChangesToProto(changes)}, nil
+}
+
+func (q queryServer) DenomCrossChainInfo(goCtx context.Context, req *btcxpb.QueryDenomCrossChainInfoRequest) (*btcxpb.QueryDenomCrossChainInfoResponse, error) {
+	ctx := common.UnwrapSDKContext(goCtx)
+	info := q.k.GetDenomCrossChainInfo(ctx, req.Denom, req.ChainId)
+	return &btcxpb.QueryDenomCrossChainInfoResponse{Info: types.DenomCrossChainInfoToProto(*info)}, nil
+}
+
+func (q queryServer) Params(goCtx context.Context, _ *btcxpb.QueryParamsRequest) (*btcxpb.QueryParamsResponse, error) {
+	ctx := common.UnwrapSDKContext(goCtx)
+	return &btcxpb.QueryParamsResponse{Params: types.ParamsToProto(q.k.GetParams(ctx))}, nil
+}
+
+func (q queryServer) RedeemScripts(goCtx context.Context, req *btcxpb.QueryRedeemScriptsRequest) (*btcxpb.QueryRedeemScriptsResponse, error) {
+	ctx := common.UnwrapSDKContext(goCtx)
+	scripts, err := q.k.GetRedeemScripts(ctx, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+	return types.RedeemScriptsToProto(scripts), nil
+}
+
+func (q queryServer) RedeemScriptInfo(goCtx context.Context, req *btcxpb.QueryRedeemScriptInfoRequest) (*btcxpb.QueryRedeemScriptInfoResponse, error) {
+	ctx := common.UnwrapSDKContext(goCtx)
+	info, err := q.k.GetRedeemScriptInfo(ctx, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+	return types.RedeemScriptInfoToProto(info), nil
+}
+
+func (q queryServer) Withdrawal(goCtx context.Context, req *btcxpb.QueryWithdrawalRequest) (*btcxpb.QueryWithdrawalResponse, error) {
+	ctx := common.UnwrapSDKContext(goCtx)
+	withdrawal, found := q.k.GetWithdrawal(ctx, req.CrossChainId)
+	if !found {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "withdrawal with crossChainId: %d does not exist", req.CrossChainId)
+	}
+	return &btcxpb.QueryWithdrawalResponse{Withdrawal: types.BtcWithdrawalToProto(withdrawal)}, nil
+}
+
+func (q queryServer) Withdrawals(goCtx context.Context, req *btcxpb.QueryWithdrawalsRequest) (*btcxpb.QueryWithdrawalsResponse, error) {
+	ctx := common.UnwrapSDKContext(goCtx)
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	page, limit := common.PageFromProto(req.Pagination)
+	return &btcxpb.QueryWithdrawalsResponse{Withdrawals: types.BtcWithdrawalsToProto(q.k.GetWithdrawalsByAddress(ctx, addr, page, limit))}, nil
+}
+
+func (q queryServer) AssetBindings(goCtx context.Context, req *btcxpb.QueryAssetBindingsRequest) (*btcxpb.QueryAssetBindingsResponse, error) {
+	ctx := common.UnwrapSDKContext(goCtx)
+	page, limit := common.PageFromProto(req.Pagination)
+	bindings := q.k.GetAssetBindings(ctx, req.Denom, page, limit)
+	return &btcxpb.QueryAssetBindingsResponse{Bindings: common.BindingsToProto(bindings)}, nil
+}
+
+func (q queryServer) DenomsByCreator(goCtx context.Context, req *btcxpb.QueryDenomsByCreatorRequest) (*btcxpb.QueryDenomsByCreatorResponse, error) {
+	ctx := common.UnwrapSDKContext(goCtx)
+	creator, err := sdk.AccAddressFromBech32(req.Creator)
+	if err != nil {
+		return nil, err
+	}
+	page, limit := common.PageFromProto(req.Pagination)
+	return &btcxpb.QueryDenomsByCreatorResponse{Denoms: q.k.GetDenomsByCreator(ctx, creator, page, limit)}, nil
+}
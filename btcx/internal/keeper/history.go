@@ -0,0 +1,52 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package keeper
+
+import (
+	"encoding/binary"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/polynetwork/cosmos-poly-module/common"
+)
+
+// recordBindingChange appends the change to the binding history, the height is filled with current block height
+func (k Keeper) recordBindingChange(ctx sdk.Context, change common.BindingChange) {
+	store := ctx.KVStore(k.storeKey)
+	var count uint64
+	if bz := store.Get(BindingChangeCountKey); bz != nil {
+		count = binary.BigEndian.Uint64(bz)
+	}
+	change.Height = ctx.BlockHeight()
+	store.Set(GetBindingChangeKey(change.Denom, change.ChainId, count), common.MustMarshalProto(common.BindingChangeToProto(change)))
+	store.Set(BindingChangeCountKey, sdk.Uint64ToBigEndian(count+1))
+}
+
+// GetBindingHistory returns the page-th page of the binding changes matching denom and chainId, empty denom and
+// zero chainId match any value. The changes are ordered by denom and chainId, and the changes of one binding in
+// the order they happened
+func (k Keeper) GetBindingHistory(ctx sdk.Context, denom string, chainId uint64, page, limit int) []common.BindingChange {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), GetBindingChangeIteratorPrefix(denom, chainId))
+	defer iterator.Close()
+
+	changes := make([]common.BindingChange, 0)
+	common.Paginate(iterator, page, limit, func(_, value []byte) bool {
+		return common.MustDecodeBindingChange(value).Match(nil, denom, chainId)
+	}, func(_, value []byte) {
+		changes = append(changes, common.MustDecodeBindingChange(value))
+	})
+	return changes
+}
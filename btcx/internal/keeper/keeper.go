@@ -21,11 +21,12 @@ import (
 	"bytes"
 	"encoding/hex"
 	"fmt"
-	"github.com/btcsuite/btcutil"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
 	"github.com/polynetwork/cosmos-poly-module/btcx/exported"
 	"github.com/polynetwork/cosmos-poly-module/btcx/internal/types"
+	"github.com/polynetwork/cosmos-poly-module/common"
 	polycommon "github.com/polynetwork/poly/common"
 	"github.com/tendermint/tendermint/libs/log"
 	"math"
@@ -37,16 +38,18 @@ import (
 type Keeper struct {
 	cdc          *codec.Codec
 	storeKey     sdk.StoreKey
+	paramSpace   params.Subspace
 	authKeeper   types.AccountKeeper
 	bankKeeper   types.BankKeeper
 	supplyKeeper types.SupplyKeeper
 	ccmKeeper    types.CCMKeeper
+	hooks        common.CrossChainHooks
 	exported.UnlockKeeper
 }
 
 // NewKeeper creates a new btcx Keeper instance
 func NewKeeper(
-	cdc *codec.Codec, key sdk.StoreKey, ak types.AccountKeeper, bk types.BankKeeper, supplyKeeper types.SupplyKeeper, ccmKeeper types.CCMKeeper) Keeper {
+	cdc *codec.Codec, key sdk.StoreKey, paramSpace params.Subspace, ak types.AccountKeeper, bk types.BankKeeper, supplyKeeper types.SupplyKeeper, ccmKeeper types.CCMKeeper) Keeper {
 
 	// ensure btcx module account is set
 	if addr := supplyKeeper.GetModuleAddress(types.ModuleName); addr == nil {
@@ -56,6 +59,7 @@ func NewKeeper(
 	return Keeper{
 		cdc:          cdc,
 		storeKey:     key,
+		paramSpace:   paramSpace.WithKeyTable(types.ParamKeyTable()),
 		authKeeper:   ak,
 		bankKeeper:   bk,
 		supplyKeeper: supplyKeeper,
@@ -63,15 +67,69 @@ func NewKeeper(
 	}
 }
 
+// GetParams returns the total set of btcx parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the total set of btcx parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetBtcNetwork returns mainnet if the param has not been set, e.g. for the chain upgraded without it
+func (k Keeper) GetBtcNetwork(ctx sdk.Context) string {
+	network := types.BtcNetworkMainnet
+	k.paramSpace.GetIfExists(ctx, types.KeyBtcNetwork, &network)
+	return network
+}
+
+// GetMinWithdrawAmount returns zero if the param has not been set, only the dust threshold is enforced then
+func (k Keeper) GetMinWithdrawAmount(ctx sdk.Context) uint64 {
+	var amount uint64
+	k.paramSpace.GetIfExists(ctx, types.KeyMinWithdrawAmount, &amount)
+	return amount
+}
+
+// ValidateBtcWithdrawal checks toAddr is a bitcoin address of the configured network and amount
+// is worth redeeming, neither below the dust threshold of toAddr nor below MinWithdrawAmount
+func (k Keeper) ValidateBtcWithdrawal(ctx sdk.Context, toAddr []byte, amount uint64) error {
+	addr, err := types.DecodeBtcAddress(string(toAddr), types.GetBtcNetParams(k.GetBtcNetwork(ctx)))
+	if err != nil {
+		return err
+	}
+	dust, err := types.DustThreshold(addr)
+	if err != nil {
+		return err
+	}
+	if amount < dust {
+		return fmt.Errorf("amount: %d is below the dust threshold: %d of btc address: %s", amount, dust, addr.EncodeAddress())
+	}
+	if min := k.GetMinWithdrawAmount(ctx); amount < min {
+		return fmt.Errorf("amount: %d is below the minimum withdraw amount: %d", amount, min)
+	}
+	return nil
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
 
-func (k Keeper) CreateDenom(ctx sdk.Context, creator sdk.AccAddress, denom string, redeemScript string) error {
+func (k Keeper) CreateDenom(ctx sdk.Context, creator sdk.AccAddress, denom string, redeemScript string, metadata *common.DenomMetadata) error {
 	if reason, exist := k.ccmKeeper.ExistDenom(ctx, denom); exist {
 		return types.ErrCreateDenom(fmt.Sprintf("denom:%s already exist, due to reason:%s", denom, reason))
 	}
+	if metadata != nil {
+		if metadata.Denom != denom {
+			return types.ErrCreateDenom(fmt.Sprintf("denom of metadata:%s should be %s", metadata.Denom, denom))
+		}
+		if err := metadata.ValidateBasic(); err != nil {
+			return types.ErrCreateDenom(fmt.Sprintf("invalid metadata, Error: %s", err))
+		}
+		k.ccmKeeper.SetDenomMetadata(ctx, *metadata)
+	}
 	k.ccmKeeper.SetDenomCreator(ctx, denom, creator)
 
 	redeemScriptBs, err := hex.DecodeString(redeemScript)
@@ -81,9 +139,7 @@ func (k Keeper) CreateDenom(ctx sdk.Context, creator sdk.AccAddress, denom strin
 	store := ctx.KVStore(k.storeKey)
 	store.Set(GetDenomToCreatorKey(denom), creator)
 
-	scriptHashBs := btcutil.Hash160(redeemScriptBs)
-	store.Set(GetCreatorDenomToScriptHashKey(creator, denom), scriptHashBs)
-	store.Set(GetScriptHashToRedeemScript(scriptHashBs), redeemScriptBs)
+	k.setRedeemScript(ctx, denom, redeemScriptBs, creator, nil)
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
@@ -101,10 +157,17 @@ func (k Keeper) BindAssetHash(ctx sdk.Context, creator sdk.AccAddress, sourceAss
 	if !k.ValidCreator(ctx, sourceAssetDenom, creator) {
 		return types.ErrBindAssetHash(fmt.Sprintf("BindAssetHash, creator is not valid, expect:%s, got:%s", k.ccmKeeper.GetDenomCreator(ctx, sourceAssetDenom).String(), creator.String()))
 	}
+	if info, found := k.ccmKeeper.GetChainInfo(ctx, toChainId); found {
+		if err := info.ValidateAssetHash(toAssetHash); err != nil {
+			return types.ErrBindAssetHash(err.Error())
+		}
+	}
 	store := ctx.KVStore(k.storeKey)
-	if !bytes.Equal(creator.Bytes(), store.Get(GetDenomToCreatorKey(sourceAssetDenom))) {
+	// btcx only records the original creator, the creator role may have been transferred in ccm since then
+	if !store.Has(GetDenomToCreatorKey(sourceAssetDenom)) {
 		return types.ErrBindAssetHash(fmt.Sprintf("BindAssetHash, creator: %s created Denom: %s, yet not in %s module", creator.String(), sourceAssetDenom, types.ModuleName))
 	}
+	oldAssetHash := store.Get(GetBindAssetHashKey([]byte(sourceAssetDenom), toChainId))
 	store.Set(GetBindAssetHashKey([]byte(sourceAssetDenom), toChainId), toAssetHash)
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
@@ -116,6 +179,44 @@ func (k Keeper) BindAssetHash(ctx sdk.Context, creator sdk.AccAddress, sourceAss
 			sdk.NewAttribute(types.AttributeKeyToAssetHash, hex.EncodeToString(toAssetHash)),
 		),
 	})
+	k.recordBindingChange(ctx, common.BindingChange{
+		BindingType: common.BindingTypeAsset,
+		Denom:       sourceAssetDenom,
+		ChainId:     toChainId,
+		OldValue:    oldAssetHash,
+		NewValue:    toAssetHash,
+		Operator:    creator,
+	})
+	return nil
+}
+
+func (k Keeper) UnbindAssetHash(ctx sdk.Context, creator sdk.AccAddress, sourceAssetDenom string, toChainId uint64) error {
+	if !k.ValidCreator(ctx, sourceAssetDenom, creator) {
+		return types.ErrUnbindAssetHash(fmt.Sprintf("creator is not valid, expect: %s, got: %s", k.ccmKeeper.GetDenomCreator(ctx, sourceAssetDenom).String(), creator.String()))
+	}
+	store := ctx.KVStore(k.storeKey)
+	oldAssetHash := store.Get(GetBindAssetHashKey([]byte(sourceAssetDenom), toChainId))
+	if len(oldAssetHash) == 0 {
+		return types.ErrUnbindAssetHash(fmt.Sprintf("denom: %s has not bound asset hash of toChainId: %d", sourceAssetDenom, toChainId))
+	}
+	store.Delete(GetBindAssetHashKey([]byte(sourceAssetDenom), toChainId))
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUnbindAsset,
+			sdk.NewAttribute(types.AttributeKeyCreator, creator.String()),
+			sdk.NewAttribute(types.AttributeKeySourceAssetDenom, sourceAssetDenom),
+			sdk.NewAttribute(types.AttributeKeyToChainId, strconv.FormatUint(toChainId, 10)),
+			sdk.NewAttribute(types.AttributeKeyToAssetHash, hex.EncodeToString(oldAssetHash)),
+		),
+	})
+	k.recordBindingChange(ctx, common.BindingChange{
+		BindingType: common.BindingTypeAsset,
+		Denom:       sourceAssetDenom,
+		ChainId:     toChainId,
+		OldValue:    oldAssetHash,
+		Operator:    creator,
+	})
 	return nil
 }
 
@@ -129,9 +230,21 @@ func (k Keeper) Lock(ctx sdk.Context, fromAddr sdk.AccAddress, sourceAssetDenom
 	if amount.GTE(sdk.NewIntFromUint64(math.MaxUint64)) {
 		return types.ErrLock(fmt.Sprintf("Invoke Lock of `btcx` module, amount: %s too big than MaxUint64", amount.String()))
 	}
+	if info, found := k.ccmKeeper.GetChainInfo(ctx, toChainId); found {
+		if err := info.ValidateAddress(toAddr); err != nil {
+			return types.ErrLock(err.Error())
+		}
+		// the amount is always serialized as uint64, yet it should fit in the amount of toChainId
+		if err := info.ValidateAmount(amount.BigInt()); err != nil {
+			return types.ErrLock(err.Error())
+		}
+	}
 	sink := polycommon.NewZeroCopySink(nil)
 	// construct args bytes
 	if toChainId == types.BtcChainId {
+		if err := k.ValidateBtcWithdrawal(ctx, toAddr, amount.BigInt().Uint64()); err != nil {
+			return types.ErrLock(err.Error())
+		}
 		creator := k.ccmKeeper.GetDenomCreator(ctx, sourceAssetDenom)
 		if creator.Empty() {
 			return types.ErrLock(fmt.Sprintf("Creator of denom: %s is Empty", sourceAssetDenom))
@@ -158,6 +271,11 @@ func (k Keeper) Lock(ctx sdk.Context, fromAddr sdk.AccAddress, sourceAssetDenom
 		}
 	}
 
+	// the cross chain id assigned to the withdrawal to btc below
+	crossChainId, err := k.ccmKeeper.GetCrossChainId(ctx)
+	if err != nil {
+		return types.ErrLock(fmt.Sprintf("Lock, GetCrossChainId Error:%s", err.Error()))
+	}
 	// invoke cross_chain_manager module to construct cosmos proof
 	if err := k.ccmKeeper.CreateCrossChainTx(ctx, fromAddr, toChainId, []byte(sourceAssetDenom), toAssetHash, "unlock", sink.Bytes()); err != nil {
 		return types.ErrLock(fmt.Sprintf("Lock, CreateCrossChainTx Error:%s", err.Error()))
@@ -177,11 +295,30 @@ func (k Keeper) Lock(ctx sdk.Context, fromAddr sdk.AccAddress, sourceAssetDenom
 			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
 		),
 	})
+	if toChainId == types.BtcChainId {
+		k.setWithdrawal(ctx, types.BtcWithdrawal{
+			CrossChainId: crossChainId.Uint64(),
+			Denom:        sourceAssetDenom,
+			FromAddress:  fromAddr,
+			ToBtcAddress: string(toAddr),
+			Amount:       amount.BigInt().Uint64(),
+			Status:       types.WithdrawalStatusPending,
+			Height:       ctx.BlockHeight(),
+		})
+	}
+	k.AfterLock(ctx, common.LockInfo{
+		Module:      types.ModuleName,
+		FromAddress: fromAddr,
+		Denom:       sourceAssetDenom,
+		ToChainId:   toChainId,
+		ToAssetHash: toAssetHash,
+		ToAddress:   toAddr,
+		Amount:      amount,
+	})
 	return nil
 }
 
 func (k Keeper) Unlock(ctx sdk.Context, fromChainId uint64, fromContractAddr sdk.AccAddress, toContractAddr []byte, argsBs []byte) error {
-
 	var args types.BTCArgs
 	if err := args.Deserialization(polycommon.NewZeroCopySource(argsBs)); err != nil {
 		return types.ErrUnLock(fmt.Sprintf("Deserialize args Error: %s", err))
@@ -210,6 +347,15 @@ func (k Keeper) Unlock(ctx sdk.Context, fromChainId uint64, fromContractAddr sdk
 			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
 		),
 	})
+	k.AfterUnlock(ctx, common.UnlockInfo{
+		Module:           types.ModuleName,
+		FromChainId:      fromChainId,
+		FromContractHash: fromContractAddr,
+		ToContractHash:   toContractAddr,
+		Denom:            toDenom,
+		ToAddress:        toAccAddr,
+		Amount:           amount,
+	})
 	return nil
 }
 
@@ -228,6 +374,9 @@ func (k Keeper) GetDenomInfo(ctx sdk.Context, denom string) *types.DenomInfo {
 	redeemHash := store.Get(GetCreatorDenomToScriptHashKey(store.Get(GetDenomToCreatorKey(denom)), denom))
 	denomInfo.RedeemScriptHash = hex.EncodeToString(redeemHash)
 	denomInfo.RedeemScipt = hex.EncodeToString(store.Get(GetScriptHashToRedeemScript(redeemHash)))
+	if md, found := k.ccmKeeper.GetDenomMetadata(ctx, denom); found {
+		denomInfo.Metadata = &md
+	}
 	return denomInfo
 }
 
@@ -20,11 +20,16 @@ package keeper_test
 import (
 	"encoding/hex"
 	"fmt"
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	supply "github.com/cosmos/cosmos-sdk/x/supply"
 	"github.com/polynetwork/cosmos-poly-module/btcx"
 	"github.com/polynetwork/cosmos-poly-module/btcx/internal/keeper"
 	"github.com/polynetwork/cosmos-poly-module/btcx/internal/types"
+	"github.com/polynetwork/cosmos-poly-module/common"
 	"github.com/polynetwork/cosmos-poly-module/simapp"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -73,7 +78,7 @@ func Test_btcx_MsgCreateDenom(t *testing.T) {
 		acc := app.AccountKeeper.NewAccountWithAddress(ctx, addr)
 		creator := acc.GetAddress()
 		require.Equal(t, addr, creator, fmt.Sprintf("expect: %s, got: %s", addr, creator))
-		err := app.BtcxKeeper.CreateDenom(ctx, creator, testCase.denom, testCase.redeemScrit)
+		err := app.BtcxKeeper.CreateDenom(ctx, creator, testCase.denom, testCase.redeemScrit, nil)
 		if testCase.expectSucceed {
 			require.Nil(t, err)
 		} else {
@@ -109,7 +114,7 @@ func Test_btcx_MsgBindAssetHash(t *testing.T) {
 	creator := acc.GetAddress()
 	redeemScript := "12345678"
 	require.Equal(t, addr, creator, fmt.Sprintf("expect: %s, got: %s", addr, creator))
-	err := app.BtcxKeeper.CreateDenom(ctx, creator, "btcx1", redeemScript)
+	err := app.BtcxKeeper.CreateDenom(ctx, creator, "btcx1", redeemScript, nil)
 	require.Nil(t, err)
 	invalidCreator := app.AccountKeeper.NewAccountWithAddress(ctx, sdk.AccAddress([]byte("invalidCreator"))).GetAddress()
 	testCases := []struct {
@@ -166,7 +171,7 @@ func Test_btcx_MsgLock(t *testing.T) {
 	denom := btcx1Coin.Denom
 	creator := sdk.AccAddress([]byte("creator"))
 
-	err = app.BtcxKeeper.CreateDenom(ctx, creator, denom, "12345678")
+	err = app.BtcxKeeper.CreateDenom(ctx, creator, denom, "12345678", nil)
 	require.Nil(t, err)
 
 	app.SupplyKeeper.SetSupply(ctx, supply.NewSupply(total.Add(btcx1Coin)))
@@ -207,3 +212,322 @@ func Test_btcx_MsgLock(t *testing.T) {
 	balance = app.BankKeeper.GetCoins(ctx, creator)
 	require.Equal(t, "97btcx1", balance.String(), "balnace of creator is not balanced")
 }
+
+func Test_btcx_BindingHistory(t *testing.T) {
+	app, ctx := createTestApp(true)
+	btcx_initSupply(t, app, ctx)
+
+	creator := sdk.AccAddress([]byte("creatorAddress123456"))
+	denom := "btcx1"
+	require.Nil(t, app.BtcxKeeper.CreateDenom(ctx, creator, denom, "12345678", nil))
+	require.True(t, types.ErrUnbindAssetHashType.Is(app.BtcxKeeper.UnbindAssetHash(ctx, creator, denom, 2)))
+
+	ctx = ctx.WithBlockHeight(5)
+	require.Nil(t, app.BtcxKeeper.BindAssetHash(ctx, creator, denom, 2, []byte{1, 2, 3, 4}))
+	require.Nil(t, app.BtcxKeeper.BindAssetHash(ctx, creator, denom, 3, []byte{1, 2, 3, 5}))
+	ctx = ctx.WithBlockHeight(6)
+	require.Nil(t, app.BtcxKeeper.BindAssetHash(ctx, creator, denom, 2, []byte{1, 2, 3, 6}))
+	require.Error(t, app.BtcxKeeper.UnbindAssetHash(ctx, sdk.AccAddress([]byte("invalidCreator")), denom, 2))
+	require.Nil(t, app.BtcxKeeper.UnbindAssetHash(ctx, creator, denom, 2))
+	require.Empty(t, app.BtcxKeeper.GetDenomCrossChainInfo(ctx, denom, 2).ToAssetHash)
+
+	querier := keeper.NewQuerier(app.BtcxKeeper)
+	query := abci.RequestQuery{
+		Path: fmt.Sprintf("custom/%s/%s", btcx.StoreKey, types.QueryBindingHistory),
+		Data: app.Codec().MustMarshalJSON(types.NewQueryBindingHistoryParam(denom, 2, 1, 0)),
+	}
+	bz, err := querier(ctx, []string{types.QueryBindingHistory}, query)
+	require.Nil(t, err)
+	var history []types.BindingChange
+	btcx.ModuleCdc.MustUnmarshalJSON(bz, &history)
+	require.Equal(t, 3, len(history))
+	require.Equal(t, []byte{1, 2, 3, 4}, history[1].OldValue)
+	require.Equal(t, []byte{1, 2, 3, 6}, history[1].NewValue)
+	require.Equal(t, int64(6), history[1].Height)
+	require.Equal(t, creator, history[1].Operator)
+	require.Equal(t, []byte{1, 2, 3, 6}, history[2].OldValue)
+	require.Empty(t, history[2].NewValue)
+	require.Equal(t, 4, len(app.BtcxKeeper.GetBindingHistory(ctx, "", 0, 1, 0)))
+	require.Equal(t, app.BtcxKeeper.GetBindingHistory(ctx, "", 0, 1, 0)[3:], app.BtcxKeeper.GetBindingHistory(ctx, "", 0, 2, 3))
+}
+
+func Test_btcx_TransferDenomCreator(t *testing.T) {
+	app, ctx := createTestApp(true)
+	btcx_initSupply(t, app, ctx)
+
+	creator := sdk.AccAddress([]byte("creatorAddress123456"))
+	newCreator := sdk.AccAddress([]byte("newCreatorAddress123"))
+	denom := "btcx1"
+	require.Nil(t, app.BtcxKeeper.CreateDenom(ctx, creator, denom, "12345678", nil))
+	require.Nil(t, app.CcmKeeper.ProposeDenomCreator(ctx, creator, denom, newCreator))
+	require.Nil(t, app.CcmKeeper.AcceptDenomCreator(ctx, newCreator, denom))
+
+	// btcx follows the creator kept by ccm
+	require.Error(t, app.BtcxKeeper.BindAssetHash(ctx, creator, denom, 2, []byte{1, 2, 3, 4}))
+	require.Nil(t, app.BtcxKeeper.BindAssetHash(ctx, newCreator, denom, 2, []byte{1, 2, 3, 4}))
+	info := app.BtcxKeeper.GetDenomInfo(ctx, denom)
+	require.Equal(t, newCreator.String(), info.Creator)
+	require.Equal(t, "12345678", info.RedeemScipt)
+}
+
+func Test_btcx_DenomMetadata(t *testing.T) {
+	app, ctx := createTestApp(true)
+	btcx_initSupply(t, app, ctx)
+
+	creator := sdk.AccAddress([]byte("creatorAddress123456"))
+	denom := "btcx1"
+	md := common.NewDenomMetadata(denom, 8, "BTC", "Bitcoin", 1, []byte{0x11})
+	require.Nil(t, app.BtcxKeeper.CreateDenom(ctx, creator, denom, "12345678", &md))
+	require.Equal(t, &md, app.BtcxKeeper.GetDenomInfo(ctx, denom).Metadata)
+
+	// btcx reports the metadata kept by ccm
+	md.Symbol = "xBTC"
+	require.Nil(t, app.CcmKeeper.UpdateDenomMetadata(ctx, creator, md))
+	require.Equal(t, "xBTC", app.BtcxKeeper.GetDenomInfo(ctx, denom).Metadata.Symbol)
+}
+
+func Test_btcx_BtcWithdrawal(t *testing.T) {
+	app, ctx := createTestApp(true)
+	btcx_initSupply(t, app, ctx)
+
+	creator := sdk.AccAddress([]byte("creatorAddress123456"))
+	denom := "btcx1"
+	require.Nil(t, app.BtcxKeeper.CreateDenom(ctx, creator, denom, "12345678", nil))
+	require.Nil(t, app.BtcxKeeper.BindAssetHash(ctx, creator, denom, types.BtcChainId, []byte{1, 2, 3, 4}))
+	coins := sdk.NewCoins(sdk.NewInt64Coin(denom, 100000))
+	app.SupplyKeeper.SetSupply(ctx, supply.NewSupply(app.SupplyKeeper.GetSupply(ctx).GetTotal().Add(coins...)))
+	_, err := app.BankKeeper.AddCoins(ctx, creator, coins)
+	require.Nil(t, err)
+	app.BtcxKeeper.SetParams(ctx, types.DefaultParams())
+
+	testCases := []struct {
+		toAddr        string
+		amount        int64
+		expectSucceed bool
+	}{
+		{"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2", 2000, true},
+		{"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN3", 2000, false},         // bad checksum
+		{"mipcBbFg9gMiCh81Kj8tqqdgoZub1ZJRfn", 2000, false},         // testnet address
+		{"bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4", 2000, true},  // p2wpkh
+		{"bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4", 1999, false}, // below the minimum withdraw amount
+		{"3J98t1WpEZ73CNmQviecrnyiWrnqRhWNLy", 3000, true},          // p2sh
+		{"", 3000, false},
+	}
+	for _, testCase := range testCases {
+		err := app.BtcxKeeper.Lock(ctx, creator, denom, types.BtcChainId, []byte(testCase.toAddr), sdk.NewInt(testCase.amount))
+		if testCase.expectSucceed {
+			require.Nil(t, err, testCase.toAddr)
+		} else {
+			require.True(t, types.ErrLockType.Is(err), testCase.toAddr)
+		}
+	}
+	require.Equal(t, sdk.NewInt(93000), app.BankKeeper.GetCoins(ctx, creator).AmountOf(denom))
+
+	// the dust threshold applies even without minimum withdraw amount
+	app.BtcxKeeper.SetParams(ctx, types.Params{BtcNetwork: types.BtcNetworkTestnet3, MinWithdrawAmount: 0})
+	require.Error(t, app.BtcxKeeper.Lock(ctx, creator, denom, types.BtcChainId, []byte("mipcBbFg9gMiCh81Kj8tqqdgoZub1ZJRfn"), sdk.NewInt(545)))
+	require.Nil(t, app.BtcxKeeper.Lock(ctx, creator, denom, types.BtcChainId, []byte("mipcBbFg9gMiCh81Kj8tqqdgoZub1ZJRfn"), sdk.NewInt(546)))
+	require.Error(t, app.BtcxKeeper.Lock(ctx, creator, denom, types.BtcChainId, []byte("1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"), sdk.NewInt(3000)))
+}
+
+func Test_btcx_RedeemScriptRotation(t *testing.T) {
+	app, ctx := createTestApp(true)
+	btcx_initSupply(t, app, ctx)
+
+	creator := sdk.AccAddress([]byte("creatorAddress123456"))
+	other := sdk.AccAddress([]byte("otherAddress12345678"))
+	denom := "btcx1"
+	require.Nil(t, app.BtcxKeeper.CreateDenom(ctx, creator, denom, "12345678", nil))
+
+	scriptA := multiSigScript(t, 1, 2)
+	scriptB := multiSigScript(t, 4, 3)
+	require.Error(t, app.BtcxKeeper.UpdateRedeemScript(ctx, other, denom, scriptA))
+	require.Error(t, app.BtcxKeeper.UpdateRedeemScript(ctx, creator, denom, "xyz"))
+	require.Error(t, app.BtcxKeeper.SetRedeemScript(ctx, "btcx2", scriptA))
+	// only a multisig script is accepted
+	require.Error(t, app.BtcxKeeper.UpdateRedeemScript(ctx, creator, denom, "abcdef"))
+	require.Error(t, app.BtcxKeeper.SetRedeemScript(ctx, denom, "51"))
+
+	require.Nil(t, app.BtcxKeeper.UpdateRedeemScript(ctx, creator, denom, scriptA))
+	require.Equal(t, scriptA, app.BtcxKeeper.GetDenomInfo(ctx, denom).RedeemScipt)
+	require.Error(t, app.BtcxKeeper.UpdateRedeemScript(ctx, creator, denom, scriptA))
+	require.Nil(t, app.BtcxKeeper.SetRedeemScript(ctx, denom, scriptB))
+
+	scripts, err := app.BtcxKeeper.GetRedeemScripts(ctx, denom)
+	require.Nil(t, err)
+	require.Equal(t, scriptB, scripts.CurrentRedeemScript)
+	scriptBBs, _ := hex.DecodeString(scriptB)
+	require.Equal(t, hex.EncodeToString(btcutil.Hash160(scriptBBs)), scripts.CurrentRedeemScriptHash)
+	require.Equal(t, 3, len(scripts.History))
+	require.Equal(t, "12345678", scripts.History[0].RedeemScript)
+	require.Equal(t, creator, scripts.History[1].Operator)
+	require.True(t, scripts.History[2].Operator.Empty())
+	require.Equal(t, scriptB, app.BtcxKeeper.GetDenomInfo(ctx, denom).RedeemScipt)
+}
+
+// multiSigScript returns the hex of a required of 3 multisig redeem script with the keys derived from seed
+func multiSigScript(t *testing.T, seed byte, required int) string {
+	pubKeys := make([]*btcutil.AddressPubKey, 0)
+	for i := seed; i < seed+3; i++ {
+		_, pub := btcec.PrivKeyFromBytes(btcec.S256(), []byte{i})
+		pubKey, err := btcutil.NewAddressPubKey(pub.SerializeCompressed(), &chaincfg.MainNetParams)
+		require.Nil(t, err)
+		pubKeys = append(pubKeys, pubKey)
+	}
+	redeemScript, err := txscript.MultiSigScript(pubKeys, required)
+	require.Nil(t, err)
+	return hex.EncodeToString(redeemScript)
+}
+
+func Test_btcx_RedeemScriptInfo(t *testing.T) {
+	app, ctx := createTestApp(true)
+	btcx_initSupply(t, app, ctx)
+
+	creator := sdk.AccAddress([]byte("creatorAddress123456"))
+	denom := "btcx1"
+	require.Nil(t, app.BtcxKeeper.CreateDenom(ctx, creator, denom, "12345678", nil))
+	_, err := app.BtcxKeeper.GetRedeemScriptInfo(ctx, denom) // 0x12 pushes more bytes than the script has
+	require.Error(t, err)
+	_, err = app.BtcxKeeper.GetRedeemScriptInfo(ctx, "btcx2")
+	require.Error(t, err)
+	require.Nil(t, app.BtcxKeeper.CreateDenom(ctx, creator, "btcx3", "51", nil))
+	info, err := app.BtcxKeeper.GetRedeemScriptInfo(ctx, "btcx3")
+	require.Nil(t, err)
+	require.Equal(t, "nonstandard", info.ScriptType)
+	require.Equal(t, 0, info.TotalKeys)
+
+	pubKeys := make([]*btcutil.AddressPubKey, 0)
+	for i := byte(1); i <= 3; i++ {
+		_, pub := btcec.PrivKeyFromBytes(btcec.S256(), []byte{i})
+		pubKey, err := btcutil.NewAddressPubKey(pub.SerializeCompressed(), &chaincfg.MainNetParams)
+		require.Nil(t, err)
+		pubKeys = append(pubKeys, pubKey)
+	}
+	redeemScript, err := txscript.MultiSigScript(pubKeys, 2)
+	require.Nil(t, err)
+	require.Nil(t, app.BtcxKeeper.SetRedeemScript(ctx, denom, hex.EncodeToString(redeemScript)))
+
+	querier := keeper.NewQuerier(app.BtcxKeeper)
+	query := abci.RequestQuery{
+		Path: fmt.Sprintf("custom/%s/%s", btcx.StoreKey, types.QueryRedeemScriptInfo),
+		Data: app.Codec().MustMarshalJSON(types.NewQueryRedeemScriptInfoParam(denom)),
+	}
+	bz, err := querier(ctx, []string{types.QueryRedeemScriptInfo}, query)
+	require.NoError(t, err)
+	btcx.ModuleCdc.MustUnmarshalJSON(bz, &info)
+
+	require.Equal(t, denom, info.Denom)
+	require.Equal(t, "multisig", info.ScriptType)
+	require.Equal(t, 2, info.RequiredSigs)
+	require.Equal(t, 3, info.TotalKeys)
+	for i, pubKey := range pubKeys {
+		require.Equal(t, hex.EncodeToString(pubKey.ScriptAddress()), info.PubKeys[i])
+	}
+	p2sh, err := btcutil.NewAddressScriptHash(redeemScript, &chaincfg.TestNet3Params)
+	require.Nil(t, err)
+	require.Equal(t, 2, len(info.Addresses))
+	require.Equal(t, types.BtcNetworkMainnet, info.Addresses[0].Network)
+	require.Equal(t, "3", info.Addresses[0].P2SH[:1])
+	require.Equal(t, "bc1q", info.Addresses[0].P2WSH[:4])
+	require.Equal(t, p2sh.EncodeAddress(), info.Addresses[1].P2SH)
+	require.Equal(t, "tb1q", info.Addresses[1].P2WSH[:4])
+}
+
+func Test_btcx_BtcWithdrawalRecords(t *testing.T) {
+	app, ctx := createTestApp(true)
+	btcx_initSupply(t, app, ctx)
+
+	creator := sdk.AccAddress([]byte("creatorAddress123456"))
+	other := sdk.AccAddress([]byte("otherAddress12345678"))
+	denom := "btcx1"
+	btcAssetHash := []byte{1, 2, 3, 4}
+	require.Nil(t, app.BtcxKeeper.CreateDenom(ctx, creator, denom, "12345678", nil))
+	require.Nil(t, app.BtcxKeeper.BindAssetHash(ctx, creator, denom, types.BtcChainId, btcAssetHash))
+	require.Nil(t, app.BtcxKeeper.BindAssetHash(ctx, creator, denom, 2, []byte{5, 6, 7, 8}))
+	coins := sdk.NewCoins(sdk.NewInt64Coin(denom, 100000))
+	app.SupplyKeeper.SetSupply(ctx, supply.NewSupply(app.SupplyKeeper.GetSupply(ctx).GetTotal().Add(coins...)))
+	_, err := app.BankKeeper.AddCoins(ctx, other, coins)
+	require.Nil(t, err)
+	app.BtcxKeeper.SetParams(ctx, types.DefaultParams())
+
+	toAddr := "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2"
+	require.Nil(t, app.BtcxKeeper.Lock(ctx, other, denom, types.BtcChainId, []byte(toAddr), sdk.NewInt(3000)))
+	require.Nil(t, app.BtcxKeeper.Lock(ctx, other, denom, 2, []byte("someAddress"), sdk.NewInt(3000)))
+	require.Nil(t, app.BtcxKeeper.Lock(ctx, other, denom, types.BtcChainId, []byte(toAddr), sdk.NewInt(4000)))
+
+	withdrawals := app.BtcxKeeper.GetWithdrawalsByAddress(ctx, other, 1, 0)
+	require.Equal(t, 2, len(withdrawals))
+	require.Equal(t, uint64(0), withdrawals[0].CrossChainId)
+	require.Equal(t, uint64(2), withdrawals[1].CrossChainId)
+	require.Equal(t, toAddr, withdrawals[1].ToBtcAddress)
+	require.Equal(t, uint64(4000), withdrawals[1].Amount)
+	require.Equal(t, types.WithdrawalStatusPending, withdrawals[1].Status)
+	require.Equal(t, withdrawals[1:], app.BtcxKeeper.GetWithdrawalsByAddress(ctx, other, 2, 1))
+	_, found := app.BtcxKeeper.GetWithdrawal(ctx, 1)
+	require.False(t, found)
+	require.Equal(t, 0, len(app.BtcxKeeper.GetWithdrawalsByAddress(ctx, creator, 1, 0)))
+
+	// reconciled by the denom creator only
+	btcTxHash := make([]byte, 32)
+	btcTxHash[0] = 0xab
+	require.Error(t, app.BtcxKeeper.ReconcileWithdrawal(ctx, other, 0, hex.EncodeToString(btcTxHash)))
+	require.Error(t, app.BtcxKeeper.ReconcileWithdrawal(ctx, creator, 1, hex.EncodeToString(btcTxHash)))
+	require.Error(t, app.BtcxKeeper.ReconcileWithdrawal(ctx, creator, 0, hex.EncodeToString(btcTxHash[:31])))
+	require.Nil(t, app.BtcxKeeper.ReconcileWithdrawal(ctx, creator, 0, hex.EncodeToString(btcTxHash)))
+	require.Error(t, app.BtcxKeeper.ReconcileWithdrawal(ctx, creator, 0, hex.EncodeToString(btcTxHash)))
+	withdrawal, found := app.BtcxKeeper.GetWithdrawal(ctx, 0)
+	require.True(t, found)
+	require.Equal(t, types.WithdrawalStatusConfirmed, withdrawal.Status)
+	require.Equal(t, hex.EncodeToString(btcTxHash), withdrawal.BtcTxHash)
+	require.Nil(t, app.BtcxKeeper.ReconcileWithdrawal(ctx, creator, 2, hex.EncodeToString(btcTxHash)))
+
+	querier := keeper.NewQuerier(app.BtcxKeeper)
+	query := abci.RequestQuery{
+		Path: fmt.Sprintf("custom/%s/%s", btcx.StoreKey, types.QueryWithdrawals),
+		Data: app.Codec().MustMarshalJSON(types.NewQueryWithdrawalsParam(other, 1, 0)),
+	}
+	bz, err := querier(ctx, []string{types.QueryWithdrawals}, query)
+	require.NoError(t, err)
+	btcx.ModuleCdc.MustUnmarshalJSON(bz, &withdrawals)
+	require.Equal(t, 2, len(withdrawals))
+	require.Equal(t, types.WithdrawalStatusConfirmed, withdrawals[1].Status)
+}
+
+func Test_btcx_Listing(t *testing.T) {
+	app, ctx := createTestApp(true)
+	btcx_initSupply(t, app, ctx)
+
+	creator := sdk.AccAddress([]byte("creatorAddress123456"))
+	other := sdk.AccAddress([]byte("otherAddress12345678"))
+	require.Nil(t, app.BtcxKeeper.CreateDenom(ctx, creator, "btcx1", "12345678", nil))
+	require.Nil(t, app.BtcxKeeper.CreateDenom(ctx, creator, "btcx10", "12345678", nil))
+	require.Nil(t, app.BtcxKeeper.CreateDenom(ctx, other, "btcx2", "12345678", nil))
+	require.Nil(t, app.BtcxKeeper.BindAssetHash(ctx, creator, "btcx1", 2, []byte{1, 2}))
+	require.Nil(t, app.BtcxKeeper.BindAssetHash(ctx, creator, "btcx1", 3, []byte{1, 3}))
+	require.Nil(t, app.BtcxKeeper.BindAssetHash(ctx, creator, "btcx10", 2, []byte{2, 2}))
+
+	querier := keeper.NewQuerier(app.BtcxKeeper)
+	query := abci.RequestQuery{
+		Path: fmt.Sprintf("custom/%s/%s", btcx.StoreKey, types.QueryAssetBindings),
+		Data: app.Codec().MustMarshalJSON(types.NewQueryAssetBindingsParam("btcx1", 1, 0)),
+	}
+	bz, err := querier(ctx, []string{types.QueryAssetBindings}, query)
+	require.Nil(t, err)
+	var bindings []types.Binding
+	btcx.ModuleCdc.MustUnmarshalJSON(bz, &bindings)
+	require.Equal(t, []types.Binding{{ChainId: 2, Hash: "0102"}, {ChainId: 3, Hash: "0103"}}, bindings)
+	require.Equal(t, []types.Binding{{ChainId: 3, Hash: "0103"}}, app.BtcxKeeper.GetAssetBindings(ctx, "btcx1", 2, 1))
+	require.Equal(t, []types.Binding{{ChainId: 2, Hash: "0202"}}, app.BtcxKeeper.GetAssetBindings(ctx, "btcx10", 1, 0))
+
+	query = abci.RequestQuery{
+		Path: fmt.Sprintf("custom/%s/%s", btcx.StoreKey, types.QueryDenomsByCreator),
+		Data: app.Codec().MustMarshalJSON(types.NewQueryDenomsByCreatorParam(creator, 1, 0)),
+	}
+	bz, err = querier(ctx, []string{types.QueryDenomsByCreator}, query)
+	require.Nil(t, err)
+	var denoms []string
+	btcx.ModuleCdc.MustUnmarshalJSON(bz, &denoms)
+	require.Equal(t, []string{"btcx1", "btcx10"}, denoms)
+	require.Equal(t, []string{"btcx10"}, app.BtcxKeeper.GetDenomsByCreator(ctx, creator, 2, 1))
+	require.Equal(t, []string{"btcx2"}, app.BtcxKeeper.GetDenomsByCreator(ctx, other, 1, 0))
+}
@@ -36,6 +36,11 @@ var (
 	DenomToCreatorPrefix           = []byte{0x04}
 	BindAssetHashPrefix            = []byte{0x05}
 	DenomToRedeemScriptKey         = []byte{0x06}
+	BindingChangePrefix            = []byte{0x07}
+	BindingChangeCountKey          = []byte{0x08}
+	RedeemScriptHistoryPrefix      = []byte{0x09}
+	WithdrawalPrefix               = []byte{0x0a}
+	AddressToWithdrawalPrefix      = []byte{0x0b}
 )
 
 // TODO: delete this method
@@ -66,3 +71,38 @@ func GetBindAssetHashKey(sourceDenomHash []byte, chainId uint64) []byte {
 	binary.LittleEndian.PutUint64(b, chainId)
 	return append(append(BindAssetHashPrefix, sourceDenomHash...), b...)
 }
+
+// GetBindingChangeKey orders the binding history by denom, chainId and index, denom is prefixed by its
+// length so that the history of a denom or of a denom and chainId can be prefix-iterated
+func GetBindingChangeKey(denom string, chainId uint64, index uint64) []byte {
+	return append(GetBindingChangeIteratorPrefix(denom, chainId), sdk.Uint64ToBigEndian(index)...)
+}
+
+// GetBindingChangeIteratorPrefix returns the longest key prefix shared by the binding history of denom and chainId,
+// empty denom and zero chainId match any value
+func GetBindingChangeIteratorPrefix(denom string, chainId uint64) []byte {
+	if denom == "" {
+		return BindingChangePrefix
+	}
+	prefix := append(append(append([]byte{}, BindingChangePrefix...), byte(len(denom))), []byte(denom)...)
+	if chainId == 0 {
+		return prefix
+	}
+	return append(prefix, sdk.Uint64ToBigEndian(chainId)...)
+}
+
+func GetRedeemScriptHistoryKey(denom string) []byte {
+	return append(RedeemScriptHistoryPrefix, []byte(denom)...)
+}
+
+func GetWithdrawalKey(crossChainId uint64) []byte {
+	return append(WithdrawalPrefix, sdk.Uint64ToBigEndian(crossChainId)...)
+}
+
+func GetAddressToWithdrawalPrefix(addr sdk.AccAddress) []byte {
+	return append(AddressToWithdrawalPrefix, addr...)
+}
+
+func GetAddressToWithdrawalKey(addr sdk.AccAddress, crossChainId uint64) []byte {
+	return append(GetAddressToWithdrawalPrefix(addr), sdk.Uint64ToBigEndian(crossChainId)...)
+}
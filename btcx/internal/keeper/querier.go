@@ -32,8 +32,24 @@ func NewQuerier(k Keeper) sdk.Querier {
 		switch path[0] {
 		case types.QueryDenomInfo:
 			return queryDenomInfo(ctx, req, k)
+		case types.QueryBindingHistory:
+			return queryBindingHistory(ctx, req, k)
 		case types.QueryDenomCrossChainInfo:
 			return queryDenomInfoWithId(ctx, req, k)
+		case types.QueryParameters:
+			return queryParams(ctx, k)
+		case types.QueryRedeemScripts:
+			return queryRedeemScripts(ctx, req, k)
+		case types.QueryRedeemScriptInfo:
+			return queryRedeemScriptInfo(ctx, req, k)
+		case types.QueryWithdrawal:
+			return queryWithdrawal(ctx, req, k)
+		case types.QueryWithdrawals:
+			return queryWithdrawals(ctx, req, k)
+		case types.QueryAssetBindings:
+			return queryAssetBindings(ctx, req, k)
+		case types.QueryDenomsByCreator:
+			return queryDenomsByCreator(ctx, req, k)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown query path: %s", path[0])
 		}
@@ -71,3 +87,133 @@ func queryDenomInfoWithId(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]b
 
 	return bz, nil
 }
+
+func queryBindingHistory(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryBindingHistoryParam
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONUnmarshal, "failed to parse params: %s", err)
+	}
+	changes := k.GetBindingHistory(ctx, params.Denom, params.ChainId, params.Page, params.Limit)
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, changes)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONMarshal, "could not marshal binding history to JSON: %s", err)
+	}
+
+	return bz, nil
+}
+
+func queryParams(ctx sdk.Context, k Keeper) ([]byte, error) {
+	params := k.GetParams(ctx)
+	bz, e := codec.MarshalJSONIndent(types.ModuleCdc, params)
+	if e != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONMarshal, "could not marshal value: %+v to JSON", params)
+	}
+
+	return bz, nil
+}
+
+func queryRedeemScripts(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryRedeemScriptsParam
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONUnmarshal, "failed to parse params: %s", err)
+	}
+	scripts, err := k.GetRedeemScripts(ctx, params.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, e := codec.MarshalJSONIndent(types.ModuleCdc, scripts)
+	if e != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONMarshal, "could not marshal RedeemScripts: %+v to JSON", scripts)
+	}
+
+	return bz, nil
+}
+
+func queryRedeemScriptInfo(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryRedeemScriptInfoParam
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONUnmarshal, "failed to parse params: %s", err)
+	}
+	info, err := k.GetRedeemScriptInfo(ctx, params.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, e := codec.MarshalJSONIndent(types.ModuleCdc, info)
+	if e != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONMarshal, "could not marshal RedeemScriptInfo: %+v to JSON", info)
+	}
+
+	return bz, nil
+}
+
+func queryWithdrawal(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryWithdrawalParam
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONUnmarshal, "failed to parse params: %s", err)
+	}
+	withdrawal, found := k.GetWithdrawal(ctx, params.CrossChainId)
+	if !found {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "withdrawal with crossChainId: %d does not exist", params.CrossChainId)
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, withdrawal)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONMarshal, "could not marshal BtcWithdrawal: %+v to JSON", withdrawal)
+	}
+
+	return bz, nil
+}
+
+func queryWithdrawals(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryWithdrawalsParam
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONUnmarshal, "failed to parse params: %s", err)
+	}
+	withdrawals := k.GetWithdrawalsByAddress(ctx, params.Address, params.Page, params.Limit)
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, withdrawals)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONMarshal, "could not marshal BtcWithdrawals: %+v to JSON", withdrawals)
+	}
+
+	return bz, nil
+}
+
+func queryAssetBindings(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryAssetBindingsParam
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONUnmarshal, "failed to parse params: %s", err)
+	}
+	bindings := k.GetAssetBindings(ctx, params.Denom, params.Page, params.Limit)
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, bindings)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONMarshal, "could not marshal asset bindings: %+v to JSON", bindings)
+	}
+
+	return bz, nil
+}
+
+func queryDenomsByCreator(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryDenomsByCreatorParam
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONUnmarshal, "failed to parse params: %s", err)
+	}
+	denoms := k.GetDenomsByCreator(ctx, params.Creator, params.Page, params.Limit)
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, denoms)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONMarshal, "could not marshal denoms: %+v to JSON", denoms)
+	}
+
+	return bz, nil
+}
@@ -0,0 +1,147 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package keeper
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/polynetwork/cosmos-poly-module/btcx/internal/types"
+	btcxpb "github.com/polynetwork/cosmos-poly-module/btcx/pb"
+	"github.com/polynetwork/cosmos-poly-module/common"
+)
+
+// UpdateRedeemScript replaces the redeem script of denom used by the withdrawals to btc, e.g. after the keys
+// of the Poly btc multisig rotate, it is only allowed for the creator of the denom
+func (k Keeper) UpdateRedeemScript(ctx sdk.Context, creator sdk.AccAddress, denom string, redeemScript string) error {
+	if !k.ValidCreator(ctx, denom, creator) {
+		return types.ErrUpdateRedeemScript(fmt.Sprintf("creator is not valid, expect: %s, got: %s", k.ccmKeeper.GetDenomCreator(ctx, denom).String(), creator.String()))
+	}
+	return k.updateRedeemScript(ctx, denom, redeemScript, creator)
+}
+
+// SetRedeemScript replaces the redeem script of denom through governance
+func (k Keeper) SetRedeemScript(ctx sdk.Context, denom string, redeemScript string) error {
+	return k.updateRedeemScript(ctx, denom, redeemScript, nil)
+}
+
+func (k Keeper) updateRedeemScript(ctx sdk.Context, denom string, redeemScript string, operator sdk.AccAddress) error {
+	redeemScriptBs, err := hex.DecodeString(redeemScript)
+	if err != nil || len(redeemScriptBs) == 0 {
+		return types.ErrUpdateRedeemScript(fmt.Sprintf("invalid redeemScript: %s, Error: %v", redeemScript, err))
+	}
+	// the withdrawals are signed by the Poly btc multisig, a script of any other class would lock them forever
+	info, err := types.ParseRedeemScript(redeemScriptBs)
+	if err != nil {
+		return types.ErrUpdateRedeemScript(err.Error())
+	}
+	if info.ScriptType != txscript.MultiSigTy.String() {
+		return types.ErrUpdateRedeemScript(fmt.Sprintf("redeemScript: %s is of class %s, expect: %s", redeemScript, info.ScriptType, txscript.MultiSigTy.String()))
+	}
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has(GetDenomToCreatorKey(denom)) {
+		return types.ErrUpdateRedeemScript(fmt.Sprintf("denom: %s has not been created in %s module", denom, types.ModuleName))
+	}
+	history := k.GetRedeemScriptHistory(ctx, denom)
+	if len(history) != 0 && history[len(history)-1].RedeemScript == hex.EncodeToString(redeemScriptBs) {
+		return types.ErrUpdateRedeemScript(fmt.Sprintf("redeemScript: %s is already the current one of denom: %s", redeemScript, denom))
+	}
+	k.setRedeemScript(ctx, denom, redeemScriptBs, operator, history)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUpdateRedeemScript,
+			sdk.NewAttribute(types.AttributeKeySourceAssetDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyRedeemScript, hex.EncodeToString(redeemScriptBs)),
+			sdk.NewAttribute(types.AttributeKeyRedeemScriptHash, hex.EncodeToString(btcutil.Hash160(redeemScriptBs))),
+			sdk.NewAttribute(types.AttributeKeyOperator, operator.String()),
+		),
+	})
+	return nil
+}
+
+// setRedeemScript makes redeemScriptBs the current redeem script of denom and appends it to history
+func (k Keeper) setRedeemScript(ctx sdk.Context, denom string, redeemScriptBs []byte, operator sdk.AccAddress, history []types.RedeemScriptRecord) {
+	store := ctx.KVStore(k.storeKey)
+	scriptHashBs := btcutil.Hash160(redeemScriptBs)
+	// the key only depends on denom, the creator role may have been transferred anyway
+	store.Set(GetCreatorDenomToScriptHashKey(nil, denom), scriptHashBs)
+	store.Set(GetScriptHashToRedeemScript(scriptHashBs), redeemScriptBs)
+
+	history = append(history, types.RedeemScriptRecord{
+		RedeemScript:     hex.EncodeToString(redeemScriptBs),
+		RedeemScriptHash: hex.EncodeToString(scriptHashBs),
+		Height:           ctx.BlockHeight(),
+		Operator:         operator,
+	})
+	store.Set(GetRedeemScriptHistoryKey(denom), common.MustMarshalProto(types.RedeemScriptHistoryToProto(history)))
+}
+
+// GetRedeemScriptHistory returns the redeem scripts of denom from the oldest to the current one, the denoms
+// created before the history was recorded start with their current redeem script at height zero
+func (k Keeper) GetRedeemScriptHistory(ctx sdk.Context, denom string) []types.RedeemScriptRecord {
+	store := ctx.KVStore(k.storeKey)
+	history := make([]types.RedeemScriptRecord, 0)
+	if bz := store.Get(GetRedeemScriptHistoryKey(denom)); bz != nil {
+		var pb btcxpb.RedeemScriptHistory
+		common.MustUnmarshalProto(bz, &pb)
+		return append(history, types.RedeemScriptHistoryFromProto(&pb)...)
+	}
+	scriptHashBs := store.Get(GetCreatorDenomToScriptHashKey(nil, denom))
+	if len(scriptHashBs) == 0 {
+		return history
+	}
+	return append(history, types.RedeemScriptRecord{
+		RedeemScript:     hex.EncodeToString(store.Get(GetScriptHashToRedeemScript(scriptHashBs))),
+		RedeemScriptHash: hex.EncodeToString(scriptHashBs),
+		Operator:         store.Get(GetDenomToCreatorKey(denom)),
+	})
+}
+
+// GetRedeemScripts returns the current redeem script of denom along with the history
+func (k Keeper) GetRedeemScripts(ctx sdk.Context, denom string) (types.RedeemScripts, error) {
+	store := ctx.KVStore(k.storeKey)
+	scriptHashBs := store.Get(GetCreatorDenomToScriptHashKey(nil, denom))
+	if len(scriptHashBs) == 0 {
+		return types.RedeemScripts{}, types.ErrInvalidRedeemScript(fmt.Sprintf("denom: %s has no redeem script in %s module", denom, types.ModuleName))
+	}
+	return types.RedeemScripts{
+		Denom:                   denom,
+		CurrentRedeemScript:     hex.EncodeToString(store.Get(GetScriptHashToRedeemScript(scriptHashBs))),
+		CurrentRedeemScriptHash: hex.EncodeToString(scriptHashBs),
+		History:                 k.GetRedeemScriptHistory(ctx, denom),
+	}, nil
+}
+
+// GetRedeemScriptInfo parses the current redeem script of denom
+func (k Keeper) GetRedeemScriptInfo(ctx sdk.Context, denom string) (types.RedeemScriptInfo, error) {
+	store := ctx.KVStore(k.storeKey)
+	scriptHashBs := store.Get(GetCreatorDenomToScriptHashKey(nil, denom))
+	if len(scriptHashBs) == 0 {
+		return types.RedeemScriptInfo{}, types.ErrInvalidRedeemScript(fmt.Sprintf("denom: %s has no redeem script in %s module", denom, types.ModuleName))
+	}
+	info, err := types.ParseRedeemScript(store.Get(GetScriptHashToRedeemScript(scriptHashBs)))
+	if err != nil {
+		return types.RedeemScriptInfo{}, types.ErrInvalidRedeemScript(err.Error())
+	}
+	info.Denom = denom
+	return info, nil
+}
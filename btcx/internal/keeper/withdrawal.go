@@ -0,0 +1,105 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package keeper
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/polynetwork/cosmos-poly-module/btcx/internal/types"
+	btcxpb "github.com/polynetwork/cosmos-poly-module/btcx/pb"
+	"github.com/polynetwork/cosmos-poly-module/common"
+)
+
+// setWithdrawal records the withdrawal to btc created by Lock as pending
+func (k Keeper) setWithdrawal(ctx sdk.Context, withdrawal types.BtcWithdrawal) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetWithdrawalKey(withdrawal.CrossChainId), common.MustMarshalProto(types.BtcWithdrawalToProto(withdrawal)))
+	store.Set(GetAddressToWithdrawalKey(withdrawal.FromAddress, withdrawal.CrossChainId), []byte{0x01})
+}
+
+// GetWithdrawal returns the withdrawal to btc with crossChainId
+func (k Keeper) GetWithdrawal(ctx sdk.Context, crossChainId uint64) (types.BtcWithdrawal, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(GetWithdrawalKey(crossChainId))
+	if bz == nil {
+		return types.BtcWithdrawal{}, false
+	}
+	var pb btcxpb.BtcWithdrawal
+	common.MustUnmarshalProto(bz, &pb)
+	return types.BtcWithdrawalFromProto(&pb), true
+}
+
+// GetWithdrawalsByAddress returns the page-th page of the withdrawals to btc of addr in the order they were created
+func (k Keeper) GetWithdrawalsByAddress(ctx sdk.Context, addr sdk.AccAddress, page, limit int) []types.BtcWithdrawal {
+	prefix := GetAddressToWithdrawalPrefix(addr)
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer iterator.Close()
+
+	withdrawals := make([]types.BtcWithdrawal, 0)
+	common.Paginate(iterator, page, limit, nil, func(key, _ []byte) {
+		if withdrawal, found := k.GetWithdrawal(ctx, binary.BigEndian.Uint64(key[len(prefix):])); found {
+			withdrawals = append(withdrawals, withdrawal)
+		}
+	})
+	return withdrawals
+}
+
+// ReconcileWithdrawal confirms the pending withdrawal with crossChainId paid by btcTxHash, it is only allowed
+// for the creator of the withdrawn denom. Poly relays nothing back from btc chain once the btc tx is paid, so
+// the btc tx is not verified here: the confirmed status and btcTxHash are only the attestation of the creator,
+// and whoever relies on them should check the btc tx on btc chain
+func (k Keeper) ReconcileWithdrawal(ctx sdk.Context, creator sdk.AccAddress, crossChainId uint64, btcTxHash string) error {
+	withdrawal, found := k.GetWithdrawal(ctx, crossChainId)
+	if !found {
+		return types.ErrConfirmWithdrawal(fmt.Sprintf("withdrawal with crossChainId: %d does not exist", crossChainId))
+	}
+	if !k.ValidCreator(ctx, withdrawal.Denom, creator) {
+		return types.ErrConfirmWithdrawal(fmt.Sprintf("creator is not valid, expect: %s, got: %s", k.ccmKeeper.GetDenomCreator(ctx, withdrawal.Denom).String(), creator.String()))
+	}
+	btcTxHashBs, err := hex.DecodeString(btcTxHash)
+	if err != nil {
+		return types.ErrConfirmWithdrawal(fmt.Sprintf("invalid btcTxHash: %s, Error: %v", btcTxHash, err))
+	}
+	return k.confirmWithdrawal(ctx, withdrawal, btcTxHashBs)
+}
+
+func (k Keeper) confirmWithdrawal(ctx sdk.Context, withdrawal types.BtcWithdrawal, btcTxHash []byte) error {
+	if withdrawal.Status != types.WithdrawalStatusPending {
+		return types.ErrConfirmWithdrawal(fmt.Sprintf("withdrawal with crossChainId: %d is already %s", withdrawal.CrossChainId, withdrawal.Status))
+	}
+	if len(btcTxHash) != 32 {
+		return types.ErrConfirmWithdrawal(fmt.Sprintf("btcTxHash: %x should be 32 bytes", btcTxHash))
+	}
+	withdrawal.Status = types.WithdrawalStatusConfirmed
+	withdrawal.BtcTxHash = hex.EncodeToString(btcTxHash)
+	withdrawal.ConfirmedHeight = ctx.BlockHeight()
+	k.setWithdrawal(ctx, withdrawal)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeConfirmWithdrawal,
+			sdk.NewAttribute(types.AttributeKeyCrossChainId, strconv.FormatUint(withdrawal.CrossChainId, 10)),
+			sdk.NewAttribute(types.AttributeKeySourceAssetDenom, withdrawal.Denom),
+			sdk.NewAttribute(types.AttributeKeyBtcTxHash, withdrawal.BtcTxHash),
+		),
+	})
+	return nil
+}
@@ -0,0 +1,112 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+)
+
+// DefaultMinRelayTxFee is the minimum relay fee in satoshis per kB of btcd and bitcoind,
+// the outputs not worth a third of the fee spending them are dust and never relayed
+const DefaultMinRelayTxFee = 1000
+
+// DecodeBtcAddress decodes addr as a P2PKH, P2SH, P2WPKH or P2WSH address of net
+func DecodeBtcAddress(addr string, net *chaincfg.Params) (btcutil.Address, error) {
+	decoded, err := btcutil.DecodeAddress(addr, net)
+	if err != nil {
+		return nil, fmt.Errorf("decode btc address: %s, err: %v", addr, err)
+	}
+	if !decoded.IsForNet(net) {
+		return nil, fmt.Errorf("btc address: %s is not for network: %s", addr, net.Name)
+	}
+	switch decoded.(type) {
+	case *btcutil.AddressPubKeyHash, *btcutil.AddressScriptHash, *btcutil.AddressWitnessPubKeyHash, *btcutil.AddressWitnessScriptHash:
+	default:
+		return nil, fmt.Errorf("unsupported type: %T of btc address: %s", decoded, addr)
+	}
+	return decoded, nil
+}
+
+// DustThreshold returns the minimum satoshis an output paying to addr should hold to be relayed,
+// following the dust rule of btcd mempool with DefaultMinRelayTxFee
+func DustThreshold(addr btcutil.Address) (uint64, error) {
+	pkScript, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		return 0, err
+	}
+	// the size of the output and of the input spending it later
+	totalSize := wire.NewTxOut(0, pkScript).SerializeSize() + 41
+	switch addr.(type) {
+	case *btcutil.AddressWitnessPubKeyHash, *btcutil.AddressWitnessScriptHash:
+		totalSize += 107 / 4
+	default:
+		totalSize += 107
+	}
+	return uint64(3 * totalSize * DefaultMinRelayTxFee / 1000), nil
+}
+
+// ParseRedeemScript describes redeemScript with the addresses paying to it on mainnet and testnet3,
+// the standard scripts such as multisig also report their threshold and public keys
+func ParseRedeemScript(redeemScript []byte) (RedeemScriptInfo, error) {
+	if len(redeemScript) == 0 {
+		return RedeemScriptInfo{}, fmt.Errorf("redeem script is empty")
+	}
+	class, addrs, requiredSigs, err := txscript.ExtractPkScriptAddrs(redeemScript, &chaincfg.MainNetParams)
+	if err != nil {
+		return RedeemScriptInfo{}, fmt.Errorf("parse redeem script: %x, err: %v", redeemScript, err)
+	}
+	info := RedeemScriptInfo{
+		RedeemScript:     hex.EncodeToString(redeemScript),
+		RedeemScriptHash: hex.EncodeToString(btcutil.Hash160(redeemScript)),
+		ScriptType:       class.String(),
+		RequiredSigs:     requiredSigs,
+		PubKeys:          make([]string, 0),
+	}
+	for _, addr := range addrs {
+		if pubKey, ok := addr.(*btcutil.AddressPubKey); ok {
+			info.PubKeys = append(info.PubKeys, hex.EncodeToString(pubKey.ScriptAddress()))
+		}
+	}
+	info.TotalKeys = len(info.PubKeys)
+
+	witnessProgram := sha256.Sum256(redeemScript)
+	for _, net := range []string{BtcNetworkMainnet, BtcNetworkTestnet3} {
+		netParams := GetBtcNetParams(net)
+		p2sh, err := btcutil.NewAddressScriptHash(redeemScript, netParams)
+		if err != nil {
+			return RedeemScriptInfo{}, err
+		}
+		p2wsh, err := btcutil.NewAddressWitnessScriptHash(witnessProgram[:], netParams)
+		if err != nil {
+			return RedeemScriptInfo{}, err
+		}
+		info.Addresses = append(info.Addresses, RedeemScriptAddress{
+			Network: net,
+			P2SH:    p2sh.EncodeAddress(),
+			P2WSH:   p2wsh.EncodeAddress(),
+		})
+	}
+	return info, nil
+}
@@ -27,7 +27,10 @@ var ModuleCdc *codec.Codec
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgCreateDenom{}, ModuleName+"/MsgCreateDenoms", nil)
 	cdc.RegisterConcrete(MsgBindAssetHash{}, ModuleName+"/MsgBindAssetHash", nil)
+	cdc.RegisterConcrete(MsgUnbindAssetHash{}, ModuleName+"/MsgUnbindAssetHash", nil)
 	cdc.RegisterConcrete(MsgLock{}, ModuleName+"/MsgLock", nil)
+	cdc.RegisterConcrete(MsgUpdateRedeemScript{}, ModuleName+"/MsgUpdateRedeemScript", nil)
+	cdc.RegisterConcrete(MsgReconcileWithdrawal{}, ModuleName+"/MsgReconcileWithdrawal", nil)
 
 }
 
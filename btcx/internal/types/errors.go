@@ -32,6 +32,9 @@ var (
 	ErrUnLockType              = sdkerrors.Register(ModuleName, 7, "ErrUnLockType")
 	ErrBurnCoinsType           = sdkerrors.Register(ModuleName, 8, "ErrBurnCoinsType")
 	ErrMintCoinsType           = sdkerrors.Register(ModuleName, 9, "ErrMintCoinsType")
+	ErrUnbindAssetHashType     = sdkerrors.Register(ModuleName, 10, "ErrUnbindAssetHashType")
+	ErrUpdateRedeemScriptType  = sdkerrors.Register(ModuleName, 11, "ErrUpdateRedeemScriptType")
+	ErrConfirmWithdrawalType   = sdkerrors.Register(ModuleName, 12, "ErrConfirmWithdrawalType")
 )
 
 func ErrInvalidChainId(chainId uint64) error {
@@ -67,3 +70,15 @@ func ErrBurnCoins(reason string) error {
 func ErrMintCoins(reason string) error {
 	return sdkerrors.Wrapf(ErrMintCoinsType, "Reason: %s", reason)
 }
+
+func ErrUnbindAssetHash(reason string) error {
+	return sdkerrors.Wrapf(ErrUnbindAssetHashType, fmt.Sprintf("%s", reason))
+}
+
+func ErrUpdateRedeemScript(reason string) error {
+	return sdkerrors.Wrapf(ErrUpdateRedeemScriptType, "Reason: %s", reason)
+}
+
+func ErrConfirmWithdrawal(reason string) error {
+	return sdkerrors.Wrapf(ErrConfirmWithdrawalType, "Reason: %s", reason)
+}
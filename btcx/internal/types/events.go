@@ -26,6 +26,7 @@ const (
 	AttributeKeyFromAssetHash    = "from_asset_hash"
 	AttributeKeyRedeemScript     = "redeem_script"
 
+	EventTypeUnbindAsset    = "unbind_asset_hash"
 	EventTypeBindAsset      = "bind_asset_hash"
 	AttributeKeyCreator     = "creator"
 	AttributeKeyToChainId   = "to_chain_id"
@@ -37,4 +38,12 @@ const (
 	AttributeKeyAmount      = "amount"
 
 	EventTypeUnlock = "unlock"
+
+	EventTypeUpdateRedeemScript  = "update_redeem_script"
+	AttributeKeyRedeemScriptHash = "redeem_script_hash"
+	AttributeKeyOperator         = "operator"
+
+	EventTypeConfirmWithdrawal = "confirm_withdrawal"
+	AttributeKeyCrossChainId   = "cross_chain_id"
+	AttributeKeyBtcTxHash      = "btc_tx_hash"
 )
@@ -22,6 +22,7 @@ import (
 	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
 	"github.com/cosmos/cosmos-sdk/x/supply/exported"
 	supplyexported "github.com/cosmos/cosmos-sdk/x/supply/exported"
+	"github.com/polynetwork/cosmos-poly-module/common"
 )
 
 type AccountKeeper interface {
@@ -60,7 +61,12 @@ type BankKeeper interface {
 
 type CCMKeeper interface {
 	CreateCrossChainTx(ctx sdk.Context, fromAddr sdk.AccAddress, toChainId uint64, fromContractHash, toContractHash []byte, method string, args []byte) error
+	GetCrossChainId(ctx sdk.Context) (sdk.Int, error)
 	SetDenomCreator(ctx sdk.Context, denom string, creator sdk.AccAddress)
 	GetDenomCreator(ctx sdk.Context, denom string) sdk.AccAddress
+	GetDenomsByCreator(ctx sdk.Context, creator sdk.AccAddress, page, limit int, accept func(denom string) bool) []string
 	ExistDenom(ctx sdk.Context, denom string) (string, bool)
+	SetDenomMetadata(ctx sdk.Context, md common.DenomMetadata)
+	GetDenomMetadata(ctx sdk.Context, denom string) (common.DenomMetadata, bool)
+	GetChainInfo(ctx sdk.Context, chainId uint64) (common.ChainInfo, bool)
 }
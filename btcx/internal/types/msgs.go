@@ -21,25 +21,31 @@ import (
 	"errors"
 	"fmt"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/polynetwork/cosmos-poly-module/common"
 
 	"encoding/hex"
 )
 
 // Governance message types and routes
 const (
-	TypeMsgBindAssetHash = "bind_asset_hash"
-	TypeMsgLock          = "lock"
-	TypeMsgCreateDenom   = "create_coin"
+	TypeMsgBindAssetHash   = "bind_asset_hash"
+	TypeMsgUnbindAssetHash = "unbind_asset_hash"
+	TypeMsgLock            = "lock"
+	TypeMsgCreateDenom     = "create_coin"
+
+	TypeMsgUpdateRedeemScript  = "update_redeem_script"
+	TypeMsgReconcileWithdrawal = "reconcile_withdrawal"
 )
 
 type MsgCreateDenom struct {
 	Creator      sdk.AccAddress
 	Denom        string
 	RedeemScript string
+	Metadata     *common.DenomMetadata // optional, Metadata.Denom should equal Denom
 }
 
-func NewMsgCreateDenom(creator sdk.AccAddress, denom string, redeemScript string) MsgCreateDenom {
-	return MsgCreateDenom{Creator: creator, Denom: denom, RedeemScript: redeemScript}
+func NewMsgCreateDenom(creator sdk.AccAddress, denom string, redeemScript string, metadata *common.DenomMetadata) MsgCreateDenom {
+	return MsgCreateDenom{Creator: creator, Denom: denom, RedeemScript: redeemScript, Metadata: metadata}
 }
 
 //nolint
@@ -57,6 +63,14 @@ func (msg MsgCreateDenom) ValidateBasic() error {
 	if _, err := hex.DecodeString(msg.RedeemScript); err != nil {
 		return ErrCreateDenom(fmt.Sprintf("MsgCreateDenom.RedeemScript: %s is not hex string format, Error:%v", msg.RedeemScript, err))
 	}
+	if msg.Metadata != nil {
+		if msg.Metadata.Denom != msg.Denom {
+			return ErrCreateDenom(fmt.Sprintf("MsgCreateDenom.Metadata.Denom: %s should be %s", msg.Metadata.Denom, msg.Denom))
+		}
+		if err := msg.Metadata.ValidateBasic(); err != nil {
+			return ErrCreateDenom(fmt.Sprintf("MsgCreateDenom.Metadata is invalid, Error:%v", err))
+		}
+	}
 	return nil
 }
 
@@ -131,6 +145,53 @@ func (msg MsgBindAssetHash) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Creator}
 }
 
+type MsgUnbindAssetHash struct {
+	Creator          sdk.AccAddress
+	SourceAssetDenom string
+	ToChainId        uint64
+}
+
+func NewMsgUnbindAssetHash(creator sdk.AccAddress, sourceAssetDenom string, toChainId uint64) MsgUnbindAssetHash {
+	return MsgUnbindAssetHash{creator, sourceAssetDenom, toChainId}
+}
+
+//nolint
+func (msg MsgUnbindAssetHash) Route() string { return RouterKey }
+func (msg MsgUnbindAssetHash) Type() string  { return TypeMsgUnbindAssetHash }
+
+// Implements Msg.
+func (msg MsgUnbindAssetHash) ValidateBasic() error {
+	if msg.Creator.Empty() {
+		return ErrUnbindAssetHash(fmt.Sprintf("Empty address:%s", msg.Creator.String()))
+	}
+	if err := sdk.ValidateDenom(msg.SourceAssetDenom); err != nil {
+		return ErrUnbindAssetHash(fmt.Sprintf("MsgUnbindAssetHash.SourceAssetDenom: %s is invalid, err: %v", msg.SourceAssetDenom, err))
+	}
+	if msg.ToChainId == 0 {
+		return ErrInvalidChainId(msg.ToChainId)
+	}
+	return nil
+}
+
+func (msg MsgUnbindAssetHash) String() string {
+	return fmt.Sprintf(`MsgUnbindAssetHash:
+  Creator:          %s
+  SourceAssetDenom: %s
+  TargetChainId:    %d
+`, msg.Creator.String(), msg.SourceAssetDenom, msg.ToChainId)
+}
+
+// Implements Msg.
+func (msg MsgUnbindAssetHash) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// Implements Msg.
+func (msg MsgUnbindAssetHash) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Creator}
+}
+
 type MsgLock struct {
 	FromAddress      sdk.AccAddress
 	SourceAssetDenom string
@@ -187,3 +248,94 @@ func (msg MsgLock) GetSignBytes() []byte {
 func (msg MsgLock) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.FromAddress}
 }
+
+type MsgUpdateRedeemScript struct {
+	Creator      sdk.AccAddress
+	Denom        string
+	RedeemScript string
+}
+
+func NewMsgUpdateRedeemScript(creator sdk.AccAddress, denom string, redeemScript string) MsgUpdateRedeemScript {
+	return MsgUpdateRedeemScript{Creator: creator, Denom: denom, RedeemScript: redeemScript}
+}
+
+//nolint
+func (msg MsgUpdateRedeemScript) Route() string { return RouterKey }
+func (msg MsgUpdateRedeemScript) Type() string  { return TypeMsgUpdateRedeemScript }
+
+// Implements Msg.
+func (msg MsgUpdateRedeemScript) ValidateBasic() error {
+	if msg.Creator.Empty() {
+		return ErrUpdateRedeemScript("MsgUpdateRedeemScript.Creator is empty")
+	}
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return ErrUpdateRedeemScript(fmt.Sprintf("MsgUpdateRedeemScript.Denom: %s is illegal, Error:%v", msg.Denom, err))
+	}
+	if bs, err := hex.DecodeString(msg.RedeemScript); err != nil || len(bs) == 0 {
+		return ErrUpdateRedeemScript(fmt.Sprintf("MsgUpdateRedeemScript.RedeemScript: %s is not non-empty hex string format, Error:%v", msg.RedeemScript, err))
+	}
+	return nil
+}
+
+func (msg MsgUpdateRedeemScript) String() string {
+	return fmt.Sprintf(`MsgUpdateRedeemScript:
+  Creator:         %s
+  Denom:           %s
+  RedeemScript:    %s
+`, msg.Creator.String(), msg.Denom, msg.RedeemScript)
+}
+
+// Implements Msg.
+func (msg MsgUpdateRedeemScript) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// Implements Msg.
+func (msg MsgUpdateRedeemScript) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Creator}
+}
+
+type MsgReconcileWithdrawal struct {
+	Creator      sdk.AccAddress
+	CrossChainId uint64
+	BtcTxHash    string
+}
+
+func NewMsgReconcileWithdrawal(creator sdk.AccAddress, crossChainId uint64, btcTxHash string) MsgReconcileWithdrawal {
+	return MsgReconcileWithdrawal{Creator: creator, CrossChainId: crossChainId, BtcTxHash: btcTxHash}
+}
+
+//nolint
+func (msg MsgReconcileWithdrawal) Route() string { return RouterKey }
+func (msg MsgReconcileWithdrawal) Type() string  { return TypeMsgReconcileWithdrawal }
+
+// Implements Msg.
+func (msg MsgReconcileWithdrawal) ValidateBasic() error {
+	if msg.Creator.Empty() {
+		return ErrConfirmWithdrawal("MsgReconcileWithdrawal.Creator is empty")
+	}
+	if bs, err := hex.DecodeString(msg.BtcTxHash); err != nil || len(bs) != 32 {
+		return ErrConfirmWithdrawal(fmt.Sprintf("MsgReconcileWithdrawal.BtcTxHash: %s is not a 32 bytes hex string, Error:%v", msg.BtcTxHash, err))
+	}
+	return nil
+}
+
+func (msg MsgReconcileWithdrawal) String() string {
+	return fmt.Sprintf(`MsgReconcileWithdrawal:
+  Creator:         %s
+  CrossChainId:    %d
+  BtcTxHash:       %s
+`, msg.Creator.String(), msg.CrossChainId, msg.BtcTxHash)
+}
+
+// Implements Msg.
+func (msg MsgReconcileWithdrawal) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// Implements Msg.
+func (msg MsgReconcileWithdrawal) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Creator}
+}
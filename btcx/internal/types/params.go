@@ -17,6 +17,107 @@
 
 package types
 
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
 const (
 	BtcChainId = uint64(1)
 )
+
+// Bitcoin networks the withdrawal addresses are decoded for
+const (
+	BtcNetworkMainnet  = "mainnet"
+	BtcNetworkTestnet3 = "testnet3"
+	BtcNetworkRegtest  = "regtest"
+	BtcNetworkSimnet   = "simnet"
+)
+
+// Parameter store keys
+var (
+	KeyBtcNetwork        = []byte("BtcNetwork")
+	KeyMinWithdrawAmount = []byte("MinWithdrawAmount")
+)
+
+type Params struct {
+	BtcNetwork        string `json:"btc_network" yaml:"btc_network"`                 // bitcoin network the addresses of withdrawals to BtcChainId should belong to
+	MinWithdrawAmount uint64 `json:"min_withdraw_amount" yaml:"min_withdraw_amount"` // minimum satoshis of a withdrawal to BtcChainId, it should cover the fee of the redeeming btc transaction
+}
+
+// ParamTable for btcx module.
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// default btcx module parameters
+func DefaultParams() Params {
+	return Params{
+		BtcNetwork:        BtcNetworkMainnet,
+		MinWithdrawAmount: 2000,
+	}
+}
+
+// validate params
+func (p Params) Validate() error {
+	if err := validateBtcNetwork(p.BtcNetwork); err != nil {
+		return err
+	}
+	if err := validateMinWithdrawAmount(p.MinWithdrawAmount); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetBtcNetParams returns the chaincfg params of network, nil if network is unknown
+func GetBtcNetParams(network string) *chaincfg.Params {
+	switch network {
+	case BtcNetworkMainnet:
+		return &chaincfg.MainNetParams
+	case BtcNetworkTestnet3:
+		return &chaincfg.TestNet3Params
+	case BtcNetworkRegtest:
+		return &chaincfg.RegressionNetParams
+	case BtcNetworkSimnet:
+		return &chaincfg.SimNetParams
+	}
+	return nil
+}
+
+func validateBtcNetwork(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if GetBtcNetParams(v) == nil {
+		return fmt.Errorf("unknown btc network: %s", v)
+	}
+	return nil
+}
+
+func validateMinWithdrawAmount(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func (p Params) String() string {
+	return fmt.Sprintf(`Btcx Params:
+  Btc Network:         %s
+  Min Withdraw Amount: %d
+`,
+		p.BtcNetwork, p.MinWithdrawAmount,
+	)
+}
+
+// Implements params.ParamSet
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		params.NewParamSetPair(KeyBtcNetwork, &p.BtcNetwork, validateBtcNetwork),
+		params.NewParamSetPair(KeyMinWithdrawAmount, &p.MinWithdrawAmount, validateMinWithdrawAmount),
+	}
+}
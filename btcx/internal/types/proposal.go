@@ -0,0 +1,89 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeUpdateRedeemScript defines the type for a UpdateRedeemScriptProposal
+	ProposalTypeUpdateRedeemScript = "UpdateRedeemScript"
+)
+
+// Assert UpdateRedeemScriptProposal implements govtypes.Content at compile-time
+var _ govtypes.Content = UpdateRedeemScriptProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeUpdateRedeemScript)
+	govtypes.RegisterProposalTypeCodec(UpdateRedeemScriptProposal{}, ModuleName+"/UpdateRedeemScriptProposal")
+}
+
+// UpdateRedeemScriptProposal replaces the redeem script of a denom through governance,
+// e.g. when its creator is unable to follow the key rotation of the Poly btc multisig
+type UpdateRedeemScriptProposal struct {
+	Title        string `json:"title" yaml:"title"`
+	Description  string `json:"description" yaml:"description"`
+	Denom        string `json:"denom" yaml:"denom"`
+	RedeemScript string `json:"redeem_script" yaml:"redeem_script"`
+}
+
+func NewUpdateRedeemScriptProposal(title, description, denom, redeemScript string) UpdateRedeemScriptProposal {
+	return UpdateRedeemScriptProposal{Title: title, Description: description, Denom: denom, RedeemScript: redeemScript}
+}
+
+// GetTitle returns the title of an update redeem script proposal.
+func (p UpdateRedeemScriptProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of an update redeem script proposal.
+func (p UpdateRedeemScriptProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of an update redeem script proposal.
+func (p UpdateRedeemScriptProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of an update redeem script proposal.
+func (p UpdateRedeemScriptProposal) ProposalType() string { return ProposalTypeUpdateRedeemScript }
+
+// ValidateBasic validates the update redeem script proposal
+func (p UpdateRedeemScriptProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if err := sdk.ValidateDenom(p.Denom); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if bs, err := hex.DecodeString(p.RedeemScript); err != nil || len(bs) == 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "redeem script: %s is not non-empty hex string format", p.RedeemScript)
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p UpdateRedeemScriptProposal) String() string {
+	return fmt.Sprintf(`Update Redeem Script Proposal:
+  Title:          %s
+  Description:    %s
+  Denom:          %s
+  RedeemScript:   %s
+`, p.Title, p.Description, p.Denom, p.RedeemScript)
+}
@@ -0,0 +1,140 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package types
+
+import (
+	btcxpb "github.com/polynetwork/cosmos-poly-module/btcx/pb"
+	"github.com/polynetwork/cosmos-poly-module/common"
+)
+
+func DenomInfoToProto(info DenomInfo) *btcxpb.DenomInfo {
+	pb := &btcxpb.DenomInfo{
+		Creator:          info.Creator,
+		Denom:            info.Denom,
+		AssetHash:        info.AssetHash,
+		TotalSupply:      info.TotalSupply.String(),
+		RedeemScript:     info.RedeemScipt,
+		RedeemScriptHash: info.RedeemScriptHash,
+	}
+	if info.Metadata != nil {
+		pb.Metadata = common.DenomMetadataToProto(*info.Metadata)
+	}
+	return pb
+}
+
+func DenomCrossChainInfoToProto(info DenomCrossChainInfo) *btcxpb.DenomCrossChainInfo {
+	return &btcxpb.DenomCrossChainInfo{
+		DenomInfo:   DenomInfoToProto(info.DenomInfo),
+		ToChainId:   info.ToChainId,
+		ToAssetHash: info.ToAssetHash,
+	}
+}
+
+func ParamsToProto(p Params) *btcxpb.Params {
+	return &btcxpb.Params{BtcNetwork: p.BtcNetwork, MinWithdrawAmount: p.MinWithdrawAmount}
+}
+
+func RedeemScriptsToProto(rs RedeemScripts) *btcxpb.QueryRedeemScriptsResponse {
+	res := &btcxpb.QueryRedeemScriptsResponse{
+		Denom:                   rs.Denom,
+		CurrentRedeemScript:     rs.CurrentRedeemScript,
+		CurrentRedeemScriptHash: rs.CurrentRedeemScriptHash,
+		History:                 make([]*btcxpb.RedeemScriptRecord, len(rs.History)),
+	}
+	for i, r := range rs.History {
+		res.History[i] = RedeemScriptRecordToProto(r)
+	}
+	return res
+}
+
+func RedeemScriptRecordToProto(r RedeemScriptRecord) *btcxpb.RedeemScriptRecord {
+	return &btcxpb.RedeemScriptRecord{RedeemScript: r.RedeemScript, RedeemScriptHash: r.RedeemScriptHash, Height: r.Height, Operator: r.Operator.String()}
+}
+
+func RedeemScriptRecordFromProto(pb *btcxpb.RedeemScriptRecord) RedeemScriptRecord {
+	return RedeemScriptRecord{RedeemScript: pb.RedeemScript, RedeemScriptHash: pb.RedeemScriptHash, Height: pb.Height, Operator: common.AccAddressFromProto(pb.Operator)}
+}
+
+func RedeemScriptHistoryToProto(history []RedeemScriptRecord) *btcxpb.RedeemScriptHistory {
+	pb := &btcxpb.RedeemScriptHistory{Records: make([]*btcxpb.RedeemScriptRecord, len(history))}
+	for i, r := range history {
+		pb.Records[i] = RedeemScriptRecordToProto(r)
+	}
+	return pb
+}
+
+func RedeemScriptHistoryFromProto(pb *btcxpb.RedeemScriptHistory) []RedeemScriptRecord {
+	history := make([]RedeemScriptRecord, len(pb.Records))
+	for i, r := range pb.Records {
+		history[i] = RedeemScriptRecordFromProto(r)
+	}
+	return history
+}
+
+func RedeemScriptInfoToProto(info RedeemScriptInfo) *btcxpb.QueryRedeemScriptInfoResponse {
+	res := &btcxpb.QueryRedeemScriptInfoResponse{
+		Denom:            info.Denom,
+		RedeemScript:     info.RedeemScript,
+		RedeemScriptHash: info.RedeemScriptHash,
+		ScriptType:       info.ScriptType,
+		RequiredSigs:     int32(info.RequiredSigs),
+		TotalKeys:        int32(info.TotalKeys),
+		PubKeys:          info.PubKeys,
+		Addresses:        make([]*btcxpb.RedeemScriptAddress, len(info.Addresses)),
+	}
+	for i, a := range info.Addresses {
+		res.Addresses[i] = &btcxpb.RedeemScriptAddress{Network: a.Network, P2Sh: a.P2SH, P2Wsh: a.P2WSH}
+	}
+	return res
+}
+
+func BtcWithdrawalToProto(w BtcWithdrawal) *btcxpb.BtcWithdrawal {
+	return &btcxpb.BtcWithdrawal{
+		CrossChainId:    w.CrossChainId,
+		Denom:           w.Denom,
+		FromAddress:     w.FromAddress.String(),
+		ToBtcAddress:    w.ToBtcAddress,
+		Amount:          w.Amount,
+		Status:          w.Status,
+		Height:          w.Height,
+		BtcTxHash:       w.BtcTxHash,
+		ConfirmedHeight: w.ConfirmedHeight,
+	}
+}
+
+func BtcWithdrawalFromProto(pb *btcxpb.BtcWithdrawal) BtcWithdrawal {
+	return BtcWithdrawal{
+		CrossChainId:    pb.CrossChainId,
+		Denom:           pb.Denom,
+		FromAddress:     common.AccAddressFromProto(pb.FromAddress),
+		ToBtcAddress:    pb.ToBtcAddress,
+		Amount:          pb.Amount,
+		Status:          pb.Status,
+		Height:          pb.Height,
+		BtcTxHash:       pb.BtcTxHash,
+		ConfirmedHeight: pb.ConfirmedHeight,
+	}
+}
+
+func BtcWithdrawalsToProto(withdrawals []BtcWithdrawal) []*btcxpb.BtcWithdrawal {
+	pbs := make([]*btcxpb.BtcWithdrawal, len(withdrawals))
+	for i, w := range withdrawals {
+		pbs[i] = BtcWithdrawalToProto(w)
+	}
+	return pbs
+}
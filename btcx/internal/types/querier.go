@@ -17,9 +17,21 @@
 
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/polynetwork/cosmos-poly-module/common"
+)
+
 const (
 	QueryDenomInfo           = "denom_info"
 	QueryDenomCrossChainInfo = "denom_cc_info"
+	QueryBindingHistory      = "binding_history"
+	QueryRedeemScripts       = "redeem_scripts"
+	QueryRedeemScriptInfo    = "redeem_script_info"
+	QueryWithdrawal          = "withdrawal"
+	QueryWithdrawals         = "withdrawals"
+	QueryAssetBindings       = "asset_bindings"
+	QueryDenomsByCreator     = "denoms_by_creator"
 )
 
 // QueryBalanceParams defines the params for querying an account balance.
@@ -41,3 +53,75 @@ type QueryDenomCrossChainInfoParam struct {
 func NewQueryDenomCrossChainInfoParam(denom string, toChainId uint64) QueryDenomCrossChainInfoParam {
 	return QueryDenomCrossChainInfoParam{denom, toChainId}
 }
+
+// BindingChange is one record of the binding history returned by QueryBindingHistory
+type BindingChange = common.BindingChange
+
+// QueryBindingHistoryParam filters the binding changes, empty Denom and zero ChainId match any value
+type QueryBindingHistoryParam struct {
+	Denom   string
+	ChainId uint64
+	Page    int
+	Limit   int
+}
+
+func NewQueryBindingHistoryParam(denom string, chainId uint64, page, limit int) QueryBindingHistoryParam {
+	return QueryBindingHistoryParam{denom, chainId, page, limit}
+}
+
+type QueryRedeemScriptsParam struct {
+	Denom string
+}
+
+func NewQueryRedeemScriptsParam(denom string) QueryRedeemScriptsParam {
+	return QueryRedeemScriptsParam{denom}
+}
+
+type QueryRedeemScriptInfoParam struct {
+	Denom string
+}
+
+func NewQueryRedeemScriptInfoParam(denom string) QueryRedeemScriptInfoParam {
+	return QueryRedeemScriptInfoParam{denom}
+}
+
+type QueryWithdrawalParam struct {
+	CrossChainId uint64
+}
+
+func NewQueryWithdrawalParam(crossChainId uint64) QueryWithdrawalParam {
+	return QueryWithdrawalParam{crossChainId}
+}
+
+type QueryWithdrawalsParam struct {
+	Address sdk.AccAddress
+	Page    int
+	Limit   int
+}
+
+func NewQueryWithdrawalsParam(address sdk.AccAddress, page, limit int) QueryWithdrawalsParam {
+	return QueryWithdrawalsParam{address, page, limit}
+}
+
+// Binding is one asset hash binding listed by QueryAssetBindings
+type Binding = common.Binding
+
+type QueryAssetBindingsParam struct {
+	Denom string
+	Page  int
+	Limit int
+}
+
+func NewQueryAssetBindingsParam(denom string, page, limit int) QueryAssetBindingsParam {
+	return QueryAssetBindingsParam{Denom: denom, Page: page, Limit: limit}
+}
+
+type QueryDenomsByCreatorParam struct {
+	Creator sdk.AccAddress
+	Page    int
+	Limit   int
+}
+
+func NewQueryDenomsByCreatorParam(creator sdk.AccAddress, page, limit int) QueryDenomsByCreatorParam {
+	return QueryDenomsByCreatorParam{Creator: creator, Page: page, Limit: limit}
+}
@@ -20,6 +20,8 @@ package types
 import (
 	"fmt"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/polynetwork/cosmos-poly-module/common"
+	"strings"
 )
 
 type DenomInfo struct {
@@ -29,10 +31,11 @@ type DenomInfo struct {
 	TotalSupply      sdk.Int
 	RedeemScipt      string
 	RedeemScriptHash string
+	Metadata         *common.DenomMetadata // nil if the creator has not registered any
 }
 
 func (msg DenomInfo) String() string {
-	return fmt.Sprintf(`
+	s := fmt.Sprintf(`
   Creator:        	 			%s
   Denom: 						%s
   AssetHash:					%s
@@ -40,6 +43,10 @@ func (msg DenomInfo) String() string {
   RedeemScriptHash(AssetHash):  %s
   RedeemScipt: 					%s
 `, msg.Creator, msg.Denom, msg.AssetHash, msg.TotalSupply.String(), msg.RedeemScriptHash, msg.RedeemScipt)
+	if msg.Metadata != nil {
+		s += msg.Metadata.String()
+	}
+	return s
 }
 
 type DenomCrossChainInfo struct {
@@ -54,3 +61,85 @@ func (msg DenomCrossChainInfo) String() string {
   ToAssetHash:		 			%s
 `, msg.ToChainId, msg.ToAssetHash)
 }
+
+// RedeemScriptRecord is a redeem script a denom has used, Operator is empty if it was set through governance
+type RedeemScriptRecord struct {
+	RedeemScript     string
+	RedeemScriptHash string
+	Height           int64
+	Operator         sdk.AccAddress
+}
+
+func (r RedeemScriptRecord) String() string {
+	return fmt.Sprintf(`
+  RedeemScript:     %s
+  RedeemScriptHash: %s
+  Height:           %d
+  Operator:         %s
+`, r.RedeemScript, r.RedeemScriptHash, r.Height, r.Operator.String())
+}
+
+// RedeemScripts is returned by QueryRedeemScripts, History lists the redeem scripts of the denom
+// from the oldest to the current one
+type RedeemScripts struct {
+	Denom                   string
+	CurrentRedeemScript     string
+	CurrentRedeemScriptHash string
+	History                 []RedeemScriptRecord
+}
+
+func (rs RedeemScripts) String() string {
+	s := fmt.Sprintf(`
+  Denom:                   %s
+  CurrentRedeemScript:     %s
+  CurrentRedeemScriptHash: %s
+  History:`, rs.Denom, rs.CurrentRedeemScript, rs.CurrentRedeemScriptHash)
+	for _, r := range rs.History {
+		s += r.String()
+	}
+	return s
+}
+
+// RedeemScriptInfo is returned by QueryRedeemScriptInfo, it describes the custody set up by the current
+// redeem script of a denom, PubKeys is empty unless the script pays to public keys
+type RedeemScriptInfo struct {
+	Denom            string
+	RedeemScript     string
+	RedeemScriptHash string
+	ScriptType       string
+	RequiredSigs     int
+	TotalKeys        int
+	PubKeys          []string
+	Addresses        []RedeemScriptAddress
+}
+
+func (info RedeemScriptInfo) String() string {
+	s := fmt.Sprintf(`
+  Denom:            %s
+  RedeemScript:     %s
+  RedeemScriptHash: %s
+  ScriptType:       %s
+  Threshold:        %d of %d
+  PubKeys:          %s
+  Addresses:`, info.Denom, info.RedeemScript, info.RedeemScriptHash, info.ScriptType, info.RequiredSigs, info.TotalKeys,
+		strings.Join(info.PubKeys, ", "))
+	for _, a := range info.Addresses {
+		s += a.String()
+	}
+	return s
+}
+
+// RedeemScriptAddress holds the addresses paying to a redeem script on a btc network
+type RedeemScriptAddress struct {
+	Network string
+	P2SH    string
+	P2WSH   string
+}
+
+func (a RedeemScriptAddress) String() string {
+	return fmt.Sprintf(`
+  Network: %s
+  P2SH:    %s
+  P2WSH:   %s
+`, a.Network, a.P2SH, a.P2WSH)
+}
@@ -0,0 +1,59 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	WithdrawalStatusPending = "pending"
+	// WithdrawalStatusConfirmed is set by the denom creator reconciling the withdrawal, the btc tx is not
+	// verified by this chain so the status is only the attestation of the creator
+	WithdrawalStatusConfirmed = "confirmed"
+)
+
+// BtcWithdrawal records the coins burnt in current chain to be paid in btc chain, it stays pending until
+// the denom creator reconciles it with the btc tx paying it
+type BtcWithdrawal struct {
+	CrossChainId    uint64
+	Denom           string
+	FromAddress     sdk.AccAddress
+	ToBtcAddress    string
+	Amount          uint64
+	Status          string
+	Height          int64
+	BtcTxHash       string
+	ConfirmedHeight int64
+}
+
+func (w BtcWithdrawal) String() string {
+	return fmt.Sprintf(`
+  CrossChainId:    %d
+  Denom:           %s
+  FromAddress:     %s
+  ToBtcAddress:    %s
+  Amount:          %d
+  Status:          %s
+  Height:          %d
+  BtcTxHash:       %s
+  ConfirmedHeight: %d
+`, w.CrossChainId, w.Denom, w.FromAddress.String(), w.ToBtcAddress, w.Amount, w.Status, w.Height, w.BtcTxHash, w.ConfirmedHeight)
+}
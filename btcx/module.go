@@ -19,10 +19,12 @@ package btcx
 
 import (
 	"encoding/json"
+	"fmt"
 	"github.com/polynetwork/cosmos-poly-module/btcx/client/rest"
 
 	"github.com/gorilla/mux"
 	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 
@@ -32,6 +34,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/polynetwork/cosmos-poly-module/btcx/client/cli"
 	"github.com/polynetwork/cosmos-poly-module/btcx/internal/types"
+	btcxpb "github.com/polynetwork/cosmos-poly-module/btcx/pb"
 )
 
 var (
@@ -56,12 +59,16 @@ func (AppModuleBasic) RegisterCodec(cdc *codec.Codec) {
 
 // default genesis state
 func (AppModuleBasic) DefaultGenesis() json.RawMessage {
-	return nil
+	return ModuleCdc.MustMarshalJSON(DefaultGenesisState())
 }
 
 // module validate genesis
 func (AppModuleBasic) ValidateGenesis(bz json.RawMessage) error {
-	return nil
+	var data GenesisState
+	if err := ModuleCdc.UnmarshalJSON(bz, &data); err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", ModuleName, err)
+	}
+	return ValidateGenesis(data)
 }
 
 // register rest routes
@@ -119,15 +126,23 @@ func (am AppModule) NewQuerierHandler() sdk.Querier {
 	return NewQuerier(am.keeper)
 }
 
+// RegisterQueryService registers the gRPC query service of the module, it is served next to the legacy querier
+func (am AppModule) RegisterQueryService(server *grpc.Server) {
+	btcxpb.RegisterQueryServer(server, NewQueryServer(am.keeper))
+}
+
 // module init-genesis
 func (am AppModule) InitGenesis(ctx sdk.Context, data json.RawMessage) []abci.ValidatorUpdate {
-
+	var genesisState GenesisState
+	ModuleCdc.MustUnmarshalJSON(data, &genesisState)
+	InitGenesis(ctx, am.keeper, genesisState)
 	return []abci.ValidatorUpdate{}
 }
 
 // module export genesis
 func (am AppModule) ExportGenesis(ctx sdk.Context) json.RawMessage {
-	return nil
+	gs := ExportGenesis(ctx, am.keeper)
+	return ModuleCdc.MustMarshalJSON(gs)
 }
 
 // module begin-block
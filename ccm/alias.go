@@ -39,29 +39,94 @@ const (
 	AttributeKeyMerkleValueMakeTxParamTxHash            = types.AttributeKeyMerkleValueMakeTxParamTxHash
 	AttributeKeyMerkleValueMakeTxParamToContractAddress = types.AttributeKeyMerkleValueMakeTxParamToContractAddress
 	AttributeKeyFromChainId                             = types.AttributeKeyFromChainId
+	EventTypeProposeDenomCreator                        = types.EventTypeProposeDenomCreator
+	EventTypeAcceptDenomCreator                         = types.EventTypeAcceptDenomCreator
+	AttributeKeyDenom                                   = types.AttributeKeyDenom
+	AttributeKeyCreator                                 = types.AttributeKeyCreator
+	AttributeKeyNewCreator                              = types.AttributeKeyNewCreator
+	EventTypeUpdateDenomMetadata                        = types.EventTypeUpdateDenomMetadata
+	AttributeKeySymbol                                  = types.AttributeKeySymbol
+	AttributeKeyDecimals                                = types.AttributeKeyDecimals
+	EventTypeSetChainInfo                               = types.EventTypeSetChainInfo
+	EventTypeRemoveChainInfo                            = types.EventTypeRemoveChainInfo
+	AttributeKeyChainId                                 = types.AttributeKeyChainId
+	AttributeKeyFamily                                  = types.AttributeKeyFamily
+	ProposalTypeSetChainInfo                            = types.ProposalTypeSetChainInfo
+	ProposalTypeRemoveChainInfo                         = types.ProposalTypeRemoveChainInfo
+	EventTypeUpdateCrossChainTxStatus                   = types.EventTypeUpdateCrossChainTxStatus
+	AttributeKeyTxHash                                  = types.AttributeKeyTxHash
+	TxStatusCreated                                     = types.TxStatusCreated
+	TxStatusAcknowledged                                = types.TxStatusAcknowledged
+	TxStatusExecuted                                    = types.TxStatusExecuted
+	TxStatusFailed                                      = types.TxStatusFailed
+	TxStatusRefunded                                    = types.TxStatusRefunded
+	TxStatusContract                                    = types.TxStatusContract
 )
 
 var (
 	// functions aliases
-	RegisterCodec              = types.RegisterCodec
-	NewKeeper                  = keeper.NewKeeper
-	NewQuerier                 = keeper.NewQuerier
-	NewGenesisState            = types.NewGenesisState
-	DefaultGenesisState        = types.DefaultGenesisState
-	ValidateGenesis            = types.ValidateGenesis
-	NewMsgProcessCrossChainTx  = types.NewMsgProcessCrossChainTx
-	GetCrossChainTxKey         = keeper.GetCrossChainTxKey
-	GetDoneTxKey               = keeper.GetDoneTxKey
-	ModuleCdc                  = types.ModuleCdc
-	OperatorKey                = types.OperatorKey
-	NewQueryModuleBalanceParam = types.NewQueryModuleBalanceParam
-	QueryModuleBalance         = types.QueryModuleBalance
+	RegisterCodec                     = types.RegisterCodec
+	NewKeeper                         = keeper.NewKeeper
+	NewQuerier                        = keeper.NewQuerier
+	NewQueryServer                    = keeper.NewQueryServer
+	NewGenesisState                   = types.NewGenesisState
+	DefaultGenesisState               = types.DefaultGenesisState
+	ValidateGenesis                   = types.ValidateGenesis
+	NewMsgProcessCrossChainTx         = types.NewMsgProcessCrossChainTx
+	GetCrossChainTxKey                = keeper.GetCrossChainTxKey
+	GetDoneTxKey                      = keeper.GetDoneTxKey
+	ModuleCdc                         = types.ModuleCdc
+	OperatorKey                       = types.OperatorKey
+	NewQueryModuleBalanceParam        = types.NewQueryModuleBalanceParam
+	QueryModuleBalance                = types.QueryModuleBalance
+	NewMsgProposeDenomCreator         = types.NewMsgProposeDenomCreator
+	NewMsgAcceptDenomCreator          = types.NewMsgAcceptDenomCreator
+	ErrTransferDenomCreator           = types.ErrTransferDenomCreator
+	GetDenomToPendingCreatorKey       = keeper.GetDenomToPendingCreatorKey
+	NewQueryDenomCreatorParam         = types.NewQueryDenomCreatorParam
+	QueryDenomCreator                 = types.QueryDenomCreator
+	NewMsgUpdateDenomMetadata         = types.NewMsgUpdateDenomMetadata
+	ErrDenomMetadata                  = types.ErrDenomMetadata
+	GetDenomToMetadataKey             = keeper.GetDenomToMetadataKey
+	NewQueryDenomMetadataParam        = types.NewQueryDenomMetadataParam
+	QueryDenomMetadata                = types.QueryDenomMetadata
+	ErrChainInfo                      = types.ErrChainInfo
+	GetChainInfoKey                   = keeper.GetChainInfoKey
+	GetAddressToCreatedTxKey          = keeper.GetAddressToCreatedTxKey
+	GetDenomToCreatorKey              = keeper.GetDenomToCreatorKey
+	GetAddressToUnlockKey             = keeper.GetAddressToUnlockKey
+	CrossChainIdKey                   = keeper.CrossChainIdKey
+	NewQueryChainInfoParam            = types.NewQueryChainInfoParam
+	QueryChainInfo                    = types.QueryChainInfo
+	QueryChains                       = types.QueryChains
+	NewSetChainInfoProposal           = types.NewSetChainInfoProposal
+	NewRemoveChainInfoProposal        = types.NewRemoveChainInfoProposal
+	QueryCreatedCrossChainTxs         = types.QueryCreatedCrossChainTxs
+	QueryReceivedUnlocks              = types.QueryReceivedUnlocks
+	NewQueryCreatedCrossChainTxsParam = types.NewQueryCreatedCrossChainTxsParam
+	NewQueryReceivedUnlocksParam      = types.NewQueryReceivedUnlocksParam
+	QueryCrossChainTxStatus           = types.QueryCrossChainTxStatus
+	NewQueryCrossChainTxStatusParam   = types.NewQueryCrossChainTxStatusParam
+	ErrCrossChainTxStatus             = types.ErrCrossChainTxStatus
+	IsValidTxStatusTransition         = types.IsValidTxStatusTransition
 )
 
 type (
-	Keeper                 = keeper.Keeper
-	MsgProcessCrossChainTx = types.MsgProcessCrossChainTx
-	UnlockKeeper           = types.UnlockKeeper
-	GenesisState           = types.GenesisState
-	Params                 = types.Params
+	Keeper                  = keeper.Keeper
+	MsgProcessCrossChainTx  = types.MsgProcessCrossChainTx
+	MsgProposeDenomCreator  = types.MsgProposeDenomCreator
+	MsgAcceptDenomCreator   = types.MsgAcceptDenomCreator
+	DenomCreatorInfo        = types.DenomCreatorInfo
+	MsgUpdateDenomMetadata  = types.MsgUpdateDenomMetadata
+	UnlockKeeper            = types.UnlockKeeper
+	SetChainInfoProposal    = types.SetChainInfoProposal
+	RemoveChainInfoProposal = types.RemoveChainInfoProposal
+	GenesisState            = types.GenesisState
+	Params                  = types.Params
+	Hooks                   = keeper.Hooks
+	CreatedCrossChainTx     = types.CreatedCrossChainTx
+	ReceivedUnlock          = types.ReceivedUnlock
+	CrossChainTxStatus      = types.CrossChainTxStatus
+	TxStatusChange          = types.TxStatusChange
+	TxStatusReceiptArgs     = types.TxStatusReceiptArgs
 )
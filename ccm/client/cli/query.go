@@ -28,6 +28,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/polynetwork/cosmos-poly-module/ccm/client/common"
 	"github.com/polynetwork/cosmos-poly-module/ccm/internal/types"
+	polycommon "github.com/polynetwork/cosmos-poly-module/common"
 	"github.com/spf13/cobra"
 	"strconv"
 	"strings"
@@ -48,6 +49,13 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 			GetCmdQueryIfContainContract(queryRoute, cdc),
 			GetCmdQueryCcmParams(queryRoute, cdc),
 			GetCmdQueryModuleBalance(queryRoute, cdc),
+			GetCmdQueryDenomCreator(queryRoute, cdc),
+			GetCmdQueryDenomMetadata(queryRoute, cdc),
+			GetCmdQueryChainInfo(queryRoute, cdc),
+			GetCmdQueryChains(queryRoute, cdc),
+			GetCmdQueryCreatedCrossChainTxs(queryRoute, cdc),
+			GetCmdQueryReceivedUnlocks(queryRoute, cdc),
+			GetCmdQueryCrossChainTxStatus(queryRoute, cdc),
 		)...,
 	)
 
@@ -145,3 +153,244 @@ $ %s query %s module-balance lockproxy
 		},
 	}
 }
+
+func GetCmdQueryDenomCreator(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "denom-creator [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the creator of denom and the address proposed to take over the creator role",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+
+Example:
+$ %s query %s denom-creator btcx
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, err := common.QueryDenomCreator(cliCtx, queryRoute, args[0])
+			if err != nil {
+				return err
+			}
+			var info types.DenomCreatorInfo
+			cdc.MustUnmarshalJSON(res, &info)
+			return cliCtx.PrintOutput(info)
+		},
+	}
+}
+
+func GetCmdQueryDenomMetadata(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "denom-metadata [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the metadata of denom, including decimals, symbol and origin asset",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+
+Example:
+$ %s query %s denom-metadata btcx
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, err := common.QueryDenomMetadata(cliCtx, queryRoute, args[0])
+			if err != nil {
+				return err
+			}
+			var md polycommon.DenomMetadata
+			cdc.MustUnmarshalJSON(res, &md)
+			return cliCtx.PrintOutput(md)
+		},
+	}
+}
+
+func GetCmdQueryChainInfo(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "chain-info [chain_id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the registered info of a chain, including its family and address encoding",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+
+Example:
+$ %s query %s chain-info 2
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			chainId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			res, err := common.QueryChainInfo(cliCtx, queryRoute, chainId)
+			if err != nil {
+				return err
+			}
+			var info polycommon.ChainInfo
+			cdc.MustUnmarshalJSON(res, &info)
+			return cliCtx.PrintOutput(info)
+		},
+	}
+}
+
+func GetCmdQueryChains(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "chains",
+		Args:  cobra.NoArgs,
+		Short: "Query all the chains of the chain registry",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+
+Example:
+$ %s query %s chains
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, err := common.QueryChains(cliCtx, queryRoute)
+			if err != nil {
+				return err
+			}
+			var infos []polycommon.ChainInfo
+			cdc.MustUnmarshalJSON(res, &infos)
+			return cliCtx.PrintOutput(infos)
+		},
+	}
+}
+
+func GetCmdQueryCreatedCrossChainTxs(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "created-txs [address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the cross chain txs created by address, page by page",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+
+Example:
+$ %s query %s created-txs cosmos1cszc6lkwmywmqlhfwmcrhwzx5d8kz3wr0sjxcp --page 1 --limit 10
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			address, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			page, limit, err := getPageFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := common.QueryCreatedCrossChainTxs(cliCtx, queryRoute, address, page, limit)
+			if err != nil {
+				return err
+			}
+			var txs []types.CreatedCrossChainTx
+			cdc.MustUnmarshalJSON(res, &txs)
+			return cliCtx.PrintOutput(txs)
+		},
+	}
+	addPageFlags(cmd)
+	return cmd
+}
+
+func GetCmdQueryReceivedUnlocks(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "received-unlocks [address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the coins unlocked to address by the cross chain txs from other chains, page by page",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+
+Example:
+$ %s query %s received-unlocks cosmos1cszc6lkwmywmqlhfwmcrhwzx5d8kz3wr0sjxcp --page 1 --limit 10
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			address, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			page, limit, err := getPageFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := common.QueryReceivedUnlocks(cliCtx, queryRoute, address, page, limit)
+			if err != nil {
+				return err
+			}
+			var unlocks []types.ReceivedUnlock
+			cdc.MustUnmarshalJSON(res, &unlocks)
+			return cliCtx.PrintOutput(unlocks)
+		},
+	}
+	addPageFlags(cmd)
+	return cmd
+}
+
+func GetCmdQueryCrossChainTxStatus(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "tx-status [cross_chain_id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the lifecycle status of the cross chain tx created in current chain with cross_chain_id",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the lifecycle status of a cross chain tx created in current chain, the tx is acknowledged once the
+proof of Poly recording it is processed, and executed, failed or refunded once the proxy in the target chain reports
+it through a status receipt relayed by Poly
+
+Example:
+$ %s query %s tx-status 12
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			crossChainId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			res, err := common.QueryCrossChainTxStatus(cliCtx, queryRoute, crossChainId)
+			if err != nil {
+				return err
+			}
+			var status types.CrossChainTxStatus
+			cdc.MustUnmarshalJSON(res, &status)
+			return cliCtx.PrintOutput(status)
+		},
+	}
+}
+
+func addPageFlags(cmd *cobra.Command) {
+	cmd.Flags().Int(flags.FlagPage, 1, "page of the results to query, starting from 1")
+	cmd.Flags().Int(flags.FlagLimit, polycommon.DefaultPageLimit, "number of results per page")
+}
+
+func getPageFlags(cmd *cobra.Command) (page, limit int, err error) {
+	if page, err = cmd.Flags().GetInt(flags.FlagPage); err != nil {
+		return
+	}
+	limit, err = cmd.Flags().GetInt(flags.FlagLimit)
+	return
+}
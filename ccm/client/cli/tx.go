@@ -19,6 +19,7 @@ package cli
 
 import (
 	"bufio"
+	"encoding/hex"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/spf13/cobra"
@@ -31,7 +32,10 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
 	"github.com/polynetwork/cosmos-poly-module/ccm/internal/types"
+	"github.com/polynetwork/cosmos-poly-module/common"
 	"strconv"
 )
 
@@ -46,6 +50,9 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 	}
 	txCmd.AddCommand(flags.PostCommands(
 		SendProcessCrossChainTxTxCmd(cdc),
+		SendProposeDenomCreatorTxCmd(cdc),
+		SendAcceptDenomCreatorTxCmd(cdc),
+		SendUpdateDenomMetadataTxCmd(cdc),
 	)...)
 	return txCmd
 }
@@ -83,3 +90,291 @@ $ %s tx %s process-crosschain-tx 0 'proof_hex_str_at_height_1000' 'header_1000'
 	}
 	return cmd
 }
+
+func SendProposeDenomCreatorTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "propose-denom-creator [denom] [new_creator_address]",
+		Short: "propose to transfer the creator role of denom, which takes effect once the new creator accepts it",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+Example:
+$ %s tx %s propose-denom-creator btcx cosmos1ayc6faczpj42eu7wjsjkwcj7h0q2p2e4vrlkzf
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			newCreator, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgProposeDenomCreator(cliCtx.GetFromAddress(), args[0], newCreator)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
+
+func SendAcceptDenomCreatorTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accept-denom-creator [denom]",
+		Short: "accept the creator role of denom proposed by its current creator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+Example:
+$ %s tx %s accept-denom-creator btcx
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			msg := types.NewMsgAcceptDenomCreator(cliCtx.GetFromAddress(), args[0])
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
+
+const (
+	FlagDecimals        = "decimals"
+	FlagSymbol          = "symbol"
+	FlagDisplayName     = "display-name"
+	FlagOriginChainId   = "origin-chain-id"
+	FlagOriginAssetHash = "origin-asset-hash"
+)
+
+func SendUpdateDenomMetadataTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-denom-metadata [denom]",
+		Short: "replace the metadata of denom, only allowed for the creator of denom",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+Example:
+$ %s tx %s update-denom-metadata btcx --decimals 8 --symbol BTC --display-name Bitcoin --origin-chain-id 1 --origin-asset-hash 0000000000000000000000000000000000000011
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			md, err := denomMetadataFromFlags(cmd, args[0])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgUpdateDenomMetadata(cliCtx.GetFromAddress(), md)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().Uint8(FlagDecimals, 0, "decimals of the denom")
+	cmd.Flags().String(FlagSymbol, "", "symbol of the denom")
+	cmd.Flags().String(FlagDisplayName, "", "display name of the denom")
+	cmd.Flags().Uint64(FlagOriginChainId, 0, "poly chain id of the origin chain the denom is bridged from")
+	cmd.Flags().String(FlagOriginAssetHash, "", "asset hash in hex on the origin chain")
+	return cmd
+}
+
+func denomMetadataFromFlags(cmd *cobra.Command, denom string) (md common.DenomMetadata, err error) {
+	md.Denom = denom
+	if md.Decimals, err = cmd.Flags().GetUint8(FlagDecimals); err != nil {
+		return
+	}
+	if md.Symbol, err = cmd.Flags().GetString(FlagSymbol); err != nil {
+		return
+	}
+	if md.DisplayName, err = cmd.Flags().GetString(FlagDisplayName); err != nil {
+		return
+	}
+	if md.OriginChainId, err = cmd.Flags().GetUint64(FlagOriginChainId); err != nil {
+		return
+	}
+	originAssetHash, err := cmd.Flags().GetString(FlagOriginAssetHash)
+	if err != nil {
+		return
+	}
+	if md.OriginAssetHash, err = hex.DecodeString(originAssetHash); err != nil {
+		err = fmt.Errorf("decode hex string: %s error: %v", originAssetHash, err)
+	}
+	return
+}
+
+const (
+	FlagChainName         = "name"
+	FlagAddressEncoding   = "address-encoding"
+	FlagAddressLength     = "address-length"
+	FlagAssetHashEncoding = "asset-hash-encoding"
+	FlagAssetHashLength   = "asset-hash-length"
+	FlagAmountWidth       = "amount-width"
+	FlagUnlockActions     = "unlock-actions"
+	FlagBondAssetHash     = "bond-asset-hash"
+)
+
+func GetCmdSubmitSetChainInfoProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-chain-info [chain_id] [family]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a proposal to register a chain, or replace its info, in the chain registry",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a set chain info proposal along with an initial deposit.
+Once passed, the destination addresses, asset hashes and amounts of the chain are checked against the registered info,
+family is one of evm, neo, ontology, btc and cosmos, encoding is one of raw, string, bech32 and base58,
+a zero length accepts any length and a zero amount width means 32 bytes, the amount in the cross chain args
+is always 32 bytes wide. An unlock action is only sent to a chain listing it in unlock actions, and the delegate
+action only carries the bond asset of the chain.
+
+Example:
+$ %s tx gov submit-proposal set-chain-info 2 evm --name ethereum --address-length 20 --asset-hash-length 20 --title "..." --description "..." --deposit 1000stake --from mykey
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+			from := cliCtx.GetFromAddress()
+
+			chainId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			info, err := chainInfoFromFlags(cmd, chainId, args[1])
+			if err != nil {
+				return err
+			}
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoins(depositStr)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewSetChainInfoProposal(title, description, info)
+			msg := gov.NewMsgSubmitProposal(content, deposit, from)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(FlagChainName, "", "name of the chain")
+	cmd.Flags().String(FlagAddressEncoding, common.EncodingRaw, "encoding of the addresses on the chain")
+	cmd.Flags().Uint32(FlagAddressLength, 0, "length in bytes of the addresses on the chain, 0 for any length")
+	cmd.Flags().String(FlagAssetHashEncoding, common.EncodingRaw, "encoding of the asset hashes on the chain")
+	cmd.Flags().Uint32(FlagAssetHashLength, 0, "length in bytes of the asset hashes on the chain, 0 for any length")
+	cmd.Flags().Uint32(FlagAmountWidth, 0, "width in bytes of the widest amount the chain accepts, 0 for 32")
+	cmd.Flags().StringSlice(FlagUnlockActions, nil, "comma separated unlock actions the lock proxy of the chain performs")
+	cmd.Flags().String(FlagBondAssetHash, "", "hex of the asset hash accepted by the delegate action of the chain")
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
+
+func chainInfoFromFlags(cmd *cobra.Command, chainId uint64, family string) (info common.ChainInfo, err error) {
+	info.ChainId = chainId
+	info.Family = family
+	if info.Name, err = cmd.Flags().GetString(FlagChainName); err != nil {
+		return info, err
+	}
+	if info.AddressEncoding, err = cmd.Flags().GetString(FlagAddressEncoding); err != nil {
+		return info, err
+	}
+	if info.AddressLength, err = cmd.Flags().GetUint32(FlagAddressLength); err != nil {
+		return info, err
+	}
+	if info.AssetHashEncoding, err = cmd.Flags().GetString(FlagAssetHashEncoding); err != nil {
+		return info, err
+	}
+	if info.AssetHashLength, err = cmd.Flags().GetUint32(FlagAssetHashLength); err != nil {
+		return info, err
+	}
+	if info.AmountWidth, err = cmd.Flags().GetUint32(FlagAmountWidth); err != nil {
+		return info, err
+	}
+	if info.UnlockActions, err = cmd.Flags().GetStringSlice(FlagUnlockActions); err != nil {
+		return info, err
+	}
+	bondAssetHash, err := cmd.Flags().GetString(FlagBondAssetHash)
+	if err != nil {
+		return info, err
+	}
+	if info.BondAssetHash, err = hex.DecodeString(strings.TrimPrefix(bondAssetHash, "0x")); err != nil {
+		return info, err
+	}
+	return info, nil
+}
+
+func GetCmdSubmitRemoveChainInfoProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-chain-info [chain_id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to remove a chain from the chain registry",
+		Long:  "Submit a remove chain info proposal along with an initial deposit.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+			from := cliCtx.GetFromAddress()
+
+			chainId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoins(depositStr)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewRemoveChainInfoProposal(title, description, chainId)
+			msg := gov.NewMsgSubmitProposal(content, deposit, from)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+
+	return cmd
+}
@@ -20,6 +20,7 @@ package common
 import (
 	"fmt"
 	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/polynetwork/cosmos-poly-module/ccm/internal/types"
 )
 
@@ -49,3 +50,66 @@ func QueryModuleBalance(cliCtx context.CLIContext, queryRoute string, moduleName
 	)
 	return res, err
 }
+
+func QueryDenomCreator(cliCtx context.CLIContext, queryRoute string, denom string) ([]byte, error) {
+
+	res, _, err := cliCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryDenomCreator),
+		cliCtx.Codec.MustMarshalJSON(types.NewQueryDenomCreatorParam(denom)),
+	)
+	return res, err
+}
+
+func QueryDenomMetadata(cliCtx context.CLIContext, queryRoute string, denom string) ([]byte, error) {
+
+	res, _, err := cliCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryDenomMetadata),
+		cliCtx.Codec.MustMarshalJSON(types.NewQueryDenomMetadataParam(denom)),
+	)
+	return res, err
+}
+
+func QueryChainInfo(cliCtx context.CLIContext, queryRoute string, chainId uint64) ([]byte, error) {
+
+	res, _, err := cliCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryChainInfo),
+		cliCtx.Codec.MustMarshalJSON(types.NewQueryChainInfoParam(chainId)),
+	)
+	return res, err
+}
+
+func QueryChains(cliCtx context.CLIContext, queryRoute string) ([]byte, error) {
+
+	res, _, err := cliCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryChains),
+		nil,
+	)
+	return res, err
+}
+
+func QueryCreatedCrossChainTxs(cliCtx context.CLIContext, queryRoute string, address sdk.AccAddress, page, limit int) ([]byte, error) {
+
+	res, _, err := cliCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryCreatedCrossChainTxs),
+		cliCtx.Codec.MustMarshalJSON(types.NewQueryCreatedCrossChainTxsParam(address, page, limit)),
+	)
+	return res, err
+}
+
+func QueryReceivedUnlocks(cliCtx context.CLIContext, queryRoute string, address sdk.AccAddress, page, limit int) ([]byte, error) {
+
+	res, _, err := cliCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryReceivedUnlocks),
+		cliCtx.Codec.MustMarshalJSON(types.NewQueryReceivedUnlocksParam(address, page, limit)),
+	)
+	return res, err
+}
+
+func QueryCrossChainTxStatus(cliCtx context.CLIContext, queryRoute string, crossChainId uint64) ([]byte, error) {
+
+	res, _, err := cliCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryCrossChainTxStatus),
+		cliCtx.Codec.MustMarshalJSON(types.NewQueryCrossChainTxStatusParam(crossChainId)),
+	)
+	return res, err
+}
@@ -0,0 +1,88 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package rest
+
+import (
+	"fmt"
+
+	"github.com/gorilla/mux"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/polynetwork/cosmos-poly-module/ccm/internal/types"
+	"github.com/polynetwork/cosmos-poly-module/common"
+	"github.com/polynetwork/cosmos-poly-module/common/openapi"
+)
+
+func registerOpenAPIRoute(r *mux.Router) {
+	r.HandleFunc("/ccm/"+openapi.FileName, openapi.Handler("ccm REST API", openAPIRoutes())).Methods("GET")
+}
+
+// openAPIRoutes describes the routes of RegisterRoutes and the governance proposal routes of ccm
+func openAPIRoutes() []openapi.Route {
+	return []openapi.Route{
+		{
+			Method:  "GET",
+			Path:    fmt.Sprintf("/ccm/if_contain_contract/{%s}/{%s}/{%s}", ModuleStoreKey, ToContract, FromChainId),
+			Summary: "Whether the module of the store key holds the contract as a cross chain target",
+			Params: []openapi.Param{
+				openapi.PathParam(ToContract, "string", "hex encoded contract hash"),
+				openapi.PathParam(FromChainId, "integer", "poly chain id of the source chain"),
+			},
+			Result: types.QueryContainToContractRes{},
+		},
+		{Method: "GET", Path: "/ccm/parameters", Summary: "Parameters of ccm module", Result: types.Params{}},
+		{Method: "GET", Path: fmt.Sprintf("/ccm/module_balance/{%s}", ModuleName), Summary: "Balance of the module account", Result: sdk.Coins{}},
+		{Method: "GET", Path: fmt.Sprintf("/ccm/denom_creator/{%s}", Denom), Summary: "Creator and pending creator of the denom", Result: types.DenomCreatorInfo{}},
+		{Method: "GET", Path: fmt.Sprintf("/ccm/denom_metadata/{%s}", Denom), Summary: "Metadata of the denom", Result: common.DenomMetadata{}},
+		{
+			Method:  "GET",
+			Path:    fmt.Sprintf("/ccm/chain_info/{%s}", ChainId),
+			Summary: "Registered info of the chain",
+			Params:  []openapi.Param{openapi.PathParam(ChainId, "integer", "poly chain id")},
+			Result:  common.ChainInfo{},
+		},
+		{Method: "GET", Path: "/ccm/chains", Summary: "Registered info of all the chains", Result: []common.ChainInfo{}},
+		{
+			Method:  "GET",
+			Path:    fmt.Sprintf("/ccm/created_txs/{%s}", Address),
+			Summary: "Cross chain txs created by the address",
+			Params:  append([]openapi.Param{openapi.PathParam(Address, "string", "bech32 account address")}, openapi.PageParams()...),
+			Result:  []types.CreatedCrossChainTx{},
+		},
+		{
+			Method:  "GET",
+			Path:    fmt.Sprintf("/ccm/received_unlocks/{%s}", Address),
+			Summary: "Coins unlocked to the address from other chains",
+			Params:  append([]openapi.Param{openapi.PathParam(Address, "string", "bech32 account address")}, openapi.PageParams()...),
+			Result:  []types.ReceivedUnlock{},
+		},
+		{
+			Method:  "GET",
+			Path:    fmt.Sprintf("/ccm/tx_status/{%s}", CrossChainId),
+			Summary: "Lifecycle status of the cross chain tx created in current chain",
+			Params:  []openapi.Param{openapi.PathParam(CrossChainId, "integer", "cross chain id of the tx")},
+			Result:  types.CrossChainTxStatus{},
+		},
+		{Method: "POST", Path: "/ccm/process_crosschain_tx", Summary: "Process a cross chain tx relayed from poly", Body: ProcessCrossChainTxReq{}},
+		{Method: "POST", Path: "/ccm/propose_denom_creator", Summary: "Propose the new creator of a denom", Body: ProposeDenomCreatorReq{}},
+		{Method: "POST", Path: "/ccm/accept_denom_creator", Summary: "Accept the creator of a denom proposed to the sender", Body: AcceptDenomCreatorReq{}},
+		{Method: "POST", Path: "/ccm/update_denom_metadata", Summary: "Update the metadata of a denom", Body: UpdateDenomMetadataReq{}},
+		{Method: "POST", Path: "/gov/proposals/set_chain_info", Summary: "Submit a proposal registering the info of a chain", Body: SetChainInfoProposalReq{}},
+		{Method: "POST", Path: "/gov/proposals/remove_chain_info", Summary: "Submit a proposal removing the info of a chain", Body: RemoveChainInfoProposalReq{}},
+	}
+}
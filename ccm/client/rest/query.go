@@ -20,8 +20,10 @@ package rest
 import (
 	"encoding/hex"
 	"fmt"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
 	"github.com/polynetwork/cosmos-poly-module/ccm/client/common"
+	polycommon "github.com/polynetwork/cosmos-poly-module/common"
 	"net/http"
 	"strconv"
 
@@ -45,6 +47,41 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router, queryRoute st
 		fmt.Sprintf("/ccm/module_balance/{%s}", ModuleName),
 		queryModuleBalance(cliCtx, queryRoute),
 	).Methods("GET")
+
+	r.HandleFunc(
+		fmt.Sprintf("/ccm/denom_creator/{%s}", Denom),
+		queryDenomCreator(cliCtx, queryRoute),
+	).Methods("GET")
+
+	r.HandleFunc(
+		fmt.Sprintf("/ccm/denom_metadata/{%s}", Denom),
+		queryDenomMetadata(cliCtx, queryRoute),
+	).Methods("GET")
+
+	r.HandleFunc(
+		fmt.Sprintf("/ccm/chain_info/{%s}", ChainId),
+		queryChainInfo(cliCtx, queryRoute),
+	).Methods("GET")
+
+	r.HandleFunc(
+		"/ccm/chains",
+		queryChains(cliCtx, queryRoute),
+	).Methods("GET")
+
+	r.HandleFunc(
+		fmt.Sprintf("/ccm/created_txs/{%s}", Address),
+		queryCreatedCrossChainTxs(cliCtx, queryRoute),
+	).Methods("GET")
+
+	r.HandleFunc(
+		fmt.Sprintf("/ccm/received_unlocks/{%s}", Address),
+		queryReceivedUnlocks(cliCtx, queryRoute),
+	).Methods("GET")
+
+	r.HandleFunc(
+		fmt.Sprintf("/ccm/tx_status/{%s}", CrossChainId),
+		queryCrossChainTxStatus(cliCtx, queryRoute),
+	).Methods("GET")
 }
 
 func queryIfContainContract(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
@@ -129,3 +166,147 @@ func queryModuleBalance(cliCtx context.CLIContext, queryRoute string) http.Handl
 		rest.PostProcessResponse(w, cliCtx, res)
 	}
 }
+
+func queryDenomCreator(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+		res, err := common.QueryDenomCreator(cliCtx, queryRoute, mux.Vars(r)[Denom])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func queryDenomMetadata(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+		res, err := common.QueryDenomMetadata(cliCtx, queryRoute, mux.Vars(r)[Denom])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func queryChainInfo(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+		chainId, err := strconv.ParseUint(mux.Vars(r)[ChainId], 10, 64)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		res, err := common.QueryChainInfo(cliCtx, queryRoute, chainId)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func queryChains(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+		res, err := common.QueryChains(cliCtx, queryRoute)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// queryCreatedCrossChainTxs accepts the optional page and limit url query parameters
+func queryCreatedCrossChainTxs(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		_, page, limit, err := rest.ParseHTTPArgsWithLimit(r, polycommon.DefaultPageLimit)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+		address, err := sdk.AccAddressFromBech32(mux.Vars(r)[Address])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		res, err := common.QueryCreatedCrossChainTxs(cliCtx, queryRoute, address, page, limit)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// queryReceivedUnlocks accepts the optional page and limit url query parameters
+func queryReceivedUnlocks(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		_, page, limit, err := rest.ParseHTTPArgsWithLimit(r, polycommon.DefaultPageLimit)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+		address, err := sdk.AccAddressFromBech32(mux.Vars(r)[Address])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		res, err := common.QueryReceivedUnlocks(cliCtx, queryRoute, address, page, limit)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func queryCrossChainTxStatus(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+		crossChainId, err := strconv.ParseUint(mux.Vars(r)[CrossChainId], 10, 64)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		res, err := common.QueryCrossChainTxStatus(cliCtx, queryRoute, crossChainId)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
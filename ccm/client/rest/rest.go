@@ -28,10 +28,15 @@ const (
 	ToContract     = "to_contract"
 	FromChainId    = "from_chain_id"
 	ModuleName     = "module_name"
+	Denom          = "denom"
+	ChainId        = "chain_id"
+	Address        = "address"
+	CrossChainId   = "cross_chain_id"
 )
 
 // RegisterRoutes registers minting module REST handlers on the provided router.
 func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, queryRoute string) {
 	registerQueryRoutes(cliCtx, r, queryRoute)
 	registerTxRoutes(cliCtx, r)
+	registerOpenAPIRoute(r)
 }
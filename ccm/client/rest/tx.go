@@ -19,7 +19,10 @@ package rest
 
 import (
 	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
 	"github.com/polynetwork/cosmos-poly-module/ccm/internal/types"
+	"github.com/polynetwork/cosmos-poly-module/common"
 	"net/http"
 
 	"github.com/gorilla/mux"
@@ -32,6 +35,9 @@ import (
 // RegisterRoutes - Central function to define routes that get registered by the main application
 func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc("/ccm/process_crosschain_tx", ProcessCrossChainTxRequestHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/ccm/propose_denom_creator", ProposeDenomCreatorRequestHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/ccm/accept_denom_creator", AcceptDenomCreatorRequestHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/ccm/update_denom_metadata", UpdateDenomMetadataRequestHandlerFn(cliCtx)).Methods("POST")
 
 }
 
@@ -66,3 +72,162 @@ func ProcessCrossChainTxRequestHandlerFn(cliCtx context.CLIContext) http.Handler
 		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
 	}
 }
+
+type ProposeDenomCreatorReq struct {
+	BaseReq    rest.BaseReq   `json:"base_req" yaml:"base_req"`
+	Denom      string         `json:"denom" yaml:"denom"`
+	NewCreator sdk.AccAddress `json:"new_creator" yaml:"new_creator"`
+}
+
+type AcceptDenomCreatorReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Denom   string       `json:"denom" yaml:"denom"`
+}
+
+func ProposeDenomCreatorRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req ProposeDenomCreatorReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+		creator, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		msg := types.NewMsgProposeDenomCreator(creator, req.Denom, req.NewCreator)
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func AcceptDenomCreatorRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req AcceptDenomCreatorReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+		newCreator, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		msg := types.NewMsgAcceptDenomCreator(newCreator, req.Denom)
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+type UpdateDenomMetadataReq struct {
+	BaseReq  rest.BaseReq         `json:"base_req" yaml:"base_req"`
+	Metadata common.DenomMetadata `json:"metadata" yaml:"metadata"`
+}
+
+func UpdateDenomMetadataRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req UpdateDenomMetadataReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+		creator, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		msg := types.NewMsgUpdateDenomMetadata(creator, req.Metadata)
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+// SetChainInfoProposalReq defines the properties of a set chain info proposal request's body.
+type SetChainInfoProposalReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title       string           `json:"title" yaml:"title"`
+	Description string           `json:"description" yaml:"description"`
+	ChainInfo   common.ChainInfo `json:"chain_info" yaml:"chain_info"`
+	Proposer    sdk.AccAddress   `json:"proposer" yaml:"proposer"`
+	Deposit     sdk.Coins        `json:"deposit" yaml:"deposit"`
+}
+
+// SetChainInfoProposalRESTHandler returns a ProposalRESTHandler that exposes the set chain info REST handler with a given sub-route.
+func SetChainInfoProposalRESTHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "set_chain_info",
+		Handler:  postSetChainInfoProposalHandlerFn(cliCtx),
+	}
+}
+
+func postSetChainInfoProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req SetChainInfoProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewSetChainInfoProposal(req.Title, req.Description, req.ChainInfo)
+		msg := gov.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+// RemoveChainInfoProposalReq defines the properties of a remove chain info proposal request's body.
+type RemoveChainInfoProposalReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	Title       string         `json:"title" yaml:"title"`
+	Description string         `json:"description" yaml:"description"`
+	ChainId     uint64         `json:"chain_id" yaml:"chain_id"`
+	Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+	Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+}
+
+// RemoveChainInfoProposalRESTHandler returns a ProposalRESTHandler that exposes the remove chain info REST handler with a given sub-route.
+func RemoveChainInfoProposalRESTHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "remove_chain_info",
+		Handler:  postRemoveChainInfoProposalHandlerFn(cliCtx),
+	}
+}
+
+func postRemoveChainInfoProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req RemoveChainInfoProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewRemoveChainInfoProposal(req.Title, req.Description, req.ChainId)
+		msg := gov.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
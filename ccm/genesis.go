@@ -24,10 +24,15 @@ import (
 // InitGenesis new ccm genesis
 func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
 	keeper.SetParams(ctx, data.Params)
+	for _, info := range data.Chains {
+		if err := keeper.SetChainInfo(ctx, info); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
 func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
 	params := keeper.GetParams(ctx)
-	return NewGenesisState(params)
+	return NewGenesisState(params, keeper.GetAllChainInfos(ctx))
 }
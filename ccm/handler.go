@@ -31,6 +31,12 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		switch msg := msg.(type) {
 		case types.MsgProcessCrossChainTx:
 			return handleMsgProcessCrossChainTx(ctx, k, msg)
+		case types.MsgProposeDenomCreator:
+			return handleMsgProposeDenomCreator(ctx, k, msg)
+		case types.MsgAcceptDenomCreator:
+			return handleMsgAcceptDenomCreator(ctx, k, msg)
+		case types.MsgUpdateDenomMetadata:
+			return handleMsgUpdateDenomMetadata(ctx, k, msg)
 
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", types.ModuleName, msg)
@@ -44,6 +50,7 @@ func handleMsgProcessCrossChainTx(ctx sdk.Context, k keeper.Keeper, msg types.Ms
 	if err != nil {
 		return nil, err
 	}
+	k.RewardProofRelayer(ctx, msg.Submitter)
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -54,3 +61,42 @@ func handleMsgProcessCrossChainTx(ctx sdk.Context, k keeper.Keeper, msg types.Ms
 
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
+
+func handleMsgProposeDenomCreator(ctx sdk.Context, k keeper.Keeper, msg types.MsgProposeDenomCreator) (*sdk.Result, error) {
+	if err := k.ProposeDenomCreator(ctx, msg.Creator, msg.Denom, msg.NewCreator); err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgAcceptDenomCreator(ctx sdk.Context, k keeper.Keeper, msg types.MsgAcceptDenomCreator) (*sdk.Result, error) {
+	if err := k.AcceptDenomCreator(ctx, msg.NewCreator, msg.Denom); err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgUpdateDenomMetadata(ctx sdk.Context, k keeper.Keeper, msg types.MsgUpdateDenomMetadata) (*sdk.Result, error) {
+	if err := k.UpdateDenomMetadata(ctx, msg.Creator, msg.Metadata); err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
@@ -0,0 +1,85 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/polynetwork/cosmos-poly-module/ccm/internal/types"
+	"github.com/polynetwork/cosmos-poly-module/common"
+	commonpb "github.com/polynetwork/cosmos-poly-module/common/pb"
+)
+
+// SetChainInfo registers or replaces the info of info.ChainId, it is called by governance
+func (k Keeper) SetChainInfo(ctx sdk.Context, info common.ChainInfo) error {
+	if err := info.ValidateBasic(); err != nil {
+		return types.ErrChainInfo(err.Error())
+	}
+	ctx.KVStore(k.storeKey).Set(GetChainInfoKey(info.ChainId), common.MustMarshalProto(common.ChainInfoToProto(info)))
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetChainInfo,
+			sdk.NewAttribute(types.AttributeKeyChainId, strconv.FormatUint(info.ChainId, 10)),
+			sdk.NewAttribute(types.AttributeKeyFamily, info.Family),
+		),
+	})
+	return nil
+}
+
+// GetChainInfo returns the info of chainId, false if the chain has not been registered
+func (k Keeper) GetChainInfo(ctx sdk.Context, chainId uint64) (info common.ChainInfo, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(GetChainInfoKey(chainId))
+	if bz == nil {
+		return info, false
+	}
+	var pb commonpb.ChainInfo
+	common.MustUnmarshalProto(bz, &pb)
+	return common.ChainInfoFromProto(&pb), true
+}
+
+// RemoveChainInfo unregisters chainId, the addresses and asset hashes of the chain will not be checked anymore
+func (k Keeper) RemoveChainInfo(ctx sdk.Context, chainId uint64) error {
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has(GetChainInfoKey(chainId)) {
+		return types.ErrChainInfo(fmt.Sprintf("chain: %d has not been registered", chainId))
+	}
+	store.Delete(GetChainInfoKey(chainId))
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRemoveChainInfo,
+			sdk.NewAttribute(types.AttributeKeyChainId, strconv.FormatUint(chainId, 10)),
+		),
+	})
+	return nil
+}
+
+// GetAllChainInfos returns the registered chains ordered by chain id
+func (k Keeper) GetAllChainInfos(ctx sdk.Context) []common.ChainInfo {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), ChainInfoPrefix)
+	defer iterator.Close()
+
+	infos := make([]common.ChainInfo, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var pb commonpb.ChainInfo
+		common.MustUnmarshalProto(iterator.Value(), &pb)
+		infos = append(infos, common.ChainInfoFromProto(&pb))
+	}
+	return infos
+}
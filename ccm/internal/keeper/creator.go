@@ -0,0 +1,106 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package keeper
+
+import (
+	"fmt"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/polynetwork/cosmos-poly-module/ccm/internal/types"
+	"github.com/polynetwork/cosmos-poly-module/common"
+)
+
+// GetPendingDenomCreator returns the address proposed to take over the creator role of denom, nil if none
+func (k Keeper) GetPendingDenomCreator(ctx sdk.Context, denom string) sdk.AccAddress {
+	return ctx.KVStore(k.storeKey).Get(GetDenomToPendingCreatorKey(denom))
+}
+
+// ProposeDenomCreator is the first step of transferring the creator role of denom, newCreator becomes
+// the creator only after accepting it. Proposing again replaces the former proposal
+func (k Keeper) ProposeDenomCreator(ctx sdk.Context, creator sdk.AccAddress, denom string, newCreator sdk.AccAddress) error {
+	currentCreator := k.GetDenomCreator(ctx, denom)
+	if len(currentCreator) == 0 {
+		return types.ErrTransferDenomCreator(fmt.Sprintf("denom: %s has no creator", denom))
+	}
+	if !currentCreator.Equals(creator) {
+		return types.ErrTransferDenomCreator(fmt.Sprintf("creator is not valid, expect: %s, got: %s", currentCreator.String(), creator.String()))
+	}
+	if newCreator.Empty() || newCreator.Equals(currentCreator) {
+		return types.ErrTransferDenomCreator(fmt.Sprintf("new creator: %s should be neither empty nor the current creator", newCreator.String()))
+	}
+	ctx.KVStore(k.storeKey).Set(GetDenomToPendingCreatorKey(denom), newCreator.Bytes())
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeProposeDenomCreator,
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyCreator, creator.String()),
+			sdk.NewAttribute(types.AttributeKeyNewCreator, newCreator.String()),
+		),
+	})
+	return nil
+}
+
+// AcceptDenomCreator is the second step of transferring the creator role of denom, it is only allowed for the proposed new creator
+func (k Keeper) AcceptDenomCreator(ctx sdk.Context, newCreator sdk.AccAddress, denom string) error {
+	pendingCreator := k.GetPendingDenomCreator(ctx, denom)
+	if len(pendingCreator) == 0 {
+		return types.ErrTransferDenomCreator(fmt.Sprintf("no creator transfer of denom: %s has been proposed", denom))
+	}
+	if !pendingCreator.Equals(newCreator) {
+		return types.ErrTransferDenomCreator(fmt.Sprintf("new creator is not valid, expect: %s, got: %s", pendingCreator.String(), newCreator.String()))
+	}
+	oldCreator := k.GetDenomCreator(ctx, denom)
+	k.SetDenomCreator(ctx, denom, newCreator)
+	ctx.KVStore(k.storeKey).Delete(GetDenomToPendingCreatorKey(denom))
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeAcceptDenomCreator,
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyCreator, oldCreator.String()),
+			sdk.NewAttribute(types.AttributeKeyNewCreator, newCreator.String()),
+		),
+	})
+	return nil
+}
+
+// GetDenomsByCreator returns the page-th page of the denoms owned by creator and accepted by accept, ordered by denom.
+// A nil accept takes all of them
+func (k Keeper) GetDenomsByCreator(ctx sdk.Context, creator sdk.AccAddress, page, limit int, accept func(denom string) bool) []string {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), GetCreatorToDenomPrefix(creator))
+	defer iterator.Close()
+
+	denoms := make([]string, 0)
+	common.Paginate(iterator, page, limit, func(key, value []byte) bool {
+		return accept == nil || accept(string(value))
+	}, func(key, value []byte) {
+		denoms = append(denoms, string(value))
+	})
+	return denoms
+}
+
+// IndexDenomCreators builds the creator index of the denoms whose creator was set before the index was kept,
+// it is run once by the upgrade handler introducing the index
+func (k Keeper) IndexDenomCreators(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, DenomToCreatorPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		denom := string(iterator.Key()[len(DenomToCreatorPrefix):])
+		store.Set(GetCreatorToDenomKey(iterator.Value(), denom), []byte(denom))
+	}
+}
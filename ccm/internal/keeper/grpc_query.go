@@ -0,0 +1,122 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/polynetwork/cosmos-poly-module/ccm/internal/types"
+	ccmpb "github.com/polynetwork/cosmos-poly-module/ccm/pb"
+	"github.com/polynetwork/cosmos-poly-module/common"
+)
+
+type queryServer struct {
+	k Keeper
+}
+
+var _ ccmpb.QueryServer = queryServer{}
+
+// NewQueryServer returns the gRPC query service of ccm module, it serves the legacy queries with typed messages
+func NewQueryServer(k Keeper) ccmpb.QueryServer {
+	return queryServer{k}
+}
+
+func (q queryServer) IfContainContract(goCtx context.Context, req *ccmpb.QueryIfContainContractRequest) (*ccmpb.QueryIfContainContractResponse, error) {
+	ctx := common.UnwrapSDKContext(goCtx)
+	res := q.k.IfContainToContract(ctx, req.KeyStore, req.ToContractAddr, req.FromChainId)
+	return &ccmpb.QueryIfContainContractResponse{KeyStore: res.KeyStore, Exist: res.Exist, Info: res.Info}, nil
+}
+
+func (q queryServer) Params(goCtx context.Context, _ *ccmpb.QueryParamsRequest) (*ccmpb.QueryParamsResponse, error) {
+	ctx := common.UnwrapSDKContext(goCtx)
+	return &ccmpb.QueryParamsResponse{Params: types.ParamsToProto(q.k.GetParams(ctx))}, nil
+}
+
+func (q queryServer) ModuleBalance(goCtx context.Context, req *ccmpb.QueryModuleBalanceRequest) (*ccmpb.QueryModuleBalanceResponse, error) {
+	ctx := common.UnwrapSDKContext(goCtx)
+	balance, err := q.k.GetModuleBalance(ctx, req.ModuleName)
+	if err != nil {
+		return nil, err
+	}
+	return &ccmpb.QueryModuleBalanceResponse{Balance: common.CoinsToProto(balance)}, nil
+}
+
+func (q queryServer) DenomCreator(goCtx context.Context, req *ccmpb.QueryDenomCreatorRequest) (*ccmpb.QueryDenomCreatorResponse, error) {
+	ctx := common.UnwrapSDKContext(goCtx)
+	return &ccmpb.QueryDenomCreatorResponse{
+		Denom:          req.Denom,
+		Creator:        q.k.GetDenomCreator(ctx, req.Denom).String(),
+		PendingCreator: q.k.GetPendingDenomCreator(ctx, req.Denom).String(),
+	}, nil
+}
+
+func (q queryServer) DenomMetadata(goCtx context.Context, req *ccmpb.QueryDenomMetadataRequest) (*ccmpb.QueryDenomMetadataResponse, error) {
+	ctx := common.UnwrapSDKContext(goCtx)
+	md, found := q.k.GetDenomMetadata(ctx, req.Denom)
+	if !found {
+		return nil, types.ErrDenomMetadata(fmt.Sprintf("metadata of denom: %s has not been registered", req.Denom))
+	}
+	return &ccmpb.QueryDenomMetadataResponse{Metadata: common.DenomMetadataToProto(md)}, nil
+}
+
+func (q queryServer) ChainInfo(goCtx context.Context, req *ccmpb.QueryChainInfoRequest) (*ccmpb.QueryChainInfoResponse, error) {
+	ctx := common.UnwrapSDKContext(goCtx)
+	info, found := q.k.GetChainInfo(ctx, req.ChainId)
+	if !found {
+		return nil, types.ErrChainInfo(fmt.Sprintf("chain: %d has not been registered", req.ChainId))
+	}
+	return &ccmpb.QueryChainInfoResponse{Info: common.ChainInfoToProto(info)}, nil
+}
+
+func (q queryServer) Chains(goCtx context.Context, _ *ccmpb.QueryChainsRequest) (*ccmpb.QueryChainsResponse, error) {
+	ctx := common.UnwrapSDKContext(goCtx)
+	return &ccmpb.QueryChainsResponse{Infos: common.ChainInfosToProto(q.k.GetAllChainInfos(ctx))}, nil
+}
+
+func (q queryServer) CreatedCrossChainTxs(goCtx context.Context, req *ccmpb.QueryCreatedCrossChainTxsRequest) (*ccmpb.QueryCreatedCrossChainTxsResponse, error) {
+	ctx := common.UnwrapSDKContext(goCtx)
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	page, limit := common.PageFromProto(req.Pagination)
+	txs := q.k.GetCreatedCrossChainTxs(ctx, addr, page, limit)
+	return &ccmpb.QueryCreatedCrossChainTxsResponse{Txs: types.CreatedCrossChainTxsToProto(txs)}, nil
+}
+
+func (q queryServer) ReceivedUnlocks(goCtx context.Context, req *ccmpb.QueryReceivedUnlocksRequest) (*ccmpb.QueryReceivedUnlocksResponse, error) {
+	ctx := common.UnwrapSDKContext(goCtx)
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	page, limit := common.PageFromProto(req.Pagination)
+	unlocks := q.k.GetReceivedUnlocks(ctx, addr, page, limit)
+	return &ccmpb.QueryReceivedUnlocksResponse{Unlocks: types.ReceivedUnlocksToProto(unlocks)}, nil
+}
+
+func (q queryServer) CrossChainTxStatus(goCtx context.Context, req *ccmpb.QueryCrossChainTxStatusRequest) (*ccmpb.QueryCrossChainTxStatusResponse, error) {
+	ctx := common.UnwrapSDKContext(goCtx)
+	status, found := q.k.GetCrossChainTxStatus(ctx, req.CrossChainId)
+	if !found {
+		return nil, types.ErrCrossChainTxStatus(fmt.Sprintf("cross chain tx with crossChainId: %d is not tracked", req.CrossChainId))
+	}
+	return &ccmpb.QueryCrossChainTxStatusResponse{Status: types.CrossChainTxStatusToProto(status)}, nil
+}
@@ -0,0 +1,121 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package keeper
+
+import (
+	"encoding/hex"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/polynetwork/cosmos-poly-module/ccm/internal/types"
+	ccmpb "github.com/polynetwork/cosmos-poly-module/ccm/pb"
+	"github.com/polynetwork/cosmos-poly-module/common"
+	ccmc "github.com/polynetwork/poly/native/service/cross_chain_manager/common"
+)
+
+// indexCreatedTx records the cross chain tx created by fromAddr under the address
+func (k Keeper) indexCreatedTx(ctx sdk.Context, fromAddr sdk.AccAddress, crossChainId uint64, txParamHash []byte, txParam ccmc.MakeTxParam) {
+	tx := types.CreatedCrossChainTx{
+		CrossChainId: crossChainId,
+		TxParamHash:  hex.EncodeToString(txParamHash),
+		FromContract: hex.EncodeToString(txParam.FromContractAddress),
+		ToChainId:    txParam.ToChainID,
+		ToContract:   hex.EncodeToString(txParam.ToContractAddress),
+		Method:       txParam.Method,
+		Height:       ctx.BlockHeight(),
+	}
+	ctx.KVStore(k.storeKey).Set(GetAddressToCreatedTxKey(fromAddr, crossChainId), common.MustMarshalProto(types.CreatedCrossChainTxToProto(tx)))
+}
+
+// GetCreatedCrossChainTxs returns the page-th page of the cross chain txs created by addr ordered by cross chain id
+func (k Keeper) GetCreatedCrossChainTxs(ctx sdk.Context, addr sdk.AccAddress, page, limit int) []types.CreatedCrossChainTx {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), GetAddressToCreatedTxPrefix(addr))
+	defer iterator.Close()
+
+	txs := make([]types.CreatedCrossChainTx, 0)
+	common.Paginate(iterator, page, limit, nil, func(key, value []byte) {
+		var tx ccmpb.CreatedCrossChainTx
+		common.MustUnmarshalProto(value, &tx)
+		txs = append(txs, types.CreatedCrossChainTxFromProto(&tx))
+	})
+	return txs
+}
+
+// receivedUnlocksKey keys the unlocks reported through Hooks in the context passed to the unlock keepers
+type receivedUnlocksKey struct{}
+
+// withReceivedUnlocks returns ctx collecting the unlocks reported through Hooks into unlocks
+func withReceivedUnlocks(ctx sdk.Context, unlocks *[]common.UnlockInfo) sdk.Context {
+	return ctx.WithValue(receivedUnlocksKey{}, unlocks)
+}
+
+// indexReceivedUnlocks records the unlocks reported through Hooks while processing merkleValue under the receivers,
+// nothing is reported if the hooks are not registered
+func (k Keeper) indexReceivedUnlocks(ctx sdk.Context, merkleValue *ccmc.ToMerkleValue, infos []common.UnlockInfo) {
+	store := ctx.KVStore(k.storeKey)
+	for _, info := range infos {
+		unlock := types.ReceivedUnlock{
+			Module:       info.Module,
+			FromChainId:  merkleValue.FromChainID,
+			CrossChainId: hex.EncodeToString(merkleValue.MakeTxParam.CrossChainID),
+			TxHash:       hex.EncodeToString(merkleValue.MakeTxParam.TxHash),
+			PolyTxHash:   hex.EncodeToString(merkleValue.TxHash),
+			Denom:        info.Denom,
+			Amount:       info.Amount,
+			Height:       ctx.BlockHeight(),
+		}
+		store.Set(GetAddressToUnlockKey(info.ToAddress, unlock.Height, unlock.FromChainId, merkleValue.MakeTxParam.CrossChainID), common.MustMarshalProto(types.ReceivedUnlockToProto(unlock)))
+	}
+}
+
+// GetReceivedUnlocks returns the page-th page of the unlocks received by addr ordered by height
+func (k Keeper) GetReceivedUnlocks(ctx sdk.Context, addr sdk.AccAddress, page, limit int) []types.ReceivedUnlock {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), GetAddressToUnlockPrefix(addr))
+	defer iterator.Close()
+
+	unlocks := make([]types.ReceivedUnlock, 0)
+	common.Paginate(iterator, page, limit, nil, func(key, value []byte) {
+		var unlock ccmpb.ReceivedUnlock
+		common.MustUnmarshalProto(value, &unlock)
+		unlocks = append(unlocks, types.ReceivedUnlockFromProto(&unlock))
+	})
+	return unlocks
+}
+
+// Hooks reports the unlocks of btcx, ft and lockproxy to ccm, the unlocks received by each account are
+// only indexed when it is registered through SetHooks of these modules
+type Hooks struct {
+	k Keeper
+}
+
+var _ common.CrossChainHooks = Hooks{}
+
+// Hooks returns the cross chain hooks indexing the received unlocks
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+func (h Hooks) AfterLock(ctx sdk.Context, info common.LockInfo) {}
+
+// AfterUnlock hands info back to ProcessCrossChainTx, which indexes it with the cross chain tx
+func (h Hooks) AfterUnlock(ctx sdk.Context, info common.UnlockInfo) {
+	if unlocks, ok := ctx.Value(receivedUnlocksKey{}).(*[]common.UnlockInfo); ok {
+		*unlocks = append(*unlocks, info)
+	}
+}
+
+func (h Hooks) AfterCrossChainTxProcessed(ctx sdk.Context, info common.CrossChainTxInfo) {}
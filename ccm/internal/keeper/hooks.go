@@ -0,0 +1,39 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/polynetwork/cosmos-poly-module/common"
+)
+
+// SetHooks sets the cross chain hooks, it can only be called once
+func (k *Keeper) SetHooks(hooks common.CrossChainHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set ccm hooks twice")
+	}
+	k.hooks = hooks
+	return k
+}
+
+// AfterCrossChainTxProcessed - call hook if registered
+func (k Keeper) AfterCrossChainTxProcessed(ctx sdk.Context, info common.CrossChainTxInfo) {
+	if k.hooks != nil {
+		k.hooks.AfterCrossChainTxProcessed(ctx, info)
+	}
+}
@@ -24,6 +24,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
 	"github.com/polynetwork/cosmos-poly-module/ccm/internal/types"
+	ccmpb "github.com/polynetwork/cosmos-poly-module/ccm/pb"
+	"github.com/polynetwork/cosmos-poly-module/common"
 	hs "github.com/polynetwork/cosmos-poly-module/headersync"
 	polycommon "github.com/polynetwork/poly/common"
 	polytype "github.com/polynetwork/poly/core/types"
@@ -43,6 +45,7 @@ type Keeper struct {
 	hsKeeper     types.HeaderSyncKeeper
 	supplyKeeper types.SupplyKeeper
 	ulKeeperMap  map[string]types.UnlockKeeper
+	hooks        common.CrossChainHooks
 }
 
 // NewKeeper creates a new mint Keeper instance
@@ -93,8 +96,14 @@ func (k Keeper) IfContainToContract(ctx sdk.Context, keystore string, toContract
 	return &res
 }
 
+// SetDenomCreator sets creator as the creator of denom and moves denom to creator in the creator index
 func (k Keeper) SetDenomCreator(ctx sdk.Context, denom string, creator sdk.AccAddress) {
-	ctx.KVStore(k.storeKey).Set(GetDenomToCreatorKey(denom), creator.Bytes())
+	store := ctx.KVStore(k.storeKey)
+	if oldCreator := store.Get(GetDenomToCreatorKey(denom)); len(oldCreator) != 0 {
+		store.Delete(GetCreatorToDenomKey(oldCreator, denom))
+	}
+	store.Set(GetDenomToCreatorKey(denom), creator.Bytes())
+	store.Set(GetCreatorToDenomKey(creator, denom), []byte(denom))
 }
 
 func (k Keeper) GetDenomCreator(ctx sdk.Context, denom string) (addr sdk.AccAddress) {
@@ -154,6 +163,8 @@ func (k Keeper) CreateCrossChainTx(ctx sdk.Context, fromAddr sdk.AccAddress, toC
 
 	txParamHash := tmhash.Sum(sink.Bytes())
 	store.Set(GetCrossChainTxKey(txParamHash), sink.Bytes())
+	k.indexCreatedTx(ctx, fromAddr, crossChainId.Uint64(), txParamHash, txParam)
+	k.setCrossChainTxCreated(ctx, fromAddr, crossChainId.Uint64(), txParamHash, txParam)
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
@@ -179,6 +190,9 @@ func (k Keeper) ProcessCrossChainTx(ctx sdk.Context, fromChainId uint64, proofSt
 	if err := headerToBeVerified.Deserialization(polycommon.NewZeroCopySource(headerBs)); err != nil {
 		return types.ErrProcessCrossChainTx(hs.ErrDeserializeHeader(err).Error())
 	}
+	if k.hsKeeper.IsChainFrozen(ctx, headerToBeVerified.ChainID) {
+		return types.ErrProcessCrossChainTx(hs.ErrChainFrozen(headerToBeVerified.ChainID).Error())
+	}
 
 	headerInCurEpoch := new(polytype.Header)
 	curHeaderBs, err := hex.DecodeString(curHeaderStr)
@@ -209,18 +223,44 @@ func (k Keeper) ProcessCrossChainTx(ctx sdk.Context, fromChainId uint64, proofSt
 		return types.ErrProcessCrossChainTx(fmt.Sprintf("VerifyToCosmostx failed, %s", err.Error()))
 	}
 	currentChainCrossChainId := k.GetParams(ctx).ChainIdInPolyNet
+	// the tx sent from current chain to another one is proven to acknowledge that Poly recorded it
+	if merkleValue.FromChainID == currentChainCrossChainId && merkleValue.MakeTxParam.ToChainID != currentChainCrossChainId {
+		if err := k.AcknowledgeCrossChainTx(ctx, merkleValue); err != nil {
+			return types.ErrProcessCrossChainTx(err.Error())
+		}
+		return nil
+	}
 	if merkleValue.MakeTxParam.ToChainID != currentChainCrossChainId {
 		return types.ErrProcessCrossChainTx(fmt.Sprintf("toChainId is not for this chain, expect: %d, got: %d", currentChainCrossChainId, merkleValue.MakeTxParam.ToChainID))
 	}
+	if string(merkleValue.MakeTxParam.ToContractAddress) == types.TxStatusContract {
+		if err := k.ProcessTxStatusReceipt(ctx, merkleValue); err != nil {
+			return types.ErrProcessCrossChainTx(err.Error())
+		}
+		return nil
+	}
 	// check if tocontractAddress is lockproxy module account, if yes, invoke lockproxy.unlock(), otherwise, invoke btcx.unlock
 	for key, unlockKeeper := range k.ulKeeperMap {
 		k.Logger(ctx).Info(fmt.Sprintf("key is %+v ", key))
 		k.Logger(ctx).Info(fmt.Sprintf("IfContains %+v ", unlockKeeper.ContainToContractAddr(ctx, merkleValue.MakeTxParam.ToContractAddress, fromChainId)))
 
 		if unlockKeeper.ContainToContractAddr(ctx, merkleValue.MakeTxParam.ToContractAddress, merkleValue.FromChainID) {
-			if err := unlockKeeper.Unlock(ctx, merkleValue.FromChainID, merkleValue.MakeTxParam.FromContractAddress, merkleValue.MakeTxParam.ToContractAddress, merkleValue.MakeTxParam.Args); err != nil {
+			var unlocks []common.UnlockInfo
+			if err := unlockKeeper.Unlock(withReceivedUnlocks(ctx, &unlocks), merkleValue.FromChainID, merkleValue.MakeTxParam.FromContractAddress, merkleValue.MakeTxParam.ToContractAddress, merkleValue.MakeTxParam.Args); err != nil {
 				return types.ErrProcessCrossChainTx(fmt.Sprintf("Unlock failed, for module: %s, Error: %s", key, err.Error()))
 			}
+			k.indexReceivedUnlocks(ctx, merkleValue, unlocks)
+			k.AfterCrossChainTxProcessed(ctx, common.CrossChainTxInfo{
+				Module:           key,
+				FromChainId:      merkleValue.FromChainID,
+				CrossChainId:     merkleValue.MakeTxParam.CrossChainID,
+				TxHash:           merkleValue.MakeTxParam.TxHash,
+				PolyTxHash:       merkleValue.TxHash,
+				FromContractHash: merkleValue.MakeTxParam.FromContractAddress,
+				ToContractHash:   merkleValue.MakeTxParam.ToContractAddress,
+				Method:           merkleValue.MakeTxParam.Method,
+				Args:             merkleValue.MakeTxParam.Args,
+			})
 			return nil
 		}
 	}
@@ -228,6 +268,11 @@ func (k Keeper) ProcessCrossChainTx(ctx sdk.Context, fromChainId uint64, proofSt
 	return types.ErrProcessCrossChainTx(fmt.Sprintf("Cannot find any unlock keeper to perform 'unlock' method for toContractAddr:%x, fromChainId:%d", merkleValue.MakeTxParam.ToContractAddress, fromChainId))
 }
 
+// RewardProofRelayer credits the submitter of a processed cross chain proof from the headersync relayer pool
+func (k Keeper) RewardProofRelayer(ctx sdk.Context, relayer sdk.AccAddress) {
+	k.hsKeeper.RewardProofRelayer(ctx, relayer)
+}
+
 func (k Keeper) VerifyToCosmosTx(ctx sdk.Context, proof []byte, header *polytype.Header) (*ccmc.ToMerkleValue, error) {
 	value, err := merkle.MerkleProve(proof, header.CrossStateRoot[:])
 	if err != nil {
@@ -275,24 +320,33 @@ func (k Keeper) putDoneTx(ctx sdk.Context, fromChainId uint64, crossChainId []by
 	store.Set(GetDoneTxKey(fromChainId, crossChainId), crossChainId)
 }
 
+// GetCrossChainId returns the cross chain id to be assigned to the next cross chain tx created in current chain
+func (k Keeper) GetCrossChainId(ctx sdk.Context) (sdk.Int, error) {
+	return k.getCrossChainId(ctx)
+}
+
 func (k Keeper) getCrossChainId(ctx sdk.Context) (sdk.Int, error) {
 	store := ctx.KVStore(k.storeKey)
 	idBs := store.Get(CrossChainIdKey)
 	if idBs == nil {
 		return sdk.NewInt(0), nil
 	}
-	var crossChainId sdk.Int
-	if err := k.cdc.UnmarshalBinaryLengthPrefixed(idBs, &crossChainId); err != nil {
-		return sdk.NewInt(0), types.ErrUnmarshalSpecificTypeFail(crossChainId, err)
+	var counter ccmpb.CrossChainIdCounter
+	if err := counter.Unmarshal(idBs); err != nil {
+		return sdk.NewInt(0), types.ErrUnmarshalSpecificTypeFail(counter, err)
+	}
+	crossChainId, ok := sdk.NewIntFromString(counter.NextId)
+	if !ok {
+		return sdk.NewInt(0), types.ErrUnmarshalSpecificTypeFail(counter, fmt.Errorf("invalid next id: %s", counter.NextId))
 	}
-
 	return crossChainId, nil
 }
 func (k Keeper) setCrossChainId(ctx sdk.Context, crossChainId sdk.Int) error {
 	store := ctx.KVStore(k.storeKey)
-	idBs, err := k.cdc.MarshalBinaryLengthPrefixed(crossChainId)
+	counter := ccmpb.CrossChainIdCounter{NextId: crossChainId.String()}
+	idBs, err := counter.Marshal()
 	if err != nil {
-		return types.ErrMarshalSpecificTypeFail(crossChainId, err)
+		return types.ErrMarshalSpecificTypeFail(counter, err)
 	}
 	store.Set(CrossChainIdKey, idBs)
 	return nil
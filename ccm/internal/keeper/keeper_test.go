@@ -0,0 +1,158 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package keeper_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/polynetwork/cosmos-poly-module/ccm/internal/types"
+	"github.com/polynetwork/cosmos-poly-module/common"
+	"github.com/polynetwork/cosmos-poly-module/simapp"
+)
+
+func createTestApp(isCheckTx bool) (*simapp.SimApp, sdk.Context) {
+	app := simapp.Setup(isCheckTx)
+	ctx := app.BaseApp.NewContext(isCheckTx, abci.Header{})
+	return app, ctx
+}
+
+func Test_ccm_DenomCreator(t *testing.T) {
+	app, ctx := createTestApp(true)
+
+	creator := sdk.AccAddress([]byte("creatorAddress123456"))
+	newCreator := sdk.AccAddress([]byte("newCreatorAddress123"))
+	other := sdk.AccAddress([]byte("otherAddress12345678"))
+	denom := "coin1"
+	app.CcmKeeper.SetDenomCreator(ctx, denom, creator)
+
+	require.True(t, types.ErrTransferDenomCreatorType.Is(app.CcmKeeper.ProposeDenomCreator(ctx, newCreator, denom, newCreator)), "only the creator proposes")
+	require.Error(t, app.CcmKeeper.ProposeDenomCreator(ctx, creator, "coin2", newCreator), "denom without creator")
+	require.Error(t, app.CcmKeeper.ProposeDenomCreator(ctx, creator, denom, creator), "the creator cannot be proposed")
+	require.Error(t, app.CcmKeeper.ProposeDenomCreator(ctx, creator, denom, nil), "empty new creator")
+	require.Error(t, app.CcmKeeper.AcceptDenomCreator(ctx, newCreator, denom), "nothing proposed")
+
+	// proposing again replaces the former proposal
+	require.Nil(t, app.CcmKeeper.ProposeDenomCreator(ctx, creator, denom, other))
+	require.Nil(t, app.CcmKeeper.ProposeDenomCreator(ctx, creator, denom, newCreator))
+	require.Equal(t, newCreator, app.CcmKeeper.GetPendingDenomCreator(ctx, denom))
+	require.Error(t, app.CcmKeeper.AcceptDenomCreator(ctx, other, denom))
+	// the creator role is kept until the new creator accepts it
+	require.Equal(t, creator, app.CcmKeeper.GetDenomCreator(ctx, denom))
+
+	require.Nil(t, app.CcmKeeper.AcceptDenomCreator(ctx, newCreator, denom))
+	require.Equal(t, newCreator, app.CcmKeeper.GetDenomCreator(ctx, denom))
+	require.Empty(t, app.CcmKeeper.GetPendingDenomCreator(ctx, denom))
+	require.Error(t, app.CcmKeeper.AcceptDenomCreator(ctx, newCreator, denom), "the proposal is consumed")
+	// the denom is listed under its new creator only
+	require.Empty(t, app.CcmKeeper.GetDenomsByCreator(ctx, creator, 1, 0, nil))
+	require.Equal(t, []string{denom}, app.CcmKeeper.GetDenomsByCreator(ctx, newCreator, 1, 0, nil))
+}
+
+func Test_ccm_DenomMetadata(t *testing.T) {
+	app, ctx := createTestApp(true)
+
+	creator := sdk.AccAddress([]byte("creatorAddress123456"))
+	other := sdk.AccAddress([]byte("otherAddress12345678"))
+	app.CcmKeeper.SetDenomCreator(ctx, "coin1", creator)
+	md := common.NewDenomMetadata("coin1", 18, "ETH", "Ether", 2, []byte{1, 2, 3, 4})
+	_, found := app.CcmKeeper.GetDenomMetadata(ctx, "coin1")
+	require.False(t, found)
+
+	noOriginHash := md
+	noOriginHash.OriginAssetHash = nil
+	require.True(t, types.ErrDenomMetadataType.Is(app.CcmKeeper.UpdateDenomMetadata(ctx, creator, noOriginHash)), "origin chain and hash are set together")
+	badDecimals := md
+	badDecimals.Decimals = 100
+	require.Error(t, app.CcmKeeper.UpdateDenomMetadata(ctx, creator, badDecimals))
+	noCreator := md
+	noCreator.Denom = "coin2"
+	require.Error(t, app.CcmKeeper.UpdateDenomMetadata(ctx, creator, noCreator))
+	require.Error(t, app.CcmKeeper.UpdateDenomMetadata(ctx, other, md), "only the creator updates the metadata")
+
+	require.Nil(t, app.CcmKeeper.UpdateDenomMetadata(ctx, creator, md))
+	md.DisplayName = "Wrapped Ether"
+	require.Nil(t, app.CcmKeeper.UpdateDenomMetadata(ctx, creator, md))
+	stored, found := app.CcmKeeper.GetDenomMetadata(ctx, "coin1")
+	require.True(t, found)
+	require.Equal(t, md, stored)
+}
+
+func Test_ccm_ChainInfo(t *testing.T) {
+	app, ctx := createTestApp(true)
+
+	evm := common.NewChainInfo(2, "ethereum", common.ChainFamilyEVM, common.EncodingRaw, 20, common.EncodingRaw, 20, 16)
+	btc := common.NewChainInfo(1, "bitcoin", common.ChainFamilyBTC, common.EncodingBase58, 0, common.EncodingRaw, 20, 8)
+	invalid := common.NewChainInfo(3, "unknown", "unknown", common.EncodingRaw, 0, common.EncodingRaw, 0, 0)
+	require.True(t, types.ErrChainInfoType.Is(app.CcmKeeper.SetChainInfo(ctx, invalid)), "unknown family should be rejected")
+	require.Nil(t, app.CcmKeeper.SetChainInfo(ctx, evm))
+	require.Nil(t, app.CcmKeeper.SetChainInfo(ctx, btc))
+	_, found := app.CcmKeeper.GetChainInfo(ctx, 3)
+	require.False(t, found)
+	stored, found := app.CcmKeeper.GetChainInfo(ctx, 2)
+	require.True(t, found)
+	require.Equal(t, evm, stored)
+
+	// registering again replaces the info
+	evm.AddressLength = 32
+	require.Nil(t, app.CcmKeeper.SetChainInfo(ctx, evm))
+	require.Equal(t, []common.ChainInfo{btc, evm}, app.CcmKeeper.GetAllChainInfos(ctx))
+
+	require.Nil(t, app.CcmKeeper.RemoveChainInfo(ctx, 2))
+	require.Error(t, app.CcmKeeper.RemoveChainInfo(ctx, 2))
+	_, found = app.CcmKeeper.GetChainInfo(ctx, 2)
+	require.False(t, found)
+	require.Equal(t, []common.ChainInfo{btc}, app.CcmKeeper.GetAllChainInfos(ctx))
+}
+
+func Test_ccm_CreatedCrossChainTxs(t *testing.T) {
+	app, ctx := createTestApp(true)
+
+	creator := sdk.AccAddress([]byte("creatorAddress123456"))
+	other := sdk.AccAddress([]byte("otherAddress12345678"))
+	ctx = ctx.WithBlockHeight(5)
+	require.Nil(t, app.CcmKeeper.CreateCrossChainTx(ctx, creator, 2, []byte("coin1"), []byte{1, 2, 3, 4}, "unlock", []byte{1}))
+	require.Nil(t, app.CcmKeeper.CreateCrossChainTx(ctx, other, 2, []byte("coin1"), []byte{1, 2, 3, 4}, "unlock", []byte{2}))
+	ctx = ctx.WithBlockHeight(6)
+	require.Nil(t, app.CcmKeeper.CreateCrossChainTx(ctx, creator, 3, []byte("coin1"), []byte{1, 2, 3, 5}, "unlock", []byte{3}))
+
+	txs := app.CcmKeeper.GetCreatedCrossChainTxs(ctx, creator, 1, 0)
+	require.Equal(t, 2, len(txs))
+	require.Equal(t, types.CreatedCrossChainTx{
+		CrossChainId: 0,
+		TxParamHash:  txs[0].TxParamHash,
+		FromContract: hex.EncodeToString([]byte("coin1")),
+		ToChainId:    2,
+		ToContract:   "01020304",
+		Method:       "unlock",
+		Height:       5,
+	}, txs[0])
+	require.Equal(t, 64, len(txs[0].TxParamHash))
+	require.Equal(t, uint64(2), txs[1].CrossChainId)
+	require.Equal(t, int64(6), txs[1].Height)
+	require.Equal(t, txs[1:], app.CcmKeeper.GetCreatedCrossChainTxs(ctx, creator, 2, 1))
+
+	otherTxs := app.CcmKeeper.GetCreatedCrossChainTxs(ctx, other, 1, 0)
+	require.Equal(t, 1, len(otherTxs))
+	require.Equal(t, uint64(1), otherTxs[0].CrossChainId)
+	require.Empty(t, app.CcmKeeper.GetReceivedUnlocks(ctx, creator, 1, 0))
+}
@@ -20,6 +20,7 @@ package keeper
 import (
 	"encoding/binary"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/polynetwork/cosmos-poly-module/ccm/internal/types"
 )
 
@@ -29,9 +30,16 @@ const (
 )
 
 var (
-	CrossChainTxDetailPrefix = []byte{0x01}
-	CrossChainDoneTxPrefix   = []byte{0x02}
-	DenomToCreatorPrefix     = []byte{0x03}
+	CrossChainTxDetailPrefix    = []byte{0x01}
+	CrossChainDoneTxPrefix      = []byte{0x02}
+	DenomToCreatorPrefix        = []byte{0x03}
+	DenomToPendingCreatorPrefix = []byte{0x04}
+	DenomToMetadataPrefix       = []byte{0x05}
+	ChainInfoPrefix             = []byte{0x06}
+	AddressToCreatedTxPrefix    = []byte{0x07}
+	AddressToUnlockPrefix       = []byte{0x08}
+	CrossChainTxStatusPrefix    = []byte{0x09}
+	CreatorToDenomPrefix        = []byte{0x0a}
 
 	CrossChainIdKey = []byte("crosschainid")
 )
@@ -48,3 +56,45 @@ func GetDoneTxKey(fromChainId uint64, crossChainid []byte) []byte {
 func GetDenomToCreatorKey(denom string) []byte {
 	return append(DenomToCreatorPrefix, []byte(denom)...)
 }
+
+func GetDenomToPendingCreatorKey(denom string) []byte {
+	return append(DenomToPendingCreatorPrefix, []byte(denom)...)
+}
+
+func GetDenomToMetadataKey(denom string) []byte {
+	return append(DenomToMetadataPrefix, []byte(denom)...)
+}
+
+func GetChainInfoKey(chainId uint64) []byte {
+	return append(ChainInfoPrefix, sdk.Uint64ToBigEndian(chainId)...)
+}
+
+func GetAddressToCreatedTxPrefix(addr sdk.AccAddress) []byte {
+	return append(append([]byte{}, AddressToCreatedTxPrefix...), addr...)
+}
+
+func GetAddressToCreatedTxKey(addr sdk.AccAddress, crossChainId uint64) []byte {
+	return append(GetAddressToCreatedTxPrefix(addr), sdk.Uint64ToBigEndian(crossChainId)...)
+}
+
+func GetAddressToUnlockPrefix(addr sdk.AccAddress) []byte {
+	return append(append([]byte{}, AddressToUnlockPrefix...), addr...)
+}
+
+// GetAddressToUnlockKey orders the unlocks of addr by height, the cross chain id is unique within fromChainId
+func GetAddressToUnlockKey(addr sdk.AccAddress, height int64, fromChainId uint64, crossChainId []byte) []byte {
+	key := append(GetAddressToUnlockPrefix(addr), sdk.Uint64ToBigEndian(uint64(height))...)
+	return append(append(key, sdk.Uint64ToBigEndian(fromChainId)...), crossChainId...)
+}
+
+func GetCrossChainTxStatusKey(crossChainId uint64) []byte {
+	return append(append([]byte{}, CrossChainTxStatusPrefix...), sdk.Uint64ToBigEndian(crossChainId)...)
+}
+
+func GetCreatorToDenomPrefix(creator sdk.AccAddress) []byte {
+	return append(append([]byte{}, CreatorToDenomPrefix...), creator...)
+}
+
+func GetCreatorToDenomKey(creator sdk.AccAddress, denom string) []byte {
+	return append(GetCreatorToDenomPrefix(creator), denom...)
+}
@@ -0,0 +1,69 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/polynetwork/cosmos-poly-module/ccm/internal/types"
+	"github.com/polynetwork/cosmos-poly-module/common"
+	commonpb "github.com/polynetwork/cosmos-poly-module/common/pb"
+)
+
+// SetDenomMetadata stores the metadata of md.Denom, it is called by the asset modules when the denom is created
+func (k Keeper) SetDenomMetadata(ctx sdk.Context, md common.DenomMetadata) {
+	ctx.KVStore(k.storeKey).Set(GetDenomToMetadataKey(md.Denom), common.MustMarshalProto(common.DenomMetadataToProto(md)))
+}
+
+// GetDenomMetadata returns the metadata of denom, false if none has been registered
+func (k Keeper) GetDenomMetadata(ctx sdk.Context, denom string) (md common.DenomMetadata, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(GetDenomToMetadataKey(denom))
+	if bz == nil {
+		return md, false
+	}
+	var pb commonpb.DenomMetadata
+	common.MustUnmarshalProto(bz, &pb)
+	return common.DenomMetadataFromProto(&pb), true
+}
+
+// UpdateDenomMetadata replaces the metadata of md.Denom, it is only allowed for the creator of the denom
+func (k Keeper) UpdateDenomMetadata(ctx sdk.Context, creator sdk.AccAddress, md common.DenomMetadata) error {
+	if err := md.ValidateBasic(); err != nil {
+		return types.ErrDenomMetadata(err.Error())
+	}
+	currentCreator := k.GetDenomCreator(ctx, md.Denom)
+	if len(currentCreator) == 0 {
+		return types.ErrDenomMetadata(fmt.Sprintf("denom: %s has no creator", md.Denom))
+	}
+	if !currentCreator.Equals(creator) {
+		return types.ErrDenomMetadata(fmt.Sprintf("creator is not valid, expect: %s, got: %s", currentCreator.String(), creator.String()))
+	}
+	k.SetDenomMetadata(ctx, md)
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUpdateDenomMetadata,
+			sdk.NewAttribute(types.AttributeKeyDenom, md.Denom),
+			sdk.NewAttribute(types.AttributeKeyCreator, creator.String()),
+			sdk.NewAttribute(types.AttributeKeySymbol, md.Symbol),
+			sdk.NewAttribute(types.AttributeKeyDecimals, strconv.FormatUint(uint64(md.Decimals), 10)),
+		),
+	})
+	return nil
+}
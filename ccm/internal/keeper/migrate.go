@@ -0,0 +1,36 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ccmpb "github.com/polynetwork/cosmos-poly-module/ccm/pb"
+	"github.com/polynetwork/cosmos-poly-module/common"
+)
+
+// MigrateStoreToProtobuf re-encodes the cross chain id counter of ccm module stored by amino into protobuf, it is run
+// once by the upgrade handler switching the store encoding. MakeTxParam keeps its zero-copy encoding since poly
+// verifies the state proof of it, and the records kept as raw bytes are left untouched
+func (k Keeper) MigrateStoreToProtobuf(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	if bz := store.Get(CrossChainIdKey); bz != nil {
+		var crossChainId sdk.Int
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &crossChainId)
+		store.Set(CrossChainIdKey, common.MustMarshalProto(&ccmpb.CrossChainIdCounter{NextId: crossChainId.String()}))
+	}
+}
@@ -0,0 +1,52 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/upgrade"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polynetwork/cosmos-poly-module/ccm/internal/keeper"
+	"github.com/polynetwork/cosmos-poly-module/ccm/internal/types"
+	"github.com/polynetwork/cosmos-poly-module/simapp"
+)
+
+func Test_ccm_MigrateStoreToProtobuf(t *testing.T) {
+	app, ctx := createTestApp(true)
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+	creator := sdk.AccAddress([]byte("creator"))
+
+	// records stored before the upgrade
+	store.Set(keeper.CrossChainIdKey, app.Codec().MustMarshalBinaryLengthPrefixed(sdk.NewInt(8)))
+	// the zero-copy MakeTxParam is verified by poly and must be left untouched
+	txParam := []byte{0x01, 0x02, 0x03}
+	store.Set(keeper.GetCrossChainTxKey([]byte("txParamHash")), txParam)
+	store.Set(keeper.GetDenomToCreatorKey("coin1"), creator)
+
+	app.UpgradeKeeper.ApplyUpgrade(ctx, upgrade.Plan{Name: simapp.ProtobufStoreUpgrade, Height: ctx.BlockHeight()})
+
+	crossChainId, err := app.CcmKeeper.GetCrossChainId(ctx)
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewInt(8), crossChainId)
+	require.Equal(t, txParam, store.Get(keeper.GetCrossChainTxKey([]byte("txParamHash"))))
+	require.Equal(t, creator, app.CcmKeeper.GetDenomCreator(ctx, "coin1"))
+	require.Equal(t, []string{"coin1"}, app.CcmKeeper.GetDenomsByCreator(ctx, creator, 1, 0, nil))
+}
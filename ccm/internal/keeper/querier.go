@@ -18,6 +18,8 @@
 package keeper
 
 import (
+	"fmt"
+
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	abci "github.com/tendermint/tendermint/abci/types"
 
@@ -36,6 +38,20 @@ func NewQuerier(k Keeper) sdk.Querier {
 			return queryParams(ctx, k)
 		case types.QueryModuleBalance:
 			return queryModuleBalance(ctx, req, k)
+		case types.QueryDenomCreator:
+			return queryDenomCreator(ctx, req, k)
+		case types.QueryDenomMetadata:
+			return queryDenomMetadata(ctx, req, k)
+		case types.QueryChainInfo:
+			return queryChainInfo(ctx, req, k)
+		case types.QueryChains:
+			return queryChains(ctx, k)
+		case types.QueryCreatedCrossChainTxs:
+			return queryCreatedCrossChainTxs(ctx, req, k)
+		case types.QueryReceivedUnlocks:
+			return queryReceivedUnlocks(ctx, req, k)
+		case types.QueryCrossChainTxStatus:
+			return queryCrossChainTxStatus(ctx, req, k)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown query path: %s", path[0])
 		}
@@ -87,3 +103,116 @@ func queryModuleBalance(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byt
 
 	return bz, nil
 }
+
+func queryDenomCreator(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryDenomCreatorParam
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONUnmarshal, "failed to parse params: %s", err)
+	}
+	info := types.DenomCreatorInfo{
+		Denom:          params.Denom,
+		Creator:        k.GetDenomCreator(ctx, params.Denom),
+		PendingCreator: k.GetPendingDenomCreator(ctx, params.Denom),
+	}
+	bz, e := codec.MarshalJSONIndent(types.ModuleCdc, info)
+	if e != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONMarshal, "could not marshal value: %+v to JSON", info)
+	}
+
+	return bz, nil
+}
+
+func queryDenomMetadata(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryDenomMetadataParam
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONUnmarshal, "failed to parse params: %s", err)
+	}
+	md, found := k.GetDenomMetadata(ctx, params.Denom)
+	if !found {
+		return nil, types.ErrDenomMetadata(fmt.Sprintf("metadata of denom: %s has not been registered", params.Denom))
+	}
+	bz, e := codec.MarshalJSONIndent(types.ModuleCdc, md)
+	if e != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONMarshal, "could not marshal value: %+v to JSON", md)
+	}
+
+	return bz, nil
+}
+
+func queryChainInfo(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryChainInfoParam
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONUnmarshal, "failed to parse params: %s", err)
+	}
+	info, found := k.GetChainInfo(ctx, params.ChainId)
+	if !found {
+		return nil, types.ErrChainInfo(fmt.Sprintf("chain: %d has not been registered", params.ChainId))
+	}
+	bz, e := codec.MarshalJSONIndent(types.ModuleCdc, info)
+	if e != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONMarshal, "could not marshal value: %+v to JSON", info)
+	}
+
+	return bz, nil
+}
+
+func queryChains(ctx sdk.Context, k Keeper) ([]byte, error) {
+	infos := k.GetAllChainInfos(ctx)
+	bz, e := codec.MarshalJSONIndent(types.ModuleCdc, infos)
+	if e != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONMarshal, "could not marshal value: %+v to JSON", infos)
+	}
+
+	return bz, nil
+}
+
+func queryCreatedCrossChainTxs(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryCreatedCrossChainTxsParam
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONUnmarshal, "failed to parse params: %s", err)
+	}
+	txs := k.GetCreatedCrossChainTxs(ctx, params.Address, params.Page, params.Limit)
+	bz, e := codec.MarshalJSONIndent(types.ModuleCdc, txs)
+	if e != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONMarshal, "could not marshal value: %+v to JSON", txs)
+	}
+
+	return bz, nil
+}
+
+func queryReceivedUnlocks(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryReceivedUnlocksParam
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONUnmarshal, "failed to parse params: %s", err)
+	}
+	unlocks := k.GetReceivedUnlocks(ctx, params.Address, params.Page, params.Limit)
+	bz, e := codec.MarshalJSONIndent(types.ModuleCdc, unlocks)
+	if e != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONMarshal, "could not marshal value: %+v to JSON", unlocks)
+	}
+
+	return bz, nil
+}
+
+func queryCrossChainTxStatus(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryCrossChainTxStatusParam
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONUnmarshal, "failed to parse params: %s", err)
+	}
+	status, found := k.GetCrossChainTxStatus(ctx, params.CrossChainId)
+	if !found {
+		return nil, types.ErrCrossChainTxStatus(fmt.Sprintf("cross chain tx with crossChainId: %d is not tracked", params.CrossChainId))
+	}
+	bz, e := codec.MarshalJSONIndent(types.ModuleCdc, status)
+	if e != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONMarshal, "could not marshal value: %+v to JSON", status)
+	}
+
+	return bz, nil
+}
@@ -0,0 +1,184 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package keeper
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/polynetwork/cosmos-poly-module/ccm/internal/types"
+	ccmpb "github.com/polynetwork/cosmos-poly-module/ccm/pb"
+	"github.com/polynetwork/cosmos-poly-module/common"
+	polycommon "github.com/polynetwork/poly/common"
+	ccmc "github.com/polynetwork/poly/native/service/cross_chain_manager/common"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+)
+
+// setCrossChainTxCreated starts tracking the status of the cross chain tx created by fromAddr
+func (k Keeper) setCrossChainTxCreated(ctx sdk.Context, fromAddr sdk.AccAddress, crossChainId uint64, txParamHash []byte, txParam ccmc.MakeTxParam) {
+	k.setCrossChainTxStatus(ctx, types.CrossChainTxStatus{
+		CrossChainId: crossChainId,
+		TxParamHash:  hex.EncodeToString(txParamHash),
+		FromAddress:  fromAddr,
+		ToChainId:    txParam.ToChainID,
+		ToContract:   hex.EncodeToString(txParam.ToContractAddress),
+		Status:       types.TxStatusCreated,
+		History: []types.TxStatusChange{{
+			Status: types.TxStatusCreated,
+			TxHash: hex.EncodeToString(txParam.TxHash),
+			Height: ctx.BlockHeight(),
+		}},
+	})
+}
+
+func (k Keeper) setCrossChainTxStatus(ctx sdk.Context, status types.CrossChainTxStatus) {
+	ctx.KVStore(k.storeKey).Set(GetCrossChainTxStatusKey(status.CrossChainId), common.MustMarshalProto(types.CrossChainTxStatusToProto(status)))
+}
+
+// GetCrossChainTxStatus returns the status of the cross chain tx created in current chain with crossChainId,
+// false if it is not tracked
+func (k Keeper) GetCrossChainTxStatus(ctx sdk.Context, crossChainId uint64) (status types.CrossChainTxStatus, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(GetCrossChainTxStatusKey(crossChainId))
+	if bz == nil {
+		return status, false
+	}
+	var pb ccmpb.CrossChainTxStatus
+	common.MustUnmarshalProto(bz, &pb)
+	return types.CrossChainTxStatusFromProto(&pb), true
+}
+
+// AcknowledgeCrossChainTx moves the cross chain tx created in current chain to acknowledged, merkleValue is the tx
+// recorded by Poly and verified against a synced Poly header, it must carry the very param current chain created
+func (k Keeper) AcknowledgeCrossChainTx(ctx sdk.Context, merkleValue *ccmc.ToMerkleValue) error {
+	status, err := k.getTrackedCrossChainTxStatus(ctx, merkleValue.MakeTxParam.CrossChainID)
+	if err != nil {
+		return err
+	}
+	sink := polycommon.NewZeroCopySink(nil)
+	merkleValue.MakeTxParam.Serialization(sink)
+	if txParamHash := hex.EncodeToString(tmhash.Sum(sink.Bytes())); txParamHash != status.TxParamHash {
+		return types.ErrCrossChainTxStatus(fmt.Sprintf("tx param hash: %s recorded by Poly differs from: %s of the tx with crossChainId: %d", txParamHash, status.TxParamHash, status.CrossChainId))
+	}
+	return k.updateCrossChainTxStatus(ctx, status, types.TxStatusAcknowledged, merkleValue.TxHash)
+}
+
+// ProcessTxStatusReceipt moves the cross chain tx in the receipt args to the status given by the method, the receipt is
+// relayed by Poly and only accepted from the to contract of the tx in its target chain, see TxStatusContract for the
+// receipt expected. A refunded receipt unlocks its refund args through the module which created the tx
+func (k Keeper) ProcessTxStatusReceipt(ctx sdk.Context, merkleValue *ccmc.ToMerkleValue) error {
+	var args types.TxStatusReceiptArgs
+	if err := args.Deserialization(polycommon.NewZeroCopySource(merkleValue.MakeTxParam.Args)); err != nil {
+		return types.ErrCrossChainTxStatus(fmt.Sprintf("Deserialize args Error: %s", err))
+	}
+	status, err := k.getTrackedCrossChainTxStatus(ctx, args.CrossChainId)
+	if err != nil {
+		return err
+	}
+	fromChainId, fromContractAddr := merkleValue.FromChainID, merkleValue.MakeTxParam.FromContractAddress
+	if fromChainId != status.ToChainId || hex.EncodeToString(fromContractAddr) != status.ToContract {
+		return types.ErrCrossChainTxStatus(fmt.Sprintf("receipt from chain: %d, contract: %x is not sent by the target chain: %d, contract: %s of the tx", fromChainId, fromContractAddr, status.ToChainId, status.ToContract))
+	}
+	method := merkleValue.MakeTxParam.Method
+	if (method == types.TxStatusRefunded) != (len(args.RefundArgs) != 0) {
+		return types.ErrCrossChainTxStatus(fmt.Sprintf("receipt with method: %s should carry refund args only if it is %s", method, types.TxStatusRefunded))
+	}
+	if err := k.updateCrossChainTxStatus(ctx, status, method, args.TxHash); err != nil {
+		return err
+	}
+	if method == types.TxStatusRefunded {
+		return k.refundCrossChainTx(ctx, merkleValue, status, args.RefundArgs)
+	}
+	return nil
+}
+
+// refundCrossChainTx unlocks refundArgs through the unlock keeper owning the from contract of the tx tracked by status
+func (k Keeper) refundCrossChainTx(ctx sdk.Context, merkleValue *ccmc.ToMerkleValue, status types.CrossChainTxStatus, refundArgs []byte) error {
+	txParamHash, err := hex.DecodeString(status.TxParamHash)
+	if err != nil {
+		return types.ErrCrossChainTxStatus(fmt.Sprintf("decode tx param hash: %s, Error: %s", status.TxParamHash, err))
+	}
+	var txParam ccmc.MakeTxParam
+	if err := txParam.Deserialization(polycommon.NewZeroCopySource(ctx.KVStore(k.storeKey).Get(GetCrossChainTxKey(txParamHash)))); err != nil {
+		return types.ErrCrossChainTxStatus(fmt.Sprintf("Deserialize tx param of crossChainId: %d, Error: %s", status.CrossChainId, err))
+	}
+	for key, unlockKeeper := range k.ulKeeperMap {
+		if !unlockKeeper.ContainToContractAddr(ctx, txParam.FromContractAddress, merkleValue.FromChainID) {
+			continue
+		}
+		var unlocks []common.UnlockInfo
+		if err := unlockKeeper.Unlock(withReceivedUnlocks(ctx, &unlocks), merkleValue.FromChainID, merkleValue.MakeTxParam.FromContractAddress, txParam.FromContractAddress, refundArgs); err != nil {
+			return types.ErrCrossChainTxStatus(fmt.Sprintf("Refund failed, for module: %s, Error: %s", key, err.Error()))
+		}
+		k.indexReceivedUnlocks(ctx, merkleValue, unlocks)
+		k.AfterCrossChainTxProcessed(ctx, common.CrossChainTxInfo{
+			Module:           key,
+			FromChainId:      merkleValue.FromChainID,
+			CrossChainId:     merkleValue.MakeTxParam.CrossChainID,
+			TxHash:           merkleValue.MakeTxParam.TxHash,
+			PolyTxHash:       merkleValue.TxHash,
+			FromContractHash: merkleValue.MakeTxParam.FromContractAddress,
+			ToContractHash:   txParam.FromContractAddress,
+			Method:           merkleValue.MakeTxParam.Method,
+			Args:             refundArgs,
+		})
+		return nil
+	}
+	return types.ErrCrossChainTxStatus(fmt.Sprintf("Cannot find any unlock keeper to refund to contract: %x from chainId: %d", txParam.FromContractAddress, merkleValue.FromChainID))
+}
+
+// getTrackedCrossChainTxStatus returns the status of the cross chain tx created in current chain with crossChainIdBs,
+// the big endian cross chain id of its param
+func (k Keeper) getTrackedCrossChainTxStatus(ctx sdk.Context, crossChainIdBs []byte) (types.CrossChainTxStatus, error) {
+	crossChainId := new(big.Int).SetBytes(crossChainIdBs)
+	if !crossChainId.IsUint64() {
+		return types.CrossChainTxStatus{}, types.ErrCrossChainTxStatus(fmt.Sprintf("crossChainId: %x is out of range", crossChainIdBs))
+	}
+	status, found := k.GetCrossChainTxStatus(ctx, crossChainId.Uint64())
+	if !found {
+		return status, types.ErrCrossChainTxStatus(fmt.Sprintf("cross chain tx with crossChainId: %s is not tracked", crossChainId.String()))
+	}
+	return status, nil
+}
+
+// updateCrossChainTxStatus moves the cross chain tx tracked by status to newStatus reported by txHash
+func (k Keeper) updateCrossChainTxStatus(ctx sdk.Context, status types.CrossChainTxStatus, newStatus string, txHash []byte) error {
+	if !types.IsValidTxStatusTransition(status.Status, newStatus) {
+		return types.ErrCrossChainTxStatus(fmt.Sprintf("cross chain tx with crossChainId: %d cannot be moved from %s to %s", status.CrossChainId, status.Status, newStatus))
+	}
+
+	status.Status = newStatus
+	status.History = append(status.History, types.TxStatusChange{
+		Status: newStatus,
+		TxHash: hex.EncodeToString(txHash),
+		Height: ctx.BlockHeight(),
+	})
+	k.setCrossChainTxStatus(ctx, status)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUpdateCrossChainTxStatus,
+			sdk.NewAttribute(types.AttributeCrossChainId, strconv.FormatUint(status.CrossChainId, 10)),
+			sdk.NewAttribute(types.AttributeKeyStatus, status.Status),
+			sdk.NewAttribute(types.AttributeKeyTxHash, hex.EncodeToString(txHash)),
+		),
+	})
+	return nil
+}
@@ -0,0 +1,137 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package keeper_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+	polycommon "github.com/polynetwork/poly/common"
+	ccmc "github.com/polynetwork/poly/native/service/cross_chain_manager/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polynetwork/cosmos-poly-module/ccm/internal/keeper"
+	"github.com/polynetwork/cosmos-poly-module/ccm/internal/types"
+	"github.com/polynetwork/cosmos-poly-module/common"
+	"github.com/polynetwork/cosmos-poly-module/simapp"
+)
+
+// createdTxParam returns the param of the cross chain tx created in current chain as recorded by Poly
+func createdTxParam(t *testing.T, app *simapp.SimApp, ctx sdk.Context, status types.CrossChainTxStatus) *ccmc.MakeTxParam {
+	txParamHash, err := hex.DecodeString(status.TxParamHash)
+	require.Nil(t, err)
+	txParam := new(ccmc.MakeTxParam)
+	require.Nil(t, txParam.Deserialization(polycommon.NewZeroCopySource(ctx.KVStore(app.GetKey(types.StoreKey)).Get(keeper.GetCrossChainTxKey(txParamHash)))))
+	return txParam
+}
+
+func Test_ccm_CrossChainTxStatus(t *testing.T) {
+	app, ctx := createTestApp(true)
+	app.SupplyKeeper.SetSupply(ctx, supply.NewSupply(sdk.Coins{sdk.NewCoin("stake", sdk.NewInt(100))}))
+
+	creator := sdk.AccAddress([]byte("creatorAddress123456"))
+	denom := "coin1"
+	toAssetHash := []byte{1, 2, 3, 4}
+	require.Nil(t, app.FtKeeper.CreateDenom(ctx, creator, denom, nil))
+	require.Nil(t, app.FtKeeper.MintCoins(ctx, creator, sdk.NewCoins(sdk.NewInt64Coin(denom, 100))))
+	require.Nil(t, app.FtKeeper.BindAssetHash(ctx, creator, denom, 2, toAssetHash, 0, 0))
+	ctx = ctx.WithBlockHeight(5)
+	require.Nil(t, app.FtKeeper.Lock(ctx, creator, denom, 2, []byte{1, 2}, sdk.NewInt(10)))
+	require.Nil(t, app.FtKeeper.Lock(ctx, creator, denom, 2, []byte{1, 2}, sdk.NewInt(20)))
+	require.Equal(t, "70coin1", app.BankKeeper.GetCoins(ctx, creator).String())
+
+	status, found := app.CcmKeeper.GetCrossChainTxStatus(ctx, 0)
+	require.True(t, found)
+	require.Equal(t, types.TxStatusCreated, status.Status)
+	require.Equal(t, creator, status.FromAddress)
+	require.Equal(t, uint64(2), status.ToChainId)
+	require.Equal(t, "01020304", status.ToContract)
+	require.Equal(t, int64(5), status.History[0].Height)
+	_, found = app.CcmKeeper.GetCrossChainTxStatus(ctx, 2)
+	require.False(t, found)
+
+	// Poly acknowledges the very param created in current chain
+	ctx = ctx.WithBlockHeight(6)
+	txParam := createdTxParam(t, app, ctx, status)
+	tampered := *txParam
+	tampered.Args = []byte{1}
+	require.Error(t, app.CcmKeeper.AcknowledgeCrossChainTx(ctx, &ccmc.ToMerkleValue{TxHash: []byte{0xaa}, FromChainID: 1, MakeTxParam: &tampered}))
+	require.Nil(t, app.CcmKeeper.AcknowledgeCrossChainTx(ctx, &ccmc.ToMerkleValue{TxHash: []byte{0xaa}, FromChainID: 1, MakeTxParam: txParam}))
+	require.Error(t, app.CcmKeeper.AcknowledgeCrossChainTx(ctx, &ccmc.ToMerkleValue{TxHash: []byte{0xaa}, FromChainID: 1, MakeTxParam: txParam}))
+
+	receipt := func(fromChainId uint64, fromContract []byte, method string, crossChainId int64, txHash, refundArgs []byte) *ccmc.ToMerkleValue {
+		sink := polycommon.NewZeroCopySink(nil)
+		args := types.TxStatusReceiptArgs{CrossChainId: sdk.NewInt(crossChainId).BigInt().Bytes(), TxHash: txHash, RefundArgs: refundArgs}
+		require.Nil(t, args.Serialization(sink))
+		return &ccmc.ToMerkleValue{
+			TxHash:      []byte{0xbb},
+			FromChainID: fromChainId,
+			MakeTxParam: &ccmc.MakeTxParam{
+				CrossChainID:        []byte{byte(crossChainId)},
+				FromContractAddress: fromContract,
+				ToContractAddress:   []byte(types.TxStatusContract),
+				Method:              method,
+				Args:                sink.Bytes(),
+			},
+		}
+	}
+
+	ctx = ctx.WithBlockHeight(7)
+	// only the to contract in the target chain reports the status
+	require.Error(t, app.CcmKeeper.ProcessTxStatusReceipt(ctx, receipt(3, toAssetHash, types.TxStatusExecuted, 0, []byte{1}, nil)))
+	require.Error(t, app.CcmKeeper.ProcessTxStatusReceipt(ctx, receipt(2, []byte{1, 2}, types.TxStatusExecuted, 0, []byte{1}, nil)))
+	require.Error(t, app.CcmKeeper.ProcessTxStatusReceipt(ctx, receipt(2, toAssetHash, types.TxStatusExecuted, 2, []byte{1}, nil)))
+	require.Error(t, app.CcmKeeper.ProcessTxStatusReceipt(ctx, receipt(2, toAssetHash, types.TxStatusCreated, 0, []byte{1}, nil)))
+	require.Error(t, app.CcmKeeper.ProcessTxStatusReceipt(ctx, receipt(2, toAssetHash, types.TxStatusAcknowledged, 0, []byte{1}, nil)))
+	require.Error(t, app.CcmKeeper.ProcessTxStatusReceipt(ctx, receipt(2, toAssetHash, types.TxStatusExecuted, 0, []byte{1}, []byte{1})), "only a refund carries refund args")
+	require.Nil(t, app.CcmKeeper.ProcessTxStatusReceipt(ctx, receipt(2, toAssetHash, types.TxStatusExecuted, 0, []byte{2}, nil)))
+	require.Error(t, app.CcmKeeper.ProcessTxStatusReceipt(ctx, receipt(2, toAssetHash, types.TxStatusFailed, 0, []byte{3}, nil)), "executed is final")
+
+	status, _ = app.CcmKeeper.GetCrossChainTxStatus(ctx, 0)
+	require.Equal(t, types.TxStatusExecuted, status.Status)
+	require.Equal(t, []types.TxStatusChange{
+		status.History[0],
+		{Status: types.TxStatusAcknowledged, TxHash: "aa", Height: 6},
+		{Status: types.TxStatusExecuted, TxHash: "02", Height: 7},
+	}, status.History)
+
+	// a failed tx is refunded by the unlock carried in the refunded receipt
+	refundArgs := func(amount int64) []byte {
+		sink := polycommon.NewZeroCopySink(nil)
+		sink.WriteVarBytes(creator)
+		amountBs, err := common.PadFixedBytes(sdk.NewInt(amount).BigInt(), common.DefaultAmountWidth)
+		require.Nil(t, err)
+		sink.WriteBytes(amountBs)
+		return sink.Bytes()
+	}
+	require.Nil(t, app.CcmKeeper.ProcessTxStatusReceipt(ctx, receipt(2, toAssetHash, types.TxStatusFailed, 1, []byte{4}, nil)))
+	require.Error(t, app.CcmKeeper.ProcessTxStatusReceipt(ctx, receipt(2, toAssetHash, types.TxStatusRefunded, 1, []byte{5}, nil)), "a refund carries refund args")
+	// the failed refund is reverted with the tx processing the receipt
+	cacheCtx, _ := ctx.CacheContext()
+	require.Error(t, app.CcmKeeper.ProcessTxStatusReceipt(cacheCtx, receipt(2, toAssetHash, types.TxStatusRefunded, 1, []byte{5}, []byte{1})), "malformed refund args")
+	require.Nil(t, app.CcmKeeper.ProcessTxStatusReceipt(ctx, receipt(2, toAssetHash, types.TxStatusRefunded, 1, []byte{5}, refundArgs(20))))
+	require.Equal(t, "90coin1", app.BankKeeper.GetCoins(ctx, creator).String())
+	require.Equal(t, 1, len(app.CcmKeeper.GetReceivedUnlocks(ctx, creator, 1, 0)))
+	require.Error(t, app.CcmKeeper.ProcessTxStatusReceipt(ctx, receipt(2, toAssetHash, types.TxStatusRefunded, 1, []byte{6}, refundArgs(20))), "refunded is final")
+
+	status, _ = app.CcmKeeper.GetCrossChainTxStatus(ctx, 1)
+	require.Equal(t, types.TxStatusRefunded, status.Status)
+	require.Equal(t, []string{types.TxStatusCreated, types.TxStatusFailed, types.TxStatusRefunded}, []string{status.History[0].Status, status.History[1].Status, status.History[2].Status})
+}
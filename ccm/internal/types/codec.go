@@ -27,6 +27,9 @@ var ModuleCdc *codec.Codec
 func RegisterCodec(cdc *codec.Codec) {
 
 	cdc.RegisterConcrete(MsgProcessCrossChainTx{}, ModuleName+"/MsgProcessCrossChainTx", nil)
+	cdc.RegisterConcrete(MsgProposeDenomCreator{}, ModuleName+"/MsgProposeDenomCreator", nil)
+	cdc.RegisterConcrete(MsgAcceptDenomCreator{}, ModuleName+"/MsgAcceptDenomCreator", nil)
+	cdc.RegisterConcrete(MsgUpdateDenomMetadata{}, ModuleName+"/MsgUpdateDenomMetadata", nil)
 }
 
 func init() {
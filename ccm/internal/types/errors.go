@@ -33,6 +33,10 @@ var (
 	ErrMsgProcessCrossChainTxType = sdkerrors.Register(ModuleName, 5, "ErrMsgProcessCrossChainTxType")
 	ErrMsgCreateCrossChainTxType  = sdkerrors.Register(ModuleName, 6, "ErrMsgCreateCrossChainTxType")
 	ErrGetModuleBalanceType       = sdkerrors.Register(ModuleName, 7, "ErrGetModuleBalanceType")
+	ErrTransferDenomCreatorType   = sdkerrors.Register(ModuleName, 8, "ErrTransferDenomCreatorType")
+	ErrDenomMetadataType          = sdkerrors.Register(ModuleName, 9, "ErrDenomMetadataType")
+	ErrChainInfoType              = sdkerrors.Register(ModuleName, 10, "ErrChainInfoType")
+	ErrCrossChainTxStatusType     = sdkerrors.Register(ModuleName, 11, "ErrCrossChainTxStatusType")
 )
 
 func ErrMarshalSpecificTypeFail(o interface{}, err error) error {
@@ -61,3 +65,19 @@ func ErrMsgCreateCrossChainTx(reason string) error {
 func ErrGetModuleBalance(reason string) error {
 	return sdkerrors.Wrapf(ErrGetModuleBalanceType, "Reason: %s", reason)
 }
+
+func ErrTransferDenomCreator(reason string) error {
+	return sdkerrors.Wrapf(ErrTransferDenomCreatorType, "Reason: %s", reason)
+}
+
+func ErrDenomMetadata(reason string) error {
+	return sdkerrors.Wrapf(ErrDenomMetadataType, "Reason: %s", reason)
+}
+
+func ErrChainInfo(reason string) error {
+	return sdkerrors.Wrapf(ErrChainInfoType, "Reason: %s", reason)
+}
+
+func ErrCrossChainTxStatus(reason string) error {
+	return sdkerrors.Wrapf(ErrCrossChainTxStatusType, "Reason: %s", reason)
+}
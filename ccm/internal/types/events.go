@@ -35,4 +35,22 @@ const (
 	AttributeKeyMerkleValueMakeTxParamTxHash            = "merkle_value:make_tx_param:txhash"
 	AttributeKeyMerkleValueMakeTxParamToContractAddress = "merkle_value:make_tx_param:to_contract_address"
 	AttributeKeyFromChainId                             = "from_chain_id"
+
+	EventTypeProposeDenomCreator = "propose_denom_creator"
+	EventTypeAcceptDenomCreator  = "accept_denom_creator"
+	AttributeKeyDenom            = "denom"
+	AttributeKeyCreator          = "creator"
+	AttributeKeyNewCreator       = "new_creator"
+
+	EventTypeUpdateDenomMetadata = "update_denom_metadata"
+	AttributeKeySymbol           = "symbol"
+	AttributeKeyDecimals         = "decimals"
+
+	EventTypeSetChainInfo    = "set_chain_info"
+	EventTypeRemoveChainInfo = "remove_chain_info"
+	AttributeKeyChainId      = "chain_id"
+	AttributeKeyFamily       = "family"
+
+	EventTypeUpdateCrossChainTxStatus = "update_cross_chain_tx_status"
+	AttributeKeyTxHash                = "tx_hash"
 )
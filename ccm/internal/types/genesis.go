@@ -17,15 +17,23 @@
 
 package types
 
+import (
+	"fmt"
+
+	"github.com/polynetwork/cosmos-poly-module/common"
+)
+
 // GenesisState - minter state
 type GenesisState struct {
-	Params Params `json:"params" yaml:"params"` // inflation params
+	Params Params             `json:"params" yaml:"params"` // inflation params
+	Chains []common.ChainInfo `json:"chains" yaml:"chains"` // chain registry
 }
 
 // NewGenesisState creates a new GenesisState object
-func NewGenesisState(params Params) GenesisState {
+func NewGenesisState(params Params, chains []common.ChainInfo) GenesisState {
 	return GenesisState{
 		Params: params,
+		Chains: chains,
 	}
 }
 
@@ -42,6 +50,16 @@ func ValidateGenesis(data GenesisState) error {
 	if err := data.Params.Validate(); err != nil {
 		return err
 	}
+	seen := make(map[uint64]bool, len(data.Chains))
+	for _, info := range data.Chains {
+		if err := info.ValidateBasic(); err != nil {
+			return err
+		}
+		if seen[info.ChainId] {
+			return fmt.Errorf("duplicated chain info of chain: %d", info.ChainId)
+		}
+		seen[info.ChainId] = true
+	}
 
 	return nil
 }
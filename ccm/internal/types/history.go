@@ -0,0 +1,73 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CreatedCrossChainTx records a cross chain tx created by an account through CreateCrossChainTx
+type CreatedCrossChainTx struct {
+	CrossChainId uint64 `json:"cross_chain_id" yaml:"cross_chain_id"`
+	TxParamHash  string `json:"tx_param_hash" yaml:"tx_param_hash"`
+	FromContract string `json:"from_contract" yaml:"from_contract"`
+	ToChainId    uint64 `json:"to_chain_id" yaml:"to_chain_id"`
+	ToContract   string `json:"to_contract" yaml:"to_contract"`
+	Method       string `json:"method" yaml:"method"`
+	Height       int64  `json:"height" yaml:"height"`
+}
+
+func (tx CreatedCrossChainTx) String() string {
+	return fmt.Sprintf(`
+  CrossChainId:   %d
+  TxParamHash:    %s
+  FromContract:   %s
+  ToChainId:      %d
+  ToContract:     %s
+  Method:         %s
+  Height:         %d
+`, tx.CrossChainId, tx.TxParamHash, tx.FromContract, tx.ToChainId, tx.ToContract, tx.Method, tx.Height)
+}
+
+// ReceivedUnlock records the coins unlocked to an account by a cross chain tx from another chain,
+// CrossChainId and TxHash are assigned by the source chain
+type ReceivedUnlock struct {
+	Module       string  `json:"module" yaml:"module"`
+	FromChainId  uint64  `json:"from_chain_id" yaml:"from_chain_id"`
+	CrossChainId string  `json:"cross_chain_id" yaml:"cross_chain_id"`
+	TxHash       string  `json:"tx_hash" yaml:"tx_hash"`
+	PolyTxHash   string  `json:"poly_tx_hash" yaml:"poly_tx_hash"`
+	Denom        string  `json:"denom" yaml:"denom"`
+	Amount       sdk.Int `json:"amount" yaml:"amount"`
+	Height       int64   `json:"height" yaml:"height"`
+}
+
+func (u ReceivedUnlock) String() string {
+	return fmt.Sprintf(`
+  Module:       %s
+  FromChainId:  %d
+  CrossChainId: %s
+  TxHash:       %s
+  PolyTxHash:   %s
+  Denom:        %s
+  Amount:       %s
+  Height:       %d
+`, u.Module, u.FromChainId, u.CrossChainId, u.TxHash, u.PolyTxHash, u.Denom, u.Amount.String(), u.Height)
+}
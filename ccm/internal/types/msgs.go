@@ -22,12 +22,16 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/polynetwork/cosmos-poly-module/common"
 )
 
 // Governance message types and routes
 const (
 	TypeMsgProcessCrossChainTx = "process_cross_chain_tx"
 	TypeMsgCreateCoins         = "create_coins"
+	TypeMsgProposeDenomCreator = "propose_denom_creator"
+	TypeMsgAcceptDenomCreator  = "accept_denom_creator"
+	TypeMsgUpdateDenomMetadata = "update_denom_metadata"
 )
 
 type MsgProcessCrossChainTx struct {
@@ -135,3 +139,133 @@ func (msg MsgCreateCrossChainTx) GetSignBytes() []byte {
 func (msg MsgCreateCrossChainTx) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{}
 }
+
+type MsgProposeDenomCreator struct {
+	Creator    sdk.AccAddress // current creator of the denom
+	Denom      string
+	NewCreator sdk.AccAddress
+}
+
+func NewMsgProposeDenomCreator(creator sdk.AccAddress, denom string, newCreator sdk.AccAddress) MsgProposeDenomCreator {
+	return MsgProposeDenomCreator{creator, denom, newCreator}
+}
+
+//nolint
+func (msg MsgProposeDenomCreator) Route() string { return RouterKey }
+func (msg MsgProposeDenomCreator) Type() string  { return TypeMsgProposeDenomCreator }
+
+// Implements Msg.
+func (msg MsgProposeDenomCreator) ValidateBasic() error {
+	if msg.Creator.Empty() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "MsgProposeDenomCreator.Creator is empty")
+	}
+	if msg.NewCreator.Empty() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "MsgProposeDenomCreator.NewCreator is empty")
+	}
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return ErrTransferDenomCreator(fmt.Sprintf("MsgProposeDenomCreator.Denom: %s is invalid, err: %v", msg.Denom, err))
+	}
+	return nil
+}
+
+func (msg MsgProposeDenomCreator) String() string {
+	return fmt.Sprintf(`Propose Denom Creator Message:
+  Creator:         %s
+  Denom:           %s
+  NewCreator:      %s
+`, msg.Creator.String(), msg.Denom, msg.NewCreator.String())
+}
+
+// Implements Msg.
+func (msg MsgProposeDenomCreator) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// Implements Msg.
+func (msg MsgProposeDenomCreator) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Creator}
+}
+
+type MsgAcceptDenomCreator struct {
+	NewCreator sdk.AccAddress // the address proposed by the current creator of the denom
+	Denom      string
+}
+
+func NewMsgAcceptDenomCreator(newCreator sdk.AccAddress, denom string) MsgAcceptDenomCreator {
+	return MsgAcceptDenomCreator{newCreator, denom}
+}
+
+//nolint
+func (msg MsgAcceptDenomCreator) Route() string { return RouterKey }
+func (msg MsgAcceptDenomCreator) Type() string  { return TypeMsgAcceptDenomCreator }
+
+// Implements Msg.
+func (msg MsgAcceptDenomCreator) ValidateBasic() error {
+	if msg.NewCreator.Empty() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "MsgAcceptDenomCreator.NewCreator is empty")
+	}
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return ErrTransferDenomCreator(fmt.Sprintf("MsgAcceptDenomCreator.Denom: %s is invalid, err: %v", msg.Denom, err))
+	}
+	return nil
+}
+
+func (msg MsgAcceptDenomCreator) String() string {
+	return fmt.Sprintf(`Accept Denom Creator Message:
+  NewCreator:      %s
+  Denom:           %s
+`, msg.NewCreator.String(), msg.Denom)
+}
+
+// Implements Msg.
+func (msg MsgAcceptDenomCreator) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// Implements Msg.
+func (msg MsgAcceptDenomCreator) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.NewCreator}
+}
+
+type MsgUpdateDenomMetadata struct {
+	Creator  sdk.AccAddress // current creator of the denom
+	Metadata common.DenomMetadata
+}
+
+func NewMsgUpdateDenomMetadata(creator sdk.AccAddress, metadata common.DenomMetadata) MsgUpdateDenomMetadata {
+	return MsgUpdateDenomMetadata{creator, metadata}
+}
+
+//nolint
+func (msg MsgUpdateDenomMetadata) Route() string { return RouterKey }
+func (msg MsgUpdateDenomMetadata) Type() string  { return TypeMsgUpdateDenomMetadata }
+
+// Implements Msg.
+func (msg MsgUpdateDenomMetadata) ValidateBasic() error {
+	if msg.Creator.Empty() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "MsgUpdateDenomMetadata.Creator is empty")
+	}
+	if err := msg.Metadata.ValidateBasic(); err != nil {
+		return ErrDenomMetadata(fmt.Sprintf("MsgUpdateDenomMetadata.Metadata is invalid, err: %v", err))
+	}
+	return nil
+}
+
+func (msg MsgUpdateDenomMetadata) String() string {
+	return fmt.Sprintf(`Update Denom Metadata Message:
+  Creator:         %s
+  %s`, msg.Creator.String(), msg.Metadata.String())
+}
+
+// Implements Msg.
+func (msg MsgUpdateDenomMetadata) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// Implements Msg.
+func (msg MsgUpdateDenomMetadata) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Creator}
+}
@@ -0,0 +1,125 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package types
+
+import (
+	"fmt"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/polynetwork/cosmos-poly-module/common"
+)
+
+const (
+	// ProposalTypeSetChainInfo defines the type for a SetChainInfoProposal
+	ProposalTypeSetChainInfo = "SetChainInfo"
+	// ProposalTypeRemoveChainInfo defines the type for a RemoveChainInfoProposal
+	ProposalTypeRemoveChainInfo = "RemoveChainInfo"
+)
+
+// Assert proposals implement govtypes.Content at compile-time
+var (
+	_ govtypes.Content = SetChainInfoProposal{}
+	_ govtypes.Content = RemoveChainInfoProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeSetChainInfo)
+	govtypes.RegisterProposalTypeCodec(SetChainInfoProposal{}, ModuleName+"/SetChainInfoProposal")
+	govtypes.RegisterProposalType(ProposalTypeRemoveChainInfo)
+	govtypes.RegisterProposalTypeCodec(RemoveChainInfoProposal{}, ModuleName+"/RemoveChainInfoProposal")
+}
+
+// SetChainInfoProposal registers a chain in the chain registry, or replaces its info if already registered
+type SetChainInfoProposal struct {
+	Title       string           `json:"title" yaml:"title"`
+	Description string           `json:"description" yaml:"description"`
+	ChainInfo   common.ChainInfo `json:"chain_info" yaml:"chain_info"`
+}
+
+func NewSetChainInfoProposal(title, description string, info common.ChainInfo) SetChainInfoProposal {
+	return SetChainInfoProposal{Title: title, Description: description, ChainInfo: info}
+}
+
+// GetTitle returns the title of a set chain info proposal.
+func (p SetChainInfoProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a set chain info proposal.
+func (p SetChainInfoProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a set chain info proposal.
+func (p SetChainInfoProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a set chain info proposal.
+func (p SetChainInfoProposal) ProposalType() string { return ProposalTypeSetChainInfo }
+
+// ValidateBasic validates the set chain info proposal
+func (p SetChainInfoProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if err := p.ChainInfo.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p SetChainInfoProposal) String() string {
+	return fmt.Sprintf(`Set Chain Info Proposal:
+  Title:          %s
+  Description:    %s
+  %s`, p.Title, p.Description, p.ChainInfo.String())
+}
+
+// RemoveChainInfoProposal removes a chain from the chain registry
+type RemoveChainInfoProposal struct {
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+	ChainId     uint64 `json:"chain_id" yaml:"chain_id"`
+}
+
+func NewRemoveChainInfoProposal(title, description string, chainId uint64) RemoveChainInfoProposal {
+	return RemoveChainInfoProposal{Title: title, Description: description, ChainId: chainId}
+}
+
+// GetTitle returns the title of a remove chain info proposal.
+func (p RemoveChainInfoProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a remove chain info proposal.
+func (p RemoveChainInfoProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a remove chain info proposal.
+func (p RemoveChainInfoProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a remove chain info proposal.
+func (p RemoveChainInfoProposal) ProposalType() string { return ProposalTypeRemoveChainInfo }
+
+// ValidateBasic validates the remove chain info proposal
+func (p RemoveChainInfoProposal) ValidateBasic() error {
+	return govtypes.ValidateAbstract(p)
+}
+
+// String implements the Stringer interface.
+func (p RemoveChainInfoProposal) String() string {
+	return fmt.Sprintf(`Remove Chain Info Proposal:
+  Title:          %s
+  Description:    %s
+  ChainId:        %d
+`, p.Title, p.Description, p.ChainId)
+}
@@ -0,0 +1,125 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package types
+
+import (
+	ccmpb "github.com/polynetwork/cosmos-poly-module/ccm/pb"
+	"github.com/polynetwork/cosmos-poly-module/common"
+)
+
+func ParamsToProto(p Params) *ccmpb.Params {
+	return &ccmpb.Params{ChainIdInPolyNet: p.ChainIdInPolyNet}
+}
+
+func CreatedCrossChainTxToProto(tx CreatedCrossChainTx) *ccmpb.CreatedCrossChainTx {
+	return &ccmpb.CreatedCrossChainTx{
+		CrossChainId: tx.CrossChainId,
+		TxParamHash:  tx.TxParamHash,
+		FromContract: tx.FromContract,
+		ToChainId:    tx.ToChainId,
+		ToContract:   tx.ToContract,
+		Method:       tx.Method,
+		Height:       tx.Height,
+	}
+}
+
+func CreatedCrossChainTxFromProto(pb *ccmpb.CreatedCrossChainTx) CreatedCrossChainTx {
+	return CreatedCrossChainTx{
+		CrossChainId: pb.CrossChainId,
+		TxParamHash:  pb.TxParamHash,
+		FromContract: pb.FromContract,
+		ToChainId:    pb.ToChainId,
+		ToContract:   pb.ToContract,
+		Method:       pb.Method,
+		Height:       pb.Height,
+	}
+}
+
+func CreatedCrossChainTxsToProto(txs []CreatedCrossChainTx) []*ccmpb.CreatedCrossChainTx {
+	pbs := make([]*ccmpb.CreatedCrossChainTx, len(txs))
+	for i, tx := range txs {
+		pbs[i] = CreatedCrossChainTxToProto(tx)
+	}
+	return pbs
+}
+
+func ReceivedUnlockToProto(unlock ReceivedUnlock) *ccmpb.ReceivedUnlock {
+	return &ccmpb.ReceivedUnlock{
+		Module:       unlock.Module,
+		FromChainId:  unlock.FromChainId,
+		CrossChainId: unlock.CrossChainId,
+		TxHash:       unlock.TxHash,
+		PolyTxHash:   unlock.PolyTxHash,
+		Denom:        unlock.Denom,
+		Amount:       unlock.Amount.String(),
+		Height:       unlock.Height,
+	}
+}
+
+func ReceivedUnlockFromProto(pb *ccmpb.ReceivedUnlock) ReceivedUnlock {
+	return ReceivedUnlock{
+		Module:       pb.Module,
+		FromChainId:  pb.FromChainId,
+		CrossChainId: pb.CrossChainId,
+		TxHash:       pb.TxHash,
+		PolyTxHash:   pb.PolyTxHash,
+		Denom:        pb.Denom,
+		Amount:       common.IntFromProto(pb.Amount),
+		Height:       pb.Height,
+	}
+}
+
+func ReceivedUnlocksToProto(unlocks []ReceivedUnlock) []*ccmpb.ReceivedUnlock {
+	pbs := make([]*ccmpb.ReceivedUnlock, len(unlocks))
+	for i, unlock := range unlocks {
+		pbs[i] = ReceivedUnlockToProto(unlock)
+	}
+	return pbs
+}
+
+func CrossChainTxStatusToProto(s CrossChainTxStatus) *ccmpb.CrossChainTxStatus {
+	history := make([]*ccmpb.TxStatusChange, len(s.History))
+	for i, change := range s.History {
+		history[i] = &ccmpb.TxStatusChange{Status: change.Status, TxHash: change.TxHash, Height: change.Height}
+	}
+	return &ccmpb.CrossChainTxStatus{
+		CrossChainId: s.CrossChainId,
+		TxParamHash:  s.TxParamHash,
+		FromAddress:  s.FromAddress.String(),
+		ToChainId:    s.ToChainId,
+		ToContract:   s.ToContract,
+		Status:       s.Status,
+		History:      history,
+	}
+}
+
+func CrossChainTxStatusFromProto(pb *ccmpb.CrossChainTxStatus) CrossChainTxStatus {
+	history := make([]TxStatusChange, len(pb.History))
+	for i, change := range pb.History {
+		history[i] = TxStatusChange{Status: change.Status, TxHash: change.TxHash, Height: change.Height}
+	}
+	return CrossChainTxStatus{
+		CrossChainId: pb.CrossChainId,
+		TxParamHash:  pb.TxParamHash,
+		FromAddress:  common.AccAddressFromProto(pb.FromAddress),
+		ToChainId:    pb.ToChainId,
+		ToContract:   pb.ToContract,
+		Status:       pb.Status,
+		History:      history,
+	}
+}
@@ -19,10 +19,19 @@ package types
 
 import (
 	"fmt"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 const (
 	QueryModuleBalance = "module_balance"
+	QueryDenomCreator  = "denom_creator"
+	QueryDenomMetadata = "denom_metadata"
+	QueryChainInfo     = "chain_info"
+	QueryChains        = "chains"
+
+	QueryCreatedCrossChainTxs = "created_cross_chain_txs"
+	QueryReceivedUnlocks      = "received_unlocks"
+	QueryCrossChainTxStatus   = "cross_chain_tx_status"
 )
 
 // QueryBalanceParams defines the params for querying an account balance.
@@ -64,3 +73,70 @@ type QueryModuleBalanceParam struct {
 func NewQueryModuleBalanceParam(moduleName string) QueryModuleBalanceParam {
 	return QueryModuleBalanceParam{ModuleName: moduleName}
 }
+
+type QueryDenomCreatorParam struct {
+	Denom string
+}
+
+func NewQueryDenomCreatorParam(denom string) QueryDenomCreatorParam {
+	return QueryDenomCreatorParam{Denom: denom}
+}
+
+// DenomCreatorInfo is returned by QueryDenomCreator, PendingCreator is the address proposed to take over the creator role
+type DenomCreatorInfo struct {
+	Denom          string         `json:"denom" yaml:"denom"`
+	Creator        sdk.AccAddress `json:"creator" yaml:"creator"`
+	PendingCreator sdk.AccAddress `json:"pending_creator" yaml:"pending_creator"`
+}
+
+func (info DenomCreatorInfo) String() string {
+	return fmt.Sprintf(`DenomCreatorInfo:
+  Denom:          %s
+  Creator:        %s
+  PendingCreator: %s
+`, info.Denom, info.Creator.String(), info.PendingCreator.String())
+}
+
+type QueryDenomMetadataParam struct {
+	Denom string
+}
+
+func NewQueryDenomMetadataParam(denom string) QueryDenomMetadataParam {
+	return QueryDenomMetadataParam{Denom: denom}
+}
+
+type QueryChainInfoParam struct {
+	ChainId uint64
+}
+
+func NewQueryChainInfoParam(chainId uint64) QueryChainInfoParam {
+	return QueryChainInfoParam{ChainId: chainId}
+}
+
+type QueryCreatedCrossChainTxsParam struct {
+	Address sdk.AccAddress
+	Page    int
+	Limit   int
+}
+
+func NewQueryCreatedCrossChainTxsParam(address sdk.AccAddress, page, limit int) QueryCreatedCrossChainTxsParam {
+	return QueryCreatedCrossChainTxsParam{Address: address, Page: page, Limit: limit}
+}
+
+type QueryReceivedUnlocksParam struct {
+	Address sdk.AccAddress
+	Page    int
+	Limit   int
+}
+
+func NewQueryReceivedUnlocksParam(address sdk.AccAddress, page, limit int) QueryReceivedUnlocksParam {
+	return QueryReceivedUnlocksParam{Address: address, Page: page, Limit: limit}
+}
+
+type QueryCrossChainTxStatusParam struct {
+	CrossChainId uint64
+}
+
+func NewQueryCrossChainTxStatusParam(crossChainId uint64) QueryCrossChainTxStatusParam {
+	return QueryCrossChainTxStatusParam{CrossChainId: crossChainId}
+}
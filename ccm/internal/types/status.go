@@ -0,0 +1,135 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	polycommon "github.com/polynetwork/poly/common"
+)
+
+// Lifecycle of a cross chain tx created in current chain, every transition is driven by data verified against the
+// Poly headers synced by headersync. The tx is acknowledged once the proof of Poly recording it is processed by
+// ProcessCrossChainTx, which keeps it as a done tx like any other proof. It is executed, failed or refunded once the
+// to contract in the target chain reports the outcome by a status receipt: a cross chain tx sent back to current
+// chain with TxStatusContract as the to contract, the new status as the method and TxStatusReceiptArgs as the args.
+// A refunded receipt carries the args of the unlock giving the coins back, performed by the module creating the tx.
+// The proxies deployed in the target chains send no status receipt yet, their txs stay acknowledged until they do
+const (
+	TxStatusCreated      = "created"
+	TxStatusAcknowledged = "acknowledged"
+	TxStatusExecuted     = "executed"
+	TxStatusFailed       = "failed"
+	TxStatusRefunded     = "refunded"
+
+	// TxStatusContract is the to contract of the status receipts relayed by Poly, it cannot collide with
+	// a denom as sdk denoms contain no underscore. The method of the receipt is the new status
+	TxStatusContract = "cross_chain_tx_status"
+)
+
+// IsValidTxStatusTransition tells if a cross chain tx in status from can be moved to status to, the receipt of the
+// target chain may be relayed before the acknowledgement of Poly. Executed and refunded are final
+func IsValidTxStatusTransition(from, to string) bool {
+	switch from {
+	case TxStatusCreated:
+		return to == TxStatusAcknowledged || to == TxStatusExecuted || to == TxStatusFailed || to == TxStatusRefunded
+	case TxStatusAcknowledged:
+		return to == TxStatusExecuted || to == TxStatusFailed || to == TxStatusRefunded
+	case TxStatusFailed:
+		return to == TxStatusRefunded
+	}
+	return false
+}
+
+// TxStatusChange records a transition of the status of a cross chain tx, TxHash is the tx of Poly for the
+// acknowledgement and the tx reporting the new status in the chain sending the receipt otherwise
+type TxStatusChange struct {
+	Status string `json:"status" yaml:"status"`
+	TxHash string `json:"tx_hash" yaml:"tx_hash"`
+	Height int64  `json:"height" yaml:"height"`
+}
+
+// CrossChainTxStatus tracks a cross chain tx created in current chain through its lifecycle, the
+// transitions are driven by the proofs of Poly and the receipts relayed from the to contract in the target chain
+type CrossChainTxStatus struct {
+	CrossChainId uint64           `json:"cross_chain_id" yaml:"cross_chain_id"`
+	TxParamHash  string           `json:"tx_param_hash" yaml:"tx_param_hash"`
+	FromAddress  sdk.AccAddress   `json:"from_address" yaml:"from_address"`
+	ToChainId    uint64           `json:"to_chain_id" yaml:"to_chain_id"`
+	ToContract   string           `json:"to_contract" yaml:"to_contract"`
+	Status       string           `json:"status" yaml:"status"`
+	History      []TxStatusChange `json:"history" yaml:"history"`
+}
+
+func (s CrossChainTxStatus) String() string {
+	var b strings.Builder
+	for _, change := range s.History {
+		b.WriteString(fmt.Sprintf("\n    %s at height %d, TxHash: %s", change.Status, change.Height, change.TxHash))
+	}
+	return fmt.Sprintf(`
+  CrossChainId: %d
+  TxParamHash:  %s
+  FromAddress:  %s
+  ToChainId:    %d
+  ToContract:   %s
+  Status:       %s
+  History:      %s
+`, s.CrossChainId, s.TxParamHash, s.FromAddress.String(), s.ToChainId, s.ToContract, s.Status, b.String())
+}
+
+// TxStatusReceiptArgs is relayed by Poly from the target chain of a cross chain tx, CrossChainId is the one
+// assigned to the tx by current chain and TxHash the tx reporting the status in the target chain. RefundArgs are
+// the unlock args of the from contract of the tx, only sent with a refunded receipt
+type TxStatusReceiptArgs struct {
+	CrossChainId []byte
+	TxHash       []byte
+	RefundArgs   []byte
+}
+
+func (this *TxStatusReceiptArgs) Serialization(sink *polycommon.ZeroCopySink) error {
+	sink.WriteVarBytes(this.CrossChainId)
+	sink.WriteVarBytes(this.TxHash)
+	if len(this.RefundArgs) != 0 {
+		sink.WriteVarBytes(this.RefundArgs)
+	}
+	return nil
+}
+
+func (this *TxStatusReceiptArgs) Deserialization(source *polycommon.ZeroCopySource) error {
+	crossChainId, eof := source.NextVarBytes()
+	if eof {
+		return fmt.Errorf("TxStatusReceiptArgs deserialize crossChainId error")
+	}
+	txHash, eof := source.NextVarBytes()
+	if eof {
+		return fmt.Errorf("TxStatusReceiptArgs deserialize txHash error")
+	}
+	this.CrossChainId = crossChainId
+	this.TxHash = txHash
+	if source.Len() == 0 {
+		return nil
+	}
+	refundArgs, eof := source.NextVarBytes()
+	if eof {
+		return fmt.Errorf("TxStatusReceiptArgs deserialize refundArgs error")
+	}
+	this.RefundArgs = refundArgs
+	return nil
+}
@@ -23,6 +23,7 @@ import (
 
 	"github.com/gorilla/mux"
 	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 
@@ -32,6 +33,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/polynetwork/cosmos-poly-module/ccm/client/cli"
 	"github.com/polynetwork/cosmos-poly-module/ccm/client/rest"
+	ccmpb "github.com/polynetwork/cosmos-poly-module/ccm/pb"
 )
 
 var (
@@ -123,6 +125,11 @@ func (am AppModule) NewQuerierHandler() sdk.Querier {
 	return NewQuerier(am.keeper)
 }
 
+// RegisterQueryService registers the gRPC query service of the module, it is served next to the legacy querier
+func (am AppModule) RegisterQueryService(server *grpc.Server) {
+	ccmpb.RegisterQueryServer(server, NewQueryServer(am.keeper))
+}
+
 // module init-genesis
 func (am AppModule) InitGenesis(ctx sdk.Context, data json.RawMessage) []abci.ValidatorUpdate {
 	var genesisState GenesisState
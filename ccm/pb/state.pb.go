@@ -0,0 +1,802 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: polynetwork/ccm/v1/state.proto
+
+package ccmpb
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MakeTxParam documents the zero-copy record under 0x01, it is never stored in protobuf encoding
+type MakeTxParam struct {
+	TxHash            []byte `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	CrossChainId      []byte `protobuf:"bytes,2,opt,name=cross_chain_id,json=crossChainId,proto3" json:"cross_chain_id,omitempty"`
+	FromContract      []byte `protobuf:"bytes,3,opt,name=from_contract,json=fromContract,proto3" json:"from_contract,omitempty"`
+	ToChainId         uint64 `protobuf:"varint,4,opt,name=to_chain_id,json=toChainId,proto3" json:"to_chain_id,omitempty"`
+	ToContractAddress []byte `protobuf:"bytes,5,opt,name=to_contract_address,json=toContractAddress,proto3" json:"to_contract_address,omitempty"`
+	Method            string `protobuf:"bytes,6,opt,name=method,proto3" json:"method,omitempty"`
+	Args              []byte `protobuf:"bytes,7,opt,name=args,proto3" json:"args,omitempty"`
+}
+
+func (m *MakeTxParam) Reset()         { *m = MakeTxParam{} }
+func (m *MakeTxParam) String() string { return proto.CompactTextString(m) }
+func (*MakeTxParam) ProtoMessage()    {}
+func (*MakeTxParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3646cc2042ec66b7, []int{0}
+}
+func (m *MakeTxParam) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MakeTxParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MakeTxParam.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MakeTxParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MakeTxParam.Merge(m, src)
+}
+func (m *MakeTxParam) XXX_Size() int {
+	return m.Size()
+}
+func (m *MakeTxParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_MakeTxParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MakeTxParam proto.InternalMessageInfo
+
+func (m *MakeTxParam) GetTxHash() []byte {
+	if m != nil {
+		return m.TxHash
+	}
+	return nil
+}
+
+func (m *MakeTxParam) GetCrossChainId() []byte {
+	if m != nil {
+		return m.CrossChainId
+	}
+	return nil
+}
+
+func (m *MakeTxParam) GetFromContract() []byte {
+	if m != nil {
+		return m.FromContract
+	}
+	return nil
+}
+
+func (m *MakeTxParam) GetToChainId() uint64 {
+	if m != nil {
+		return m.ToChainId
+	}
+	return 0
+}
+
+func (m *MakeTxParam) GetToContractAddress() []byte {
+	if m != nil {
+		return m.ToContractAddress
+	}
+	return nil
+}
+
+func (m *MakeTxParam) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *MakeTxParam) GetArgs() []byte {
+	if m != nil {
+		return m.Args
+	}
+	return nil
+}
+
+type CrossChainIdCounter struct {
+	NextId string `protobuf:"bytes,1,opt,name=next_id,json=nextId,proto3" json:"next_id,omitempty"`
+}
+
+func (m *CrossChainIdCounter) Reset()         { *m = CrossChainIdCounter{} }
+func (m *CrossChainIdCounter) String() string { return proto.CompactTextString(m) }
+func (*CrossChainIdCounter) ProtoMessage()    {}
+func (*CrossChainIdCounter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3646cc2042ec66b7, []int{1}
+}
+func (m *CrossChainIdCounter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CrossChainIdCounter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CrossChainIdCounter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CrossChainIdCounter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CrossChainIdCounter.Merge(m, src)
+}
+func (m *CrossChainIdCounter) XXX_Size() int {
+	return m.Size()
+}
+func (m *CrossChainIdCounter) XXX_DiscardUnknown() {
+	xxx_messageInfo_CrossChainIdCounter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CrossChainIdCounter proto.InternalMessageInfo
+
+func (m *CrossChainIdCounter) GetNextId() string {
+	if m != nil {
+		return m.NextId
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*MakeTxParam)(nil), "polynetwork.ccm.v1.MakeTxParam")
+	proto.RegisterType((*CrossChainIdCounter)(nil), "polynetwork.ccm.v1.CrossChainIdCounter")
+}
+
+func init() { proto.RegisterFile("polynetwork/ccm/v1/state.proto", fileDescriptor_3646cc2042ec66b7) }
+
+var fileDescriptor_3646cc2042ec66b7 = []byte{
+	// 328 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0x31, 0x6f, 0xea, 0x30,
+	0x10, 0xc7, 0xf1, 0x7b, 0xbc, 0x20, 0x0c, 0xaf, 0x52, 0x8d, 0xd4, 0x66, 0xb2, 0x10, 0xed, 0xc0,
+	0x42, 0x22, 0x54, 0xa9, 0x4b, 0xa7, 0x36, 0x4b, 0x19, 0x2a, 0x21, 0xd4, 0xa9, 0x4b, 0xe4, 0x38,
+	0x2e, 0x41, 0xe0, 0x5c, 0x64, 0x1f, 0x34, 0xfd, 0x16, 0xfd, 0x58, 0x1d, 0x19, 0x3b, 0x56, 0x30,
+	0xf7, 0x3b, 0x54, 0x31, 0xa0, 0xd2, 0xcd, 0xf7, 0xfb, 0x9f, 0x7f, 0x3a, 0xfb, 0x28, 0x2f, 0x60,
+	0xf1, 0x9a, 0x2b, 0x7c, 0x01, 0x33, 0x0f, 0xa5, 0xd4, 0xe1, 0x6a, 0x18, 0x5a, 0x14, 0xa8, 0x82,
+	0xc2, 0x00, 0x02, 0x63, 0x47, 0x79, 0x20, 0xa5, 0x0e, 0x56, 0xc3, 0xde, 0x17, 0xa1, 0xad, 0x07,
+	0x31, 0x57, 0x8f, 0xe5, 0x58, 0x18, 0xa1, 0xd9, 0x39, 0x6d, 0x60, 0x19, 0x67, 0xc2, 0x66, 0x3e,
+	0xe9, 0x92, 0x7e, 0x7b, 0xe2, 0x61, 0x79, 0x2f, 0x6c, 0xc6, 0x2e, 0xe9, 0x89, 0x34, 0x60, 0x6d,
+	0x2c, 0x33, 0x31, 0xcb, 0xe3, 0x59, 0xea, 0xff, 0x71, 0x79, 0xdb, 0xd1, 0xa8, 0x82, 0xa3, 0x94,
+	0x5d, 0xd0, 0xff, 0xcf, 0x06, 0x74, 0x2c, 0x21, 0x47, 0x23, 0x24, 0xfa, 0x7f, 0x77, 0x4d, 0x15,
+	0x8c, 0xf6, 0x8c, 0x71, 0xda, 0x42, 0xf8, 0xf1, 0xd4, 0xbb, 0xa4, 0x5f, 0x9f, 0x34, 0x11, 0x0e,
+	0x92, 0x80, 0x76, 0xaa, 0x7c, 0xdf, 0x1e, 0x8b, 0x34, 0x35, 0xca, 0x5a, 0xff, 0x9f, 0x53, 0x9d,
+	0x22, 0x1c, 0x44, 0xb7, 0xbb, 0x80, 0x9d, 0x51, 0x4f, 0x2b, 0xcc, 0x20, 0xf5, 0xbd, 0x2e, 0xe9,
+	0x37, 0x27, 0xfb, 0x8a, 0x31, 0x5a, 0x17, 0x66, 0x6a, 0xfd, 0x86, 0xbb, 0xe8, 0xce, 0xbd, 0x80,
+	0x76, 0xa2, 0xa3, 0x81, 0x23, 0x58, 0xe6, 0xa8, 0x4c, 0xf5, 0xec, 0x5c, 0x95, 0x58, 0x8d, 0x43,
+	0x76, 0x8e, 0xaa, 0x1c, 0xa5, 0x77, 0xe3, 0xf7, 0x0d, 0x27, 0xeb, 0x0d, 0x27, 0x9f, 0x1b, 0x4e,
+	0xde, 0xb6, 0xbc, 0xb6, 0xde, 0xf2, 0xda, 0xc7, 0x96, 0xd7, 0x9e, 0xae, 0xa7, 0x33, 0xcc, 0x96,
+	0x49, 0x20, 0x41, 0x87, 0xbf, 0x3e, 0x1e, 0xac, 0x06, 0x3b, 0xa8, 0xd0, 0x40, 0x43, 0xba, 0x5c,
+	0x28, 0xb7, 0x8b, 0x22, 0xb9, 0x91, 0x52, 0x17, 0x49, 0xe2, 0xb9, 0x65, 0x5c, 0x7d, 0x0f, 0x00,
+	0xcf, 0x24, 0x7c, 0x95, 0xae, 0x01, 0x00, 0x00,
+}
+
+func (m *MakeTxParam) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MakeTxParam) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MakeTxParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Args) > 0 {
+		i -= len(m.Args)
+		copy(dAtA[i:], m.Args)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Args)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Method) > 0 {
+		i -= len(m.Method)
+		copy(dAtA[i:], m.Method)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Method)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ToContractAddress) > 0 {
+		i -= len(m.ToContractAddress)
+		copy(dAtA[i:], m.ToContractAddress)
+		i = encodeVarintState(dAtA, i, uint64(len(m.ToContractAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ToChainId != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.ToChainId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.FromContract) > 0 {
+		i -= len(m.FromContract)
+		copy(dAtA[i:], m.FromContract)
+		i = encodeVarintState(dAtA, i, uint64(len(m.FromContract)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CrossChainId) > 0 {
+		i -= len(m.CrossChainId)
+		copy(dAtA[i:], m.CrossChainId)
+		i = encodeVarintState(dAtA, i, uint64(len(m.CrossChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintState(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CrossChainIdCounter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CrossChainIdCounter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CrossChainIdCounter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NextId) > 0 {
+		i -= len(m.NextId)
+		copy(dAtA[i:], m.NextId)
+		i = encodeVarintState(dAtA, i, uint64(len(m.NextId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintState(dAtA []byte, offset int, v uint64) int {
+	offset -= sovState(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MakeTxParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.CrossChainId)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.FromContract)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.ToChainId != 0 {
+		n += 1 + sovState(uint64(m.ToChainId))
+	}
+	l = len(m.ToContractAddress)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Method)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Args)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
+func (m *CrossChainIdCounter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NextId)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
+func sovState(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozState(x uint64) (n int) {
+	return sovState(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MakeTxParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MakeTxParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MakeTxParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = append(m.TxHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxHash == nil {
+				m.TxHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CrossChainId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CrossChainId = append(m.CrossChainId[:0], dAtA[iNdEx:postIndex]...)
+			if m.CrossChainId == nil {
+				m.CrossChainId = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromContract", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromContract = append(m.FromContract[:0], dAtA[iNdEx:postIndex]...)
+			if m.FromContract == nil {
+				m.FromContract = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToChainId", wireType)
+			}
+			m.ToChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToContractAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToContractAddress = append(m.ToContractAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ToContractAddress == nil {
+				m.ToContractAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Method = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Args = append(m.Args[:0], dAtA[iNdEx:postIndex]...)
+			if m.Args == nil {
+				m.Args = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CrossChainIdCounter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CrossChainIdCounter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CrossChainIdCounter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipState(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthState
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupState
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthState
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthState        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowState          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupState = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,2311 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: polynetwork/ccm/v1/tx.proto
+
+package ccmpb
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	pb "github.com/polynetwork/cosmos-poly-module/common/pb"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type MsgProcessCrossChainTx struct {
+	Submitter   string `protobuf:"bytes,1,opt,name=submitter,proto3" json:"submitter,omitempty"`
+	FromChainId uint64 `protobuf:"varint,2,opt,name=from_chain_id,json=fromChainId,proto3" json:"from_chain_id,omitempty"`
+	Proof       string `protobuf:"bytes,3,opt,name=proof,proto3" json:"proof,omitempty"`
+	Header      string `protobuf:"bytes,4,opt,name=header,proto3" json:"header,omitempty"`
+	HeaderProof string `protobuf:"bytes,5,opt,name=header_proof,json=headerProof,proto3" json:"header_proof,omitempty"`
+	CurHeader   string `protobuf:"bytes,6,opt,name=cur_header,json=curHeader,proto3" json:"cur_header,omitempty"`
+}
+
+func (m *MsgProcessCrossChainTx) Reset()         { *m = MsgProcessCrossChainTx{} }
+func (m *MsgProcessCrossChainTx) String() string { return proto.CompactTextString(m) }
+func (*MsgProcessCrossChainTx) ProtoMessage()    {}
+func (*MsgProcessCrossChainTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6653908fc39273b7, []int{0}
+}
+func (m *MsgProcessCrossChainTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgProcessCrossChainTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProcessCrossChainTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgProcessCrossChainTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProcessCrossChainTx.Merge(m, src)
+}
+func (m *MsgProcessCrossChainTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProcessCrossChainTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProcessCrossChainTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgProcessCrossChainTx proto.InternalMessageInfo
+
+func (m *MsgProcessCrossChainTx) GetSubmitter() string {
+	if m != nil {
+		return m.Submitter
+	}
+	return ""
+}
+
+func (m *MsgProcessCrossChainTx) GetFromChainId() uint64 {
+	if m != nil {
+		return m.FromChainId
+	}
+	return 0
+}
+
+func (m *MsgProcessCrossChainTx) GetProof() string {
+	if m != nil {
+		return m.Proof
+	}
+	return ""
+}
+
+func (m *MsgProcessCrossChainTx) GetHeader() string {
+	if m != nil {
+		return m.Header
+	}
+	return ""
+}
+
+func (m *MsgProcessCrossChainTx) GetHeaderProof() string {
+	if m != nil {
+		return m.HeaderProof
+	}
+	return ""
+}
+
+func (m *MsgProcessCrossChainTx) GetCurHeader() string {
+	if m != nil {
+		return m.CurHeader
+	}
+	return ""
+}
+
+type MsgProcessCrossChainTxResponse struct {
+}
+
+func (m *MsgProcessCrossChainTxResponse) Reset()         { *m = MsgProcessCrossChainTxResponse{} }
+func (m *MsgProcessCrossChainTxResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgProcessCrossChainTxResponse) ProtoMessage()    {}
+func (*MsgProcessCrossChainTxResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6653908fc39273b7, []int{1}
+}
+func (m *MsgProcessCrossChainTxResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgProcessCrossChainTxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProcessCrossChainTxResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgProcessCrossChainTxResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProcessCrossChainTxResponse.Merge(m, src)
+}
+func (m *MsgProcessCrossChainTxResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProcessCrossChainTxResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProcessCrossChainTxResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgProcessCrossChainTxResponse proto.InternalMessageInfo
+
+// MsgCreateCrossChainTx is kept for the clients decoding old txs, it is not routed by the handler
+type MsgCreateCrossChainTx struct {
+	ToChainId         uint64 `protobuf:"varint,1,opt,name=to_chain_id,json=toChainId,proto3" json:"to_chain_id,omitempty"`
+	ToContractAddress []byte `protobuf:"bytes,2,opt,name=to_contract_address,json=toContractAddress,proto3" json:"to_contract_address,omitempty"`
+	Method            string `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`
+	Args              []byte `protobuf:"bytes,4,opt,name=args,proto3" json:"args,omitempty"`
+}
+
+func (m *MsgCreateCrossChainTx) Reset()         { *m = MsgCreateCrossChainTx{} }
+func (m *MsgCreateCrossChainTx) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateCrossChainTx) ProtoMessage()    {}
+func (*MsgCreateCrossChainTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6653908fc39273b7, []int{2}
+}
+func (m *MsgCreateCrossChainTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateCrossChainTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateCrossChainTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateCrossChainTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateCrossChainTx.Merge(m, src)
+}
+func (m *MsgCreateCrossChainTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateCrossChainTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateCrossChainTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateCrossChainTx proto.InternalMessageInfo
+
+func (m *MsgCreateCrossChainTx) GetToChainId() uint64 {
+	if m != nil {
+		return m.ToChainId
+	}
+	return 0
+}
+
+func (m *MsgCreateCrossChainTx) GetToContractAddress() []byte {
+	if m != nil {
+		return m.ToContractAddress
+	}
+	return nil
+}
+
+func (m *MsgCreateCrossChainTx) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *MsgCreateCrossChainTx) GetArgs() []byte {
+	if m != nil {
+		return m.Args
+	}
+	return nil
+}
+
+type MsgProposeDenomCreator struct {
+	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Denom      string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	NewCreator string `protobuf:"bytes,3,opt,name=new_creator,json=newCreator,proto3" json:"new_creator,omitempty"`
+}
+
+func (m *MsgProposeDenomCreator) Reset()         { *m = MsgProposeDenomCreator{} }
+func (m *MsgProposeDenomCreator) String() string { return proto.CompactTextString(m) }
+func (*MsgProposeDenomCreator) ProtoMessage()    {}
+func (*MsgProposeDenomCreator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6653908fc39273b7, []int{3}
+}
+func (m *MsgProposeDenomCreator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgProposeDenomCreator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProposeDenomCreator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgProposeDenomCreator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProposeDenomCreator.Merge(m, src)
+}
+func (m *MsgProposeDenomCreator) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProposeDenomCreator) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProposeDenomCreator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgProposeDenomCreator proto.InternalMessageInfo
+
+func (m *MsgProposeDenomCreator) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgProposeDenomCreator) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *MsgProposeDenomCreator) GetNewCreator() string {
+	if m != nil {
+		return m.NewCreator
+	}
+	return ""
+}
+
+type MsgProposeDenomCreatorResponse struct {
+}
+
+func (m *MsgProposeDenomCreatorResponse) Reset()         { *m = MsgProposeDenomCreatorResponse{} }
+func (m *MsgProposeDenomCreatorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgProposeDenomCreatorResponse) ProtoMessage()    {}
+func (*MsgProposeDenomCreatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6653908fc39273b7, []int{4}
+}
+func (m *MsgProposeDenomCreatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgProposeDenomCreatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProposeDenomCreatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgProposeDenomCreatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProposeDenomCreatorResponse.Merge(m, src)
+}
+func (m *MsgProposeDenomCreatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProposeDenomCreatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProposeDenomCreatorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgProposeDenomCreatorResponse proto.InternalMessageInfo
+
+type MsgAcceptDenomCreator struct {
+	NewCreator string `protobuf:"bytes,1,opt,name=new_creator,json=newCreator,proto3" json:"new_creator,omitempty"`
+	Denom      string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *MsgAcceptDenomCreator) Reset()         { *m = MsgAcceptDenomCreator{} }
+func (m *MsgAcceptDenomCreator) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptDenomCreator) ProtoMessage()    {}
+func (*MsgAcceptDenomCreator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6653908fc39273b7, []int{5}
+}
+func (m *MsgAcceptDenomCreator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptDenomCreator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptDenomCreator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAcceptDenomCreator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptDenomCreator.Merge(m, src)
+}
+func (m *MsgAcceptDenomCreator) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptDenomCreator) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptDenomCreator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcceptDenomCreator proto.InternalMessageInfo
+
+func (m *MsgAcceptDenomCreator) GetNewCreator() string {
+	if m != nil {
+		return m.NewCreator
+	}
+	return ""
+}
+
+func (m *MsgAcceptDenomCreator) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+type MsgAcceptDenomCreatorResponse struct {
+}
+
+func (m *MsgAcceptDenomCreatorResponse) Reset()         { *m = MsgAcceptDenomCreatorResponse{} }
+func (m *MsgAcceptDenomCreatorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptDenomCreatorResponse) ProtoMessage()    {}
+func (*MsgAcceptDenomCreatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6653908fc39273b7, []int{6}
+}
+func (m *MsgAcceptDenomCreatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptDenomCreatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptDenomCreatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAcceptDenomCreatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptDenomCreatorResponse.Merge(m, src)
+}
+func (m *MsgAcceptDenomCreatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptDenomCreatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptDenomCreatorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcceptDenomCreatorResponse proto.InternalMessageInfo
+
+type MsgUpdateDenomMetadata struct {
+	Creator  string            `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Metadata *pb.DenomMetadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (m *MsgUpdateDenomMetadata) Reset()         { *m = MsgUpdateDenomMetadata{} }
+func (m *MsgUpdateDenomMetadata) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateDenomMetadata) ProtoMessage()    {}
+func (*MsgUpdateDenomMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6653908fc39273b7, []int{7}
+}
+func (m *MsgUpdateDenomMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateDenomMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateDenomMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateDenomMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateDenomMetadata.Merge(m, src)
+}
+func (m *MsgUpdateDenomMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateDenomMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateDenomMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateDenomMetadata proto.InternalMessageInfo
+
+func (m *MsgUpdateDenomMetadata) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgUpdateDenomMetadata) GetMetadata() *pb.DenomMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+type MsgUpdateDenomMetadataResponse struct {
+}
+
+func (m *MsgUpdateDenomMetadataResponse) Reset()         { *m = MsgUpdateDenomMetadataResponse{} }
+func (m *MsgUpdateDenomMetadataResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateDenomMetadataResponse) ProtoMessage()    {}
+func (*MsgUpdateDenomMetadataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6653908fc39273b7, []int{8}
+}
+func (m *MsgUpdateDenomMetadataResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateDenomMetadataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateDenomMetadataResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateDenomMetadataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateDenomMetadataResponse.Merge(m, src)
+}
+func (m *MsgUpdateDenomMetadataResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateDenomMetadataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateDenomMetadataResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateDenomMetadataResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgProcessCrossChainTx)(nil), "polynetwork.ccm.v1.MsgProcessCrossChainTx")
+	proto.RegisterType((*MsgProcessCrossChainTxResponse)(nil), "polynetwork.ccm.v1.MsgProcessCrossChainTxResponse")
+	proto.RegisterType((*MsgCreateCrossChainTx)(nil), "polynetwork.ccm.v1.MsgCreateCrossChainTx")
+	proto.RegisterType((*MsgProposeDenomCreator)(nil), "polynetwork.ccm.v1.MsgProposeDenomCreator")
+	proto.RegisterType((*MsgProposeDenomCreatorResponse)(nil), "polynetwork.ccm.v1.MsgProposeDenomCreatorResponse")
+	proto.RegisterType((*MsgAcceptDenomCreator)(nil), "polynetwork.ccm.v1.MsgAcceptDenomCreator")
+	proto.RegisterType((*MsgAcceptDenomCreatorResponse)(nil), "polynetwork.ccm.v1.MsgAcceptDenomCreatorResponse")
+	proto.RegisterType((*MsgUpdateDenomMetadata)(nil), "polynetwork.ccm.v1.MsgUpdateDenomMetadata")
+	proto.RegisterType((*MsgUpdateDenomMetadataResponse)(nil), "polynetwork.ccm.v1.MsgUpdateDenomMetadataResponse")
+}
+
+func init() { proto.RegisterFile("polynetwork/ccm/v1/tx.proto", fileDescriptor_6653908fc39273b7) }
+
+var fileDescriptor_6653908fc39273b7 = []byte{
+	// 579 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x4f, 0x6f, 0xd3, 0x4e,
+	0x10, 0xad, 0x7f, 0x4d, 0xf3, 0x23, 0x93, 0x70, 0x60, 0x0b, 0x55, 0x14, 0xa8, 0x1b, 0x2c, 0x0e,
+	0x05, 0xa9, 0x8e, 0x12, 0x24, 0x2e, 0x5c, 0x28, 0xe1, 0x00, 0x87, 0xa0, 0xc8, 0x82, 0x0b, 0x17,
+	0xcb, 0x59, 0x6f, 0x93, 0x88, 0xae, 0xc7, 0xda, 0x5d, 0x27, 0xe5, 0x5b, 0x20, 0xf1, 0xa5, 0x10,
+	0xa7, 0x1e, 0x39, 0x21, 0x94, 0x7c, 0x11, 0xe4, 0x5d, 0x3b, 0xa9, 0x13, 0xb7, 0xca, 0x6d, 0x77,
+	0xe6, 0xbd, 0x79, 0xf3, 0x66, 0xff, 0xc0, 0xe3, 0x18, 0x2f, 0xbf, 0x45, 0x4c, 0xcd, 0x51, 0x7c,
+	0xed, 0x50, 0xca, 0x3b, 0xb3, 0x6e, 0x47, 0x5d, 0xb9, 0xb1, 0x40, 0x85, 0x84, 0xdc, 0x48, 0xba,
+	0x94, 0x72, 0x77, 0xd6, 0x6d, 0x39, 0x05, 0x02, 0x72, 0x8e, 0x51, 0xca, 0x31, 0x2b, 0xc3, 0x73,
+	0x7e, 0x59, 0x70, 0x34, 0x90, 0xe3, 0xa1, 0x40, 0xca, 0xa4, 0xec, 0x0b, 0x94, 0xb2, 0x3f, 0x09,
+	0xa6, 0xd1, 0xa7, 0x2b, 0xf2, 0x04, 0x6a, 0x32, 0x19, 0xf1, 0xa9, 0x52, 0x4c, 0x34, 0xad, 0xb6,
+	0x75, 0x5a, 0xf3, 0xd6, 0x01, 0xe2, 0xc0, 0xfd, 0x0b, 0x81, 0xdc, 0xa7, 0x29, 0xda, 0x9f, 0x86,
+	0xcd, 0xff, 0xda, 0xd6, 0x69, 0xc5, 0xab, 0xa7, 0x41, 0x5d, 0xe1, 0x43, 0x48, 0x1e, 0xc2, 0x41,
+	0x2c, 0x10, 0x2f, 0x9a, 0xfb, 0x9a, 0x6d, 0x36, 0xe4, 0x08, 0xaa, 0x13, 0x16, 0x84, 0x4c, 0x34,
+	0x2b, 0x3a, 0x9c, 0xed, 0xc8, 0x53, 0x68, 0x98, 0x95, 0x6f, 0x48, 0x07, 0x3a, 0x5b, 0x37, 0xb1,
+	0xa1, 0xa6, 0x1e, 0x03, 0xd0, 0x44, 0xf8, 0x19, 0xbd, 0x6a, 0x7a, 0xa2, 0x89, 0x78, 0xaf, 0x03,
+	0x4e, 0x1b, 0xec, 0x72, 0x2f, 0x1e, 0x93, 0x31, 0x46, 0x92, 0x39, 0x3f, 0x2c, 0x78, 0x34, 0x90,
+	0xe3, 0xbe, 0x60, 0x81, 0x62, 0x05, 0xb7, 0x36, 0xd4, 0x15, 0xae, 0xdd, 0x58, 0xda, 0x4d, 0x4d,
+	0x61, 0xee, 0xc5, 0x85, 0xc3, 0x34, 0x8f, 0x91, 0x12, 0x01, 0x55, 0x7e, 0x10, 0x86, 0x82, 0x49,
+	0xa9, 0x5d, 0x37, 0xbc, 0x07, 0x0a, 0xfb, 0x59, 0xe6, 0xdc, 0x24, 0x52, 0x97, 0x9c, 0xa9, 0x09,
+	0x86, 0x99, 0xf9, 0x6c, 0x47, 0x08, 0x54, 0x02, 0x31, 0x96, 0xda, 0x7b, 0xc3, 0xd3, 0x6b, 0x67,
+	0x9a, 0x9f, 0x41, 0x8c, 0x92, 0xbd, 0x63, 0x11, 0x72, 0xdd, 0x20, 0x0a, 0xd2, 0x84, 0xff, 0xa9,
+	0x59, 0x66, 0x27, 0x90, 0x6f, 0xd3, 0xd9, 0x86, 0x29, 0x52, 0x77, 0x50, 0xf3, 0xcc, 0x86, 0x9c,
+	0x40, 0x3d, 0x62, 0x73, 0x3f, 0xe7, 0x18, 0x69, 0x88, 0xd8, 0x3c, 0x2b, 0xb8, 0x1e, 0xd1, 0xa6,
+	0xd4, 0x6a, 0x44, 0x1f, 0xf5, 0x84, 0xce, 0x29, 0x65, 0xb1, 0x2a, 0xf4, 0xb2, 0x51, 0xdb, 0xda,
+	0xac, 0x5d, 0xde, 0x92, 0x73, 0x02, 0xc7, 0xa5, 0xf5, 0x56, 0x82, 0x4a, 0xbb, 0xff, 0x1c, 0x87,
+	0x81, 0x32, 0x1d, 0x0d, 0x98, 0x0a, 0xc2, 0x40, 0x05, 0x77, 0xb8, 0x7f, 0x03, 0xf7, 0x78, 0x86,
+	0xd2, 0x6a, 0xf5, 0xde, 0x33, 0xb7, 0xf0, 0x02, 0xcc, 0x1d, 0x9f, 0x75, 0xdd, 0x42, 0x45, 0x6f,
+	0xc5, 0xca, 0x06, 0x51, 0xa2, 0x9a, 0xf7, 0xd5, 0xfb, 0xb3, 0x0f, 0xfb, 0x03, 0x39, 0x26, 0x09,
+	0x1c, 0x96, 0x3d, 0x8f, 0x17, 0xee, 0xf6, 0x93, 0x73, 0xcb, 0xaf, 0x5f, 0xab, 0xb7, 0x3b, 0x36,
+	0x97, 0xcf, 0x64, 0xb7, 0x6e, 0xc4, 0x1d, 0xb2, 0x9b, 0xd8, 0x56, 0x6f, 0x77, 0xec, 0x4a, 0x56,
+	0x00, 0x29, 0x39, 0xfb, 0xe7, 0xb7, 0x54, 0xda, 0x86, 0xb6, 0xba, 0x3b, 0x43, 0x6f, 0x5a, 0x2d,
+	0x3b, 0xfe, 0xdb, 0xac, 0x96, 0x60, 0x5b, 0xbd, 0xdd, 0xb1, 0xb9, 0xec, 0xdb, 0xe1, 0xcf, 0x85,
+	0x6d, 0x5d, 0x2f, 0x6c, 0xeb, 0xef, 0xc2, 0xb6, 0xbe, 0x2f, 0xed, 0xbd, 0xeb, 0xa5, 0xbd, 0xf7,
+	0x7b, 0x69, 0xef, 0x7d, 0x79, 0x35, 0x9e, 0xaa, 0x49, 0x32, 0x4a, 0xaf, 0x51, 0xa7, 0xf8, 0x89,
+	0x4a, 0x8e, 0xf2, 0x2c, 0x0d, 0x9d, 0x71, 0x0c, 0x93, 0x4b, 0xa6, 0x3f, 0xe2, 0x78, 0xf4, 0x9a,
+	0x52, 0x1e, 0x8f, 0x46, 0x55, 0xfd, 0xa9, 0xbe, 0xfc, 0x37, 0x00, 0x72, 0xbe, 0x66, 0x2b, 0xab,
+	0x05, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	ProcessCrossChainTx(ctx context.Context, in *MsgProcessCrossChainTx, opts ...grpc.CallOption) (*MsgProcessCrossChainTxResponse, error)
+	ProposeDenomCreator(ctx context.Context, in *MsgProposeDenomCreator, opts ...grpc.CallOption) (*MsgProposeDenomCreatorResponse, error)
+	AcceptDenomCreator(ctx context.Context, in *MsgAcceptDenomCreator, opts ...grpc.CallOption) (*MsgAcceptDenomCreatorResponse, error)
+	UpdateDenomMetadata(ctx context.Context, in *MsgUpdateDenomMetadata, opts ...grpc.CallOption) (*MsgUpdateDenomMetadataResponse, error)
+}
+
+type msgClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewMsgClient(cc *grpc.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) ProcessCrossChainTx(ctx context.Context, in *MsgProcessCrossChainTx, opts ...grpc.CallOption) (*MsgProcessCrossChainTxResponse, error) {
+	out := new(MsgProcessCrossChainTxResponse)
+	err := c.cc.Invoke(ctx, "/polynetwork.ccm.v1.Msg/ProcessCrossChainTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ProposeDenomCreator(ctx context.Context, in *MsgProposeDenomCreator, opts ...grpc.CallOption) (*MsgProposeDenomCreatorResponse, error) {
+	out := new(MsgProposeDenomCreatorResponse)
+	err := c.cc.Invoke(ctx, "/polynetwork.ccm.v1.Msg/ProposeDenomCreator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptDenomCreator(ctx context.Context, in *MsgAcceptDenomCreator, opts ...grpc.CallOption) (*MsgAcceptDenomCreatorResponse, error) {
+	out := new(MsgAcceptDenomCreatorResponse)
+	err := c.cc.Invoke(ctx, "/polynetwork.ccm.v1.Msg/AcceptDenomCreator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateDenomMetadata(ctx context.Context, in *MsgUpdateDenomMetadata, opts ...grpc.CallOption) (*MsgUpdateDenomMetadataResponse, error) {
+	out := new(MsgUpdateDenomMetadataResponse)
+	err := c.cc.Invoke(ctx, "/polynetwork.ccm.v1.Msg/UpdateDenomMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	ProcessCrossChainTx(context.Context, *MsgProcessCrossChainTx) (*MsgProcessCrossChainTxResponse, error)
+	ProposeDenomCreator(context.Context, *MsgProposeDenomCreator) (*MsgProposeDenomCreatorResponse, error)
+	AcceptDenomCreator(context.Context, *MsgAcceptDenomCreator) (*MsgAcceptDenomCreatorResponse, error)
+	UpdateDenomMetadata(context.Context, *MsgUpdateDenomMetadata) (*MsgUpdateDenomMetadataResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) ProcessCrossChainTx(ctx context.Context, req *MsgProcessCrossChainTx) (*MsgProcessCrossChainTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProcessCrossChainTx not implemented")
+}
+func (*UnimplementedMsgServer) ProposeDenomCreator(ctx context.Context, req *MsgProposeDenomCreator) (*MsgProposeDenomCreatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposeDenomCreator not implemented")
+}
+func (*UnimplementedMsgServer) AcceptDenomCreator(ctx context.Context, req *MsgAcceptDenomCreator) (*MsgAcceptDenomCreatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptDenomCreator not implemented")
+}
+func (*UnimplementedMsgServer) UpdateDenomMetadata(ctx context.Context, req *MsgUpdateDenomMetadata) (*MsgUpdateDenomMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDenomMetadata not implemented")
+}
+
+func RegisterMsgServer(s *grpc.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_ProcessCrossChainTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProcessCrossChainTx)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ProcessCrossChainTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/polynetwork.ccm.v1.Msg/ProcessCrossChainTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProcessCrossChainTx(ctx, req.(*MsgProcessCrossChainTx))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ProposeDenomCreator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProposeDenomCreator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ProposeDenomCreator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/polynetwork.ccm.v1.Msg/ProposeDenomCreator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProposeDenomCreator(ctx, req.(*MsgProposeDenomCreator))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptDenomCreator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptDenomCreator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptDenomCreator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/polynetwork.ccm.v1.Msg/AcceptDenomCreator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptDenomCreator(ctx, req.(*MsgAcceptDenomCreator))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateDenomMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateDenomMetadata)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateDenomMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/polynetwork.ccm.v1.Msg/UpdateDenomMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateDenomMetadata(ctx, req.(*MsgUpdateDenomMetadata))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "polynetwork.ccm.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ProcessCrossChainTx",
+			Handler:    _Msg_ProcessCrossChainTx_Handler,
+		},
+		{
+			MethodName: "ProposeDenomCreator",
+			Handler:    _Msg_ProposeDenomCreator_Handler,
+		},
+		{
+			MethodName: "AcceptDenomCreator",
+			Handler:    _Msg_AcceptDenomCreator_Handler,
+		},
+		{
+			MethodName: "UpdateDenomMetadata",
+			Handler:    _Msg_UpdateDenomMetadata_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "polynetwork/ccm/v1/tx.proto",
+}
+
+func (m *MsgProcessCrossChainTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProcessCrossChainTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProcessCrossChainTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CurHeader) > 0 {
+		i -= len(m.CurHeader)
+		copy(dAtA[i:], m.CurHeader)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CurHeader)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.HeaderProof) > 0 {
+		i -= len(m.HeaderProof)
+		copy(dAtA[i:], m.HeaderProof)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.HeaderProof)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Header) > 0 {
+		i -= len(m.Header)
+		copy(dAtA[i:], m.Header)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Header)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Proof) > 0 {
+		i -= len(m.Proof)
+		copy(dAtA[i:], m.Proof)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Proof)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.FromChainId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.FromChainId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProcessCrossChainTxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProcessCrossChainTxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProcessCrossChainTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateCrossChainTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateCrossChainTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateCrossChainTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Args) > 0 {
+		i -= len(m.Args)
+		copy(dAtA[i:], m.Args)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Args)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Method) > 0 {
+		i -= len(m.Method)
+		copy(dAtA[i:], m.Method)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Method)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ToContractAddress) > 0 {
+		i -= len(m.ToContractAddress)
+		copy(dAtA[i:], m.ToContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ToContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ToChainId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ToChainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProposeDenomCreator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProposeDenomCreator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProposeDenomCreator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewCreator) > 0 {
+		i -= len(m.NewCreator)
+		copy(dAtA[i:], m.NewCreator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewCreator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProposeDenomCreatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProposeDenomCreatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProposeDenomCreatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptDenomCreator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptDenomCreator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptDenomCreator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NewCreator) > 0 {
+		i -= len(m.NewCreator)
+		copy(dAtA[i:], m.NewCreator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewCreator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptDenomCreatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptDenomCreatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptDenomCreatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateDenomMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateDenomMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateDenomMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateDenomMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateDenomMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateDenomMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgProcessCrossChainTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.FromChainId != 0 {
+		n += 1 + sovTx(uint64(m.FromChainId))
+	}
+	l = len(m.Proof)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Header)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.HeaderProof)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CurHeader)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgProcessCrossChainTxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateCrossChainTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ToChainId != 0 {
+		n += 1 + sovTx(uint64(m.ToChainId))
+	}
+	l = len(m.ToContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Method)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Args)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgProposeDenomCreator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewCreator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgProposeDenomCreatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAcceptDenomCreator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NewCreator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAcceptDenomCreatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateDenomMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateDenomMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgProcessCrossChainTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProcessCrossChainTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProcessCrossChainTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submitter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromChainId", wireType)
+			}
+			m.FromChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Header = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeaderProof", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HeaderProof = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurHeader", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurHeader = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProcessCrossChainTxResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProcessCrossChainTxResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProcessCrossChainTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateCrossChainTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateCrossChainTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateCrossChainTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToChainId", wireType)
+			}
+			m.ToChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToContractAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToContractAddress = append(m.ToContractAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ToContractAddress == nil {
+				m.ToContractAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Method = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Args = append(m.Args[:0], dAtA[iNdEx:postIndex]...)
+			if m.Args == nil {
+				m.Args = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProposeDenomCreator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProposeDenomCreator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProposeDenomCreator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewCreator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewCreator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProposeDenomCreatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProposeDenomCreatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProposeDenomCreatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcceptDenomCreator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptDenomCreator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptDenomCreator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewCreator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewCreator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcceptDenomCreatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptDenomCreatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptDenomCreatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateDenomMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateDenomMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateDenomMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &pb.DenomMetadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateDenomMetadataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateDenomMetadataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateDenomMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,98 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package common
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	BindingTypeProxy = "proxy"
+	BindingTypeAsset = "asset"
+)
+
+// BindingChange records one change of a proxy hash or asset hash binding, the binding
+// has been created when OldValue is empty and has been removed when NewValue is empty
+type BindingChange struct {
+	BindingType string           // proxy or asset
+	LockProxy   []byte           // lockproxy contract hash owning the binding, only set by lockproxy module
+	Denom       string           // denom of the asset binding, empty for proxy binding
+	ChainId     uint64           // poly chain id the binding points to
+	OldValue    []byte           // proxy or asset hash before the change
+	NewValue    []byte           // proxy or asset hash after the change
+	Height      int64            // block height of the change
+	Operator    sdk.AccAddress   // account who made the change, the approver reaching the threshold of an operator group
+	Approvers   []sdk.AccAddress // members of the operator group who approved the change, empty if Operator made it alone
+
+	// decimals of the source asset and of the asset bound in ChainId before and after the change of an asset binding
+	// as the binding keeps them, both zero when they are equal so that the amount is not scaled
+	OldSourceDecimals uint8
+	OldToDecimals     uint8
+	SourceDecimals    uint8
+	ToDecimals        uint8
+}
+
+// Match checks whether the change belongs to the binding filtered by lockProxy, denom and chainId,
+// empty lockProxy, empty denom and zero chainId match any value
+func (c BindingChange) Match(lockProxy []byte, denom string, chainId uint64) bool {
+	if len(lockProxy) != 0 && !bytes.Equal(c.LockProxy, lockProxy) {
+		return false
+	}
+	if denom != "" && c.Denom != denom {
+		return false
+	}
+	return chainId == 0 || c.ChainId == chainId
+}
+
+func (c BindingChange) String() string {
+	return fmt.Sprintf(`BindingChange:
+  BindingType:  %s
+  LockProxy:    %s
+  Denom:        %s
+  ChainId:      %d
+  OldValue:     %s
+  NewValue:     %s
+  Height:       %d
+  Operator:     %s
+  Approvers:    %v
+  OldDecimals:  %d -> %d
+  NewDecimals:  %d -> %d
+`, c.BindingType, hex.EncodeToString(c.LockProxy), c.Denom, c.ChainId, hex.EncodeToString(c.OldValue), hex.EncodeToString(c.NewValue), c.Height, c.Operator.String(), c.Approvers,
+		c.OldSourceDecimals, c.OldToDecimals, c.SourceDecimals, c.ToDecimals)
+}
+
+// Binding is a proxy hash or asset hash bound to the chain ChainId, listed by the binding queries
+type Binding struct {
+	ChainId uint64
+	Hash    string // proxy or asset hash in hex
+}
+
+func NewBinding(chainId uint64, hash []byte) Binding {
+	return Binding{ChainId: chainId, Hash: hex.EncodeToString(hash)}
+}
+
+func (b Binding) String() string {
+	return fmt.Sprintf(`Binding:
+  ChainId:      %d
+  Hash:         %s
+`, b.ChainId, b.Hash)
+}
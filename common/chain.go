@@ -0,0 +1,229 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package common
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/btcsuite/btcutil/base58"
+	"github.com/btcsuite/btcutil/bech32"
+)
+
+// Chain families of the registry
+const (
+	ChainFamilyEVM      = "evm"
+	ChainFamilyNEO      = "neo"
+	ChainFamilyOntology = "ontology"
+	ChainFamilyBTC      = "btc"
+	ChainFamilyCosmos   = "cosmos"
+)
+
+// Encodings of the addresses and asset hashes of a chain
+const (
+	// EncodingRaw accepts any bytes, e.g. 20 bytes evm addresses
+	EncodingRaw = "raw"
+	// EncodingString accepts printable ascii only, e.g. cosmos denoms
+	EncodingString = "string"
+	// EncodingBech32 accepts a bech32 string with a valid checksum
+	EncodingBech32 = "bech32"
+	// EncodingBase58 accepts a base58check string, e.g. legacy btc addresses
+	EncodingBase58 = "base58"
+)
+
+const (
+	MaxChainNameLength = 64
+	// DefaultAmountWidth is the fixed byte width of the amount in the cross chain args, and the widest
+	// amount accepted by a chain without AmountWidth
+	DefaultAmountWidth = 32
+)
+
+// ChainInfo describes a chain connected through Poly, it is registered by governance and used to
+// reject malformed destination addresses and asset hashes before funds leave the chain.
+// A zero length accepts any length, a zero AmountWidth means DefaultAmountWidth.
+// UnlockActions lists the unlock actions the lock proxy of the chain performs, and BondAssetHash is the asset
+// the delegate action of the chain accepts, an action is only relayed to a chain listing it
+type ChainInfo struct {
+	ChainId           uint64   `json:"chain_id" yaml:"chain_id"`
+	Name              string   `json:"name" yaml:"name"`
+	Family            string   `json:"family" yaml:"family"`
+	AddressEncoding   string   `json:"address_encoding" yaml:"address_encoding"`
+	AddressLength     uint32   `json:"address_length" yaml:"address_length"`
+	AssetHashEncoding string   `json:"asset_hash_encoding" yaml:"asset_hash_encoding"`
+	AssetHashLength   uint32   `json:"asset_hash_length" yaml:"asset_hash_length"`
+	AmountWidth       uint32   `json:"amount_width" yaml:"amount_width"`
+	UnlockActions     []string `json:"unlock_actions" yaml:"unlock_actions"`
+	BondAssetHash     []byte   `json:"bond_asset_hash" yaml:"bond_asset_hash"`
+}
+
+func NewChainInfo(chainId uint64, name, family, addressEncoding string, addressLength uint32, assetHashEncoding string, assetHashLength uint32, amountWidth uint32) ChainInfo {
+	return ChainInfo{
+		ChainId:           chainId,
+		Name:              name,
+		Family:            family,
+		AddressEncoding:   addressEncoding,
+		AddressLength:     addressLength,
+		AssetHashEncoding: assetHashEncoding,
+		AssetHashLength:   assetHashLength,
+		AmountWidth:       amountWidth,
+	}
+}
+
+func isValidChainFamily(family string) bool {
+	switch family {
+	case ChainFamilyEVM, ChainFamilyNEO, ChainFamilyOntology, ChainFamilyBTC, ChainFamilyCosmos:
+		return true
+	}
+	return false
+}
+
+func isValidEncoding(encoding string) bool {
+	switch encoding {
+	case EncodingRaw, EncodingString, EncodingBech32, EncodingBase58:
+		return true
+	}
+	return false
+}
+
+// ValidateBasic checks the chain info without touching the store
+func (info ChainInfo) ValidateBasic() error {
+	if info.ChainId == 0 {
+		return fmt.Errorf("chain id should not be zero")
+	}
+	if len(info.Name) > MaxChainNameLength {
+		return fmt.Errorf("name of chain: %d is longer than %d", info.ChainId, MaxChainNameLength)
+	}
+	if !isValidChainFamily(info.Family) {
+		return fmt.Errorf("unknown family: %s of chain: %d", info.Family, info.ChainId)
+	}
+	if !isValidEncoding(info.AddressEncoding) {
+		return fmt.Errorf("unknown address encoding: %s of chain: %d", info.AddressEncoding, info.ChainId)
+	}
+	if !isValidEncoding(info.AssetHashEncoding) {
+		return fmt.Errorf("unknown asset hash encoding: %s of chain: %d", info.AssetHashEncoding, info.ChainId)
+	}
+	if info.AmountWidth > DefaultAmountWidth {
+		return fmt.Errorf("amount width: %d of chain: %d exceeds the maximum: %d", info.AmountWidth, info.ChainId, DefaultAmountWidth)
+	}
+	seen := make(map[string]bool, len(info.UnlockActions))
+	for _, name := range info.UnlockActions {
+		if name == "" || seen[name] {
+			return fmt.Errorf("empty or duplicated unlock action: %q of chain: %d", name, info.ChainId)
+		}
+		seen[name] = true
+	}
+	if len(info.BondAssetHash) != 0 {
+		if err := info.ValidateAssetHash(info.BondAssetHash); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// HasUnlockAction reports whether the lock proxy of this chain performs the unlock action name
+func (info ChainInfo) HasUnlockAction(name string) bool {
+	for _, action := range info.UnlockActions {
+		if action == name {
+			return true
+		}
+	}
+	return false
+}
+
+// GetAmountWidth returns the byte width of the widest amount this chain accepts, the amount in the cross chain
+// args is always padded to DefaultAmountWidth
+func (info ChainInfo) GetAmountWidth() int {
+	if info.AmountWidth == 0 {
+		return DefaultAmountWidth
+	}
+	return int(info.AmountWidth)
+}
+
+// ValidateAddress checks addr is a well formed address on this chain
+func (info ChainInfo) ValidateAddress(addr []byte) error {
+	if err := validateEncoded(addr, info.AddressEncoding, info.AddressLength); err != nil {
+		return fmt.Errorf("invalid address: %x of chain: %d, err: %v", addr, info.ChainId, err)
+	}
+	return nil
+}
+
+// ValidateAssetHash checks hash is a well formed asset hash on this chain
+func (info ChainInfo) ValidateAssetHash(hash []byte) error {
+	if err := validateEncoded(hash, info.AssetHashEncoding, info.AssetHashLength); err != nil {
+		return fmt.Errorf("invalid asset hash: %x of chain: %d, err: %v", hash, info.ChainId, err)
+	}
+	return nil
+}
+
+// ValidateAmount checks amount is nonnegative and fits in the amount width of this chain
+func (info ChainInfo) ValidateAmount(amount *big.Int) error {
+	if amount.Sign() < 0 {
+		return fmt.Errorf("negative amount: %s", amount.String())
+	}
+	// the highest bit is reserved as in PadFixedBytes
+	if amount.BitLen() > info.GetAmountWidth()*8-1 {
+		return fmt.Errorf("amount: %s exceeds the amount width: %d of chain: %d", amount.String(), info.GetAmountWidth(), info.ChainId)
+	}
+	return nil
+}
+
+func validateEncoded(bz []byte, encoding string, length uint32) error {
+	if len(bz) == 0 {
+		return fmt.Errorf("empty value")
+	}
+	if length != 0 && len(bz) != int(length) {
+		return fmt.Errorf("expect length: %d, got: %d", length, len(bz))
+	}
+	switch encoding {
+	case EncodingRaw:
+	case EncodingString:
+		for _, b := range bz {
+			if b < 0x21 || b > 0x7e {
+				return fmt.Errorf("non printable character: 0x%02x", b)
+			}
+		}
+	case EncodingBech32:
+		if _, _, err := bech32.Decode(string(bz)); err != nil {
+			return err
+		}
+	case EncodingBase58:
+		if _, _, err := base58.CheckDecode(string(bz)); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unknown encoding: %s", encoding)
+	}
+	return nil
+}
+
+func (info ChainInfo) String() string {
+	return fmt.Sprintf(`ChainInfo:
+  ChainId:           %d
+  Name:              %s
+  Family:            %s
+  AddressEncoding:   %s
+  AddressLength:     %d
+  AssetHashEncoding: %s
+  AssetHashLength:   %d
+  AmountWidth:       %d
+  UnlockActions:     %s
+  BondAssetHash:     %x
+`, info.ChainId, info.Name, info.Family, info.AddressEncoding, info.AddressLength, info.AssetHashEncoding, info.AssetHashLength, info.AmountWidth,
+		strings.Join(info.UnlockActions, ","), info.BondAssetHash)
+}
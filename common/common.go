@@ -65,3 +65,49 @@ func ToArrayReverse(arr []byte) []byte {
 	}
 	return x
 }
+
+// MaxAmountBitLen is the widest amount sdk.Int accepts
+const MaxAmountBitLen = 255
+
+// ValidateDecimals checks the decimals of an asset binding are within MaxDenomDecimals
+func ValidateDecimals(sourceDecimals, toDecimals uint8) error {
+	if sourceDecimals > MaxDenomDecimals || toDecimals > MaxDenomDecimals {
+		return fmt.Errorf("decimals: %d and %d should not exceed the maximum: %d", sourceDecimals, toDecimals, MaxDenomDecimals)
+	}
+	return nil
+}
+
+// ScaleAmount converts amount expressed with fromDecimals into the amount expressed with toDecimals,
+// it fails if the conversion would drop the non-zero dust below the precision of toDecimals or
+// the scaled amount would be too wide for sdk.Int
+func ScaleAmount(amount *big.Int, fromDecimals, toDecimals uint8) (*big.Int, error) {
+	scaled, dust, err := TruncateAmount(amount, fromDecimals, toDecimals)
+	if err != nil {
+		return nil, err
+	}
+	if dust.Sign() != 0 {
+		return nil, fmt.Errorf("ScaleAmount, amount: %s with decimals: %d loses dust: %s when converted to decimals: %d", amount.String(), fromDecimals, dust.String(), toDecimals)
+	}
+	return scaled, nil
+}
+
+// TruncateAmount converts amount expressed with fromDecimals into the amount expressed with toDecimals and
+// returns the dust below the precision of toDecimals, expressed with fromDecimals, which is dropped by the
+// conversion. It only fails if the scaled amount would be too wide for sdk.Int
+func TruncateAmount(amount *big.Int, fromDecimals, toDecimals uint8) (scaled, dust *big.Int, err error) {
+	dust = new(big.Int)
+	switch {
+	case fromDecimals == toDecimals:
+		scaled = new(big.Int).Set(amount)
+	case toDecimals > fromDecimals:
+		factor := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(toDecimals-fromDecimals)), nil)
+		scaled = new(big.Int).Mul(amount, factor)
+	default:
+		factor := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(fromDecimals-toDecimals)), nil)
+		scaled, dust = new(big.Int).QuoRem(amount, factor, dust)
+	}
+	if scaled.BitLen() > MaxAmountBitLen {
+		return nil, nil, fmt.Errorf("ScaleAmount, amount: %s with decimals: %d overflows %d bits when converted to decimals: %d", amount.String(), fromDecimals, MaxAmountBitLen, toDecimals)
+	}
+	return scaled, dust, nil
+}
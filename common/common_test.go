@@ -18,8 +18,11 @@
 package common
 
 import (
+	"fmt"
 	"github.com/stretchr/testify/assert"
+	dbm "github.com/tendermint/tm-db"
 	"math/big"
+	"strings"
 	"testing"
 )
 
@@ -61,3 +64,90 @@ func Test_PadFixedBytes(t *testing.T) {
 		}
 	}
 }
+
+func Test_ScaleAmount(t *testing.T) {
+	testCases := []struct {
+		amount       string
+		fromDecimals uint8
+		toDecimals   uint8
+		expect       string // empty if precision is lost or the result overflows
+	}{
+		{"123", 6, 6, "123"},
+		{"123", 6, 18, "123000000000000"},
+		{"123000000000000", 18, 6, "123"},
+		{"123000000000001", 18, 6, ""},
+		{"0", 18, 6, "0"},
+		{"5", 8, 0, ""},
+		{"1", 0, 76, "1" + strings.Repeat("0", 76)},
+		{"1", 0, 77, ""},
+		{"57896044618658097711785492504343953926634992332820282019728792003956564819968", 6, 6, ""},
+	}
+	for _, tc := range testCases {
+		amount, _ := big.NewInt(0).SetString(tc.amount, 10)
+		res, err := ScaleAmount(amount, tc.fromDecimals, tc.toDecimals)
+		if tc.expect == "" {
+			assert.Error(t, err)
+			continue
+		}
+		assert.Nil(t, err)
+		assert.Equal(t, tc.expect, res.String())
+	}
+
+	// the truncated conversion returns the dust dropped below the precision of toDecimals
+	amount, _ := big.NewInt(0).SetString("123000000000001", 10)
+	scaled, dust, err := TruncateAmount(amount, 18, 6)
+	assert.Nil(t, err)
+	assert.Equal(t, "123", scaled.String())
+	assert.Equal(t, "1", dust.String())
+	_, dust, err = TruncateAmount(big.NewInt(5), 0, 8)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, dust.Sign())
+	_, _, err = TruncateAmount(big.NewInt(1), 0, 77)
+	assert.Error(t, err)
+}
+
+func Test_ChainInfoValidateAddress(t *testing.T) {
+	btc := NewChainInfo(1, "bitcoin", ChainFamilyBTC, EncodingBase58, 0, EncodingRaw, 20, 8)
+	assert.Nil(t, btc.ValidateBasic())
+	assert.Nil(t, btc.ValidateAddress([]byte("1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2")))
+	assert.NotNil(t, btc.ValidateAddress([]byte("1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN3")), "bad checksum should be rejected")
+	assert.NotNil(t, btc.ValidateAmount(big.NewInt(0).Lsh(big.NewInt(1), 63)), "amount wider than 8 bytes should be rejected")
+
+	cosmos := NewChainInfo(5, "cosmos", ChainFamilyCosmos, EncodingBech32, 0, EncodingString, 0, 0)
+	assert.Nil(t, cosmos.ValidateAddress([]byte("bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4")))
+	assert.NotNil(t, cosmos.ValidateAddress([]byte("bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t5")), "bad checksum should be rejected")
+	assert.Nil(t, cosmos.ValidateAssetHash([]byte("uatom")))
+	assert.NotNil(t, cosmos.ValidateAssetHash([]byte{0x00, 0x01}), "non printable asset hash should be rejected")
+	assert.NotNil(t, cosmos.ValidateAssetHash(nil), "empty asset hash should be rejected")
+	assert.Equal(t, DefaultAmountWidth, cosmos.GetAmountWidth())
+
+	cosmos.UnlockActions = []string{"send", "delegate"}
+	cosmos.BondAssetHash = []byte("uatom")
+	assert.Nil(t, cosmos.ValidateBasic())
+	assert.True(t, cosmos.HasUnlockAction("delegate"))
+	assert.False(t, cosmos.HasUnlockAction("unknown"))
+	cosmos.UnlockActions = []string{"send", "send"}
+	assert.NotNil(t, cosmos.ValidateBasic(), "duplicated unlock action should be rejected")
+	cosmos.UnlockActions = nil
+	cosmos.BondAssetHash = []byte{0x00}
+	assert.NotNil(t, cosmos.ValidateBasic(), "malformed bond asset hash should be rejected")
+}
+
+func Test_Paginate(t *testing.T) {
+	db := dbm.NewMemDB()
+	for i := 0; i < MaxPageLimit+10; i++ {
+		db.Set([]byte(fmt.Sprintf("%05d", i)), []byte{0x01})
+	}
+	count := func(page, limit int) int {
+		iterator, err := db.Iterator(nil, nil)
+		assert.Nil(t, err)
+		defer iterator.Close()
+		n := 0
+		Paginate(iterator, page, limit, nil, func(key, value []byte) { n++ })
+		return n
+	}
+	assert.Equal(t, DefaultPageLimit, count(1, 0))
+	assert.Equal(t, 5, count(1, 5))
+	assert.Equal(t, MaxPageLimit, count(1, MaxPageLimit+10))
+	assert.Equal(t, 10, count(2, MaxPageLimit+10))
+}
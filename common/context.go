@@ -0,0 +1,36 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package common
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type sdkContextKey struct{}
+
+// WrapSDKContext carries ctx in the context.Context handed to the gRPC query services
+func WrapSDKContext(ctx sdk.Context) context.Context {
+	return context.WithValue(ctx.Context(), sdkContextKey{}, ctx)
+}
+
+// UnwrapSDKContext returns the sdk.Context carried by goCtx, it panics if goCtx is not built by WrapSDKContext
+func UnwrapSDKContext(goCtx context.Context) sdk.Context {
+	return goCtx.Value(sdkContextKey{}).(sdk.Context)
+}
@@ -0,0 +1,93 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package common
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// LockInfo describes the coins locked in current chain to be crossed to another chain
+type LockInfo struct {
+	Module        string         // module handling the lock, lockproxy, ft or btcx
+	LockProxyHash []byte         // lockproxy contract hash, only set by lockproxy module
+	FromAddress   sdk.AccAddress // owner of the locked coins
+	Denom         string         // denom of the locked coins
+	ToChainId     uint64         // poly chain id of the target chain
+	ToAssetHash   []byte         // asset hash in the target chain
+	ToAddress     []byte         // receiver address in the target chain
+	Amount        sdk.Int        // amount of the locked coins
+}
+
+// UnlockInfo describes the coins unlocked in current chain crossed from another chain
+type UnlockInfo struct {
+	Module           string         // module handling the unlock, lockproxy, ft or btcx
+	FromChainId      uint64         // poly chain id of the source chain
+	FromContractHash []byte         // proxy or asset hash in the source chain
+	ToContractHash   []byte         // lockproxy hash or denom hash in current chain
+	Denom            string         // denom of the unlocked coins
+	ToAddress        sdk.AccAddress // receiver of the unlocked coins
+	Amount           sdk.Int        // amount of the unlocked coins
+}
+
+// CrossChainTxInfo describes the cross chain tx from another chain verified and executed in current chain
+type CrossChainTxInfo struct {
+	Module           string // module executing the tx, lockproxy, ft or btcx
+	FromChainId      uint64 // poly chain id of the source chain
+	CrossChainId     []byte // cross chain id assigned by the source chain
+	TxHash           []byte // tx hash of the source chain
+	PolyTxHash       []byte // tx hash in poly chain
+	FromContractHash []byte
+	ToContractHash   []byte
+	Method           string
+	Args             []byte
+}
+
+// CrossChainHooks are called by the cross chain modules after coins are locked, unlocked
+// and the cross chain tx is executed, all within the same transaction
+type CrossChainHooks interface {
+	AfterLock(ctx sdk.Context, info LockInfo)                          // called by lockproxy, ft and btcx after coins are locked
+	AfterUnlock(ctx sdk.Context, info UnlockInfo)                      // called by lockproxy, ft and btcx after coins are unlocked
+	AfterCrossChainTxProcessed(ctx sdk.Context, info CrossChainTxInfo) // called by ccm after the unlock of the cross chain tx succeeds
+}
+
+var _ CrossChainHooks = MultiCrossChainHooks{}
+
+// combine multiple cross chain hooks, all hook functions are run in array sequence
+type MultiCrossChainHooks []CrossChainHooks
+
+func NewMultiCrossChainHooks(hooks ...CrossChainHooks) MultiCrossChainHooks {
+	return hooks
+}
+
+func (h MultiCrossChainHooks) AfterLock(ctx sdk.Context, info LockInfo) {
+	for i := range h {
+		h[i].AfterLock(ctx, info)
+	}
+}
+
+func (h MultiCrossChainHooks) AfterUnlock(ctx sdk.Context, info UnlockInfo) {
+	for i := range h {
+		h[i].AfterUnlock(ctx, info)
+	}
+}
+
+func (h MultiCrossChainHooks) AfterCrossChainTxProcessed(ctx sdk.Context, info CrossChainTxInfo) {
+	for i := range h {
+		h[i].AfterCrossChainTxProcessed(ctx, info)
+	}
+}
@@ -0,0 +1,103 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package common
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	MaxDenomSymbolLength      = 32
+	MaxDenomDisplayNameLength = 64
+	MaxDenomDecimals          = 77 // 10^77 still fits in uint256
+)
+
+// DenomMetadata describes a bridged denom for wallets and explorers, OriginChainId and
+// OriginAssetHash point to the asset on its origin chain the denom has been bridged from
+type DenomMetadata struct {
+	Denom           string `json:"denom" yaml:"denom"`
+	Decimals        uint8  `json:"decimals" yaml:"decimals"`
+	Symbol          string `json:"symbol" yaml:"symbol"`
+	DisplayName     string `json:"display_name" yaml:"display_name"`
+	OriginChainId   uint64 `json:"origin_chain_id" yaml:"origin_chain_id"`
+	OriginAssetHash []byte `json:"origin_asset_hash" yaml:"origin_asset_hash"`
+}
+
+func NewDenomMetadata(denom string, decimals uint8, symbol, displayName string, originChainId uint64, originAssetHash []byte) DenomMetadata {
+	return DenomMetadata{denom, decimals, symbol, displayName, originChainId, originAssetHash}
+}
+
+// ValidateBasic checks the metadata without touching the store
+func (md DenomMetadata) ValidateBasic() error {
+	if err := sdk.ValidateDenom(md.Denom); err != nil {
+		return fmt.Errorf("invalid denom: %s, err: %v", md.Denom, err)
+	}
+	if md.Decimals > MaxDenomDecimals {
+		return fmt.Errorf("decimals: %d of denom: %s exceeds the maximum: %d", md.Decimals, md.Denom, MaxDenomDecimals)
+	}
+	if len(md.Symbol) > MaxDenomSymbolLength {
+		return fmt.Errorf("symbol of denom: %s is longer than %d", md.Denom, MaxDenomSymbolLength)
+	}
+	if len(md.DisplayName) > MaxDenomDisplayNameLength {
+		return fmt.Errorf("display name of denom: %s is longer than %d", md.Denom, MaxDenomDisplayNameLength)
+	}
+	if (md.OriginChainId == 0) != (len(md.OriginAssetHash) == 0) {
+		return fmt.Errorf("origin chain id and origin asset hash of denom: %s should be set together", md.Denom)
+	}
+	return nil
+}
+
+// ValidateDenomsMetadata checks the metadata attached to the creation of coins, each one
+// should be valid and describe a distinct denom among coins
+func ValidateDenomsMetadata(mds []DenomMetadata, coins sdk.Coins) error {
+	seen := make(map[string]bool, len(mds))
+	for _, md := range mds {
+		if err := md.ValidateBasic(); err != nil {
+			return err
+		}
+		if seen[md.Denom] {
+			return fmt.Errorf("duplicated metadata of denom: %s", md.Denom)
+		}
+		seen[md.Denom] = true
+		found := false
+		for _, coin := range coins {
+			if coin.Denom == md.Denom {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("denom: %s of metadata is not among the coins: %s", md.Denom, coins.String())
+		}
+	}
+	return nil
+}
+
+func (md DenomMetadata) String() string {
+	return fmt.Sprintf(`DenomMetadata:
+  Denom:           %s
+  Decimals:        %d
+  Symbol:          %s
+  DisplayName:     %s
+  OriginChainId:   %d
+  OriginAssetHash: %s
+`, md.Denom, md.Decimals, md.Symbol, md.DisplayName, md.OriginChainId, hex.EncodeToString(md.OriginAssetHash))
+}
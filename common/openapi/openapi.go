@@ -0,0 +1,198 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+// Package openapi builds the OpenAPI documents of the REST routes of the modules from the go types the routes
+// read and write, so the documents follow the request and response types as they change.
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/polynetwork/cosmos-poly-module/common"
+)
+
+const (
+	openAPIVersion = "3.0.3"
+	// FileName is the last element of the path each module serves its document under, e.g. /ccm/openapi.json
+	FileName = "openapi.json"
+)
+
+var pathParamRegexp = regexp.MustCompile(`{([^}]+)}`)
+
+// Param describes a path or url query parameter of a route
+type Param struct {
+	Name        string
+	In          string // path or query
+	Type        string // string or integer
+	Description string
+}
+
+// PathParam returns the description of the path parameter name
+func PathParam(name, typ, description string) Param {
+	return Param{Name: name, In: "path", Type: typ, Description: description}
+}
+
+// QueryParam returns the description of the optional url query parameter name
+func QueryParam(name, typ, description string) Param {
+	return Param{Name: name, In: "query", Type: typ, Description: description}
+}
+
+// PageParams describe the page and limit url query parameters of the listing queries
+func PageParams() []Param {
+	return []Param{
+		QueryParam("page", "integer", "page number starting from 1, defaults to 1"),
+		QueryParam("limit", "integer", fmt.Sprintf("entries per page, defaults to %d and capped to %d", common.DefaultPageLimit, common.MaxPageLimit)),
+	}
+}
+
+// Route describes a REST route, the GET routes respond with the height and the query result, and the POST
+// routes respond with the unsigned StdTx built from the request
+type Route struct {
+	Method  string
+	Path    string // mux path template, e.g. /ccm/chain_info/{chain_id}
+	Summary string
+	Params  []Param     // the path parameters not listed here are documented as strings
+	Body    interface{} // request body, nil for the routes without body
+	Result  interface{} // result field of the GET routes, a Schema is used as is
+}
+
+// NewDocument returns the OpenAPI document of routes
+func NewDocument(title string, routes []Route) map[string]interface{} {
+	b := newSchemaBuilder()
+	errResponse := Schema{"description": "error", "content": jsonContent(b.schemaOf(reflect.TypeOf(rest.ErrorResponse{})))}
+
+	paths := make(map[string]map[string]interface{})
+	for _, route := range routes {
+		operation := map[string]interface{}{
+			"summary":     route.Summary,
+			"operationId": operationId(route),
+			"parameters":  parameters(route),
+		}
+		responses := map[string]interface{}{"400": errResponse, "500": errResponse}
+		if route.Method == http.MethodGet {
+			responses["200"] = Schema{"description": "query result at height", "content": jsonContent(Schema{
+				"type": "object",
+				"properties": map[string]Schema{
+					"height": {"type": "string", "format": "int64"},
+					"result": b.resultSchema(route.Result),
+				},
+			})}
+		} else {
+			responses["200"] = Schema{"description": "unsigned tx to be signed and broadcast", "content": jsonContent(Schema{
+				"type": "object",
+				"properties": map[string]Schema{
+					"type":  {"type": "string", "example": "cosmos-sdk/StdTx"},
+					"value": b.schemaOf(reflect.TypeOf(authtypes.StdTx{})),
+				},
+			})}
+		}
+		operation["responses"] = responses
+		if route.Body != nil {
+			operation["requestBody"] = Schema{"required": true, "content": jsonContent(b.schemaOf(reflect.TypeOf(route.Body)))}
+		}
+		if paths[route.Path] == nil {
+			paths[route.Path] = make(map[string]interface{})
+		}
+		paths[route.Path][strings.ToLower(route.Method)] = operation
+	}
+
+	return map[string]interface{}{
+		"openapi":    openAPIVersion,
+		"info":       map[string]interface{}{"title": title, "version": "1.0.0"},
+		"paths":      paths,
+		"components": map[string]interface{}{"schemas": b.components},
+	}
+}
+
+// Handler serves the OpenAPI document of routes
+func Handler(title string, routes []Route) http.HandlerFunc {
+	bz, err := json.MarshalIndent(NewDocument(title, routes), "", "  ")
+	return func(w http.ResponseWriter, r *http.Request) {
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(bz)
+	}
+}
+
+func (b *schemaBuilder) resultSchema(result interface{}) Schema {
+	if s, ok := result.(Schema); ok {
+		return s
+	}
+	return b.schemaOf(reflect.TypeOf(result))
+}
+
+func parameters(route Route) []Schema {
+	params := append([]Param{}, route.Params...)
+	for _, match := range pathParamRegexp.FindAllStringSubmatch(route.Path, -1) {
+		if !hasParam(params, match[1]) {
+			params = append(params, PathParam(match[1], "string", ""))
+		}
+	}
+	if route.Method == http.MethodGet {
+		params = append(params, QueryParam("height", "integer", "block height to query at, defaults to the latest"))
+	}
+
+	res := make([]Schema, 0, len(params))
+	for _, param := range params {
+		s := Schema{
+			"name":     param.Name,
+			"in":       param.In,
+			"required": param.In == "path",
+			"schema":   Schema{"type": param.Type},
+		}
+		if param.Description != "" {
+			s["description"] = param.Description
+		}
+		res = append(res, s)
+	}
+	return res
+}
+
+func hasParam(params []Param, name string) bool {
+	for _, param := range params {
+		if param.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// operationId derives the id from the path without its parameters, e.g. get_ccm_chain_info
+func operationId(route Route) string {
+	var elems []string
+	for _, elem := range strings.Split(route.Path, "/") {
+		if elem != "" && !strings.HasPrefix(elem, "{") {
+			elems = append(elems, elem)
+		}
+	}
+	return strings.ToLower(route.Method) + "_" + strings.Join(elems, "_")
+}
+
+func jsonContent(s Schema) map[string]interface{} {
+	return map[string]interface{}{"application/json": map[string]interface{}{"schema": s}}
+}
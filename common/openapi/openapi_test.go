@@ -0,0 +1,87 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package openapi
+
+import (
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+type testRecord struct {
+	Id      uint64         `json:"id"`
+	Height  int64          `json:"height"`
+	Decimal uint8          `json:"decimal"`
+	Hash    []byte         `json:"hash"`
+	Owner   sdk.AccAddress `json:"owner"`
+	Amount  sdk.Int        `json:"amount"`
+	Value   *big.Int       `json:"value"`
+	Coins   sdk.Coins      `json:"coins"`
+	Msg     sdk.Msg        `json:"msg"`
+	Ignored string         `json:"-"`
+	Parent  *testRecord    `json:"parent"`
+	Untag   bool
+	private bool
+}
+
+func Test_SchemaOf(t *testing.T) {
+	s, components := SchemaOf([]testRecord{})
+	assert.Equal(t, Schema{"type": "array", "items": Schema{"$ref": "#/components/schemas/common.openapi.testRecord"}}, s)
+
+	record := components["common.openapi.testRecord"]
+	properties := record["properties"].(map[string]Schema)
+	assert.Equal(t, 11, len(properties))
+	assert.Equal(t, Schema{"type": "string", "format": "uint64"}, properties["id"])
+	assert.Equal(t, Schema{"type": "string", "format": "int64"}, properties["height"])
+	assert.Equal(t, "integer", properties["decimal"]["type"])
+	assert.Equal(t, Schema{"type": "string", "format": "byte"}, properties["hash"])
+	assert.Equal(t, Schema{"type": "string"}, properties["owner"])
+	assert.Equal(t, Schema{"type": "string"}, properties["amount"])
+	assert.Equal(t, "integer", properties["value"]["type"])
+	assert.Equal(t, Schema{"type": "array", "items": Schema{"$ref": "#/components/schemas/cosmos-sdk.types.Coin"}}, properties["coins"])
+	assert.Contains(t, properties["msg"]["properties"], "type")
+	assert.Equal(t, Schema{"$ref": "#/components/schemas/common.openapi.testRecord"}, properties["parent"])
+	assert.Equal(t, Schema{"type": "boolean"}, properties["Untag"])
+	assert.NotContains(t, properties, "Ignored")
+	assert.Contains(t, components, "cosmos-sdk.types.Coin")
+}
+
+func Test_NewDocument(t *testing.T) {
+	doc := NewDocument("test", []Route{
+		{Method: "GET", Path: "/test/record/{id}/{owner}", Params: []Param{PathParam("id", "integer", "")}, Result: testRecord{}},
+		{Method: "POST", Path: "/test/create", Body: testRecord{}},
+	})
+	paths := doc["paths"].(map[string]map[string]interface{})
+
+	get := paths["/test/record/{id}/{owner}"]["get"].(map[string]interface{})
+	assert.Equal(t, "get_test_record", get["operationId"])
+	params := get["parameters"].([]Schema)
+	assert.Equal(t, 3, len(params))
+	assert.Equal(t, Schema{"type": "integer"}, params[0]["schema"])
+	assert.Equal(t, "owner", params[1]["name"])
+	assert.Equal(t, Schema{"type": "string"}, params[1]["schema"])
+	assert.Equal(t, "height", params[2]["name"])
+	assert.Equal(t, "query", params[2]["in"])
+
+	post := paths["/test/create"]["post"].(map[string]interface{})
+	assert.Equal(t, "post_test_create", post["operationId"])
+	assert.Contains(t, post, "requestBody")
+	assert.Contains(t, doc["components"].(map[string]interface{})["schemas"], "auth.types.StdTx")
+}
@@ -0,0 +1,173 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package openapi
+
+import (
+	"encoding/json"
+	"math/big"
+	"reflect"
+	"strings"
+)
+
+// Schema is an OpenAPI schema object
+type Schema map[string]interface{}
+
+var (
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	bigIntType        = reflect.TypeOf(big.Int{})
+)
+
+// schemaBuilder derives the schemas of the amino JSON encoding of go values, the named structs are collected
+// as components and referred by $ref
+type schemaBuilder struct {
+	components map[string]Schema
+	names      map[reflect.Type]string
+}
+
+func newSchemaBuilder() *schemaBuilder {
+	return &schemaBuilder{components: make(map[string]Schema), names: make(map[reflect.Type]string)}
+}
+
+// SchemaOf returns the schema of the amino JSON encoding of v along with the components it refers to
+func SchemaOf(v interface{}) (Schema, map[string]Schema) {
+	b := newSchemaBuilder()
+	return b.schemaOf(reflect.TypeOf(v)), b.components
+}
+
+func (b *schemaBuilder) schemaOf(t reflect.Type) Schema {
+	if t == nil {
+		return Schema{}
+	}
+	if t.Kind() == reflect.Ptr {
+		return b.schemaOf(t.Elem())
+	}
+	if s, ok := marshalerSchema(t); ok {
+		return s
+	}
+	switch t.Kind() {
+	case reflect.Bool:
+		return Schema{"type": "boolean"}
+	case reflect.Int8, reflect.Int16, reflect.Int32:
+		return Schema{"type": "integer", "format": "int32"}
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return Schema{"type": "integer", "format": "int32", "minimum": 0}
+	case reflect.Int, reflect.Int64:
+		// amino encodes 64 bits integers as strings
+		return Schema{"type": "string", "format": "int64"}
+	case reflect.Uint, reflect.Uint64:
+		return Schema{"type": "string", "format": "uint64"}
+	case reflect.Float32, reflect.Float64:
+		return Schema{"type": "number"}
+	case reflect.String:
+		return Schema{"type": "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return Schema{"type": "string", "format": "byte"}
+		}
+		return Schema{"type": "array", "items": b.schemaOf(t.Elem())}
+	case reflect.Map:
+		return Schema{"type": "object", "additionalProperties": b.schemaOf(t.Elem())}
+	case reflect.Interface:
+		// amino wraps the concrete value of an interface with its registered name
+		return Schema{
+			"type": "object",
+			"properties": map[string]Schema{
+				"type":  {"type": "string"},
+				"value": {"type": "object"},
+			},
+		}
+	case reflect.Struct:
+		return b.structSchema(t)
+	}
+	return Schema{}
+}
+
+// marshalerSchema handles the types encoding themselves, e.g. sdk.Int and sdk.AccAddress, by the JSON of
+// their zero values. The types not encoded to a string or number fall back to their go structure.
+func marshalerSchema(t reflect.Type) (s Schema, ok bool) {
+	if t == bigIntType {
+		return Schema{"type": "integer", "description": "arbitrary precision integer"}, true
+	}
+	if !reflect.PtrTo(t).Implements(jsonMarshalerType) {
+		return nil, false
+	}
+	defer func() {
+		if recover() != nil {
+			s, ok = nil, false
+		}
+	}()
+	bz, err := reflect.New(t).Interface().(json.Marshaler).MarshalJSON()
+	if err != nil || len(bz) == 0 {
+		return nil, false
+	}
+	switch {
+	case bz[0] == '"':
+		return Schema{"type": "string"}, true
+	case bz[0] == '-' || (bz[0] >= '0' && bz[0] <= '9'):
+		return Schema{"type": "number"}, true
+	}
+	return nil, false
+}
+
+func (b *schemaBuilder) structSchema(t reflect.Type) Schema {
+	if t.Name() == "" {
+		return b.fieldsSchema(t)
+	}
+	name, found := b.names[t]
+	if !found {
+		name = componentName(t)
+		b.names[t] = name
+		// registered before the fields to stop the recursion of self referencing types
+		b.components[name] = Schema{}
+		b.components[name] = b.fieldsSchema(t)
+	}
+	return Schema{"$ref": "#/components/schemas/" + name}
+}
+
+func (b *schemaBuilder) fieldsSchema(t reflect.Type) Schema {
+	properties := make(map[string]Schema)
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+		name := field.Name
+		if tag := strings.Split(field.Tag.Get("json"), ",")[0]; tag == "-" {
+			continue
+		} else if tag != "" {
+			name = tag
+		}
+		properties[name] = b.schemaOf(field.Type)
+	}
+	return Schema{"type": "object", "properties": properties}
+}
+
+// componentName names t by the last elements of its package path, e.g. ccm.types.CrossChainTxStatus, skipping
+// the internal and client elements
+func componentName(t reflect.Type) string {
+	var elems []string
+	for _, elem := range strings.Split(t.PkgPath(), "/") {
+		if elem != "internal" && elem != "client" {
+			elems = append(elems, elem)
+		}
+	}
+	if len(elems) > 2 {
+		elems = elems[len(elems)-2:]
+	}
+	return strings.Join(append(elems, t.Name()), ".")
+}
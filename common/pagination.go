@@ -0,0 +1,56 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package common
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// DefaultPageLimit is the number of entries of a page when the listing queries are not given a limit
+	DefaultPageLimit = 100
+	// MaxPageLimit bounds the entries of a page so that a query cannot walk a whole store
+	MaxPageLimit = 1000
+)
+
+// Paginate walks iterator and calls cb with the entries on the page-th page of limit entries, pages start
+// from one, a non-positive limit means DefaultPageLimit and a limit above MaxPageLimit is capped to it. Only the entries accepted by accept are counted,
+// a nil accept takes all of them. The caller still closes iterator.
+func Paginate(iterator sdk.Iterator, page, limit int, accept func(key, value []byte) bool, cb func(key, value []byte)) {
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 {
+		limit = DefaultPageLimit
+	}
+	if limit > MaxPageLimit {
+		limit = MaxPageLimit
+	}
+	skip := (page - 1) * limit
+	for ; iterator.Valid() && limit > 0; iterator.Next() {
+		if accept != nil && !accept(iterator.Key(), iterator.Value()) {
+			continue
+		}
+		if skip > 0 {
+			skip--
+			continue
+		}
+		cb(iterator.Key(), iterator.Value())
+		limit--
+	}
+}
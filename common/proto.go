@@ -0,0 +1,236 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package common
+
+import (
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	commonpb "github.com/polynetwork/cosmos-poly-module/common/pb"
+)
+
+// MustMarshalProto encodes a record to be stored with protobuf, the encoding of the stored records since the store migration
+func MustMarshalProto(msg proto.Message) []byte {
+	bz, err := proto.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+// MustUnmarshalProto decodes a record stored by MustMarshalProto into msg
+func MustUnmarshalProto(bz []byte, msg proto.Message) {
+	if err := proto.Unmarshal(bz, msg); err != nil {
+		panic(err)
+	}
+}
+
+// PageFromProto returns the page and limit selected by p for Paginate, a nil p selects the first page
+func PageFromProto(p *commonpb.PageRequest) (page, limit int) {
+	if p == nil {
+		return 1, 0
+	}
+	return int(p.Page), int(p.Limit)
+}
+
+func AccAddressesToProto(addrs []sdk.AccAddress) []string {
+	if len(addrs) == 0 {
+		return nil
+	}
+	strs := make([]string, len(addrs))
+	for i, addr := range addrs {
+		strs[i] = addr.String()
+	}
+	return strs
+}
+
+// AccAddressFromProto parses the bech32 address s, an empty s is the empty address. The length of the address
+// is not verified since lock proxy hashes are stored as addresses as well
+func AccAddressFromProto(s string) sdk.AccAddress {
+	if s == "" {
+		return nil
+	}
+	bz, err := sdk.GetFromBech32(s, sdk.GetConfig().GetBech32AccountAddrPrefix())
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+func AccAddressesFromProto(strs []string) []sdk.AccAddress {
+	if len(strs) == 0 {
+		return nil
+	}
+	addrs := make([]sdk.AccAddress, len(strs))
+	for i, s := range strs {
+		addrs[i] = AccAddressFromProto(s)
+	}
+	return addrs
+}
+
+// IntFromProto parses the decimal string of a sdk.Int
+func IntFromProto(s string) sdk.Int {
+	i, ok := sdk.NewIntFromString(s)
+	if !ok {
+		panic(fmt.Sprintf("invalid integer: %s", s))
+	}
+	return i
+}
+
+func CoinsToProto(coins sdk.Coins) []*commonpb.Coin {
+	pbs := make([]*commonpb.Coin, len(coins))
+	for i, coin := range coins {
+		pbs[i] = &commonpb.Coin{Denom: coin.Denom, Amount: coin.Amount.String()}
+	}
+	return pbs
+}
+
+// CoinsFromProto keeps the order of pbs, which are encoded from valid sorted coins
+func CoinsFromProto(pbs []*commonpb.Coin) sdk.Coins {
+	if len(pbs) == 0 {
+		return nil
+	}
+	coins := make(sdk.Coins, len(pbs))
+	for i, pb := range pbs {
+		coins[i] = sdk.Coin{Denom: pb.Denom, Amount: IntFromProto(pb.Amount)}
+	}
+	return coins
+}
+
+func BindingsToProto(bindings []Binding) []*commonpb.Binding {
+	pbs := make([]*commonpb.Binding, len(bindings))
+	for i, b := range bindings {
+		pbs[i] = &commonpb.Binding{ChainId: b.ChainId, Hash: b.Hash}
+	}
+	return pbs
+}
+
+func BindingChangeToProto(c BindingChange) *commonpb.BindingChange {
+	return &commonpb.BindingChange{
+		BindingType: c.BindingType,
+		LockProxy:   c.LockProxy,
+		Denom:       c.Denom,
+		ChainId:     c.ChainId,
+		OldValue:    c.OldValue,
+		NewValue:    c.NewValue,
+		Height:      c.Height,
+		Operator:    c.Operator.String(),
+		Approvers:   AccAddressesToProto(c.Approvers),
+
+		OldSourceDecimals: uint32(c.OldSourceDecimals),
+		OldToDecimals:     uint32(c.OldToDecimals),
+		SourceDecimals:    uint32(c.SourceDecimals),
+		ToDecimals:        uint32(c.ToDecimals),
+	}
+}
+
+func BindingChangeFromProto(pb *commonpb.BindingChange) BindingChange {
+	return BindingChange{
+		BindingType: pb.BindingType,
+		LockProxy:   pb.LockProxy,
+		Denom:       pb.Denom,
+		ChainId:     pb.ChainId,
+		OldValue:    pb.OldValue,
+		NewValue:    pb.NewValue,
+		Height:      pb.Height,
+		Operator:    AccAddressFromProto(pb.Operator),
+		Approvers:   AccAddressesFromProto(pb.Approvers),
+
+		OldSourceDecimals: uint8(pb.OldSourceDecimals),
+		OldToDecimals:     uint8(pb.OldToDecimals),
+		SourceDecimals:    uint8(pb.SourceDecimals),
+		ToDecimals:        uint8(pb.ToDecimals),
+	}
+}
+
+// MustDecodeBindingChange decodes a record of the binding history stored by BindingChangeToProto
+func MustDecodeBindingChange(bz []byte) BindingChange {
+	var pb commonpb.BindingChange
+	MustUnmarshalProto(bz, &pb)
+	return BindingChangeFromProto(&pb)
+}
+
+func BindingChangesToProto(changes []BindingChange) []*commonpb.BindingChange {
+	pbs := make([]*commonpb.BindingChange, len(changes))
+	for i, c := range changes {
+		pbs[i] = BindingChangeToProto(c)
+	}
+	return pbs
+}
+
+func DenomMetadataToProto(md DenomMetadata) *commonpb.DenomMetadata {
+	return &commonpb.DenomMetadata{
+		Denom:           md.Denom,
+		Decimals:        uint32(md.Decimals),
+		Symbol:          md.Symbol,
+		DisplayName:     md.DisplayName,
+		OriginChainId:   md.OriginChainId,
+		OriginAssetHash: md.OriginAssetHash,
+	}
+}
+
+func DenomMetadataFromProto(pb *commonpb.DenomMetadata) DenomMetadata {
+	return DenomMetadata{
+		Denom:           pb.Denom,
+		Decimals:        uint8(pb.Decimals),
+		Symbol:          pb.Symbol,
+		DisplayName:     pb.DisplayName,
+		OriginChainId:   pb.OriginChainId,
+		OriginAssetHash: pb.OriginAssetHash,
+	}
+}
+
+func ChainInfoToProto(info ChainInfo) *commonpb.ChainInfo {
+	return &commonpb.ChainInfo{
+		ChainId:           info.ChainId,
+		Name:              info.Name,
+		Family:            info.Family,
+		AddressEncoding:   info.AddressEncoding,
+		AddressLength:     info.AddressLength,
+		AssetHashEncoding: info.AssetHashEncoding,
+		AssetHashLength:   info.AssetHashLength,
+		AmountWidth:       info.AmountWidth,
+		UnlockActions:     info.UnlockActions,
+		BondAssetHash:     info.BondAssetHash,
+	}
+}
+
+func ChainInfoFromProto(pb *commonpb.ChainInfo) ChainInfo {
+	return ChainInfo{
+		ChainId:           pb.ChainId,
+		Name:              pb.Name,
+		Family:            pb.Family,
+		AddressEncoding:   pb.AddressEncoding,
+		AddressLength:     pb.AddressLength,
+		AssetHashEncoding: pb.AssetHashEncoding,
+		AssetHashLength:   pb.AssetHashLength,
+		AmountWidth:       pb.AmountWidth,
+		UnlockActions:     pb.UnlockActions,
+		BondAssetHash:     pb.BondAssetHash,
+	}
+}
+
+func ChainInfosToProto(infos []ChainInfo) []*commonpb.ChainInfo {
+	pbs := make([]*commonpb.ChainInfo, len(infos))
+	for i, info := range infos {
+		pbs[i] = ChainInfoToProto(info)
+	}
+	return pbs
+}
@@ -37,6 +37,7 @@ const (
 	AttributeKeyToChainId = types.AttributeKeyToChainId
 
 	EventTypeBindAsset           = types.EventTypeBindAsset
+	EventTypeUnbindAsset         = types.EventTypeUnbindAsset
 	AttributeKeySourceAssetDenom = types.AttributeKeySourceAssetDenom
 	AttributeKeyFromAssetHash    = types.AttributeKeyFromAssetHash
 	AttributeKeyToChainAssetHash = types.AttributeKeyToChainAssetHash
@@ -54,24 +55,46 @@ const (
 	EventTypeSetRedeemScript = types.EventTypeSetRedeemScript
 	AttributeKeyRedeemKey    = types.AttributeKeyRedeemKey
 	AttributeKeyRedeemScript = types.AttributeKeyRedeemScript
+
+	EventTypeMintCoins             = types.EventTypeMintCoins
+	EventTypeBurnCoins             = types.EventTypeBurnCoins
+	EventTypeTransferMintAuthority = types.EventTypeTransferMintAuthority
+	AttributeKeyMinter             = types.AttributeKeyMinter
+	AttributeKeyAuthorityModule    = types.AttributeKeyAuthorityModule
+	QueryMintInfo                  = types.QueryMintInfo
+	QueryAssetBindings             = types.QueryAssetBindings
+	QueryDenomsByCreator           = types.QueryDenomsByCreator
 )
 
 var (
 	// functions aliases
-	RegisterCodec = types.RegisterCodec
-	NewKeeper     = keeper.NewKeeper
-	NewQuerier    = keeper.NewQuerier
-
-	NewMsgLock          = types.NewMsgLock
-	NewMsgCreateDenom   = types.NewMsgCreateDenom
-	NewMsgBindAssetHash = types.NewMsgBindAssetHash
-	NewMsgCreateCoins   = types.NewMsgCreateCoins
+	RegisterCodec  = types.RegisterCodec
+	NewKeeper      = keeper.NewKeeper
+	NewQuerier     = keeper.NewQuerier
+	NewQueryServer = keeper.NewQueryServer
+
+	NewMsgLock                   = types.NewMsgLock
+	NewMsgCreateDenom            = types.NewMsgCreateDenom
+	NewMsgBindAssetHash          = types.NewMsgBindAssetHash
+	NewMsgUnbindAssetHash        = types.NewMsgUnbindAssetHash
+	NewMsgSettleAssetDecimals    = types.NewMsgSettleAssetDecimals
+	NewMsgCreateCoins            = types.NewMsgCreateCoins
+	NewQueryBindingHistoryParam  = types.NewQueryBindingHistoryParam
+	NewMsgMintCoins              = types.NewMsgMintCoins
+	NewMsgBurnCoins              = types.NewMsgBurnCoins
+	NewMsgTransferMintAuthority  = types.NewMsgTransferMintAuthority
+	NewMintInfo                  = types.NewMintInfo
+	NewQueryMintInfoParam        = types.NewQueryMintInfoParam
+	NewQueryAssetBindingsParam   = types.NewQueryAssetBindingsParam
+	NewQueryDenomsByCreatorParam = types.NewQueryDenomsByCreatorParam
 
 	// key function
 
 	ModuleCdc = types.ModuleCdc
 
-	ErrInvalidChainId = types.ErrInvalidChainId
+	ErrInvalidChainId      = types.ErrInvalidChainId
+	ErrMintAuthority       = types.ErrMintAuthority
+	ErrSettleAssetDecimals = types.ErrSettleAssetDecimals
 
 	// query balance path
 
@@ -80,11 +103,20 @@ var (
 type (
 	Keeper = keeper.Keeper
 
-	MsgBindAssetHash    = types.MsgBindAssetHash
-	MsgLock             = types.MsgLock
-	MsgCreateDenom      = types.MsgCreateDenom
-	DenomInfo           = types.DenomInfo
-	DenomCrossChainInfo = types.DenomCrossChainInfo
-	TxArgs              = types.TxArgs
-	UnlockKeeper        = exported.UnlockKeeper
+	MsgBindAssetHash       = types.MsgBindAssetHash
+	MsgUnbindAssetHash     = types.MsgUnbindAssetHash
+	MsgSettleAssetDecimals = types.MsgSettleAssetDecimals
+	MsgLock                = types.MsgLock
+	MsgCreateDenom         = types.MsgCreateDenom
+	DenomInfo              = types.DenomInfo
+	DenomCrossChainInfo    = types.DenomCrossChainInfo
+	TxArgs                 = types.TxArgs
+	BindingChange          = types.BindingChange
+	UnlockKeeper           = exported.UnlockKeeper
+
+	MsgMintCoins             = types.MsgMintCoins
+	MsgBurnCoins             = types.MsgBurnCoins
+	MsgTransferMintAuthority = types.MsgTransferMintAuthority
+	MintInfo                 = types.MintInfo
+	Binding                  = types.Binding
 )
@@ -28,6 +28,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	polycommon "github.com/polynetwork/cosmos-poly-module/common"
 	"github.com/polynetwork/cosmos-poly-module/ft/client/common"
 	"github.com/polynetwork/cosmos-poly-module/ft/internal/types"
 	"strconv"
@@ -47,6 +49,10 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		flags.GetCommands(
 			GetCmdQueryDenomInfo(queryRoute, cdc),
 			GetCmdQueryDenomCrossChainInfo(queryRoute, cdc),
+			GetCmdQueryBindingHistory(queryRoute, cdc),
+			GetCmdQueryMintInfo(queryRoute, cdc),
+			GetCmdQueryAssetBindings(queryRoute, cdc),
+			GetCmdQueryDenomsByCreator(queryRoute, cdc),
 		)...,
 	)
 
@@ -119,3 +125,165 @@ $ %s query %s denom-cross-chain-info btcx 2
 		},
 	}
 }
+
+const (
+	FlagDenom     = "denom"
+	FlagToChainId = "to-chain-id"
+)
+
+func GetCmdQueryBindingHistory(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "binding-history",
+		Short: "Query the history of asset hash binding changes",
+		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query every bind, rebind and unbind of asset hashes page by page, optionally filtered by denom and target chainId.
+The changes are ordered by binding, and the changes of one binding in the order they happened
+
+Example:
+$ %s query %s binding-history --denom btcx --to-chain-id 2 --page 2 --limit 50
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+			chainId, err := cmd.Flags().GetUint64(FlagToChainId)
+			if err != nil {
+				return err
+			}
+			page, limit, err := getPageFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := common.QueryBindingHistory(cliCtx, queryRoute, denom, chainId, page, limit)
+			if err != nil {
+				return err
+			}
+			var changes []types.BindingChange
+			cdc.MustUnmarshalJSON(res, &changes)
+			return cliCtx.PrintOutput(changes)
+		},
+	}
+	cmd.Flags().String(FlagDenom, "", "source asset denom, all denoms if empty")
+	cmd.Flags().Uint64(FlagToChainId, 0, "target chainId, all chains if zero")
+	addPageFlags(cmd)
+	return cmd
+}
+
+func GetCmdQueryMintInfo(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "mint-info [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the supply cap and the mint authority of a denom created by create-coins",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+Example:
+$ %s query %s mint-info mst2
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, err := common.QueryMintInfo(cliCtx, queryRoute, args[0])
+			if err != nil {
+				return err
+			}
+			var info types.MintInfo
+			cdc.MustUnmarshalJSON(res, &info)
+			return cliCtx.PrintOutput(info)
+		},
+	}
+}
+
+func GetCmdQueryAssetBindings(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "asset-bindings [denom]",
+		Short: "Query all the asset hashes bound to denom page by page",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+
+Example:
+$ %s query %s asset-bindings mst2 --page 1 --limit 50
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			page, limit, err := getPageFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := common.QueryAssetBindings(cliCtx, queryRoute, args[0], page, limit)
+			if err != nil {
+				return err
+			}
+			var bindings []types.Binding
+			cdc.MustUnmarshalJSON(res, &bindings)
+			return cliCtx.PrintOutput(bindings)
+		},
+	}
+	addPageFlags(cmd)
+	return cmd
+}
+
+func GetCmdQueryDenomsByCreator(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denoms-by-creator [creator]",
+		Short: "Query all the denoms owned by creator page by page",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+
+Example:
+$ %s query %s denoms-by-creator cosmos1ayc6faczpj42eu7wjsjkwcj7h0q2p2e4vrlkzf --page 1 --limit 50
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			creator, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			page, limit, err := getPageFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := common.QueryDenomsByCreator(cliCtx, queryRoute, creator, page, limit)
+			if err != nil {
+				return err
+			}
+			var denoms []string
+			cdc.MustUnmarshalJSON(res, &denoms)
+			return cliCtx.PrintOutput(denoms)
+		},
+	}
+	addPageFlags(cmd)
+	return cmd
+}
+
+func addPageFlags(cmd *cobra.Command) {
+	cmd.Flags().Int(flags.FlagPage, 1, "page of the results to query, starting from 1")
+	cmd.Flags().Int(flags.FlagLimit, polycommon.DefaultPageLimit, "number of results per page")
+}
+
+func getPageFlags(cmd *cobra.Command) (page, limit int, err error) {
+	if page, err = cmd.Flags().GetInt(flags.FlagPage); err != nil {
+		return
+	}
+	limit, err = cmd.Flags().GetInt(flags.FlagLimit)
+	return
+}
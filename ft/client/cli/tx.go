@@ -32,6 +32,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/polynetwork/cosmos-poly-module/common"
 	"github.com/polynetwork/cosmos-poly-module/ft/internal/types"
 	"math/big"
 	"strconv"
@@ -49,8 +50,13 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 	txCmd.AddCommand(flags.PostCommands(
 		SendCreateDenomTxCmd(cdc),
 		SendBindAssetHashTxCmd(cdc),
+		SendUnbindAssetHashTxCmd(cdc),
+		SendSettleAssetDecimalsTxCmd(cdc),
 		SendLockTxCmd(cdc),
 		SendCreateCoinsTxCmd(cdc),
+		SendMintCoinsTxCmd(cdc),
+		SendBurnCoinsTxCmd(cdc),
+		SendTransferMintAuthorityTxCmd(cdc),
 	)...)
 	return txCmd
 }
@@ -62,7 +68,7 @@ func SendCreateDenomTxCmd(cdc *codec.Codec) *cobra.Command {
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`
 Example:
-$ %s tx %s create-denom cosmos1lzk4nch5v2snduup2uujpud9j6gqeunqarx2d9 ont 
+$ %s tx %s create-denom cosmos1lzk4nch5v2snduup2uujpud9j6gqeunqarx2d9 ont --decimals 9 --symbol ONT --display-name Ontology
 `,
 				version.ClientName, types.ModuleName,
 			),
@@ -81,13 +87,58 @@ $ %s tx %s create-denom cosmos1lzk4nch5v2snduup2uujpud9j6gqeunqarx2d9 ont
 			//	coins[i] = sdk.NewCoin(coin.Denom, sdk.NewInt(0))
 			//}
 			// build and sign the transaction, then broadcast to Tendermint
-			msg := types.NewMsgCreateDenom(creator, args[1])
+			metadata, err := denomMetadataFromFlags(cmd, args[1])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgCreateDenom(creator, args[1], metadata)
 			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
+	addDenomMetadataFlags(cmd)
 	return cmd
 }
 
+const (
+	FlagSourceDecimals = "source-decimals"
+	FlagToDecimals     = "to-decimals"
+
+	FlagDecimals        = "decimals"
+	FlagSymbol          = "symbol"
+	FlagDisplayName     = "display-name"
+	FlagOriginChainId   = "origin-chain-id"
+	FlagOriginAssetHash = "origin-asset-hash"
+
+	FlagSupplyCap = "supply-cap"
+)
+
+func addDenomMetadataFlags(cmd *cobra.Command) {
+	cmd.Flags().Uint8(FlagDecimals, 0, "decimals of the denom")
+	cmd.Flags().String(FlagSymbol, "", "symbol of the denom")
+	cmd.Flags().String(FlagDisplayName, "", "display name of the denom")
+	cmd.Flags().Uint64(FlagOriginChainId, 0, "poly chain id of the origin chain the denom is bridged from")
+	cmd.Flags().String(FlagOriginAssetHash, "", "asset hash in hex on the origin chain")
+}
+
+// denomMetadataFromFlags returns nil if none of the metadata flags is set
+func denomMetadataFromFlags(cmd *cobra.Command, denom string) (*common.DenomMetadata, error) {
+	if !cmd.Flags().Changed(FlagDecimals) && !cmd.Flags().Changed(FlagSymbol) && !cmd.Flags().Changed(FlagDisplayName) &&
+		!cmd.Flags().Changed(FlagOriginChainId) && !cmd.Flags().Changed(FlagOriginAssetHash) {
+		return nil, nil
+	}
+	decimals, _ := cmd.Flags().GetUint8(FlagDecimals)
+	symbol, _ := cmd.Flags().GetString(FlagSymbol)
+	displayName, _ := cmd.Flags().GetString(FlagDisplayName)
+	originChainId, _ := cmd.Flags().GetUint64(FlagOriginChainId)
+	originAssetHashStr, _ := cmd.Flags().GetString(FlagOriginAssetHash)
+	originAssetHash, err := hex.DecodeString(originAssetHashStr)
+	if err != nil {
+		return nil, fmt.Errorf("decode hex string: %s error: %v", originAssetHashStr, err)
+	}
+	md := common.NewDenomMetadata(denom, decimals, symbol, displayName, originChainId, originAssetHash)
+	return &md, nil
+}
+
 func SendBindAssetHashTxCmd(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "bind-asset-hash [source_asset_denom] [target_chainId] [target_asset_hash] [initialAmount]",
@@ -122,10 +173,21 @@ $ %s tx %s bind-asset-hash ont 3 00000000000000000001 100000
 				return fmt.Errorf("decode hex string 'targetProxyHash' error:%v", err)
 			}
 
-			msg := types.NewMsgBindAssetHash(cliCtx.GetFromAddress(), sourceAssetDenom, toChainId, toAssetHash)
+			sourceDecimals, err := cmd.Flags().GetUint8(FlagSourceDecimals)
+			if err != nil {
+				return err
+			}
+			toDecimals, err := cmd.Flags().GetUint8(FlagToDecimals)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBindAssetHash(cliCtx.GetFromAddress(), sourceAssetDenom, toChainId, toAssetHash, sourceDecimals, toDecimals)
 			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
+	cmd.Flags().Uint8(FlagSourceDecimals, 0, "decimals of source_asset_denom in current chain")
+	cmd.Flags().Uint8(FlagToDecimals, 0, "decimals of target_asset_hash in target_chainId, amount is scaled when differs from source decimals")
 	return cmd
 }
 
@@ -185,7 +247,7 @@ func SendCreateCoinsTxCmd(cdc *codec.Codec) *cobra.Command {
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`
 Example:
-$ %s tx %s create-coins cosmos1lzk4nch5v2snduup2uujpud9j6gqeunqarx2d9  100000mst2 
+$ %s tx %s create-coins cosmos1lzk4nch5v2snduup2uujpud9j6gqeunqarx2d9  100000mst2 --decimals 6 --symbol MST2
 `,
 				version.ClientName, types.ModuleName,
 			),
@@ -204,7 +266,179 @@ $ %s tx %s create-coins cosmos1lzk4nch5v2snduup2uujpud9j6gqeunqarx2d9  100000mst
 			//	coins[i] = sdk.NewCoin(coin.Denom, sdk.NewInt(0))
 			//}
 			// build and sign the transaction, then broadcast to Tendermint
-			msg := types.NewMsgCreateCoins(creator, args[1])
+			coins, err := sdk.ParseCoins(args[1])
+			if err != nil {
+				return err
+			}
+			if coins.Empty() {
+				return fmt.Errorf("coins: %s should not be empty", args[1])
+			}
+			var metadata []common.DenomMetadata
+			if md, err := denomMetadataFromFlags(cmd, coins.GetDenomByIndex(0)); err != nil {
+				return err
+			} else if md != nil {
+				if len(coins) != 1 {
+					return fmt.Errorf("metadata flags only apply to creating coins of a single denom, got: %s", coins.String())
+				}
+				metadata = append(metadata, *md)
+			}
+			supplyCap, err := cmd.Flags().GetString(FlagSupplyCap)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgCreateCoins(creator, args[1], supplyCap, metadata)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	addDenomMetadataFlags(cmd)
+	cmd.Flags().String(FlagSupplyCap, "", "hard cap of the total supply per denom, e.g. 1000000mst2, no cap if empty")
+	return cmd
+}
+
+func SendUnbindAssetHashTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unbind-asset-hash [source_asset_denom] [target_chainId]",
+		Short: "remove the asset hash of source_asset_denom in target_chainId bound by the denom creator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+Example:
+$ %s tx %s unbind-asset-hash ont 3
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			toChainId, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUnbindAssetHash(cliCtx.GetFromAddress(), args[0], toChainId)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
+
+func SendSettleAssetDecimalsTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "settle-asset-decimals [source_asset_denom] [chainId]",
+		Short: "let the unlock from chainId scale with the decimals of the asset binding after they changed, only allowed for the denom creator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+Until settled, the unlock keeps the decimals the binding had before the change, settle it once every transfer
+sent from chainId under the former decimals has been unlocked.
+
+Example:
+$ %s tx %s settle-asset-decimals ont 3
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			chainId, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSettleAssetDecimals(cliCtx.GetFromAddress(), args[0], chainId)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
+
+func SendMintCoinsTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint-coins [to_address] [coin]",
+		Short: "mint coin of a denom created by create-coins to to_address, only allowed for the denom creator holding the mint authority",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+Example:
+$ %s tx %s mint-coins cosmos1lzk4nch5v2snduup2uujpud9j6gqeunqarx2d9 100000mst2
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			toAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			amount, err := sdk.ParseCoin(args[1])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgMintCoins(cliCtx.GetFromAddress(), toAddr, amount)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
+
+func SendBurnCoinsTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burn-coins [coin]",
+		Short: "burn coin of a denom created by create-coins from the balance of the denom creator holding the mint authority",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+Example:
+$ %s tx %s burn-coins 100000mst2
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			amount, err := sdk.ParseCoin(args[0])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgBurnCoins(cliCtx.GetFromAddress(), amount)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
+
+func SendTransferMintAuthorityTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-mint-authority [denom] [module_name]",
+		Short: "hand the mint authority of denom over to a module account, the creator can not mint or burn the denom any more",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+Example:
+$ %s tx %s transfer-mint-authority mst2 distribution
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			msg := types.NewMsgTransferMintAuthority(cliCtx.GetFromAddress(), args[0], args[1])
 			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
@@ -21,6 +21,7 @@ import (
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/polynetwork/cosmos-poly-module/ft/internal/types"
 )
 
@@ -43,3 +44,39 @@ func QueryDenomCrossChainInfo(cliCtx context.CLIContext, queryRoute string, deno
 
 	return res, err
 }
+
+func QueryBindingHistory(cliCtx context.CLIContext, queryRoute string, denom string, chainId uint64, page, limit int) ([]byte, error) {
+
+	res, _, err := cliCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryBindingHistory),
+		cliCtx.Codec.MustMarshalJSON(types.NewQueryBindingHistoryParam(denom, chainId, page, limit)),
+	)
+	return res, err
+}
+
+func QueryMintInfo(cliCtx context.CLIContext, queryRoute string, denom string) ([]byte, error) {
+
+	res, _, err := cliCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryMintInfo),
+		cliCtx.Codec.MustMarshalJSON(types.NewQueryMintInfoParam(denom)),
+	)
+	return res, err
+}
+
+func QueryAssetBindings(cliCtx context.CLIContext, queryRoute string, denom string, page, limit int) ([]byte, error) {
+
+	res, _, err := cliCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryAssetBindings),
+		cliCtx.Codec.MustMarshalJSON(types.NewQueryAssetBindingsParam(denom, page, limit)),
+	)
+	return res, err
+}
+
+func QueryDenomsByCreator(cliCtx context.CLIContext, queryRoute string, creator sdk.AccAddress, page, limit int) ([]byte, error) {
+
+	res, _, err := cliCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryDenomsByCreator),
+		cliCtx.Codec.MustMarshalJSON(types.NewQueryDenomsByCreatorParam(creator, page, limit)),
+	)
+	return res, err
+}
@@ -0,0 +1,92 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package rest
+
+import (
+	"fmt"
+
+	"github.com/gorilla/mux"
+
+	"github.com/polynetwork/cosmos-poly-module/common"
+	"github.com/polynetwork/cosmos-poly-module/common/openapi"
+	"github.com/polynetwork/cosmos-poly-module/ft/internal/types"
+)
+
+func registerOpenAPIRoute(r *mux.Router) {
+	r.HandleFunc("/ft/"+openapi.FileName, openapi.Handler("ft REST API", openAPIRoutes())).Methods("GET")
+}
+
+// openAPIRoutes describes the routes of RegisterRoutes
+func openAPIRoutes() []openapi.Route {
+	chainIdParam := openapi.PathParam(ChainId, "integer", "poly chain id of the target chain")
+	return []openapi.Route{
+		{Method: "GET", Path: fmt.Sprintf("/ft/denom_info/{%s}", Denom), Summary: "Creator, supply and metadata of the denom", Result: types.DenomInfo{}},
+		{
+			Method:  "GET",
+			Path:    fmt.Sprintf("/ft/denom_cc_info/{%s}/{%s}", Denom, ChainId),
+			Summary: "Info of the denom along with its binding to the target chain",
+			Params:  []openapi.Param{chainIdParam},
+			Result:  types.DenomCrossChainInfo{},
+		},
+		{
+			Method:  "GET",
+			Path:    "/ft/binding_history",
+			Summary: "Binding changes of the denoms, filtered by the optional parameters",
+			Params: append([]openapi.Param{
+				openapi.QueryParam(Denom, "string", "denom of the bindings"),
+				openapi.QueryParam(ChainId, "integer", "poly chain id of the target chain"),
+			}, openapi.PageParams()...),
+			Result: []common.BindingChange{},
+		},
+		{Method: "GET", Path: fmt.Sprintf("/ft/mint_info/{%s}", Denom), Summary: "Mint authority and supply cap of the denom", Result: types.MintInfo{}},
+		{
+			Method:  "GET",
+			Path:    fmt.Sprintf("/ft/asset_bindings/{%s}", Denom),
+			Summary: "Asset hashes bound to the denom per chain",
+			Params:  openapi.PageParams(),
+			Result:  []common.Binding{},
+		},
+		{
+			Method:  "GET",
+			Path:    fmt.Sprintf("/ft/denoms_by_creator/{%s}", Creator),
+			Summary: "Denoms created by the address",
+			Params:  append([]openapi.Param{openapi.PathParam(Creator, "string", "bech32 account address")}, openapi.PageParams()...),
+			Result:  []string{},
+		},
+		{
+			Method:  "POST",
+			Path:    fmt.Sprintf("/ft/create_coins/{%s}", Coins),
+			Summary: "Create the coins with their supply minted to the sender",
+			Params:  []openapi.Param{openapi.PathParam(Coins, "string", "coins to create, e.g. 1000000mycoin")},
+			Body:    CreateReq{},
+		},
+		{Method: "POST", Path: fmt.Sprintf("/ft/create_denom/{%s}", Denom), Summary: "Create the denom crossed independently", Body: CreateDenomReq{}},
+		{Method: "POST", Path: "/ft/bind_asset_hash", Summary: "Bind the asset hash of the target chain to a denom", Body: BindAssetHashReq{}},
+		{Method: "POST", Path: "/ft/unbind_asset_hash", Summary: "Unbind the asset hash of the target chain from a denom", Body: UnbindAssetHashReq{}},
+		{
+			Method:  "POST",
+			Path:    "/ft/settle_asset_decimals",
+			Summary: "Let the unlock from the chain scale with the decimals of the asset binding after they changed",
+			Body:    SettleAssetDecimalsReq{},
+		},
+		{Method: "POST", Path: "/ft/lock", Summary: "Lock coins to cross them to the target chain", Body: LockReq{}},
+		{Method: "POST", Path: "/ft/mint_coins", Summary: "Mint coins of a denom with the mint authority", Body: MintCoinsReq{}},
+		{Method: "POST", Path: "/ft/burn_coins", Summary: "Burn coins of a denom with the mint authority", Body: BurnCoinsReq{}},
+		{Method: "POST", Path: "/ft/transfer_mint_authority", Summary: "Hand the mint authority of a denom over to a module account", Body: TransferMintAuthorityReq{}},
+	}
+}
@@ -20,11 +20,14 @@ package rest
 import (
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/gorilla/mux"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
+	polycommon "github.com/polynetwork/cosmos-poly-module/common"
 	"github.com/polynetwork/cosmos-poly-module/ft/client/common"
 )
 
@@ -39,6 +42,25 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router, queryRoute st
 		queryDemonCrossChainInfoHandlerFn(cliCtx, queryRoute),
 	).Methods("GET")
 
+	r.HandleFunc(
+		"/ft/binding_history",
+		queryBindingHistoryHandlerFn(cliCtx, queryRoute),
+	).Methods("GET")
+
+	r.HandleFunc(
+		fmt.Sprintf("/ft/mint_info/{%s}", Denom),
+		queryMintInfoHandlerFn(cliCtx, queryRoute),
+	).Methods("GET")
+
+	r.HandleFunc(
+		fmt.Sprintf("/ft/asset_bindings/{%s}", Denom),
+		queryAssetBindingsHandlerFn(cliCtx, queryRoute),
+	).Methods("GET")
+
+	r.HandleFunc(
+		fmt.Sprintf("/ft/denoms_by_creator/{%s}", Creator),
+		queryDenomsByCreatorHandlerFn(cliCtx, queryRoute),
+	).Methods("GET")
 }
 
 func queryDemonHandlerFn(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
@@ -104,3 +126,104 @@ func checkResponseQueryDenomCCInfoResponse(
 
 	return res, true
 }
+
+// queryBindingHistoryHandlerFn accepts the optional denom and chain_id url query parameters as filters, along with
+// the optional page and limit
+func queryBindingHistoryHandlerFn(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		_, page, limit, err := rest.ParseHTTPArgsWithLimit(r, polycommon.DefaultPageLimit)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+		query := r.URL.Query()
+		var chainId uint64
+		if chainIdStr := query.Get(ChainId); chainIdStr != "" {
+			chainId, err = strconv.ParseUint(chainIdStr, 10, 64)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+
+		res, err := common.QueryBindingHistory(cliCtx, queryRoute, query.Get(Denom), chainId, page, limit)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func queryMintInfoHandlerFn(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, err := common.QueryMintInfo(cliCtx, queryRoute, mux.Vars(r)[Denom])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// queryAssetBindingsHandlerFn accepts the optional page and limit url query parameters
+func queryAssetBindingsHandlerFn(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		_, page, limit, err := rest.ParseHTTPArgsWithLimit(r, polycommon.DefaultPageLimit)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, err := common.QueryAssetBindings(cliCtx, queryRoute, mux.Vars(r)[Denom], page, limit)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// queryDenomsByCreatorHandlerFn accepts the optional page and limit url query parameters
+func queryDenomsByCreatorHandlerFn(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		_, page, limit, err := rest.ParseHTTPArgsWithLimit(r, polycommon.DefaultPageLimit)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+		creator, err := sdk.AccAddressFromBech32(mux.Vars(r)[Creator])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, err := common.QueryDenomsByCreator(cliCtx, queryRoute, creator, page, limit)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
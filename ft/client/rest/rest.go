@@ -24,6 +24,7 @@ import (
 )
 
 const (
+	Creator = "creator"
 	Denom   = "denom"
 	Coins   = "coins"
 	ChainId = "chain_id"
@@ -33,4 +34,5 @@ const (
 func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, queryRoute string) {
 	registerQueryRoutes(cliCtx, r, queryRoute)
 	registerTxRoutes(cliCtx, r)
+	registerOpenAPIRoute(r)
 }
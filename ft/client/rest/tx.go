@@ -30,6 +30,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
 
 	"encoding/hex"
+	"github.com/polynetwork/cosmos-poly-module/common"
 	"github.com/polynetwork/cosmos-poly-module/ft/internal/types"
 )
 
@@ -38,20 +39,45 @@ func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc(fmt.Sprintf("/ft/create_coins/{%s}", Coins), CreateCoinsRequestHandlerFn(cliCtx)).Methods("POST")
 	r.HandleFunc(fmt.Sprintf("/ft/create_denom/{%s}", Denom), CreateDenomRequestHandlerFn(cliCtx)).Methods("POST")
 	r.HandleFunc("/ft/bind_asset_hash", BindAssetHashRequestHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/ft/unbind_asset_hash", UnbindAssetHashRequestHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/ft/settle_asset_decimals", SettleAssetDecimalsRequestHandlerFn(cliCtx)).Methods("POST")
 	r.HandleFunc("/ft/lock", LockRequestHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/ft/mint_coins", MintCoinsRequestHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/ft/burn_coins", BurnCoinsRequestHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/ft/transfer_mint_authority", TransferMintAuthorityRequestHandlerFn(cliCtx)).Methods("POST")
 
 }
 
 type CreateReq struct {
-	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	BaseReq   rest.BaseReq           `json:"base_req" yaml:"base_req"`
+	SupplyCap string                 `json:"supply_cap" yaml:"supply_cap"`
+	Metadata  []common.DenomMetadata `json:"metadata" yaml:"metadata"`
+}
+
+type CreateDenomReq struct {
+	BaseReq  rest.BaseReq          `json:"base_req" yaml:"base_req"`
+	Metadata *common.DenomMetadata `json:"metadata" yaml:"metadata"`
 }
 
 type BindAssetHashReq struct {
-	BaseReq     rest.BaseReq `json:"base_req" yaml:"base_req"`
-	Denom       string       `json:"denom" yaml:"denom"`
-	ToChainId   uint64       `json:"to_chain_id" yaml:"to_chain_id"`
-	ToAssetHash string       `json:"to_asset_hash" yaml:"to_asset_hash"`
+	BaseReq        rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Denom          string       `json:"denom" yaml:"denom"`
+	ToChainId      uint64       `json:"to_chain_id" yaml:"to_chain_id"`
+	ToAssetHash    string       `json:"to_asset_hash" yaml:"to_asset_hash"`
+	SourceDecimals uint8        `json:"source_decimals" yaml:"source_decimals"`
+	ToDecimals     uint8        `json:"to_decimals" yaml:"to_decimals"`
+}
+type UnbindAssetHashReq struct {
+	BaseReq   rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Denom     string       `json:"denom" yaml:"denom"`
+	ToChainId uint64       `json:"to_chain_id" yaml:"to_chain_id"`
 }
+type SettleAssetDecimalsReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Denom   string       `json:"denom" yaml:"denom"`
+	ChainId uint64       `json:"chain_id" yaml:"chain_id"`
+}
+
 type LockReq struct {
 	BaseReq   rest.BaseReq `json:"base_req" yaml:"base_req"`
 	Denom     string       `json:"denom" yaml:"denom"`
@@ -82,7 +108,7 @@ func CreateCoinsRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		if !req.BaseReq.ValidateBasic(w) {
 			return
 		}
-		msg := types.NewMsgCreateCoins(cliCtx.GetFromAddress(), vars[Coins])
+		msg := types.NewMsgCreateCoins(cliCtx.GetFromAddress(), vars[Coins], req.SupplyCap, req.Metadata)
 		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
 	}
 }
@@ -93,7 +119,7 @@ func CreateDenomRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		vars := mux.Vars(r)
 		denom := vars[Denom]
 
-		var req CreateReq
+		var req CreateDenomReq
 		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
 			return
 		}
@@ -103,7 +129,7 @@ func CreateDenomRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
-		msg := types.NewMsgCreateDenom(cliCtx.GetFromAddress(), denom)
+		msg := types.NewMsgCreateDenom(cliCtx.GetFromAddress(), denom, req.Metadata)
 		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
 	}
 }
@@ -124,7 +150,7 @@ func BindAssetHashRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
-		msg := types.NewMsgBindAssetHash(cliCtx.GetFromAddress(), req.Denom, req.ToChainId, toAssetHash)
+		msg := types.NewMsgBindAssetHash(cliCtx.GetFromAddress(), req.Denom, req.ToChainId, toAssetHash, req.SourceDecimals, req.ToDecimals)
 		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
 	}
 }
@@ -144,3 +170,125 @@ func LockRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
 	}
 }
+
+func UnbindAssetHashRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req UnbindAssetHashReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		msg := types.NewMsgUnbindAssetHash(fromAddr, req.Denom, req.ToChainId)
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func SettleAssetDecimalsRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req SettleAssetDecimalsReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		msg := types.NewMsgSettleAssetDecimals(fromAddr, req.Denom, req.ChainId)
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+type MintCoinsReq struct {
+	BaseReq   rest.BaseReq   `json:"base_req" yaml:"base_req"`
+	ToAddress sdk.AccAddress `json:"to_address" yaml:"to_address"`
+	Amount    sdk.Coin       `json:"amount" yaml:"amount"`
+}
+
+type BurnCoinsReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Amount  sdk.Coin     `json:"amount" yaml:"amount"`
+}
+
+type TransferMintAuthorityReq struct {
+	BaseReq    rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Denom      string       `json:"denom" yaml:"denom"`
+	ModuleName string       `json:"module_name" yaml:"module_name"`
+}
+
+func MintCoinsRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req MintCoinsReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		msg := types.NewMsgMintCoins(fromAddr, req.ToAddress, req.Amount)
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func BurnCoinsRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req BurnCoinsReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		msg := types.NewMsgBurnCoins(fromAddr, req.Amount)
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func TransferMintAuthorityRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req TransferMintAuthorityReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		msg := types.NewMsgTransferMintAuthority(fromAddr, req.Denom, req.ModuleName)
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
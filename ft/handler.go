@@ -33,11 +33,21 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			return handleMsgCreateDenom(ctx, k, msg)
 		case types.MsgBindAssetHash:
 			return handleMsgBindAssetHash(ctx, k, msg)
+		case types.MsgUnbindAssetHash:
+			return handleMsgUnbindAssetHash(ctx, k, msg)
+		case types.MsgSettleAssetDecimals:
+			return handleMsgSettleAssetDecimals(ctx, k, msg)
 		case types.MsgLock:
 			return handleMsgLock(ctx, k, msg)
 
 		case types.MsgCreateCoins:
 			return handleMsgCreateCoins(ctx, k, msg)
+		case types.MsgMintCoins:
+			return handleMsgMintCoins(ctx, k, msg)
+		case types.MsgBurnCoins:
+			return handleMsgBurnCoins(ctx, k, msg)
+		case types.MsgTransferMintAuthority:
+			return handleMsgTransferMintAuthority(ctx, k, msg)
 
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", types.ModuleName, msg)
@@ -47,7 +57,7 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 
 // Handle MsgMultiSend.
 func handleMsgCreateDenom(ctx sdk.Context, k keeper.Keeper, msg types.MsgCreateDenom) (*sdk.Result, error) {
-	if err := k.CreateDenom(ctx, msg.Creator, msg.Denom); err != nil {
+	if err := k.CreateDenom(ctx, msg.Creator, msg.Denom, msg.Metadata); err != nil {
 		return nil, err
 	}
 
@@ -63,7 +73,35 @@ func handleMsgCreateDenom(ctx sdk.Context, k keeper.Keeper, msg types.MsgCreateD
 
 func handleMsgBindAssetHash(ctx sdk.Context, k keeper.Keeper, msg types.MsgBindAssetHash) (*sdk.Result, error) {
 
-	if err := k.BindAssetHash(ctx, msg.Creator, msg.SourceAssetDenom, msg.ToChainId, msg.ToAssetHash); err != nil {
+	if err := k.BindAssetHash(ctx, msg.Creator, msg.SourceAssetDenom, msg.ToChainId, msg.ToAssetHash, msg.SourceDecimals, msg.ToDecimals); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgUnbindAssetHash(ctx sdk.Context, k keeper.Keeper, msg types.MsgUnbindAssetHash) (*sdk.Result, error) {
+	if err := k.UnbindAssetHash(ctx, msg.Creator, msg.SourceAssetDenom, msg.ToChainId); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgSettleAssetDecimals(ctx sdk.Context, k keeper.Keeper, msg types.MsgSettleAssetDecimals) (*sdk.Result, error) {
+	if err := k.SettleAssetDecimals(ctx, msg.Creator, msg.SourceAssetDenom, msg.ChainId); err != nil {
 		return nil, err
 	}
 
@@ -96,7 +134,11 @@ func handleMsgCreateCoins(ctx sdk.Context, k keeper.Keeper, msg types.MsgCreateC
 	if err != nil {
 		return nil, err
 	}
-	if err := k.CreateCoins(ctx, msg.Creator, coins); err != nil {
+	supplyCap, err := sdk.ParseCoins(msg.SupplyCap)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.CreateCoins(ctx, msg.Creator, coins, supplyCap, msg.Metadata); err != nil {
 		return nil, err
 	}
 
@@ -109,3 +151,45 @@ func handleMsgCreateCoins(ctx sdk.Context, k keeper.Keeper, msg types.MsgCreateC
 
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
+
+func handleMsgMintCoins(ctx sdk.Context, k keeper.Keeper, msg types.MsgMintCoins) (*sdk.Result, error) {
+	if err := k.Mint(ctx, msg.Creator, msg.ToAddress, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgBurnCoins(ctx sdk.Context, k keeper.Keeper, msg types.MsgBurnCoins) (*sdk.Result, error) {
+	if err := k.Burn(ctx, msg.Creator, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgTransferMintAuthority(ctx sdk.Context, k keeper.Keeper, msg types.MsgTransferMintAuthority) (*sdk.Result, error) {
+	if err := k.TransferMintAuthority(ctx, msg.Creator, msg.Denom, msg.ModuleName); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
@@ -22,15 +22,25 @@ import (
 	"encoding/hex"
 	"fmt"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/polynetwork/cosmos-poly-module/common"
 	"github.com/polynetwork/cosmos-poly-module/ft/internal/types"
 	polycommon "github.com/polynetwork/poly/common"
 	"strconv"
 )
 
-func (k Keeper) CreateDenom(ctx sdk.Context, creator sdk.AccAddress, denom string) error {
+func (k Keeper) CreateDenom(ctx sdk.Context, creator sdk.AccAddress, denom string, metadata *common.DenomMetadata) error {
 	if reason, exist := k.ccmKeeper.ExistDenom(ctx, denom); exist {
 		return types.ErrCreateDenom(fmt.Sprintf("denom: %s already exist, due to reason: %s", denom, reason))
 	}
+	if metadata != nil {
+		if metadata.Denom != denom {
+			return types.ErrCreateDenom(fmt.Sprintf("denom of metadata: %s should be %s", metadata.Denom, denom))
+		}
+		if err := metadata.ValidateBasic(); err != nil {
+			return types.ErrCreateDenom(fmt.Sprintf("invalid metadata, err: %v", err))
+		}
+		k.ccmKeeper.SetDenomMetadata(ctx, *metadata)
+	}
 	//k.SetOperator(ctx, denom, creator)
 	k.ccmKeeper.SetDenomCreator(ctx, denom, creator)
 	ctx.KVStore(k.storeKey).Set(GetIndependentCrossDenomKey(denom), []byte(denom))
@@ -45,7 +55,7 @@ func (k Keeper) CreateDenom(ctx sdk.Context, creator sdk.AccAddress, denom strin
 	return nil
 }
 
-func (k Keeper) BindAssetHash(ctx sdk.Context, creator sdk.AccAddress, sourceAssetDenom string, toChainId uint64, toAssetHash []byte) error {
+func (k Keeper) BindAssetHash(ctx sdk.Context, creator sdk.AccAddress, sourceAssetDenom string, toChainId uint64, toAssetHash []byte, sourceDecimals, toDecimals uint8) error {
 	if !k.ValidCreator(ctx, sourceAssetDenom, creator) {
 		return types.ErrBindAssetHash(fmt.Sprintf("creator is not valid, expect: %s, got: %s", k.ccmKeeper.GetDenomCreator(ctx, sourceAssetDenom).String(), creator.String()))
 	}
@@ -55,7 +65,22 @@ func (k Keeper) BindAssetHash(ctx sdk.Context, creator sdk.AccAddress, sourceAss
 		return types.ErrBindAssetHash(fmt.Sprintf("denom: %s is not designed to be able to be bondAssetHash through this interface", sourceAssetDenom))
 
 	}
+	if info, found := k.ccmKeeper.GetChainInfo(ctx, toChainId); found {
+		if err := info.ValidateAssetHash(toAssetHash); err != nil {
+			return types.ErrBindAssetHash(err.Error())
+		}
+	}
+	oldAssetHash := store.Get(GetBindAssetHashKey([]byte(sourceAssetDenom), toChainId))
+	oldSourceDecimals, oldToDecimals := k.GetAssetDecimals(ctx, sourceAssetDenom, toChainId)
 	store.Set(GetBindAssetHashKey([]byte(sourceAssetDenom), toChainId), toAssetHash)
+	// only the binding with different decimals needs the amount to be scaled
+	if sourceDecimals != toDecimals {
+		store.Set(GetBindAssetDecimalsKey([]byte(sourceAssetDenom), toChainId), []byte{sourceDecimals, toDecimals})
+	} else {
+		store.Delete(GetBindAssetDecimalsKey([]byte(sourceAssetDenom), toChainId))
+	}
+	newSourceDecimals, newToDecimals := k.GetAssetDecimals(ctx, sourceAssetDenom, toChainId)
+	k.updateUnlockAssetDecimals(ctx, sourceAssetDenom, toChainId, len(oldAssetHash) != 0, oldSourceDecimals, oldToDecimals, true, newSourceDecimals, newToDecimals)
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
@@ -65,18 +90,147 @@ func (k Keeper) BindAssetHash(ctx sdk.Context, creator sdk.AccAddress, sourceAss
 			sdk.NewAttribute(types.AttributeKeyFromAssetHash, hex.EncodeToString(sdk.AccAddress(sourceAssetDenom))),
 			sdk.NewAttribute(types.AttributeKeyToChainId, strconv.FormatUint(toChainId, 10)),
 			sdk.NewAttribute(types.AttributeKeyToChainAssetHash, hex.EncodeToString(toAssetHash)),
+			sdk.NewAttribute(types.AttributeKeySourceDecimals, strconv.FormatUint(uint64(sourceDecimals), 10)),
+			sdk.NewAttribute(types.AttributeKeyToDecimals, strconv.FormatUint(uint64(toDecimals), 10)),
+		),
+	})
+	k.recordBindingChange(ctx, common.BindingChange{
+		BindingType: common.BindingTypeAsset,
+		Denom:       sourceAssetDenom,
+		ChainId:     toChainId,
+		OldValue:    oldAssetHash,
+		NewValue:    toAssetHash,
+		Operator:    creator,
+
+		OldSourceDecimals: oldSourceDecimals,
+		OldToDecimals:     oldToDecimals,
+		SourceDecimals:    newSourceDecimals,
+		ToDecimals:        newToDecimals,
+	})
+	return nil
+}
+
+func (k Keeper) UnbindAssetHash(ctx sdk.Context, creator sdk.AccAddress, sourceAssetDenom string, toChainId uint64) error {
+	if !k.ValidCreator(ctx, sourceAssetDenom, creator) {
+		return types.ErrUnbindAssetHash(fmt.Sprintf("creator is not valid, expect: %s, got: %s", k.ccmKeeper.GetDenomCreator(ctx, sourceAssetDenom).String(), creator.String()))
+	}
+	store := ctx.KVStore(k.storeKey)
+	oldAssetHash := store.Get(GetBindAssetHashKey([]byte(sourceAssetDenom), toChainId))
+	if len(oldAssetHash) == 0 {
+		return types.ErrUnbindAssetHash(fmt.Sprintf("denom: %s has not bound asset hash of toChainId: %d", sourceAssetDenom, toChainId))
+	}
+	oldSourceDecimals, oldToDecimals := k.GetAssetDecimals(ctx, sourceAssetDenom, toChainId)
+	store.Delete(GetBindAssetHashKey([]byte(sourceAssetDenom), toChainId))
+	store.Delete(GetBindAssetDecimalsKey([]byte(sourceAssetDenom), toChainId))
+	k.updateUnlockAssetDecimals(ctx, sourceAssetDenom, toChainId, true, oldSourceDecimals, oldToDecimals, false, 0, 0)
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUnbindAsset,
+			sdk.NewAttribute(types.AttributeKeyCreator, creator.String()),
+			sdk.NewAttribute(types.AttributeKeySourceAssetDenom, sourceAssetDenom),
+			sdk.NewAttribute(types.AttributeKeyToChainId, strconv.FormatUint(toChainId, 10)),
+			sdk.NewAttribute(types.AttributeKeyToChainAssetHash, hex.EncodeToString(oldAssetHash)),
+		),
+	})
+	k.recordBindingChange(ctx, common.BindingChange{
+		BindingType: common.BindingTypeAsset,
+		Denom:       sourceAssetDenom,
+		ChainId:     toChainId,
+		OldValue:    oldAssetHash,
+		Operator:    creator,
+
+		OldSourceDecimals: oldSourceDecimals,
+		OldToDecimals:     oldToDecimals,
+	})
+	return nil
+}
+
+// GetAssetDecimals returns the decimals of denom in current chain and of the asset bound in toChainId
+func (k Keeper) GetAssetDecimals(ctx sdk.Context, denom string, toChainId uint64) (sourceDecimals, toDecimals uint8) {
+	bz := ctx.KVStore(k.storeKey).Get(GetBindAssetDecimalsKey([]byte(denom), toChainId))
+	if len(bz) != 2 {
+		return 0, 0
+	}
+	return bz[0], bz[1]
+}
+
+// GetUnlockAssetDecimals returns the decimals Unlock scales the amount from fromChainId with, they are those of the
+// binding except while a decimals change of the binding is not settled, then they are the decimals before the change
+// under which the transfers in flight have been sent
+func (k Keeper) GetUnlockAssetDecimals(ctx sdk.Context, denom string, fromChainId uint64) (sourceDecimals, fromDecimals uint8, settling bool) {
+	bz := ctx.KVStore(k.storeKey).Get(GetUnlockAssetDecimalsKey([]byte(denom), fromChainId))
+	if len(bz) != 2 {
+		sourceDecimals, fromDecimals = k.GetAssetDecimals(ctx, denom, fromChainId)
+		return sourceDecimals, fromDecimals, false
+	}
+	return bz[0], bz[1], true
+}
+
+// updateUnlockAssetDecimals keeps the decimals of a binding for Unlock when they change or the binding is removed, the
+// kept decimals are dropped once the binding gets the same decimals again or the change is settled
+func (k Keeper) updateUnlockAssetDecimals(ctx sdk.Context, denom string, chainId uint64, wasBound bool, oldSourceDecimals, oldToDecimals uint8, isBound bool, newSourceDecimals, newToDecimals uint8) {
+	store := ctx.KVStore(k.storeKey)
+	key := GetUnlockAssetDecimalsKey([]byte(denom), chainId)
+	if bz := store.Get(key); len(bz) == 2 {
+		if isBound && bz[0] == newSourceDecimals && bz[1] == newToDecimals {
+			store.Delete(key)
+		}
+		return
+	}
+	if wasBound && (!isBound || oldSourceDecimals != newSourceDecimals || oldToDecimals != newToDecimals) {
+		store.Set(key, []byte{oldSourceDecimals, oldToDecimals})
+	}
+}
+
+// SettleAssetDecimals lets Unlock scale with the decimals of the binding after they changed, it should only be called
+// once every transfer sent from chainId under the former decimals has been unlocked
+func (k Keeper) SettleAssetDecimals(ctx sdk.Context, creator sdk.AccAddress, denom string, chainId uint64) error {
+	if !k.ValidCreator(ctx, denom, creator) {
+		return types.ErrSettleAssetDecimals(fmt.Sprintf("creator is not valid, expect: %s, got: %s", k.ccmKeeper.GetDenomCreator(ctx, denom).String(), creator.String()))
+	}
+	store := ctx.KVStore(k.storeKey)
+	key := GetUnlockAssetDecimalsKey([]byte(denom), chainId)
+	if !store.Has(key) {
+		return types.ErrSettleAssetDecimals(fmt.Sprintf("denom: %s has no decimals change of chainId: %d to settle", denom, chainId))
+	}
+	store.Delete(key)
+	sourceDecimals, toDecimals := k.GetAssetDecimals(ctx, denom, chainId)
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSettleAssetDecimals,
+			sdk.NewAttribute(types.AttributeKeyCreator, creator.String()),
+			sdk.NewAttribute(types.AttributeKeySourceAssetDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyToChainId, strconv.FormatUint(chainId, 10)),
+			sdk.NewAttribute(types.AttributeKeySourceDecimals, strconv.FormatUint(uint64(sourceDecimals), 10)),
+			sdk.NewAttribute(types.AttributeKeyToDecimals, strconv.FormatUint(uint64(toDecimals), 10)),
 		),
 	})
 	return nil
 }
 
 func (k Keeper) Lock(ctx sdk.Context, fromAddr sdk.AccAddress, sourceAssetDenom string, toChainId uint64, toAddr []byte, amount sdk.Int) error {
+	info, hasInfo := k.ccmKeeper.GetChainInfo(ctx, toChainId)
+	if hasInfo {
+		if err := info.ValidateAddress(toAddr); err != nil {
+			return types.ErrLock(err.Error())
+		}
+	}
+	sourceDecimals, toDecimals := k.GetAssetDecimals(ctx, sourceAssetDenom, toChainId)
+	toAmount, err := common.ScaleAmount(amount.BigInt(), sourceDecimals, toDecimals)
+	if err != nil {
+		return types.ErrLock(fmt.Sprintf("amount cannot be crossed to toChainId: %d, Error: %s", toChainId, err.Error()))
+	}
+	if hasInfo {
+		if err := info.ValidateAmount(toAmount); err != nil {
+			return types.ErrLock(err.Error())
+		}
+	}
 	sink := polycommon.NewZeroCopySink(nil)
 	args := types.TxArgs{
 		ToAddress: toAddr,
-		Amount:    amount.BigInt(),
+		Amount:    toAmount,
 	}
-	if err := args.Serialization(sink, 32); err != nil {
+	if err := args.Serialization(sink, common.DefaultAmountWidth); err != nil {
 		return types.ErrLock(fmt.Sprintf("TxArgs Serialization error: %s", err.Error()))
 	}
 
@@ -107,13 +261,22 @@ func (k Keeper) Lock(ctx sdk.Context, fromAddr sdk.AccAddress, sourceAssetDenom
 			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
 		),
 	})
+	k.AfterLock(ctx, common.LockInfo{
+		Module:      types.ModuleName,
+		FromAddress: fromAddr,
+		Denom:       sourceAssetDenom,
+		ToChainId:   toChainId,
+		ToAssetHash: toAssetHash,
+		ToAddress:   toAddr,
+		Amount:      amount,
+	})
 	return nil
 }
 
 func (k Keeper) Unlock(ctx sdk.Context, fromChainId uint64, fromContractAddr sdk.AccAddress, toContractAddr []byte, argsBs []byte) error {
 
 	var args types.TxArgs
-	if err := args.Deserialization(polycommon.NewZeroCopySource(argsBs), 32); err != nil {
+	if err := args.Deserialization(polycommon.NewZeroCopySource(argsBs), common.DefaultAmountWidth); err != nil {
 		return types.ErrUnLock(fmt.Sprintf("Deserialize args: %x,  Error: %s", argsBs, err.Error()))
 	}
 
@@ -125,7 +288,14 @@ func (k Keeper) Unlock(ctx sdk.Context, fromChainId uint64, fromContractAddr sdk
 	}
 
 	toAccAddr := sdk.AccAddress(args.ToAddress)
-	amount := sdk.NewIntFromBigInt(args.Amount)
+	// the dust below the precision of current chain is left in fromChainId, the unlock event reports it so that it
+	// can be refunded there
+	sourceDecimals, fromDecimals, _ := k.GetUnlockAssetDecimals(ctx, denom, fromChainId)
+	scaledAmount, dust, err := common.TruncateAmount(args.Amount, fromDecimals, sourceDecimals)
+	if err != nil {
+		return types.ErrUnLock(fmt.Sprintf("amount cannot be crossed from fromChainId: %d, Error: %s", fromChainId, err.Error()))
+	}
+	amount := sdk.NewIntFromBigInt(scaledAmount)
 	if err := k.MintCoins(ctx, toAccAddr, sdk.NewCoins(sdk.NewCoin(denom, amount))); err != nil {
 		return types.ErrUnLock(fmt.Sprintf("ft_crossed_independently.Unlock.MintCoins, toAddress: %s, denom: %s, amount: %s, Error: %s", toAccAddr.String(), denom, amount.String(), err.Error()))
 	}
@@ -136,8 +306,18 @@ func (k Keeper) Unlock(ctx sdk.Context, fromChainId uint64, fromContractAddr sdk
 			sdk.NewAttribute(types.AttributeKeyToAssetHash, hex.EncodeToString([]byte(denom))),
 			sdk.NewAttribute(types.AttributeKeyToAddress, toAccAddr.String()),
 			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyDust, dust.String()),
 		),
 	})
+	k.AfterUnlock(ctx, common.UnlockInfo{
+		Module:           types.ModuleName,
+		FromChainId:      fromChainId,
+		FromContractHash: fromContractAddr,
+		ToContractHash:   toContractAddr,
+		Denom:            denom,
+		ToAddress:        toAccAddr,
+		Amount:           amount,
+	})
 	return nil
 }
 
@@ -146,19 +326,26 @@ func (k Keeper) GetDenomInfo(ctx sdk.Context, denom string) *types.DenomInfo {
 	if len(operator) == 0 {
 		return nil
 	}
-	return &types.DenomInfo{
+	denomInfo := &types.DenomInfo{
 		Creator:     operator.String(),
 		Denom:       denom,
 		AssetHash:   hex.EncodeToString([]byte(denom)),
 		TotalSupply: k.supplyKeeper.GetSupply(ctx).GetTotal().AmountOf(denom),
 	}
+	if md, found := k.ccmKeeper.GetDenomMetadata(ctx, denom); found {
+		denomInfo.Metadata = &md
+	}
+	return denomInfo
 }
 
 func (k Keeper) GetDenomCrossChainInfo(ctx sdk.Context, denom string, toChainId uint64) *types.DenomCrossChainInfo {
+	sourceDecimals, toDecimals := k.GetAssetDecimals(ctx, denom, toChainId)
 	return &types.DenomCrossChainInfo{
-		DenomInfo:   *k.GetDenomInfo(ctx, denom),
-		ToChainId:   toChainId,
-		ToAssetHash: hex.EncodeToString(ctx.KVStore(k.storeKey).Get(GetBindAssetHashKey([]byte(denom), toChainId))),
+		DenomInfo:      *k.GetDenomInfo(ctx, denom),
+		ToChainId:      toChainId,
+		ToAssetHash:    hex.EncodeToString(ctx.KVStore(k.storeKey).Get(GetBindAssetHashKey([]byte(denom), toChainId))),
+		SourceDecimals: sourceDecimals,
+		ToDecimals:     toDecimals,
 	}
 }
 
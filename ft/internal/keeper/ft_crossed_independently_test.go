@@ -23,9 +23,11 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/supply"
 	"github.com/polynetwork/cosmos-poly-module/btcx"
+	"github.com/polynetwork/cosmos-poly-module/common"
 	"github.com/polynetwork/cosmos-poly-module/ft"
 	"github.com/polynetwork/cosmos-poly-module/ft/internal/keeper"
 	"github.com/polynetwork/cosmos-poly-module/ft/internal/types"
+	polycommon "github.com/polynetwork/poly/common"
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/stretchr/testify/require"
@@ -47,7 +49,7 @@ func Test_ft_crossed_independently_MsgCreateDenom(t *testing.T) {
 	}
 	for _, testCase := range testCases {
 		creator := sdk.AccAddress([]byte(testCase.address))
-		err := app.FtKeeper.CreateDenom(ctx, creator, testCase.denom)
+		err := app.FtKeeper.CreateDenom(ctx, creator, testCase.denom, nil)
 		if testCase.expectSucceed {
 			require.Nil(t, err)
 		} else {
@@ -62,9 +64,9 @@ func Test_ft_crossed_independently_MsgBindAssetHash(t *testing.T) {
 
 	creator1 := sdk.AccAddress([]byte("addr1"))
 	creator2 := sdk.AccAddress([]byte("addr2"))
-	err := app.FtKeeper.CreateDenom(ctx, creator1, "coin1")
+	err := app.FtKeeper.CreateDenom(ctx, creator1, "coin1", nil)
 	require.Nil(t, err, "create denom error")
-	err = app.FtKeeper.CreateDenom(ctx, creator2, "coin2")
+	err = app.FtKeeper.CreateDenom(ctx, creator2, "coin2", nil)
 	require.Nil(t, err, "create denom error")
 
 	testCases := []struct {
@@ -82,7 +84,7 @@ func Test_ft_crossed_independently_MsgBindAssetHash(t *testing.T) {
 	}
 	querier := keeper.NewQuerier(app.FtKeeper)
 	for _, testCase := range testCases {
-		err := app.FtKeeper.BindAssetHash(ctx, testCase.operator, testCase.denom, testCase.toChainId, testCase.toAssetHash)
+		err := app.FtKeeper.BindAssetHash(ctx, testCase.operator, testCase.denom, testCase.toChainId, testCase.toAssetHash, 0, 0)
 		if testCase.expectSucceed {
 			require.Nil(t, err)
 
@@ -131,7 +133,7 @@ func Test_ft_crossed_independently_MsgLock(t *testing.T) {
 	denom := coin1Coin.Denom
 	creator := sdk.AccAddress([]byte("creator"))
 
-	err = app.FtKeeper.CreateDenom(ctx, creator, denom)
+	err = app.FtKeeper.CreateDenom(ctx, creator, denom, nil)
 	require.Nil(t, err)
 
 	app.SupplyKeeper.SetSupply(ctx, supply.NewSupply(total.Add(coin1Coin)))
@@ -141,9 +143,9 @@ func Test_ft_crossed_independently_MsgLock(t *testing.T) {
 	require.Nil(t, err)
 	require.Equal(t, sdk.Coins{coin1Coin}, balance, "create balance is not equal to 100btcx1")
 
-	err = app.FtKeeper.BindAssetHash(ctx, creator, denom, 2, []byte{1, 2, 3, 4})
+	err = app.FtKeeper.BindAssetHash(ctx, creator, denom, 2, []byte{1, 2, 3, 4}, 0, 0)
 	require.Nil(t, err)
-	err = app.FtKeeper.BindAssetHash(ctx, creator, denom, 3, []byte{1, 2, 3, 5})
+	err = app.FtKeeper.BindAssetHash(ctx, creator, denom, 3, []byte{1, 2, 3, 5}, 0, 0)
 	require.Nil(t, err)
 
 	testCases := []struct {
@@ -173,3 +175,249 @@ func Test_ft_crossed_independently_MsgLock(t *testing.T) {
 	require.Equal(t, "97coin1", balance.String(), "balnace of creator is not balanced")
 
 }
+
+func Test_ft_ScaleDecimals(t *testing.T) {
+	app, ctx := createTestApp(true)
+	app.SupplyKeeper.SetSupply(ctx, supply.NewSupply(sdk.Coins{sdk.NewCoin("stake", sdk.NewInt(100))}))
+
+	creator := sdk.AccAddress([]byte("creator"))
+	denom := "coin1"
+	require.Nil(t, app.FtKeeper.CreateDenom(ctx, creator, denom, nil))
+	require.Nil(t, app.FtKeeper.MintCoins(ctx, creator, sdk.NewCoins(sdk.NewInt64Coin(denom, 1000))))
+	require.Nil(t, app.FtKeeper.BindAssetHash(ctx, creator, denom, 2, []byte{1, 2, 3, 4}, 8, 6))
+	info := app.FtKeeper.GetDenomCrossChainInfo(ctx, denom, 2)
+	require.Equal(t, uint8(8), info.SourceDecimals)
+	require.Equal(t, uint8(6), info.ToDecimals)
+
+	err := app.FtKeeper.Lock(ctx, creator, denom, 2, []byte{1, 2}, sdk.NewInt(150))
+	require.True(t, types.ErrLockType.Is(err), "amount losing precision should be rejected")
+	require.Nil(t, app.FtKeeper.Lock(ctx, creator, denom, 2, []byte{1, 2}, sdk.NewInt(200)))
+	require.Equal(t, "800coin1", app.BankKeeper.GetCoins(ctx, creator).String())
+
+	receiver := sdk.AccAddress([]byte("receiverAddress12345"))
+	sink := polycommon.NewZeroCopySink(nil)
+	sink.WriteVarBytes(receiver)
+	amountBs, err := common.PadFixedBytes(sdk.NewInt(3).BigInt(), 32)
+	require.Nil(t, err)
+	sink.WriteBytes(amountBs)
+	require.Nil(t, app.FtKeeper.Unlock(ctx, 2, []byte{1, 2, 3, 4}, []byte(denom), sink.Bytes()))
+	require.Equal(t, "300coin1", app.BankKeeper.GetCoins(ctx, receiver).String())
+	require.Error(t, app.FtKeeper.SettleAssetDecimals(ctx, creator, denom, 2), "nothing to settle")
+
+	// transfers sent before the decimals change are unlocked with the former decimals until it is settled
+	require.Nil(t, app.FtKeeper.BindAssetHash(ctx, creator, denom, 2, []byte{1, 2, 3, 4}, 8, 9))
+	sourceDecimals, fromDecimals, settling := app.FtKeeper.GetUnlockAssetDecimals(ctx, denom, 2)
+	require.Equal(t, []uint8{8, 6}, []uint8{sourceDecimals, fromDecimals})
+	require.True(t, settling)
+	require.Nil(t, app.FtKeeper.Unlock(ctx, 2, []byte{1, 2, 3, 4}, []byte(denom), sink.Bytes()))
+	require.Equal(t, "600coin1", app.BankKeeper.GetCoins(ctx, receiver).String())
+	require.Error(t, app.FtKeeper.SettleAssetDecimals(ctx, sdk.AccAddress([]byte("invalidCreator")), denom, 2))
+	require.Nil(t, app.FtKeeper.SettleAssetDecimals(ctx, creator, denom, 2))
+	_, fromDecimals, settling = app.FtKeeper.GetUnlockAssetDecimals(ctx, denom, 2)
+	require.Equal(t, uint8(9), fromDecimals)
+	require.False(t, settling)
+
+	// the inbound dust below the precision of the denom is truncated and reported
+	sink = polycommon.NewZeroCopySink(nil)
+	sink.WriteVarBytes(receiver)
+	amountBs, err = common.PadFixedBytes(sdk.NewInt(1001).BigInt(), 32)
+	require.Nil(t, err)
+	sink.WriteBytes(amountBs)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.Nil(t, app.FtKeeper.Unlock(ctx, 2, []byte{1, 2, 3, 4}, []byte(denom), sink.Bytes()))
+	require.Equal(t, "700coin1", app.BankKeeper.GetCoins(ctx, receiver).String())
+	require.Equal(t, "1", eventAttribute(ctx, types.EventTypeUnlock, types.AttributeKeyDust))
+}
+
+func eventAttribute(ctx sdk.Context, eventType, key string) string {
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != eventType {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == key {
+				return string(attr.Value)
+			}
+		}
+	}
+	return ""
+}
+
+func Test_ft_UnbindAssetHash(t *testing.T) {
+	app, ctx := createTestApp(true)
+	app.SupplyKeeper.SetSupply(ctx, supply.NewSupply(sdk.Coins{sdk.NewCoin("stake", sdk.NewInt(100))}))
+
+	creator := sdk.AccAddress([]byte("creator"))
+	denom := "coin1"
+	require.Nil(t, app.FtKeeper.CreateDenom(ctx, creator, denom, nil))
+	require.True(t, types.ErrUnbindAssetHashType.Is(app.FtKeeper.UnbindAssetHash(ctx, creator, denom, 2)))
+
+	ctx = ctx.WithBlockHeight(3)
+	require.Nil(t, app.FtKeeper.BindAssetHash(ctx, creator, denom, 2, []byte{1, 2, 3, 4}, 8, 6))
+	require.Error(t, app.FtKeeper.UnbindAssetHash(ctx, sdk.AccAddress([]byte("invalidCreator")), denom, 2))
+	ctx = ctx.WithBlockHeight(4)
+	require.Nil(t, app.FtKeeper.UnbindAssetHash(ctx, creator, denom, 2))
+	info := app.FtKeeper.GetDenomCrossChainInfo(ctx, denom, 2)
+	require.Empty(t, info.ToAssetHash)
+	require.Equal(t, uint8(0), info.SourceDecimals)
+
+	// unlock from the unbound asset hash is rejected
+	sink := polycommon.NewZeroCopySink(nil)
+	sink.WriteVarBytes(sdk.AccAddress([]byte("receiverAddress12345")))
+	amountBs, err := common.PadFixedBytes(sdk.NewInt(3).BigInt(), 32)
+	require.Nil(t, err)
+	sink.WriteBytes(amountBs)
+	require.Error(t, app.FtKeeper.Unlock(ctx, 2, []byte{1, 2, 3, 4}, []byte(denom), sink.Bytes()))
+
+	history := app.FtKeeper.GetBindingHistory(ctx, denom, 2, 1, 0)
+	require.Equal(t, []types.BindingChange{
+		{BindingType: common.BindingTypeAsset, Denom: denom, ChainId: 2, NewValue: []byte{1, 2, 3, 4}, Height: 3, Operator: creator, SourceDecimals: 8, ToDecimals: 6},
+		{BindingType: common.BindingTypeAsset, Denom: denom, ChainId: 2, OldValue: []byte{1, 2, 3, 4}, Height: 4, Operator: creator, OldSourceDecimals: 8, OldToDecimals: 6},
+	}, history)
+	require.Empty(t, app.FtKeeper.GetBindingHistory(ctx, "coin2", 0, 1, 0))
+}
+
+func Test_ft_TransferDenomCreator(t *testing.T) {
+	app, ctx := createTestApp(true)
+	app.SupplyKeeper.SetSupply(ctx, supply.NewSupply(sdk.Coins{sdk.NewCoin("stake", sdk.NewInt(100))}))
+
+	creator := sdk.AccAddress([]byte("creatorAddress123456"))
+	newCreator := sdk.AccAddress([]byte("newCreatorAddress123"))
+	denom := "coin1"
+	require.Nil(t, app.FtKeeper.CreateDenom(ctx, creator, denom, nil))
+	require.Nil(t, app.CcmKeeper.ProposeDenomCreator(ctx, creator, denom, newCreator))
+	require.Error(t, app.FtKeeper.BindAssetHash(ctx, newCreator, denom, 2, []byte{1, 2, 3, 4}, 0, 0))
+	require.Nil(t, app.CcmKeeper.AcceptDenomCreator(ctx, newCreator, denom))
+
+	// ft follows the creator kept by ccm
+	require.Error(t, app.FtKeeper.BindAssetHash(ctx, creator, denom, 2, []byte{1, 2, 3, 4}, 0, 0))
+	require.Nil(t, app.FtKeeper.BindAssetHash(ctx, newCreator, denom, 2, []byte{1, 2, 3, 4}, 0, 0))
+	require.Equal(t, newCreator.String(), app.FtKeeper.GetDenomInfo(ctx, denom).Creator)
+	require.Equal(t, []string{denom}, app.FtKeeper.GetDenomsByCreator(ctx, newCreator, 1, 0))
+}
+
+func Test_ft_DenomMetadata(t *testing.T) {
+	app, ctx := createTestApp(true)
+	app.SupplyKeeper.SetSupply(ctx, supply.NewSupply(sdk.Coins{sdk.NewCoin("stake", sdk.NewInt(100))}))
+
+	creator := sdk.AccAddress([]byte("creatorAddress123456"))
+	md := common.NewDenomMetadata("coin1", 18, "ETH", "Ether", 2, []byte{1, 2, 3, 4})
+
+	wrongDenom := md
+	wrongDenom.Denom = "coin2"
+	require.Error(t, app.FtKeeper.CreateDenom(ctx, creator, "coin1", &wrongDenom))
+	require.Nil(t, app.FtKeeper.CreateDenom(ctx, creator, "coin1", &md))
+	require.Equal(t, &md, app.FtKeeper.GetDenomInfo(ctx, "coin1").Metadata)
+	// ft reports the metadata kept by ccm
+	md.DisplayName = "Wrapped Ether"
+	require.Nil(t, app.CcmKeeper.UpdateDenomMetadata(ctx, creator, md))
+	require.Equal(t, "Wrapped Ether", app.FtKeeper.GetDenomInfo(ctx, "coin1").Metadata.DisplayName)
+
+	// metadata is optional and may only describe the created coins
+	require.Nil(t, app.FtKeeper.CreateDenom(ctx, creator, "coin2", nil))
+	require.Nil(t, app.FtKeeper.GetDenomInfo(ctx, "coin2").Metadata)
+	coins := sdk.NewCoins(sdk.NewCoin("coin3", sdk.NewInt(100)))
+	require.Error(t, app.FtKeeper.CreateCoins(ctx, creator, coins, nil, []common.DenomMetadata{common.NewDenomMetadata("coin4", 6, "", "", 0, nil)}))
+	require.Nil(t, app.FtKeeper.CreateCoins(ctx, creator, coins, nil, []common.DenomMetadata{common.NewDenomMetadata("coin3", 6, "C3", "", 0, nil)}))
+	require.Equal(t, uint8(6), app.FtKeeper.GetDenomInfo(ctx, "coin3").Metadata.Decimals)
+}
+
+func Test_ft_ChainRegistry(t *testing.T) {
+	app, ctx := createTestApp(true)
+	app.SupplyKeeper.SetSupply(ctx, supply.NewSupply(sdk.Coins{sdk.NewCoin("stake", sdk.NewInt(100))}))
+
+	creator := sdk.AccAddress([]byte("creator"))
+	denom := "coin1"
+	require.Nil(t, app.FtKeeper.CreateDenom(ctx, creator, denom, nil))
+	require.Nil(t, app.FtKeeper.MintCoins(ctx, creator, sdk.NewCoins(sdk.NewInt64Coin(denom, 1000))))
+
+	evm := common.NewChainInfo(2, "ethereum", common.ChainFamilyEVM, common.EncodingRaw, 20, common.EncodingRaw, 20, 16)
+	require.Nil(t, app.CcmKeeper.SetChainInfo(ctx, evm))
+
+	// asset hash and address of registered chains should match the registered encoding and length
+	err := app.FtKeeper.BindAssetHash(ctx, creator, denom, 2, []byte{1, 2, 3, 4}, 0, 0)
+	require.True(t, types.ErrBindAssetHashType.Is(err), "asset hash of wrong length should be rejected")
+	assetHash := make([]byte, 20)
+	require.Nil(t, app.FtKeeper.BindAssetHash(ctx, creator, denom, 2, assetHash, 0, 0))
+	err = app.FtKeeper.Lock(ctx, creator, denom, 2, []byte{1, 2}, sdk.NewInt(100))
+	require.True(t, types.ErrLockType.Is(err), "address of wrong length should be rejected")
+	require.Nil(t, app.FtKeeper.Lock(ctx, creator, denom, 2, make([]byte, 20), sdk.NewInt(100)))
+
+	// unregistered chains are not checked
+	require.Nil(t, app.FtKeeper.BindAssetHash(ctx, creator, denom, 4, []byte{1, 2, 3, 4}, 0, 0))
+	require.Nil(t, app.FtKeeper.Lock(ctx, creator, denom, 4, []byte{1, 2}, sdk.NewInt(100)))
+	require.Equal(t, "800coin1", app.BankKeeper.GetCoins(ctx, creator).String())
+
+	// the amount in the args keeps the fixed width whatever width the registered chain accepts
+	receiver := sdk.AccAddress([]byte("receiverAddress12345"))
+	for _, width := range []int{16, common.DefaultAmountWidth} {
+		sink := polycommon.NewZeroCopySink(nil)
+		sink.WriteVarBytes(receiver)
+		amountBs, err := common.PadFixedBytes(sdk.NewInt(3).BigInt(), width)
+		require.Nil(t, err)
+		sink.WriteBytes(amountBs)
+		err = app.FtKeeper.Unlock(ctx, 2, assetHash, []byte(denom), sink.Bytes())
+		if width == common.DefaultAmountWidth {
+			require.Nil(t, err)
+		} else {
+			require.True(t, types.ErrUnLockType.Is(err), "amount of width: %d should be rejected", width)
+		}
+	}
+	require.Equal(t, "3coin1", app.BankKeeper.GetCoins(ctx, receiver).String())
+
+	// the registered width only bounds the amount locked to the chain
+	require.Nil(t, app.FtKeeper.BindAssetHash(ctx, creator, denom, 2, assetHash, 0, 37))
+	err = app.FtKeeper.Lock(ctx, creator, denom, 2, make([]byte, 20), sdk.NewInt(100))
+	require.True(t, types.ErrLockType.Is(err), "amount wider than the registered width should be rejected")
+
+	// the chain removed from the registry is not checked anymore
+	require.Nil(t, app.CcmKeeper.RemoveChainInfo(ctx, 2))
+	require.Nil(t, app.FtKeeper.Lock(ctx, creator, denom, 2, []byte{1, 2}, sdk.NewInt(100)))
+}
+
+func Test_ft_Listing(t *testing.T) {
+	app, ctx := createTestApp(true)
+	app.SupplyKeeper.SetSupply(ctx, supply.NewSupply(sdk.Coins{sdk.NewCoin("stake", sdk.NewInt(100))}))
+
+	creator := sdk.AccAddress([]byte("creatorAddress123456"))
+	other := sdk.AccAddress([]byte("otherAddress12345678"))
+	require.Nil(t, app.FtKeeper.CreateDenom(ctx, creator, "coin1", nil))
+	require.Nil(t, app.FtKeeper.CreateDenom(ctx, creator, "coin10", nil))
+	require.Nil(t, app.FtKeeper.CreateDenom(ctx, other, "coin2", nil))
+	require.Nil(t, app.FtKeeper.CreateDenom(ctx, creator, "coin3", nil))
+
+	require.Nil(t, app.FtKeeper.BindAssetHash(ctx, creator, "coin1", 2, []byte{1, 2}, 0, 0))
+	require.Nil(t, app.FtKeeper.BindAssetHash(ctx, creator, "coin1", 3, []byte{1, 3}, 0, 0))
+	require.Nil(t, app.FtKeeper.BindAssetHash(ctx, creator, "coin10", 2, []byte{2, 2}, 0, 0))
+
+	require.Equal(t, []types.Binding{{ChainId: 2, Hash: "0102"}, {ChainId: 3, Hash: "0103"}}, app.FtKeeper.GetAssetBindings(ctx, "coin1", 1, 0))
+	require.Equal(t, []types.Binding{{ChainId: 3, Hash: "0103"}}, app.FtKeeper.GetAssetBindings(ctx, "coin1", 2, 1))
+	require.Equal(t, []types.Binding{{ChainId: 2, Hash: "0202"}}, app.FtKeeper.GetAssetBindings(ctx, "coin10", 1, 0))
+	require.Empty(t, app.FtKeeper.GetAssetBindings(ctx, "coin3", 1, 0))
+
+	require.Equal(t, []string{"coin1", "coin10", "coin3"}, app.FtKeeper.GetDenomsByCreator(ctx, creator, 1, 0))
+	require.Equal(t, []string{"coin3"}, app.FtKeeper.GetDenomsByCreator(ctx, creator, 2, 2))
+	require.Equal(t, []string{"coin2"}, app.FtKeeper.GetDenomsByCreator(ctx, other, 1, 0))
+	// the denoms created through other modules are not listed
+	require.Nil(t, app.LockProxyKeeper.CreateLockProxy(ctx, other))
+	require.Nil(t, app.LockProxyKeeper.CreateCoinAndDelegateToProxy(ctx, other, sdk.NewInt64Coin("coin4", 100), other))
+	require.Equal(t, []string{"coin2"}, app.FtKeeper.GetDenomsByCreator(ctx, other, 1, 0))
+}
+
+func Test_ft_CreatedCrossChainTxs(t *testing.T) {
+	app, ctx := createTestApp(true)
+	app.SupplyKeeper.SetSupply(ctx, supply.NewSupply(sdk.Coins{sdk.NewCoin("stake", sdk.NewInt(100))}))
+
+	creator := sdk.AccAddress([]byte("creatorAddress123456"))
+	denom := "coin1"
+	require.Nil(t, app.FtKeeper.CreateDenom(ctx, creator, denom, nil))
+	require.Nil(t, app.FtKeeper.MintCoins(ctx, creator, sdk.NewCoins(sdk.NewInt64Coin(denom, 100))))
+	require.Nil(t, app.FtKeeper.BindAssetHash(ctx, creator, denom, 2, []byte{1, 2, 3, 4}, 0, 0))
+
+	// the lock is indexed by ccm under the sender, from the denom to the bound asset hash
+	require.Nil(t, app.FtKeeper.Lock(ctx, creator, denom, 2, []byte{1, 2}, sdk.NewInt(1)))
+	txs := app.CcmKeeper.GetCreatedCrossChainTxs(ctx, creator, 1, 0)
+	require.Equal(t, 1, len(txs))
+	require.Equal(t, hex.EncodeToString([]byte(denom)), txs[0].FromContract)
+	require.Equal(t, "01020304", txs[0].ToContract)
+}
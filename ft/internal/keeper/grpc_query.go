@@ -0,0 +1,83 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/polynetwork/cosmos-poly-module/common"
+	"github.com/polynetwork/cosmos-poly-module/ft/internal/types"
+	ftpb "github.com/polynetwork/cosmos-poly-module/ft/pb"
+)
+
+type queryServer struct {
+	k Keeper
+}
+
+var _ ftpb.QueryServer = queryServer{}
+
+// NewQueryServer returns the gRPC query service of ft module, it serves the legacy queries with typed messages
+func NewQueryServer(k Keeper) ftpb.QueryServer {
+	return queryServer{k}
+}
+
+func (q queryServer) DenomInfo(goCtx context.Context, req *ftpb.QueryDenomInfoRequest) (*ftpb.QueryDenomInfoResponse, error) {
+	ctx := common.UnwrapSDKContext(goCtx)
+	return &ftpb.QueryDenomInfoResponse{Info: types.DenomInfoToProto(*q.k.GetDenomInfo(ctx, req.Denom))}, nil
+}
+
+func (q queryServer) DenomCrossChainInfo(goCtx context.Context, req *ftpb.QueryDenomCrossChainInfoRequest) (*ftpb.QueryDenomCrossChainInfoResponse, error) {
+	ctx := common.UnwrapSDKContext(goCtx)
+	info := q.k.GetDenomCrossChainInfo(ctx, req.Denom, req.ChainId)
+	return &ftpb.QueryDenomCrossChainInfoResponse{Info: types.DenomCrossChainInfoToProto(*info)}, nil
+}
+
+func (q queryServer) BindingHistory(goCtx context.Context, req *ftpb.QueryBindingHistoryRequest) (*ftpb.QueryBindingHistoryResponse, error) {
+	ctx := common.UnwrapSDKContext(goCtx)
+	page, limit := common.PageFromProto(req.Pagination)
+	changes := q.k.GetBindingHistory(ctx, req.Denom, req.ChainId, page, limit)
+	return &ftpb.QueryBindingHistoryResponse{Changes: common.BindingChangesToProto(changes)}, nil
+}
+
+func (q queryServer) MintInfo(goCtx context.Context, req *ftpb.QueryMintInfoRequest) (*ftpb.QueryMintInfoResponse, error) {
+	ctx := common.UnwrapSDKContext(goCtx)
+	info, found := q.k.GetMintInfo(ctx, req.Denom)
+	if !found {
+		return nil, types.ErrMintAuthority(fmt.Sprintf("denom: %s is not created through create coins", req.Denom))
+	}
+	return &ftpb.QueryMintInfoResponse{Info: types.MintInfoToProto(info)}, nil
+}
+
+func (q queryServer) AssetBindings(goCtx context.Context, req *ftpb.QueryAssetBindingsRequest) (*ftpb.QueryAssetBindingsResponse, error) {
+	ctx := common.UnwrapSDKContext(goCtx)
+	page, limit := common.PageFromProto(req.Pagination)
+	bindings := q.k.GetAssetBindings(ctx, req.Denom, page, limit)
+	return &ftpb.QueryAssetBindingsResponse{Bindings: common.BindingsToProto(bindings)}, nil
+}
+
+func (q queryServer) DenomsByCreator(goCtx context.Context, req *ftpb.QueryDenomsByCreatorRequest) (*ftpb.QueryDenomsByCreatorResponse, error) {
+	ctx := common.UnwrapSDKContext(goCtx)
+	creator, err := sdk.AccAddressFromBech32(req.Creator)
+	if err != nil {
+		return nil, err
+	}
+	page, limit := common.PageFromProto(req.Pagination)
+	return &ftpb.QueryDenomsByCreatorResponse{Denoms: q.k.GetDenomsByCreator(ctx, creator, page, limit)}, nil
+}
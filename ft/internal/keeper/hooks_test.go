@@ -0,0 +1,95 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+	"github.com/polynetwork/cosmos-poly-module/common"
+	"github.com/polynetwork/cosmos-poly-module/ft/internal/keeper"
+	"github.com/polynetwork/cosmos-poly-module/ft/internal/types"
+	polycommon "github.com/polynetwork/poly/common"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+type recordHooks struct {
+	locks   []common.LockInfo
+	unlocks []common.UnlockInfo
+}
+
+func (h *recordHooks) AfterLock(_ sdk.Context, info common.LockInfo) {
+	h.locks = append(h.locks, info)
+}
+
+func (h *recordHooks) AfterUnlock(_ sdk.Context, info common.UnlockInfo) {
+	h.unlocks = append(h.unlocks, info)
+}
+
+func (h *recordHooks) AfterCrossChainTxProcessed(_ sdk.Context, _ common.CrossChainTxInfo) {}
+
+func Test_ft_Hooks(t *testing.T) {
+	app, ctx := createTestApp(true)
+	app.SupplyKeeper.SetSupply(ctx, supply.NewSupply(sdk.Coins{sdk.NewCoin("stake", sdk.NewInt(100))}))
+	hooksA, hooksB := new(recordHooks), new(recordHooks)
+	// simapp registers the ccm hooks
+	require.Panics(t, func() { app.FtKeeper.SetHooks(hooksA) })
+	ftKeeper := keeper.NewKeeper(app.Codec(), app.GetKey(types.StoreKey), app.AccountKeeper, app.BankKeeper, app.SupplyKeeper, app.CcmKeeper)
+	ftKeeper.SetHooks(common.NewMultiCrossChainHooks(hooksA, hooksB))
+	require.Panics(t, func() { ftKeeper.SetHooks(hooksA) })
+
+	creator := sdk.AccAddress([]byte("creator"))
+	denom := "coin1"
+	require.Nil(t, ftKeeper.CreateDenom(ctx, creator, denom, nil))
+	require.Nil(t, ftKeeper.MintCoins(ctx, creator, sdk.NewCoins(sdk.NewInt64Coin(denom, 100))))
+	require.Nil(t, ftKeeper.BindAssetHash(ctx, creator, denom, 2, []byte{1, 2, 3, 4}, 0, 0))
+
+	// failed lock does not call hooks
+	require.Error(t, ftKeeper.Lock(ctx, creator, denom, 3, []byte{1, 2}, sdk.NewInt(10)))
+	require.Nil(t, ftKeeper.Lock(ctx, creator, denom, 2, []byte{1, 2}, sdk.NewInt(10)))
+	expLock := common.LockInfo{
+		Module:      types.ModuleName,
+		FromAddress: creator,
+		Denom:       denom,
+		ToChainId:   2,
+		ToAssetHash: []byte{1, 2, 3, 4},
+		ToAddress:   []byte{1, 2},
+		Amount:      sdk.NewInt(10),
+	}
+	require.Equal(t, []common.LockInfo{expLock}, hooksA.locks)
+	require.Equal(t, []common.LockInfo{expLock}, hooksB.locks)
+
+	receiver := sdk.AccAddress([]byte("receiver"))
+	sink := polycommon.NewZeroCopySink(nil)
+	sink.WriteVarBytes(receiver)
+	amountBs, err := common.PadFixedBytes(sdk.NewInt(6).BigInt(), 32)
+	require.Nil(t, err)
+	sink.WriteBytes(amountBs)
+	require.Nil(t, ftKeeper.Unlock(ctx, 2, []byte{1, 2, 3, 4}, []byte(denom), sink.Bytes()))
+	expUnlock := common.UnlockInfo{
+		Module:           types.ModuleName,
+		FromChainId:      2,
+		FromContractHash: []byte{1, 2, 3, 4},
+		ToContractHash:   []byte(denom),
+		Denom:            denom,
+		ToAddress:        receiver,
+		Amount:           sdk.NewInt(6),
+	}
+	require.Equal(t, []common.UnlockInfo{expUnlock}, hooksA.unlocks)
+	require.Equal(t, []common.UnlockInfo{expUnlock}, hooksB.unlocks)
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/supply/exported"
+	"github.com/polynetwork/cosmos-poly-module/common"
 	"github.com/polynetwork/cosmos-poly-module/ft/internal/types"
 	"github.com/tendermint/tendermint/libs/log"
 )
@@ -34,6 +35,7 @@ type Keeper struct {
 	bankKeeper   types.BankKeeper
 	supplyKeeper types.SupplyKeeper
 	ccmKeeper    types.CrossChainManager
+	hooks        common.CrossChainHooks
 }
 
 // NewKeeper creates a new mint Keeper instance
@@ -72,12 +74,24 @@ func (k Keeper) EnsureAccountExist(ctx sdk.Context, addr sdk.AccAddress) error {
 	return nil
 }
 
-func (k Keeper) CreateCoins(ctx sdk.Context, creator sdk.AccAddress, coins sdk.Coins) error {
+// CreateCoins creates the denoms of coins and mints coins to creator, supplyCap optionally caps the total supply of
+// each denom for the later minting by the holder of the mint authority
+func (k Keeper) CreateCoins(ctx sdk.Context, creator sdk.AccAddress, coins sdk.Coins, supplyCap sdk.Coins, metadata []common.DenomMetadata) error {
+	if err := common.ValidateDenomsMetadata(metadata, coins); err != nil {
+		return types.ErrCreateCoins(fmt.Sprintf("invalid metadata, err: %v", err))
+	}
+	if err := types.ValidateSupplyCap(supplyCap, coins); err != nil {
+		return types.ErrCreateCoins(err.Error())
+	}
 	for _, coin := range coins {
 		if reason, exist := k.ccmKeeper.ExistDenom(ctx, coin.Denom); exist {
 			return types.ErrCreateCoins(fmt.Sprintf("denom:%s already exist, due to reason:%s", coin.Denom, reason))
 		}
 		k.ccmKeeper.SetDenomCreator(ctx, coin.Denom, creator)
+		k.setMintInfo(ctx, types.NewMintInfo(coin.Denom, supplyCap.AmountOf(coin.Denom)))
+	}
+	for _, md := range metadata {
+		k.ccmKeeper.SetDenomMetadata(ctx, md)
 	}
 	if err := k.MintCoins(ctx, creator, sdk.NewCoins(coins...)); err != nil {
 		return types.ErrCreateCoins(fmt.Sprintf("MintCoins Error: %s", err.Error()))
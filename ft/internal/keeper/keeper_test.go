@@ -57,7 +57,7 @@ func Test_ft_MsgCreateCoins(t *testing.T) {
 		require.Equal(t, addr, creator, fmt.Sprintf("expect: %s, got: %s", addr, creator))
 		coins, err := sdk.ParseCoins(testCase.coins)
 		require.Nil(t, err)
-		err = app.FtKeeper.CreateCoins(ctx, creator, coins)
+		err = app.FtKeeper.CreateCoins(ctx, creator, coins, nil, nil)
 		if testCase.expectSucceed {
 			require.Nil(t, err)
 		} else {
@@ -65,3 +65,37 @@ func Test_ft_MsgCreateCoins(t *testing.T) {
 		}
 	}
 }
+
+func Test_ft_MintAuthority(t *testing.T) {
+	app, ctx := createTestApp(true)
+	app.SupplyKeeper.SetSupply(ctx, supply.NewSupply(sdk.Coins{sdk.NewCoin("stake", sdk.NewInt(100))}))
+
+	creator := sdk.AccAddress([]byte("creatorAddress123456"))
+	other := sdk.AccAddress([]byte("otherAddress12345678"))
+	coins := sdk.NewCoins(sdk.NewCoin("coin1", sdk.NewInt(100)))
+	require.Error(t, app.FtKeeper.CreateCoins(ctx, creator, coins, sdk.NewCoins(sdk.NewCoin("coin1", sdk.NewInt(99))), nil))
+	require.Nil(t, app.FtKeeper.CreateCoins(ctx, creator, coins, sdk.NewCoins(sdk.NewCoin("coin1", sdk.NewInt(150))), nil))
+	info, found := app.FtKeeper.GetMintInfo(ctx, "coin1")
+	require.True(t, found)
+	require.Equal(t, sdk.NewInt(150), info.SupplyCap)
+
+	require.Error(t, app.FtKeeper.Mint(ctx, other, other, sdk.NewCoin("coin1", sdk.NewInt(10))))
+	require.Nil(t, app.FtKeeper.Mint(ctx, creator, other, sdk.NewCoin("coin1", sdk.NewInt(50))))
+	require.Error(t, app.FtKeeper.Mint(ctx, creator, other, sdk.NewCoin("coin1", sdk.NewInt(1))))
+	require.Equal(t, sdk.NewInt(50), app.BankKeeper.GetCoins(ctx, other).AmountOf("coin1"))
+	require.Nil(t, app.FtKeeper.Burn(ctx, creator, sdk.NewCoin("coin1", sdk.NewInt(30))))
+	require.Equal(t, sdk.NewInt(120), app.SupplyKeeper.GetSupply(ctx).GetTotal().AmountOf("coin1"))
+
+	// denoms not created through create coins can not be minted
+	require.Nil(t, app.FtKeeper.CreateDenom(ctx, creator, "coin2", nil))
+	require.Error(t, app.FtKeeper.Mint(ctx, creator, creator, sdk.NewCoin("coin2", sdk.NewInt(1))))
+
+	require.Error(t, app.FtKeeper.TransferMintAuthority(ctx, creator, "coin1", "unknownModule"))
+	require.Error(t, app.FtKeeper.ModuleMint(ctx, "distribution", other, sdk.NewCoin("coin1", sdk.NewInt(1))))
+	require.Nil(t, app.FtKeeper.TransferMintAuthority(ctx, creator, "coin1", "distribution"))
+	require.Error(t, app.FtKeeper.Mint(ctx, creator, creator, sdk.NewCoin("coin1", sdk.NewInt(1))))
+	require.Error(t, app.FtKeeper.TransferMintAuthority(ctx, creator, "coin1", "gov"))
+	require.Nil(t, app.FtKeeper.ModuleMint(ctx, "distribution", app.SupplyKeeper.GetModuleAddress("distribution"), sdk.NewCoin("coin1", sdk.NewInt(30))))
+	require.Nil(t, app.FtKeeper.ModuleBurn(ctx, "distribution", sdk.NewCoin("coin1", sdk.NewInt(10))))
+	require.Equal(t, sdk.NewInt(140), app.SupplyKeeper.GetSupply(ctx).GetTotal().AmountOf("coin1"))
+}
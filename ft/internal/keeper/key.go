@@ -19,11 +19,17 @@ package keeper
 
 import (
 	"encoding/binary"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 var (
 	BindAssetHashPrefix         = []byte{0x01}
 	IndependentCrossDenomPrefix = []byte{0x02}
+	BindAssetDecimalsPrefix     = []byte{0x03}
+	BindingChangePrefix         = []byte{0x04}
+	BindingChangeCountKey       = []byte{0x05}
+	MintInfoPrefix              = []byte{0x06}
+	UnlockAssetDecimalsPrefix   = []byte{0x07}
 )
 
 func GetBindAssetHashKey(sourceDenomHash []byte, chainId uint64) []byte {
@@ -32,6 +38,41 @@ func GetBindAssetHashKey(sourceDenomHash []byte, chainId uint64) []byte {
 	return append(append(BindAssetHashPrefix, sourceDenomHash...), b...)
 }
 
+func GetBindAssetDecimalsKey(sourceDenomHash []byte, chainId uint64) []byte {
+	b := make([]byte, 8)
+	binary.LittleEndian.PutUint64(b, chainId)
+	return append(append(BindAssetDecimalsPrefix, sourceDenomHash...), b...)
+}
+
+func GetUnlockAssetDecimalsKey(sourceDenomHash []byte, fromChainId uint64) []byte {
+	b := make([]byte, 8)
+	binary.LittleEndian.PutUint64(b, fromChainId)
+	return append(append(append([]byte{}, UnlockAssetDecimalsPrefix...), sourceDenomHash...), b...)
+}
+
 func GetIndependentCrossDenomKey(denom string) []byte {
 	return append(IndependentCrossDenomPrefix, []byte(denom)...)
 }
+
+// GetBindingChangeKey orders the binding history by denom, chainId and index, denom is prefixed by its
+// length so that the history of a denom or of a denom and chainId can be prefix-iterated
+func GetBindingChangeKey(denom string, chainId uint64, index uint64) []byte {
+	return append(GetBindingChangeIteratorPrefix(denom, chainId), sdk.Uint64ToBigEndian(index)...)
+}
+
+// GetBindingChangeIteratorPrefix returns the longest key prefix shared by the binding history of denom and chainId,
+// empty denom and zero chainId match any value
+func GetBindingChangeIteratorPrefix(denom string, chainId uint64) []byte {
+	if denom == "" {
+		return BindingChangePrefix
+	}
+	prefix := append(append(append([]byte{}, BindingChangePrefix...), byte(len(denom))), []byte(denom)...)
+	if chainId == 0 {
+		return prefix
+	}
+	return append(prefix, sdk.Uint64ToBigEndian(chainId)...)
+}
+
+func GetMintInfoKey(denom string) []byte {
+	return append(MintInfoPrefix, []byte(denom)...)
+}
@@ -0,0 +1,50 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package keeper
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/polynetwork/cosmos-poly-module/common"
+)
+
+// GetAssetBindings returns the page-th page of the asset hashes bound to denom in other chains
+func (k Keeper) GetAssetBindings(ctx sdk.Context, denom string, page, limit int) []common.Binding {
+	prefix := append(append([]byte{}, BindAssetHashPrefix...), denom...)
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer iterator.Close()
+
+	bindings := make([]common.Binding, 0)
+	// the keys of the denoms prefixed with denom are longer
+	common.Paginate(iterator, page, limit, func(key, value []byte) bool {
+		return len(key) == len(prefix)+8 && len(value) != 0
+	}, func(key, value []byte) {
+		bindings = append(bindings, common.NewBinding(binary.LittleEndian.Uint64(key[len(prefix):]), value))
+	})
+	return bindings
+}
+
+// GetDenomsByCreator returns the page-th page of the denoms created through ft module and now owned
+// by creator, ordered by denom
+func (k Keeper) GetDenomsByCreator(ctx sdk.Context, creator sdk.AccAddress, page, limit int) []string {
+	store := ctx.KVStore(k.storeKey)
+	return k.ccmKeeper.GetDenomsByCreator(ctx, creator, page, limit, func(denom string) bool {
+		return store.Has(GetIndependentCrossDenomKey(denom))
+	})
+}
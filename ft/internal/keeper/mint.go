@@ -0,0 +1,165 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/polynetwork/cosmos-poly-module/common"
+	"github.com/polynetwork/cosmos-poly-module/ft/internal/types"
+	ftpb "github.com/polynetwork/cosmos-poly-module/ft/pb"
+)
+
+// GetMintInfo returns the mint info of denom, false if denom has not been created through CreateCoins
+func (k Keeper) GetMintInfo(ctx sdk.Context, denom string) (info types.MintInfo, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(GetMintInfoKey(denom))
+	if bz == nil {
+		return info, false
+	}
+	var pb ftpb.MintInfo
+	common.MustUnmarshalProto(bz, &pb)
+	return types.MintInfoFromProto(&pb), true
+}
+
+func (k Keeper) setMintInfo(ctx sdk.Context, info types.MintInfo) {
+	ctx.KVStore(k.storeKey).Set(GetMintInfoKey(info.Denom), common.MustMarshalProto(types.MintInfoToProto(info)))
+}
+
+// Mint issues amount to toAddr, it is only allowed for the creator of the denom while the creator holds the mint authority
+func (k Keeper) Mint(ctx sdk.Context, creator, toAddr sdk.AccAddress, amount sdk.Coin) error {
+	info, err := k.creatorMintInfo(ctx, creator, amount.Denom)
+	if err != nil {
+		return err
+	}
+	return k.mint(ctx, info, creator.String(), toAddr, amount)
+}
+
+// Burn destroys amount from the balance of the creator of the denom while the creator holds the mint authority
+func (k Keeper) Burn(ctx sdk.Context, creator sdk.AccAddress, amount sdk.Coin) error {
+	if _, err := k.creatorMintInfo(ctx, creator, amount.Denom); err != nil {
+		return err
+	}
+	return k.burn(ctx, creator, amount)
+}
+
+// ModuleMint issues amount to toAddr on behalf of the module account holding the mint authority of the denom
+func (k Keeper) ModuleMint(ctx sdk.Context, moduleName string, toAddr sdk.AccAddress, amount sdk.Coin) error {
+	info, err := k.moduleMintInfo(ctx, moduleName, amount.Denom)
+	if err != nil {
+		return err
+	}
+	return k.mint(ctx, info, moduleName, toAddr, amount)
+}
+
+// ModuleBurn destroys amount from the balance of the module account holding the mint authority of the denom
+func (k Keeper) ModuleBurn(ctx sdk.Context, moduleName string, amount sdk.Coin) error {
+	if _, err := k.moduleMintInfo(ctx, moduleName, amount.Denom); err != nil {
+		return err
+	}
+	return k.burn(ctx, k.supplyKeeper.GetModuleAddress(moduleName), amount)
+}
+
+// TransferMintAuthority hands the mint authority of denom over to the module account moduleName,
+// the creator can no longer mint or burn the denom afterwards and the transfer can not be reverted
+func (k Keeper) TransferMintAuthority(ctx sdk.Context, creator sdk.AccAddress, denom string, moduleName string) error {
+	info, err := k.creatorMintInfo(ctx, creator, denom)
+	if err != nil {
+		return err
+	}
+	if moduleName == "" || k.supplyKeeper.GetModuleAddress(moduleName) == nil {
+		return types.ErrMintAuthority(fmt.Sprintf("module account: %s does not exist", moduleName))
+	}
+	info.AuthorityModule = moduleName
+	k.setMintInfo(ctx, info)
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeTransferMintAuthority,
+			sdk.NewAttribute(types.AttributeKeySourceAssetDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyCreator, creator.String()),
+			sdk.NewAttribute(types.AttributeKeyAuthorityModule, moduleName),
+		),
+	})
+	return nil
+}
+
+func (k Keeper) creatorMintInfo(ctx sdk.Context, creator sdk.AccAddress, denom string) (types.MintInfo, error) {
+	info, found := k.GetMintInfo(ctx, denom)
+	if !found {
+		return info, types.ErrMintAuthority(fmt.Sprintf("denom: %s is not created through create coins", denom))
+	}
+	if info.AuthorityModule != "" {
+		return info, types.ErrMintAuthority(fmt.Sprintf("mint authority of denom: %s has been transferred to module: %s", denom, info.AuthorityModule))
+	}
+	if !k.ValidCreator(ctx, denom, creator) {
+		return info, types.ErrMintAuthority(fmt.Sprintf("creator is not valid, expect: %s, got: %s", k.ccmKeeper.GetDenomCreator(ctx, denom).String(), creator.String()))
+	}
+	return info, nil
+}
+
+func (k Keeper) moduleMintInfo(ctx sdk.Context, moduleName string, denom string) (types.MintInfo, error) {
+	info, found := k.GetMintInfo(ctx, denom)
+	if !found {
+		return info, types.ErrMintAuthority(fmt.Sprintf("denom: %s is not created through create coins", denom))
+	}
+	if moduleName == "" || info.AuthorityModule != moduleName {
+		return info, types.ErrMintAuthority(fmt.Sprintf("module: %s does not hold the mint authority of denom: %s", moduleName, denom))
+	}
+	return info, nil
+}
+
+func (k Keeper) mint(ctx sdk.Context, info types.MintInfo, minter string, toAddr sdk.AccAddress, amount sdk.Coin) error {
+	if !amount.IsPositive() {
+		return types.ErrMintCoins(fmt.Sprintf("amount: %s should be positive", amount.String()))
+	}
+	if info.IsCapped() {
+		totalSupply := k.supplyKeeper.GetSupply(ctx).GetTotal().AmountOf(info.Denom)
+		if totalSupply.Add(amount.Amount).GT(info.SupplyCap) {
+			return types.ErrMintCoins(fmt.Sprintf("total supply: %s plus amount: %s exceeds the supply cap: %s", totalSupply.String(), amount.Amount.String(), info.SupplyCap.String()))
+		}
+	}
+	if err := k.MintCoins(ctx, toAddr, sdk.NewCoins(amount)); err != nil {
+		return err
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeMintCoins,
+			sdk.NewAttribute(types.AttributeKeyMinter, minter),
+			sdk.NewAttribute(types.AttributeKeyToAddress, toAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+		),
+	})
+	return nil
+}
+
+func (k Keeper) burn(ctx sdk.Context, fromAddr sdk.AccAddress, amount sdk.Coin) error {
+	if !amount.IsPositive() {
+		return types.ErrBurnCoins(fmt.Sprintf("amount: %s should be positive", amount.String()))
+	}
+	if err := k.BurnCoins(ctx, fromAddr, sdk.NewCoins(amount)); err != nil {
+		return err
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeBurnCoins,
+			sdk.NewAttribute(types.AttributeKeyFromAddress, fromAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+		),
+	})
+	return nil
+}
@@ -18,6 +18,7 @@
 package keeper
 
 import (
+	"fmt"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -34,8 +35,16 @@ func NewQuerier(k Keeper) sdk.Querier {
 
 		case types.QueryDenomInfo:
 			return queryDenomInfo(ctx, req, k)
+		case types.QueryBindingHistory:
+			return queryBindingHistory(ctx, req, k)
 		case types.QueryDenomCrossChainInfo:
 			return queryDenomCrossChainInfo(ctx, req, k)
+		case types.QueryMintInfo:
+			return queryMintInfo(ctx, req, k)
+		case types.QueryAssetBindings:
+			return queryAssetBindings(ctx, req, k)
+		case types.QueryDenomsByCreator:
+			return queryDenomsByCreator(ctx, req, k)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown query path: %s", path[0])
 		}
@@ -73,3 +82,69 @@ func queryDenomCrossChainInfo(ctx sdk.Context, req abci.RequestQuery, k Keeper)
 
 	return bz, nil
 }
+
+func queryBindingHistory(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryBindingHistoryParam
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONUnmarshal, "failed to parse params: %s", err)
+	}
+	changes := k.GetBindingHistory(ctx, params.Denom, params.ChainId, params.Page, params.Limit)
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, changes)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONMarshal, "could not marshal binding history to JSON: %s", err)
+	}
+
+	return bz, nil
+}
+
+func queryMintInfo(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryMintInfoParam
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONUnmarshal, "failed to parse params: %s", err)
+	}
+	info, found := k.GetMintInfo(ctx, params.Denom)
+	if !found {
+		return nil, types.ErrMintAuthority(fmt.Sprintf("denom: %s is not created through create coins", params.Denom))
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, info)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONMarshal, "could not marshal MintInfo: %s to JSON", info.String())
+	}
+
+	return bz, nil
+}
+
+func queryAssetBindings(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryAssetBindingsParam
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONUnmarshal, "failed to parse params: %s", err)
+	}
+	bindings := k.GetAssetBindings(ctx, params.Denom, params.Page, params.Limit)
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, bindings)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONMarshal, "could not marshal asset bindings: %+v to JSON", bindings)
+	}
+
+	return bz, nil
+}
+
+func queryDenomsByCreator(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryDenomsByCreatorParam
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONUnmarshal, "failed to parse params: %s", err)
+	}
+	denoms := k.GetDenomsByCreator(ctx, params.Creator, params.Page, params.Limit)
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, denoms)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONMarshal, "could not marshal denoms: %+v to JSON", denoms)
+	}
+
+	return bz, nil
+}
@@ -28,8 +28,13 @@ func RegisterCodec(cdc *codec.Codec) {
 
 	cdc.RegisterConcrete(MsgCreateDenom{}, ModuleName+"/MsgCreateDenom", nil)
 	cdc.RegisterConcrete(MsgBindAssetHash{}, ModuleName+"/MsgBindAssetHash", nil)
+	cdc.RegisterConcrete(MsgUnbindAssetHash{}, ModuleName+"/MsgUnbindAssetHash", nil)
+	cdc.RegisterConcrete(MsgSettleAssetDecimals{}, ModuleName+"/MsgSettleAssetDecimals", nil)
 	cdc.RegisterConcrete(MsgLock{}, ModuleName+"/MsgLock", nil)
 	cdc.RegisterConcrete(MsgCreateCoins{}, ModuleName+"/MsgCreateCoins", nil)
+	cdc.RegisterConcrete(MsgMintCoins{}, ModuleName+"/MsgMintCoins", nil)
+	cdc.RegisterConcrete(MsgBurnCoins{}, ModuleName+"/MsgBurnCoins", nil)
+	cdc.RegisterConcrete(MsgTransferMintAuthority{}, ModuleName+"/MsgTransferMintAuthority", nil)
 
 }
 
@@ -37,6 +37,9 @@ var (
 	ErrMsgLockType          = sdkerrors.Register(ModuleName, 11, "ErrMsgLockType")
 	ErrLockType             = sdkerrors.Register(ModuleName, 12, "ErrLockType")
 	ErrUnLockType           = sdkerrors.Register(ModuleName, 13, "ErrUnLockType")
+	ErrUnbindAssetHashType  = sdkerrors.Register(ModuleName, 14, "ErrUnbindAssetHashType")
+	ErrMintAuthorityType    = sdkerrors.Register(ModuleName, 15, "ErrMintAuthorityType")
+	ErrSettleDecimalsType   = sdkerrors.Register(ModuleName, 16, "ErrSettleAssetDecimalsType")
 )
 
 func ErrInvalidChainId(chainId uint64) error {
@@ -83,3 +86,15 @@ func ErrBurnCoins(reason string) error {
 func ErrMintCoins(reason string) error {
 	return sdkerrors.Wrapf(ErrMintCoinsType, "Reason: %s", reason)
 }
+
+func ErrUnbindAssetHash(reason string) error {
+	return sdkerrors.Wrapf(ErrUnbindAssetHashType, fmt.Sprintf("Reason: %s", reason))
+}
+
+func ErrMintAuthority(reason string) error {
+	return sdkerrors.Wrapf(ErrMintAuthorityType, "Reason: %s", reason)
+}
+
+func ErrSettleAssetDecimals(reason string) error {
+	return sdkerrors.Wrapf(ErrSettleDecimalsType, "Reason: %s", reason)
+}
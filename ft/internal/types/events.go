@@ -25,7 +25,9 @@ const (
 
 	AttributeKeyToChainId = "to_chain_id"
 
+	EventTypeUnbindAsset         = "unbind_asset_hash"
 	EventTypeBindAsset           = "bind_asset_hash"
+	EventTypeSettleAssetDecimals = "settle_asset_decimals"
 	AttributeKeySourceAssetDenom = "source_asset_denom"
 	AttributeKeyCreator          = "creator"
 	AttributeKeyFromAssetHash    = "from_asset_hash"
@@ -34,6 +36,9 @@ const (
 	AttributeKeyFromAddress      = "from_address"
 	AttributeKeyToAddress        = "to_address"
 	AttributeKeyAmount           = "amount"
+	AttributeKeyDust             = "dust"
+	AttributeKeySourceDecimals   = "source_decimals"
+	AttributeKeyToDecimals       = "to_decimals"
 
 	AttributeKeyFromChainId = "from_chain_id"
 
@@ -44,4 +49,10 @@ const (
 	EventTypeSetRedeemScript = "set_redeem_script"
 	AttributeKeyRedeemKey    = "redeem_key"
 	AttributeKeyRedeemScript = "redeem_script"
+
+	EventTypeMintCoins             = "mint_coins"
+	EventTypeBurnCoins             = "burn_coins"
+	EventTypeTransferMintAuthority = "transfer_mint_authority"
+	AttributeKeyMinter             = "minter"
+	AttributeKeyAuthorityModule    = "authority_module"
 )
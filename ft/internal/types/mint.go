@@ -0,0 +1,61 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MintInfo is recorded for every denom created through CreateCoins, it controls the later issuance of the denom
+type MintInfo struct {
+	Denom           string  `json:"denom" yaml:"denom"`
+	SupplyCap       sdk.Int `json:"supply_cap" yaml:"supply_cap"`             // zero means the supply is not capped
+	AuthorityModule string  `json:"authority_module" yaml:"authority_module"` // empty if the denom creator holds the mint authority
+}
+
+func NewMintInfo(denom string, supplyCap sdk.Int) MintInfo {
+	return MintInfo{Denom: denom, SupplyCap: supplyCap}
+}
+
+// IsCapped returns whether the total supply of the denom is capped
+func (info MintInfo) IsCapped() bool {
+	return info.SupplyCap.IsPositive()
+}
+
+func (info MintInfo) String() string {
+	return fmt.Sprintf(`MintInfo:
+  Denom:           %s
+  SupplyCap:       %s
+  AuthorityModule: %s
+`, info.Denom, info.SupplyCap.String(), info.AuthorityModule)
+}
+
+// ValidateSupplyCap checks every capped denom is among coins and its cap covers the initial amount
+func ValidateSupplyCap(supplyCap sdk.Coins, coins sdk.Coins) error {
+	for _, c := range supplyCap {
+		if !coins.AmountOf(c.Denom).IsPositive() {
+			return fmt.Errorf("capped denom: %s is not among the coins: %s", c.Denom, coins.String())
+		}
+		if c.Amount.LT(coins.AmountOf(c.Denom)) {
+			return fmt.Errorf("supply cap: %s is less than the initial amount: %s", c.String(), coins.AmountOf(c.Denom).String())
+		}
+	}
+	return nil
+}
@@ -22,22 +22,31 @@ import (
 	"fmt"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/polynetwork/cosmos-poly-module/common"
 )
 
 // Governance message types and routes
 const (
-	TypeMsgCreateDenom   = "create_denom"
-	TypeMsgBindAssetHash = "bind_asset_hash"
-	TypeMsgLock          = "lock"
+	TypeMsgCreateDenom     = "create_denom"
+	TypeMsgBindAssetHash   = "bind_asset_hash"
+	TypeMsgUnbindAssetHash = "unbind_asset_hash"
+	TypeMsgLock            = "lock"
+
+	TypeMsgSettleAssetDecimals = "settle_asset_decimals"
+
+	TypeMsgMintCoins             = "mint_coins"
+	TypeMsgBurnCoins             = "burn_coins"
+	TypeMsgTransferMintAuthority = "transfer_mint_authority"
 )
 
 type MsgCreateDenom struct {
-	Creator sdk.AccAddress
-	Denom   string
+	Creator  sdk.AccAddress
+	Denom    string
+	Metadata *common.DenomMetadata // optional, Metadata.Denom should equal Denom
 }
 
-func NewMsgCreateDenom(creator sdk.AccAddress, denom string) MsgCreateDenom {
-	return MsgCreateDenom{Creator: creator, Denom: denom}
+func NewMsgCreateDenom(creator sdk.AccAddress, denom string, metadata *common.DenomMetadata) MsgCreateDenom {
+	return MsgCreateDenom{Creator: creator, Denom: denom, Metadata: metadata}
 }
 
 //nolint
@@ -52,6 +61,14 @@ func (msg MsgCreateDenom) ValidateBasic() error {
 	if err := sdk.ValidateDenom(msg.Denom); err != nil {
 		return ErrMsgCreateDenom(fmt.Sprintf("MsgCreateDenom.Denom:%s is invalid, err: %v", msg.Denom, err))
 	}
+	if msg.Metadata != nil {
+		if msg.Metadata.Denom != msg.Denom {
+			return ErrMsgCreateDenom(fmt.Sprintf("MsgCreateDenom.Metadata.Denom:%s should be %s", msg.Metadata.Denom, msg.Denom))
+		}
+		if err := msg.Metadata.ValidateBasic(); err != nil {
+			return ErrMsgCreateDenom(fmt.Sprintf("MsgCreateDenom.Metadata is invalid, err: %v", err))
+		}
+	}
 	return nil
 }
 
@@ -78,10 +95,12 @@ type MsgBindAssetHash struct {
 	SourceAssetDenom string
 	ToChainId        uint64
 	ToAssetHash      []byte
+	SourceDecimals   uint8 // decimals of SourceAssetDenom in current chain
+	ToDecimals       uint8 // decimals of ToAssetHash in toChainId, amounts are not scaled if equal to SourceDecimals
 }
 
-func NewMsgBindAssetHash(creator sdk.AccAddress, sourceAssetDenom string, toChainId uint64, toAssetHash []byte) MsgBindAssetHash {
-	return MsgBindAssetHash{creator, sourceAssetDenom, toChainId, toAssetHash}
+func NewMsgBindAssetHash(creator sdk.AccAddress, sourceAssetDenom string, toChainId uint64, toAssetHash []byte, sourceDecimals, toDecimals uint8) MsgBindAssetHash {
+	return MsgBindAssetHash{creator, sourceAssetDenom, toChainId, toAssetHash, sourceDecimals, toDecimals}
 }
 
 //nolint
@@ -105,6 +124,9 @@ func (msg MsgBindAssetHash) ValidateBasic() error {
 		// handler is implemented.
 		return ErrMsgBindAssetHash(fmt.Sprintf("empty MsgBindAssetHash.ToAssetHash"))
 	}
+	if err := common.ValidateDecimals(msg.SourceDecimals, msg.ToDecimals); err != nil {
+		return ErrMsgBindAssetHash(fmt.Sprintf("MsgBindAssetHash decimals are invalid, err: %v", err))
+	}
 	return nil
 }
 
@@ -114,7 +136,9 @@ func (msg MsgBindAssetHash) String() string {
   SourceAssetDenom: 	%s
   TargetChainId:  		%d
   TargetAssetHash:      %x
-`, msg.Creator.String(), msg.SourceAssetDenom, msg.ToChainId, msg.ToAssetHash)
+  SourceDecimals:       %d
+  TargetDecimals:       %d
+`, msg.Creator.String(), msg.SourceAssetDenom, msg.ToChainId, msg.ToAssetHash, msg.SourceDecimals, msg.ToDecimals)
 }
 
 // Implements Msg.
@@ -128,6 +152,100 @@ func (msg MsgBindAssetHash) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Creator}
 }
 
+type MsgUnbindAssetHash struct {
+	Creator          sdk.AccAddress
+	SourceAssetDenom string
+	ToChainId        uint64
+}
+
+func NewMsgUnbindAssetHash(creator sdk.AccAddress, sourceAssetDenom string, toChainId uint64) MsgUnbindAssetHash {
+	return MsgUnbindAssetHash{creator, sourceAssetDenom, toChainId}
+}
+
+//nolint
+func (msg MsgUnbindAssetHash) Route() string { return RouterKey }
+func (msg MsgUnbindAssetHash) Type() string  { return TypeMsgUnbindAssetHash }
+
+// Implements Msg.
+func (msg MsgUnbindAssetHash) ValidateBasic() error {
+	if msg.Creator.Empty() {
+		return sdkerrors.ErrInvalidAddress
+	}
+	if err := sdk.ValidateDenom(msg.SourceAssetDenom); err != nil {
+		return ErrMsgBindAssetHash(fmt.Sprintf("MsgUnbindAssetHash.SourceAssetDenom: %s is invalid, err: %v", msg.SourceAssetDenom, err))
+	}
+	if msg.ToChainId == 0 {
+		return ErrInvalidChainId(msg.ToChainId)
+	}
+	return nil
+}
+
+func (msg MsgUnbindAssetHash) String() string {
+	return fmt.Sprintf(`MsgUnbindAssetHash:
+  Creator:          %s
+  SourceAssetDenom: %s
+  TargetChainId:    %d
+`, msg.Creator.String(), msg.SourceAssetDenom, msg.ToChainId)
+}
+
+// Implements Msg.
+func (msg MsgUnbindAssetHash) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// Implements Msg.
+func (msg MsgUnbindAssetHash) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Creator}
+}
+
+type MsgSettleAssetDecimals struct {
+	Creator          sdk.AccAddress
+	SourceAssetDenom string
+	ChainId          uint64
+}
+
+func NewMsgSettleAssetDecimals(creator sdk.AccAddress, sourceAssetDenom string, chainId uint64) MsgSettleAssetDecimals {
+	return MsgSettleAssetDecimals{creator, sourceAssetDenom, chainId}
+}
+
+//nolint
+func (msg MsgSettleAssetDecimals) Route() string { return RouterKey }
+func (msg MsgSettleAssetDecimals) Type() string  { return TypeMsgSettleAssetDecimals }
+
+// Implements Msg.
+func (msg MsgSettleAssetDecimals) ValidateBasic() error {
+	if msg.Creator.Empty() {
+		return sdkerrors.ErrInvalidAddress
+	}
+	if err := sdk.ValidateDenom(msg.SourceAssetDenom); err != nil {
+		return ErrSettleAssetDecimals(fmt.Sprintf("MsgSettleAssetDecimals.SourceAssetDenom: %s is invalid, err: %v", msg.SourceAssetDenom, err))
+	}
+	if msg.ChainId == 0 {
+		return ErrInvalidChainId(msg.ChainId)
+	}
+	return nil
+}
+
+func (msg MsgSettleAssetDecimals) String() string {
+	return fmt.Sprintf(`MsgSettleAssetDecimals:
+  Creator:          %s
+  SourceAssetDenom: %s
+  ChainId:          %d
+`, msg.Creator.String(), msg.SourceAssetDenom, msg.ChainId)
+}
+
+// Implements Msg.
+func (msg MsgSettleAssetDecimals) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// Implements Msg.
+func (msg MsgSettleAssetDecimals) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Creator}
+}
+
 type MsgLock struct {
 	FromAddress      sdk.AccAddress
 	SourceAssetDenom string
@@ -189,12 +307,14 @@ func (msg MsgLock) GetSigners() []sdk.AccAddress {
 }
 
 type MsgCreateCoins struct {
-	Creator sdk.AccAddress
-	Coins   string
+	Creator   sdk.AccAddress
+	Coins     string
+	SupplyCap string                 // optional hard cap of the total supply per denom, empty for no cap
+	Metadata  []common.DenomMetadata // optional metadata of the created denoms
 }
 
-func NewMsgCreateCoins(creator sdk.AccAddress, coins string) MsgCreateCoins {
-	return MsgCreateCoins{Creator: creator, Coins: coins}
+func NewMsgCreateCoins(creator sdk.AccAddress, coins string, supplyCap string, metadata []common.DenomMetadata) MsgCreateCoins {
+	return MsgCreateCoins{Creator: creator, Coins: coins, SupplyCap: supplyCap, Metadata: metadata}
 }
 
 func (msg MsgCreateCoins) Route() string { return RouterKey }
@@ -205,9 +325,20 @@ func (msg MsgCreateCoins) ValidateBasic() error {
 	if msg.Creator.Empty() {
 		return ErrCreateCoins(fmt.Sprintf("MsgCreateDenom.Creator is empty"))
 	}
-	if _, err := sdk.ParseCoins(msg.Coins); err != nil {
+	coins, err := sdk.ParseCoins(msg.Coins)
+	if err != nil {
 		return ErrCreateCoins(fmt.Sprintf("MsgCreateCoins.Coins:%s is invalid", msg.Coins))
 	}
+	supplyCap, err := sdk.ParseCoins(msg.SupplyCap)
+	if err != nil {
+		return ErrCreateCoins(fmt.Sprintf("MsgCreateCoins.SupplyCap:%s is invalid", msg.SupplyCap))
+	}
+	if err := ValidateSupplyCap(supplyCap, coins); err != nil {
+		return ErrCreateCoins(fmt.Sprintf("MsgCreateCoins.SupplyCap is invalid, err: %v", err))
+	}
+	if err := common.ValidateDenomsMetadata(msg.Metadata, coins); err != nil {
+		return ErrCreateCoins(fmt.Sprintf("MsgCreateCoins.Metadata is invalid, err: %v", err))
+	}
 	return nil
 }
 
@@ -215,7 +346,8 @@ func (msg MsgCreateCoins) String() string {
 	return fmt.Sprintf(`Create Coins Message:
   Creator:         %s
   Denom: 		   %s
-`, msg.Creator.String(), msg.Coins)
+  SupplyCap: 	   %s
+`, msg.Creator.String(), msg.Coins, msg.SupplyCap)
 }
 
 // Implements Msg.
@@ -228,3 +360,139 @@ func (msg MsgCreateCoins) GetSignBytes() []byte {
 func (msg MsgCreateCoins) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Creator}
 }
+
+type MsgMintCoins struct {
+	Creator   sdk.AccAddress
+	ToAddress sdk.AccAddress
+	Amount    sdk.Coin
+}
+
+func NewMsgMintCoins(creator, toAddress sdk.AccAddress, amount sdk.Coin) MsgMintCoins {
+	return MsgMintCoins{Creator: creator, ToAddress: toAddress, Amount: amount}
+}
+
+//nolint
+func (msg MsgMintCoins) Route() string { return RouterKey }
+func (msg MsgMintCoins) Type() string  { return TypeMsgMintCoins }
+
+// Implements Msg.
+func (msg MsgMintCoins) ValidateBasic() error {
+	if msg.Creator.Empty() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "MsgMintCoins.Creator is empty")
+	}
+	if msg.ToAddress.Empty() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "MsgMintCoins.ToAddress is empty")
+	}
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return ErrMintCoins(fmt.Sprintf("MsgMintCoins.Amount:%s is invalid", msg.Amount.String()))
+	}
+	return nil
+}
+
+func (msg MsgMintCoins) String() string {
+	return fmt.Sprintf(`Mint Coins Message:
+  Creator:         %s
+  ToAddress:       %s
+  Amount:          %s
+`, msg.Creator.String(), msg.ToAddress.String(), msg.Amount.String())
+}
+
+// Implements Msg.
+func (msg MsgMintCoins) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// Implements Msg.
+func (msg MsgMintCoins) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Creator}
+}
+
+type MsgBurnCoins struct {
+	Creator sdk.AccAddress
+	Amount  sdk.Coin
+}
+
+func NewMsgBurnCoins(creator sdk.AccAddress, amount sdk.Coin) MsgBurnCoins {
+	return MsgBurnCoins{Creator: creator, Amount: amount}
+}
+
+//nolint
+func (msg MsgBurnCoins) Route() string { return RouterKey }
+func (msg MsgBurnCoins) Type() string  { return TypeMsgBurnCoins }
+
+// Implements Msg.
+func (msg MsgBurnCoins) ValidateBasic() error {
+	if msg.Creator.Empty() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "MsgBurnCoins.Creator is empty")
+	}
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return ErrBurnCoins(fmt.Sprintf("MsgBurnCoins.Amount:%s is invalid", msg.Amount.String()))
+	}
+	return nil
+}
+
+func (msg MsgBurnCoins) String() string {
+	return fmt.Sprintf(`Burn Coins Message:
+  Creator:         %s
+  Amount:          %s
+`, msg.Creator.String(), msg.Amount.String())
+}
+
+// Implements Msg.
+func (msg MsgBurnCoins) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// Implements Msg.
+func (msg MsgBurnCoins) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Creator}
+}
+
+type MsgTransferMintAuthority struct {
+	Creator    sdk.AccAddress
+	Denom      string
+	ModuleName string // module account taking over the mint authority
+}
+
+func NewMsgTransferMintAuthority(creator sdk.AccAddress, denom string, moduleName string) MsgTransferMintAuthority {
+	return MsgTransferMintAuthority{Creator: creator, Denom: denom, ModuleName: moduleName}
+}
+
+//nolint
+func (msg MsgTransferMintAuthority) Route() string { return RouterKey }
+func (msg MsgTransferMintAuthority) Type() string  { return TypeMsgTransferMintAuthority }
+
+// Implements Msg.
+func (msg MsgTransferMintAuthority) ValidateBasic() error {
+	if msg.Creator.Empty() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "MsgTransferMintAuthority.Creator is empty")
+	}
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return ErrMintAuthority(fmt.Sprintf("MsgTransferMintAuthority.Denom:%s is invalid, err: %v", msg.Denom, err))
+	}
+	if msg.ModuleName == "" {
+		return ErrMintAuthority("MsgTransferMintAuthority.ModuleName is empty")
+	}
+	return nil
+}
+
+func (msg MsgTransferMintAuthority) String() string {
+	return fmt.Sprintf(`Transfer Mint Authority Message:
+  Creator:         %s
+  Denom:           %s
+  ModuleName:      %s
+`, msg.Creator.String(), msg.Denom, msg.ModuleName)
+}
+
+// Implements Msg.
+func (msg MsgTransferMintAuthority) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// Implements Msg.
+func (msg MsgTransferMintAuthority) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Creator}
+}
@@ -0,0 +1,54 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package types
+
+import (
+	"github.com/polynetwork/cosmos-poly-module/common"
+	ftpb "github.com/polynetwork/cosmos-poly-module/ft/pb"
+)
+
+func DenomInfoToProto(info DenomInfo) *ftpb.DenomInfo {
+	pb := &ftpb.DenomInfo{
+		Creator:     info.Creator,
+		Denom:       info.Denom,
+		AssetHash:   info.AssetHash,
+		TotalSupply: info.TotalSupply.String(),
+	}
+	if info.Metadata != nil {
+		pb.Metadata = common.DenomMetadataToProto(*info.Metadata)
+	}
+	return pb
+}
+
+func DenomCrossChainInfoToProto(info DenomCrossChainInfo) *ftpb.DenomCrossChainInfo {
+	return &ftpb.DenomCrossChainInfo{
+		DenomInfo:      DenomInfoToProto(info.DenomInfo),
+		ToChainId:      info.ToChainId,
+		ToAssetHash:    info.ToAssetHash,
+		SourceDecimals: uint32(info.SourceDecimals),
+		ToDecimals:     uint32(info.ToDecimals),
+	}
+}
+
+func MintInfoToProto(info MintInfo) *ftpb.MintInfo {
+	return &ftpb.MintInfo{Denom: info.Denom, SupplyCap: info.SupplyCap.String(), AuthorityModule: info.AuthorityModule}
+}
+
+func MintInfoFromProto(pb *ftpb.MintInfo) MintInfo {
+	return MintInfo{Denom: pb.Denom, SupplyCap: common.IntFromProto(pb.SupplyCap), AuthorityModule: pb.AuthorityModule}
+}
@@ -17,9 +17,18 @@
 
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/polynetwork/cosmos-poly-module/common"
+)
+
 const (
 	QueryDenomInfo           = "denom_info"
 	QueryDenomCrossChainInfo = "denom_cc_info"
+	QueryBindingHistory      = "binding_history"
+	QueryMintInfo            = "mint_info"
+	QueryAssetBindings       = "asset_bindings"
+	QueryDenomsByCreator     = "denoms_by_creator"
 )
 
 // QueryBalanceParams defines the params for querying an account balance.
@@ -41,3 +50,49 @@ type QueryDenomCrossChainInfoParam struct {
 func NewQueryDenomCrossChainInfoParam(denom string, toChainId uint64) QueryDenomCrossChainInfoParam {
 	return QueryDenomCrossChainInfoParam{denom, toChainId}
 }
+
+// BindingChange is one record of the binding history returned by QueryBindingHistory
+type BindingChange = common.BindingChange
+
+// QueryBindingHistoryParam filters the binding changes, empty Denom and zero ChainId match any value
+type QueryBindingHistoryParam struct {
+	Denom   string
+	ChainId uint64
+	Page    int
+	Limit   int
+}
+
+func NewQueryBindingHistoryParam(denom string, chainId uint64, page, limit int) QueryBindingHistoryParam {
+	return QueryBindingHistoryParam{denom, chainId, page, limit}
+}
+
+type QueryMintInfoParam struct {
+	Denom string
+}
+
+func NewQueryMintInfoParam(denom string) QueryMintInfoParam {
+	return QueryMintInfoParam{denom}
+}
+
+// Binding is one asset hash binding listed by QueryAssetBindings
+type Binding = common.Binding
+
+type QueryAssetBindingsParam struct {
+	Denom string
+	Page  int
+	Limit int
+}
+
+func NewQueryAssetBindingsParam(denom string, page, limit int) QueryAssetBindingsParam {
+	return QueryAssetBindingsParam{Denom: denom, Page: page, Limit: limit}
+}
+
+type QueryDenomsByCreatorParam struct {
+	Creator sdk.AccAddress
+	Page    int
+	Limit   int
+}
+
+func NewQueryDenomsByCreatorParam(creator sdk.AccAddress, page, limit int) QueryDenomsByCreatorParam {
+	return QueryDenomsByCreatorParam{Creator: creator, Page: page, Limit: limit}
+}
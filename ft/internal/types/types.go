@@ -20,6 +20,7 @@ package types
 import (
 	"fmt"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/polynetwork/cosmos-poly-module/common"
 )
 
 type DenomInfo struct {
@@ -27,26 +28,35 @@ type DenomInfo struct {
 	Denom       string
 	AssetHash   string
 	TotalSupply sdk.Int
+	Metadata    *common.DenomMetadata // nil if the creator has not registered any
 }
 
 func (msg DenomInfo) String() string {
-	return fmt.Sprintf(`
+	s := fmt.Sprintf(`
   Creator: 			 %s
   Denom:			 %s
   AssetHash:		 %s
   TotalSupply:		 %s
 `, msg.Creator, msg.Denom, msg.AssetHash, msg.TotalSupply.String())
+	if msg.Metadata != nil {
+		s += msg.Metadata.String()
+	}
+	return s
 }
 
 type DenomCrossChainInfo struct {
 	DenomInfo
-	ToChainId   uint64
-	ToAssetHash string
+	ToChainId      uint64
+	ToAssetHash    string
+	SourceDecimals uint8
+	ToDecimals     uint8
 }
 
 func (msg DenomCrossChainInfo) String() string {
 	return msg.DenomInfo.String() + fmt.Sprintf(`
   ToChainId:       	 %d
   ToAssetHash:		 %s
-`, msg.ToChainId, msg.ToAssetHash)
+  SourceDecimals:	 %d
+  ToDecimals:		 %d
+`, msg.ToChainId, msg.ToAssetHash, msg.SourceDecimals, msg.ToDecimals)
 }
@@ -22,6 +22,7 @@ import (
 
 	"github.com/gorilla/mux"
 	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 
@@ -31,6 +32,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/polynetwork/cosmos-poly-module/ft/client/cli"
 	"github.com/polynetwork/cosmos-poly-module/ft/client/rest"
+	ftpb "github.com/polynetwork/cosmos-poly-module/ft/pb"
 )
 
 var (
@@ -117,6 +119,11 @@ func (am AppModule) NewQuerierHandler() sdk.Querier {
 	return NewQuerier(am.keeper)
 }
 
+// RegisterQueryService registers the gRPC query service of the module, it is served next to the legacy querier
+func (am AppModule) RegisterQueryService(server *grpc.Server) {
+	ftpb.RegisterQueryServer(server, NewQueryServer(am.keeper))
+}
+
 // module init-genesis
 func (am AppModule) InitGenesis(ctx sdk.Context, data json.RawMessage) []abci.ValidatorUpdate {
 	return []abci.ValidatorUpdate{}
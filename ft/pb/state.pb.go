@@ -0,0 +1,36 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: polynetwork/ft/v1/state.proto
+
+package ftpb
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+func init() { proto.RegisterFile("polynetwork/ft/v1/state.proto", fileDescriptor_61739117e75b0f4e) }
+
+var fileDescriptor_61739117e75b0f4e = []byte{
+	// 135 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2d, 0xc8, 0xcf, 0xa9,
+	0xcc, 0x4b, 0x2d, 0x29, 0xcf, 0x2f, 0xca, 0xd6, 0x4f, 0x2b, 0xd1, 0x2f, 0x33, 0xd4, 0x2f, 0x2e,
+	0x49, 0x2c, 0x49, 0xd5, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x44, 0x92, 0xd6, 0x4b, 0x2b,
+	0xd1, 0x2b, 0x33, 0x74, 0xf2, 0x3b, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f,
+	0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28,
+	0x93, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0x7d, 0x64, 0x63, 0x93, 0xf3,
+	0x8b, 0x73, 0xf3, 0x8b, 0x75, 0x41, 0x42, 0xba, 0xb9, 0xf9, 0x29, 0xa5, 0x39, 0xa9, 0x20, 0x9b,
+	0x0a, 0x92, 0xac, 0xd3, 0x4a, 0x0a, 0x92, 0x92, 0xd8, 0xc0, 0x36, 0x19, 0x03, 0x06, 0x00, 0x58,
+	0x11, 0x07, 0x23, 0x8a, 0x00, 0x00, 0x00,
+}
@@ -3,14 +3,17 @@ module github.com/polynetwork/cosmos-poly-module
 go 1.14
 
 require (
+	github.com/btcsuite/btcd v0.20.1-beta
 	github.com/btcsuite/btcutil v1.0.2
 	github.com/cosmos/cosmos-sdk v0.39.0
 	github.com/davecgh/go-spew v1.1.1
+	github.com/gogo/protobuf v1.3.1
 	github.com/gorilla/mux v1.7.4
 	github.com/polynetwork/poly v0.0.0-20200710095239-0596a3d7afe5
 	github.com/spf13/cobra v1.0.0
 	github.com/stretchr/testify v1.6.1
 	github.com/tendermint/tendermint v0.33.6
 	github.com/tendermint/tm-db v0.5.1
+	google.golang.org/grpc v1.30.0
 	gotest.tools v2.2.0+incompatible
 )
@@ -23,40 +23,100 @@ import (
 )
 
 const (
-	ModuleName                    = types.ModuleName
-	DefaultParamspace             = types.DefaultParamspace
-	StoreKey                      = types.StoreKey
-	QuerierRoute                  = types.QuerierRoute
-	QueryConsensusPeers           = types.QueryConsensusPeers
-	RouterKey                     = types.RouterKey
-	AttributeValueCategory        = types.AttributeValueCategory
-	EventTypeSyncHeader           = types.EventTypeSyncHeader
-	AttributeKeyChainId           = types.AttributeKeyChainId
-	AttributeKeyHeight            = types.AttributeKeyHeight
-	AttributeKeyBlockHash         = types.AttributeKeyBlockHash
-	AttributeKeyNativeChainHeight = types.AttributeKeyNativeChainHeight
+	ModuleName                      = types.ModuleName
+	DefaultParamspace               = types.DefaultParamspace
+	StoreKey                        = types.StoreKey
+	QuerierRoute                    = types.QuerierRoute
+	QueryConsensusPeers             = types.QueryConsensusPeers
+	QueryParameters                 = types.QueryParameters
+	QueryFrozenChain                = types.QueryFrozenChain
+	QueryEvidence                   = types.QueryEvidence
+	QueryConsensusEpochs            = types.QueryConsensusEpochs
+	QueryConsensusPeersByHeight     = types.QueryConsensusPeersByHeight
+	QueryRelayerReward              = types.QueryRelayerReward
+	QueryRelayerPool                = types.QueryRelayerPool
+	QuerySyncedChainIds             = types.QuerySyncedChainIds
+	RouterKey                       = types.RouterKey
+	AttributeValueCategory          = types.AttributeValueCategory
+	EventTypeSyncHeader             = types.EventTypeSyncHeader
+	EventTypeResetGenesisHeader     = types.EventTypeResetGenesisHeader
+	EventTypeFreezeChain            = types.EventTypeFreezeChain
+	EventTypeUnfreezeChain          = types.EventTypeUnfreezeChain
+	EventTypeFundRelayerPool        = types.EventTypeFundRelayerPool
+	EventTypeCreditRelayerReward    = types.EventTypeCreditRelayerReward
+	EventTypeClaimRelayerReward     = types.EventTypeClaimRelayerReward
+	AttributeKeyDepositor           = types.AttributeKeyDepositor
+	AttributeKeyRelayer             = types.AttributeKeyRelayer
+	AttributeKeyAmount              = types.AttributeKeyAmount
+	AttributeKeyRewardReason        = types.AttributeKeyRewardReason
+	AttributeValueRewardReasonEpoch = types.AttributeValueRewardReasonEpoch
+	AttributeValueRewardReasonProof = types.AttributeValueRewardReasonProof
+	AttributeKeySubmitter           = types.AttributeKeySubmitter
+	AttributeKeyChainId             = types.AttributeKeyChainId
+	AttributeKeyHeight              = types.AttributeKeyHeight
+	AttributeKeyBlockHash           = types.AttributeKeyBlockHash
+	AttributeKeyNativeChainHeight   = types.AttributeKeyNativeChainHeight
+	ProposalTypeSyncGenesisHeader   = types.ProposalTypeSyncGenesisHeader
+	ProposalTypeUnfreezeChain       = types.ProposalTypeUnfreezeChain
 )
 
 var (
-	ModuleCdc                    = types.ModuleCdc
-	RegisterCodec                = types.RegisterCodec
-	NewQuerier                   = keeper.NewQuerier
-	NewKeeper                    = keeper.NewKeeper
-	NewMsgSyncGenesisParam       = types.NewMsgSyncGenesisParam
-	NewMsgSyncHeadersParam       = types.NewMsgSyncHeadersParam
-	NewQueryConsensusPeersParams = types.NewQueryConsensusPeersParams
-	GetConsensusPeerKey          = keeper.GetConsensusPeerKey
-	ErrDeserializeHeader         = types.ErrDeserializeHeader
-	ErrMarshalSpecificTypeFail   = types.ErrMarshalSpecificTypeFail
-	ErrUnmarshalSpecificTypeFail = types.ErrUnmarshalSpecificTypeFail
-	ConsensusPeerPrefix          = keeper.ConsensusPeerPrefix
-	KeyHeaderHashPrefix          = keeper.KeyHeaderHashPrefix
+	ModuleCdc                            = types.ModuleCdc
+	RegisterCodec                        = types.RegisterCodec
+	NewQuerier                           = keeper.NewQuerier
+	NewQueryServer                       = keeper.NewQueryServer
+	NewKeeper                            = keeper.NewKeeper
+	ParamKeyTable                        = types.ParamKeyTable
+	DefaultParams                        = types.DefaultParams
+	NewGenesisState                      = types.NewGenesisState
+	DefaultGenesisState                  = types.DefaultGenesisState
+	ValidateGenesis                      = types.ValidateGenesis
+	NewSyncGenesisHeaderProposal         = types.NewSyncGenesisHeaderProposal
+	NewUnfreezeChainProposal             = types.NewUnfreezeChainProposal
+	NewMsgSubmitEquivocationEvidence     = types.NewMsgSubmitEquivocationEvidence
+	NewQueryFrozenChainParams            = types.NewQueryFrozenChainParams
+	NewQueryEvidenceParams               = types.NewQueryEvidenceParams
+	NewQueryConsensusEpochsParams        = types.NewQueryConsensusEpochsParams
+	NewQueryConsensusPeersByHeightParams = types.NewQueryConsensusPeersByHeightParams
+	NewQueryRelayerRewardParams          = types.NewQueryRelayerRewardParams
+	NewQuerySyncedChainIdsParams         = types.NewQuerySyncedChainIdsParams
+	NewMsgFundRelayerPool                = types.NewMsgFundRelayerPool
+	NewMsgClaimRelayerReward             = types.NewMsgClaimRelayerReward
+	NewMsgSyncGenesisParam               = types.NewMsgSyncGenesisParam
+	NewMsgSyncHeadersParam               = types.NewMsgSyncHeadersParam
+	NewQueryConsensusPeersParams         = types.NewQueryConsensusPeersParams
+	GetConsensusPeerKey                  = keeper.GetConsensusPeerKey
+	ErrDeserializeHeader                 = types.ErrDeserializeHeader
+	ErrMarshalSpecificTypeFail           = types.ErrMarshalSpecificTypeFail
+	ErrUnmarshalSpecificTypeFail         = types.ErrUnmarshalSpecificTypeFail
+	ErrGenesisSyncDisabled               = types.ErrGenesisSyncDisabled
+	ErrChainFrozen                       = types.ErrChainFrozen
+	ErrInvalidEvidence                   = types.ErrInvalidEvidence
+	ErrRelayerReward                     = types.ErrRelayerReward
+	ConsensusPeerPrefix                  = keeper.ConsensusPeerPrefix
+	KeyHeaderHashPrefix                  = keeper.KeyHeaderHashPrefix
+	FrozenChainPrefix                    = keeper.FrozenChainPrefix
+	EvidencePrefix                       = keeper.EvidencePrefix
+	ConsensusPeerHistoryPrefix           = keeper.ConsensusPeerHistoryPrefix
+	RelayerRewardPrefix                  = keeper.RelayerRewardPrefix
+	OutstandingRewardPrefix              = keeper.OutstandingRewardPrefix
 )
 
 type (
-	Keeper              = keeper.Keeper
-	ConsensusPeers      = types.ConsensusPeers
-	MsgSyncGenesisParam = types.MsgSyncGenesisParam
-	MsgSyncHeadersParam = types.MsgSyncHeadersParam
-	QueryHeaderParams   = types.QueryConsensusPeersParams
+	Keeper                        = keeper.Keeper
+	ConsensusPeers                = types.ConsensusPeers
+	MsgSyncGenesisParam           = types.MsgSyncGenesisParam
+	MsgSyncHeadersParam           = types.MsgSyncHeadersParam
+	QueryHeaderParams             = types.QueryConsensusPeersParams
+	GenesisState                  = types.GenesisState
+	Params                        = types.Params
+	SyncGenesisHeaderProposal     = types.SyncGenesisHeaderProposal
+	UnfreezeChainProposal         = types.UnfreezeChainProposal
+	MsgSubmitEquivocationEvidence = types.MsgSubmitEquivocationEvidence
+	EquivocationEvidence          = types.EquivocationEvidence
+	QueryFrozenChainRes           = types.QueryFrozenChainRes
+	MsgFundRelayerPool            = types.MsgFundRelayerPool
+	MsgClaimRelayerReward         = types.MsgClaimRelayerReward
+	RelayerPool                   = types.RelayerPool
+	SupplyKeeper                  = types.SupplyKeeper
 )
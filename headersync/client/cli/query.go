@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/version"
+	modulecommon "github.com/polynetwork/cosmos-poly-module/common"
 	polycommon "github.com/polynetwork/poly/common"
 	"strings"
 
@@ -29,6 +30,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/polynetwork/cosmos-poly-module/headersync/client/common"
 	"github.com/polynetwork/cosmos-poly-module/headersync/internal/types"
 	"strconv"
@@ -47,6 +49,14 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	ccQueryCmd.AddCommand(
 		flags.GetCommands(
 			GetCmdQueryConsensusPeers(queryRoute, cdc),
+			GetCmdQueryParams(queryRoute, cdc),
+			GetCmdQueryFrozenChain(queryRoute, cdc),
+			GetCmdQueryEvidence(queryRoute, cdc),
+			GetCmdQueryConsensusEpochs(queryRoute, cdc),
+			GetCmdQueryConsensusPeersByHeight(queryRoute, cdc),
+			GetCmdQueryRelayerReward(queryRoute, cdc),
+			GetCmdQueryRelayerPool(queryRoute, cdc),
+			GetCmdQuerySyncedChainIds(queryRoute, cdc),
 		)...,
 	)
 
@@ -92,3 +102,277 @@ $ %s query %s consensus-pper 0
 		},
 	}
 }
+
+func GetCmdQueryParams(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "parameters",
+		Args:  cobra.NoArgs,
+		Short: "Query the parameters of headersync module, including whether permissionless genesis header sync is allowed",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+
+Example:
+$ %s query %s parameters
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			paramsBs, err := common.QueryParams(cliCtx, queryRoute)
+			if err != nil {
+				return err
+			}
+			var params types.Params
+			if err := cdc.UnmarshalJSON(paramsBs, &params); err != nil {
+				return err
+			}
+			fmt.Printf("Paramters res is:\n %s\n", params.String())
+			return nil
+		},
+	}
+}
+
+func GetCmdQueryFrozenChain(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "frozen-chain [chainId]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query if a specific chainId is frozen due to equivocation evidence",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+
+Example:
+$ %s query %s frozen-chain 0
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			chainId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			res, err := common.QueryFrozenChain(cliCtx, queryRoute, chainId)
+			if err != nil {
+				return err
+			}
+			var frozen types.QueryFrozenChainRes
+			cdc.MustUnmarshalJSON(res, &frozen)
+			return cliCtx.PrintOutput(frozen)
+		},
+	}
+}
+
+func GetCmdQueryEvidence(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "evidence [chainId]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query all the equivocation evidences submitted for a specific chainId",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+
+Example:
+$ %s query %s evidence 0
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			chainId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			res, err := common.QueryEvidence(cliCtx, queryRoute, chainId)
+			if err != nil {
+				return err
+			}
+			var evidences []types.EquivocationEvidence
+			cdc.MustUnmarshalJSON(res, &evidences)
+			return cliCtx.PrintOutput(evidences)
+		},
+	}
+}
+
+func GetCmdQueryConsensusEpochs(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "consensus-epochs [chainId]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the start heights of all the consensus epochs synced of a specific chainId",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+
+Example:
+$ %s query %s consensus-epochs 0
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			chainId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			res, err := common.QueryConsensusEpochs(cliCtx, queryRoute, chainId)
+			if err != nil {
+				return err
+			}
+			var epochs []uint32
+			cdc.MustUnmarshalJSON(res, &epochs)
+			return cliCtx.PrintOutput(epochs)
+		},
+	}
+}
+
+func GetCmdQueryConsensusPeersByHeight(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "consensus-peer-by-height [chainId] [height]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query consensus peers info of a specific chainId active at height",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the consensus peers of the epoch with the largest start height not above height,
+headers after that start height are signed by these consensus peers
+
+Example:
+$ %s query %s consensus-peer-by-height 0 60000
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			chainId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			height, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return err
+			}
+			res, err := common.QueryConsensusPeersByHeight(cliCtx, queryRoute, chainId, uint32(height))
+			if err != nil {
+				return err
+			}
+			var cp types.ConsensusPeers
+			if err := cp.Deserialization(polycommon.NewZeroCopySource(res)); err != nil {
+				return err
+			}
+			fmt.Printf("ConsensusPeers at height %d is:\n %s\n", height, cp.String())
+			return nil
+		},
+	}
+}
+
+func GetCmdQueryRelayerReward(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "relayer-reward [address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the rewards credited to a relayer and not claimed yet",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+
+Example:
+$ %s query %s relayer-reward cosmos1...
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			relayer, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			res, err := common.QueryRelayerReward(cliCtx, queryRoute, relayer)
+			if err != nil {
+				return err
+			}
+			var reward sdk.Coins
+			cdc.MustUnmarshalJSON(res, &reward)
+			return cliCtx.PrintOutput(reward)
+		},
+	}
+}
+
+func GetCmdQueryRelayerPool(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "relayer-pool",
+		Args:  cobra.NoArgs,
+		Short: "Query the balance of the relayer reward pool and the rewards not claimed yet",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+
+Example:
+$ %s query %s relayer-pool
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, err := common.QueryRelayerPool(cliCtx, queryRoute)
+			if err != nil {
+				return err
+			}
+			var pool types.RelayerPool
+			cdc.MustUnmarshalJSON(res, &pool)
+			return cliCtx.PrintOutput(pool)
+		},
+	}
+}
+
+func GetCmdQuerySyncedChainIds(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "synced-chain-ids",
+		Args:  cobra.NoArgs,
+		Short: "Query the chainIds whose genesis header has been synced, page by page",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+
+Example:
+$ %s query %s synced-chain-ids --page 1 --limit 10
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			page, limit, err := getPageFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := common.QuerySyncedChainIds(cliCtx, queryRoute, page, limit)
+			if err != nil {
+				return err
+			}
+			var chainIds []uint64
+			cdc.MustUnmarshalJSON(res, &chainIds)
+			return cliCtx.PrintOutput(chainIds)
+		},
+	}
+	addPageFlags(cmd)
+	return cmd
+}
+
+func addPageFlags(cmd *cobra.Command) {
+	cmd.Flags().Int(flags.FlagPage, 1, "page of the results to query, starting from 1")
+	cmd.Flags().Int(flags.FlagLimit, modulecommon.DefaultPageLimit, "number of results per page")
+}
+
+func getPageFlags(cmd *cobra.Command) (page, limit int, err error) {
+	if page, err = cmd.Flags().GetInt(flags.FlagPage); err != nil {
+		return
+	}
+	limit, err = cmd.Flags().GetInt(flags.FlagLimit)
+	return
+}
@@ -21,6 +21,7 @@ import (
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/polynetwork/cosmos-poly-module/headersync/internal/types"
 )
 
@@ -33,3 +34,75 @@ func QueryConsensusPeers(cliCtx context.CLIContext, queryRoute string, chainId u
 	)
 	return res, err
 }
+
+func QueryParams(cliCtx context.CLIContext, queryRoute string) ([]byte, error) {
+
+	res, _, err := cliCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryParameters),
+		nil,
+	)
+	return res, err
+}
+
+func QueryFrozenChain(cliCtx context.CLIContext, queryRoute string, chainId uint64) ([]byte, error) {
+
+	res, _, err := cliCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryFrozenChain),
+		cliCtx.Codec.MustMarshalJSON(types.NewQueryFrozenChainParams(chainId)),
+	)
+	return res, err
+}
+
+func QueryEvidence(cliCtx context.CLIContext, queryRoute string, chainId uint64) ([]byte, error) {
+
+	res, _, err := cliCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryEvidence),
+		cliCtx.Codec.MustMarshalJSON(types.NewQueryEvidenceParams(chainId)),
+	)
+	return res, err
+}
+
+func QueryConsensusEpochs(cliCtx context.CLIContext, queryRoute string, chainId uint64) ([]byte, error) {
+
+	res, _, err := cliCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryConsensusEpochs),
+		cliCtx.Codec.MustMarshalJSON(types.NewQueryConsensusEpochsParams(chainId)),
+	)
+	return res, err
+}
+
+func QueryConsensusPeersByHeight(cliCtx context.CLIContext, queryRoute string, chainId uint64, height uint32) ([]byte, error) {
+
+	res, _, err := cliCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryConsensusPeersByHeight),
+		cliCtx.Codec.MustMarshalJSON(types.NewQueryConsensusPeersByHeightParams(chainId, height)),
+	)
+	return res, err
+}
+
+func QueryRelayerReward(cliCtx context.CLIContext, queryRoute string, relayer sdk.AccAddress) ([]byte, error) {
+
+	res, _, err := cliCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryRelayerReward),
+		cliCtx.Codec.MustMarshalJSON(types.NewQueryRelayerRewardParams(relayer)),
+	)
+	return res, err
+}
+
+func QueryRelayerPool(cliCtx context.CLIContext, queryRoute string) ([]byte, error) {
+
+	res, _, err := cliCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryRelayerPool),
+		nil,
+	)
+	return res, err
+}
+
+func QuerySyncedChainIds(cliCtx context.CLIContext, queryRoute string, page, limit int) ([]byte, error) {
+
+	res, _, err := cliCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/%s", queryRoute, types.QuerySyncedChainIds),
+		cliCtx.Codec.MustMarshalJSON(types.NewQuerySyncedChainIdsParams(page, limit)),
+	)
+	return res, err
+}
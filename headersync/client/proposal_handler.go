@@ -0,0 +1,30 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	"github.com/polynetwork/cosmos-poly-module/headersync/client/cli"
+	"github.com/polynetwork/cosmos-poly-module/headersync/client/rest"
+)
+
+// ProposalHandler handles sync genesis header proposals
+var ProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitSyncGenesisHeaderProposal, rest.ProposalRESTHandler)
+
+// UnfreezeChainProposalHandler handles unfreeze chain proposals
+var UnfreezeChainProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitUnfreezeChainProposal, rest.UnfreezeChainProposalRESTHandler)
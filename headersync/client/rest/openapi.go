@@ -0,0 +1,91 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package rest
+
+import (
+	"fmt"
+
+	"github.com/gorilla/mux"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/polynetwork/cosmos-poly-module/common/openapi"
+	"github.com/polynetwork/cosmos-poly-module/headersync/internal/types"
+)
+
+func registerOpenAPIRoute(r *mux.Router) {
+	r.HandleFunc("/headersync/"+openapi.FileName, openapi.Handler("headersync REST API", openAPIRoutes())).Methods("GET")
+}
+
+// openAPIRoutes describes the routes of RegisterRoutes and the governance proposal routes of headersync
+func openAPIRoutes() []openapi.Route {
+	chainIdParam := openapi.PathParam(ChainId, "integer", "poly chain id")
+	consensusPeers := openapi.Schema{"type": "string", "format": "binary", "description": "zero-copy serialized ConsensusPeers"}
+	return []openapi.Route{
+		{
+			Method:  "GET",
+			Path:    fmt.Sprintf("/headersync/current_consensus_peers/{%s}", ChainId),
+			Summary: "Consensus peers of the current epoch of the chain",
+			Params:  []openapi.Param{chainIdParam},
+			Result:  consensusPeers,
+		},
+		{
+			Method:  "GET",
+			Path:    fmt.Sprintf("/headersync/consensus_epochs/{%s}", ChainId),
+			Summary: "Start heights of the consensus epochs synced for the chain",
+			Params:  []openapi.Param{chainIdParam},
+			Result:  []uint32{},
+		},
+		{
+			Method:  "GET",
+			Path:    fmt.Sprintf("/headersync/consensus_peers/{%s}/{%s}", ChainId, Height),
+			Summary: "Consensus peers of the epoch containing the height",
+			Params:  []openapi.Param{chainIdParam, openapi.PathParam(Height, "integer", "poly block height")},
+			Result:  consensusPeers,
+		},
+		{
+			Method:  "GET",
+			Path:    fmt.Sprintf("/headersync/frozen_chain/{%s}", ChainId),
+			Summary: "Whether the chain is frozen by an equivocation",
+			Params:  []openapi.Param{chainIdParam},
+			Result:  types.QueryFrozenChainRes{},
+		},
+		{
+			Method:  "GET",
+			Path:    fmt.Sprintf("/headersync/evidence/{%s}", ChainId),
+			Summary: "Equivocation evidences submitted for the chain",
+			Params:  []openapi.Param{chainIdParam},
+			Result:  []types.EquivocationEvidence{},
+		},
+		{
+			Method:  "GET",
+			Path:    fmt.Sprintf("/headersync/relayer_reward/{%s}", Address),
+			Summary: "Rewards of the relayer not claimed yet",
+			Params:  []openapi.Param{openapi.PathParam(Address, "string", "bech32 account address")},
+			Result:  sdk.Coins{},
+		},
+		{Method: "GET", Path: "/headersync/relayer_pool", Summary: "Balance and outstanding rewards of the relayer pool", Result: types.RelayerPool{}},
+		{Method: "GET", Path: "/headersync/synced_chain_ids", Summary: "Ids of the chains with synced consensus peers", Params: openapi.PageParams(), Result: []uint64{}},
+		{Method: "GET", Path: "/headersync/parameters", Summary: "Parameters of headersync module", Result: types.Params{}},
+		{Method: "POST", Path: "/headersync/sync_headers", Summary: "Sync poly headers", Body: SyncHeadersReq{}},
+		{Method: "POST", Path: "/headersync/submit_evidence", Summary: "Submit two conflicting poly headers of the same height", Body: SubmitEvidenceReq{}},
+		{Method: "POST", Path: "/headersync/fund_relayer_pool", Summary: "Fund the relayer pool", Body: FundRelayerPoolReq{}},
+		{Method: "POST", Path: "/headersync/claim_relayer_reward", Summary: "Claim the rewards of the sender", Body: ClaimRelayerRewardReq{}},
+		{Method: "POST", Path: "/gov/proposals/sync_genesis_header", Summary: "Submit a proposal syncing the genesis header of a chain", Body: SyncGenesisHeaderProposalReq{}},
+		{Method: "POST", Path: "/gov/proposals/unfreeze_chain", Summary: "Submit a proposal unfreezing a chain", Body: UnfreezeChainProposalReq{}},
+	}
+}
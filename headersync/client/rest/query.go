@@ -24,7 +24,9 @@ import (
 	"github.com/gorilla/mux"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
+	polycommon "github.com/polynetwork/cosmos-poly-module/common"
 	"github.com/polynetwork/cosmos-poly-module/headersync/client/common"
 	"strconv"
 )
@@ -34,6 +36,46 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router, queryRoute st
 		fmt.Sprintf("/headersync/current_consensus_peers/{%s}", ChainId),
 		queryCurrentCPHandlerFn(cliCtx, queryRoute),
 	).Methods("GET")
+
+	r.HandleFunc(
+		fmt.Sprintf("/headersync/consensus_epochs/{%s}", ChainId),
+		queryConsensusEpochsHandlerFn(cliCtx, queryRoute),
+	).Methods("GET")
+
+	r.HandleFunc(
+		fmt.Sprintf("/headersync/consensus_peers/{%s}/{%s}", ChainId, Height),
+		queryConsensusPeersByHeightHandlerFn(cliCtx, queryRoute),
+	).Methods("GET")
+
+	r.HandleFunc(
+		fmt.Sprintf("/headersync/frozen_chain/{%s}", ChainId),
+		queryFrozenChainHandlerFn(cliCtx, queryRoute),
+	).Methods("GET")
+
+	r.HandleFunc(
+		fmt.Sprintf("/headersync/evidence/{%s}", ChainId),
+		queryEvidenceHandlerFn(cliCtx, queryRoute),
+	).Methods("GET")
+
+	r.HandleFunc(
+		fmt.Sprintf("/headersync/relayer_reward/{%s}", Address),
+		queryRelayerRewardHandlerFn(cliCtx, queryRoute),
+	).Methods("GET")
+
+	r.HandleFunc(
+		"/headersync/relayer_pool",
+		queryRelayerPoolHandlerFn(cliCtx, queryRoute),
+	).Methods("GET")
+
+	r.HandleFunc(
+		"/headersync/synced_chain_ids",
+		querySyncedChainIdsHandlerFn(cliCtx, queryRoute),
+	).Methods("GET")
+
+	r.HandleFunc(
+		"/headersync/parameters",
+		queryParamsHandlerFn(cliCtx, queryRoute),
+	).Methods("GET")
 }
 
 func queryCurrentCPHandlerFn(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
@@ -68,3 +110,162 @@ func checkResponseQueryCPResponse(
 	}
 	return res, true
 }
+
+func queryParamsHandlerFn(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+		res, err := common.QueryParams(cliCtx, queryRoute)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func queryFrozenChainHandlerFn(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+		chainId, err := strconv.ParseUint(mux.Vars(r)[ChainId], 10, 64)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		res, err := common.QueryFrozenChain(cliCtx, queryRoute, chainId)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func queryEvidenceHandlerFn(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+		chainId, err := strconv.ParseUint(mux.Vars(r)[ChainId], 10, 64)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		res, err := common.QueryEvidence(cliCtx, queryRoute, chainId)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func queryConsensusEpochsHandlerFn(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+		chainId, err := strconv.ParseUint(mux.Vars(r)[ChainId], 10, 64)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		res, err := common.QueryConsensusEpochs(cliCtx, queryRoute, chainId)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func queryConsensusPeersByHeightHandlerFn(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+		vars := mux.Vars(r)
+		chainId, err := strconv.ParseUint(vars[ChainId], 10, 64)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		height, err := strconv.ParseUint(vars[Height], 10, 32)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		res, err := common.QueryConsensusPeersByHeight(cliCtx, queryRoute, chainId, uint32(height))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func queryRelayerRewardHandlerFn(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+		relayer, err := sdk.AccAddressFromBech32(mux.Vars(r)[Address])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		res, err := common.QueryRelayerReward(cliCtx, queryRoute, relayer)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func queryRelayerPoolHandlerFn(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+		res, err := common.QueryRelayerPool(cliCtx, queryRoute)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// querySyncedChainIdsHandlerFn accepts the optional page and limit url query parameters
+func querySyncedChainIdsHandlerFn(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		_, page, limit, err := rest.ParseHTTPArgsWithLimit(r, polycommon.DefaultPageLimit)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, err := common.QuerySyncedChainIds(cliCtx, queryRoute, page, limit)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
@@ -25,10 +25,12 @@ import (
 const (
 	ChainId = "chain_id"
 	Height  = "height"
+	Address = "address"
 )
 
 // RegisterRoutes registers minting module REST handlers on the provided router.
 func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, queryRoute string) {
 	registerQueryRoutes(cliCtx, r, queryRoute)
 	registerTxRoutes(cliCtx, r)
+	registerOpenAPIRoute(r)
 }
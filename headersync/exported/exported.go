@@ -27,4 +27,6 @@ import (
 type HeaderSyncKeeper interface {
 	ProcessHeader(ctx sdk.Context, header *polytype.Header, headerProof []byte, curHeader *polytype.Header) error
 	GetConsensusPeers(ctx sdk.Context, chainId uint64) (*types.ConsensusPeers, error)
+	IsChainFrozen(ctx sdk.Context, chainId uint64) bool
+	RewardProofRelayer(ctx sdk.Context, relayer sdk.AccAddress)
 }
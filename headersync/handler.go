@@ -33,6 +33,12 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			return handleMsgGenesisHeader(ctx, k, msg)
 		case types.MsgSyncHeadersParam:
 			return handleMsgBlockHeaders(ctx, k, msg)
+		case types.MsgSubmitEquivocationEvidence:
+			return handleMsgSubmitEquivocationEvidence(ctx, k, msg)
+		case types.MsgFundRelayerPool:
+			return handleMsgFundRelayerPool(ctx, k, msg)
+		case types.MsgClaimRelayerReward:
+			return handleMsgClaimRelayerReward(ctx, k, msg)
 
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", types.ModuleName, msg)
@@ -41,6 +47,9 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 }
 
 func handleMsgGenesisHeader(ctx sdk.Context, k keeper.Keeper, msg types.MsgSyncGenesisParam) (*sdk.Result, error) {
+	if !k.GetParams(ctx).AllowPermissionlessGenesisSync {
+		return nil, types.ErrGenesisSyncDisabled("permissionless genesis header sync is disabled, submit a SyncGenesisHeaderProposal instead")
+	}
 	err := k.SyncGenesisHeader(ctx, msg.GenesisHeader)
 	if err != nil {
 		return nil, err
@@ -58,7 +67,55 @@ func handleMsgGenesisHeader(ctx sdk.Context, k keeper.Keeper, msg types.MsgSyncG
 
 // Handle MsgMultiSend.
 func handleMsgBlockHeaders(ctx sdk.Context, k keeper.Keeper, msg types.MsgSyncHeadersParam) (*sdk.Result, error) {
-	err := k.SyncBlockHeaders(ctx, msg.Headers)
+	err := k.SyncBlockHeaders(ctx, msg.Syncer, msg.Headers)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgSubmitEquivocationEvidence(ctx sdk.Context, k keeper.Keeper, msg types.MsgSubmitEquivocationEvidence) (*sdk.Result, error) {
+	err := k.SubmitEquivocationEvidence(ctx, msg.Submitter, msg.HeaderA, msg.HeaderB)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgFundRelayerPool(ctx sdk.Context, k keeper.Keeper, msg types.MsgFundRelayerPool) (*sdk.Result, error) {
+	err := k.FundRelayerPool(ctx, msg.Depositor, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgClaimRelayerReward(ctx sdk.Context, k keeper.Keeper, msg types.MsgClaimRelayerReward) (*sdk.Result, error) {
+	err := k.ClaimRelayerReward(ctx, msg.Relayer)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,163 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package keeper
+
+import (
+	"encoding/hex"
+	"fmt"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/polynetwork/cosmos-poly-module/common"
+	"github.com/polynetwork/cosmos-poly-module/headersync/internal/types"
+	headersyncpb "github.com/polynetwork/cosmos-poly-module/headersync/pb"
+	polycommon "github.com/polynetwork/poly/common"
+	polytype "github.com/polynetwork/poly/core/types"
+)
+
+// SubmitEquivocationEvidence verifies two different headers of the same height, not below the start of current
+// epoch, are both signed by the consensus peers, if so, stores the evidence and freezes the chain until governance unfreezes it
+func (keeper Keeper) SubmitEquivocationEvidence(ctx sdk.Context, submitter sdk.AccAddress, headerAStr, headerBStr string) error {
+	headerA, headerABs, err := decodeEvidenceHeader(headerAStr)
+	if err != nil {
+		return err
+	}
+	headerB, headerBBs, err := decodeEvidenceHeader(headerBStr)
+	if err != nil {
+		return err
+	}
+	if headerA.ChainID != headerB.ChainID || headerA.Height != headerB.Height {
+		return types.ErrInvalidEvidence(fmt.Sprintf("headers are not of the same chainId and height, got chainId: %d, height: %d and chainId: %d, height: %d",
+			headerA.ChainID, headerA.Height, headerB.ChainID, headerB.Height))
+	}
+	if headerA.Hash() == headerB.Hash() {
+		return types.ErrInvalidEvidence("headers are identical")
+	}
+	// peers of a previous epoch are retired, an equivocation of them cannot affect the headers synced any more
+	consensusPeers, err := keeper.GetConsensusPeers(ctx, headerA.ChainID)
+	if err != nil {
+		return err
+	}
+	if headerA.Height < consensusPeers.Height {
+		return types.ErrInvalidEvidence(fmt.Sprintf("height: %d is below the start height: %d of current epoch", headerA.Height, consensusPeers.Height))
+	}
+	if keeper.GetEquivocationEvidence(ctx, headerA.ChainID, headerA.Height) != nil {
+		return types.ErrInvalidEvidence(fmt.Sprintf("evidence of chainId: %d, height: %d already exists", headerA.ChainID, headerA.Height))
+	}
+
+	for _, header := range []*polytype.Header{headerA, headerB} {
+		if err := keeper.VerifyHeaderSigByEpoch(ctx, header); err != nil {
+			hash := header.Hash()
+			return types.ErrInvalidEvidence(fmt.Sprintf("header: %s is not signed by consensus peers, Error: %s", hash.ToHexString(), err.Error()))
+		}
+	}
+
+	evidence := types.EquivocationEvidence{
+		ChainID:     headerA.ChainID,
+		Height:      headerA.Height,
+		HeaderA:     headerABs,
+		HeaderB:     headerBBs,
+		Submitter:   submitter,
+		SubmittedAt: ctx.BlockHeight(),
+	}
+	if err := keeper.SetEquivocationEvidence(ctx, evidence); err != nil {
+		return err
+	}
+	keeper.FreezeChain(ctx, evidence.ChainID)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeFreezeChain,
+			sdk.NewAttribute(types.AttributeKeyChainId, fmt.Sprintf("%d", evidence.ChainID)),
+			sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprintf("%d", evidence.Height)),
+			sdk.NewAttribute(types.AttributeKeySubmitter, submitter.String()),
+		),
+	})
+	return nil
+}
+
+func decodeEvidenceHeader(headerStr string) (*polytype.Header, []byte, error) {
+	headerBs, err := hex.DecodeString(headerStr)
+	if err != nil {
+		return nil, nil, types.ErrInvalidEvidence(fmt.Sprintf("hex.DecodeString error: %s", err.Error()))
+	}
+	header := new(polytype.Header)
+	if err := header.Deserialization(polycommon.NewZeroCopySource(headerBs)); err != nil {
+		return nil, nil, types.ErrDeserializeHeader(err)
+	}
+	return header, headerBs, nil
+}
+
+func (keeper Keeper) SetEquivocationEvidence(ctx sdk.Context, evidence types.EquivocationEvidence) error {
+	store := ctx.KVStore(keeper.storeKey)
+	bz, err := types.EquivocationEvidenceToProto(evidence).Marshal()
+	if err != nil {
+		return types.ErrMarshalSpecificTypeFail(evidence, err)
+	}
+	store.Set(GetEvidenceKey(evidence.ChainID, evidence.Height), bz)
+	return nil
+}
+
+func (keeper Keeper) GetEquivocationEvidence(ctx sdk.Context, chainId uint64, height uint32) *types.EquivocationEvidence {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(GetEvidenceKey(chainId, height))
+	if bz == nil {
+		return nil
+	}
+	var pb headersyncpb.EquivocationEvidence
+	common.MustUnmarshalProto(bz, &pb)
+	evidence := types.EquivocationEvidenceFromProto(&pb)
+	return &evidence
+}
+
+// GetAllEquivocationEvidence returns all the evidences of chainId ordered by height
+func (keeper Keeper) GetAllEquivocationEvidence(ctx sdk.Context, chainId uint64) []types.EquivocationEvidence {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, GetEvidencePrefix(chainId))
+	defer iterator.Close()
+
+	evidences := make([]types.EquivocationEvidence, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var pb headersyncpb.EquivocationEvidence
+		common.MustUnmarshalProto(iterator.Value(), &pb)
+		evidences = append(evidences, types.EquivocationEvidenceFromProto(&pb))
+	}
+	return evidences
+}
+
+func (keeper Keeper) FreezeChain(ctx sdk.Context, chainId uint64) {
+	ctx.KVStore(keeper.storeKey).Set(GetFrozenChainKey(chainId), []byte{0x01})
+}
+
+// UnfreezeChain lifts the freeze of chainId, it should only be reached through governance
+func (keeper Keeper) UnfreezeChain(ctx sdk.Context, chainId uint64) error {
+	if !keeper.IsChainFrozen(ctx, chainId) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "chainId: %d is not frozen", chainId)
+	}
+	ctx.KVStore(keeper.storeKey).Delete(GetFrozenChainKey(chainId))
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUnfreezeChain,
+			sdk.NewAttribute(types.AttributeKeyChainId, fmt.Sprintf("%d", chainId)),
+		),
+	})
+	return nil
+}
+
+func (keeper Keeper) IsChainFrozen(ctx sdk.Context, chainId uint64) bool {
+	return ctx.KVStore(keeper.storeKey).Has(GetFrozenChainKey(chainId))
+}
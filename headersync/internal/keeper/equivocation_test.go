@@ -0,0 +1,155 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package keeper_test
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/polynetwork/cosmos-poly-module/headersync"
+	"github.com/polynetwork/cosmos-poly-module/headersync/internal/types"
+	polyaccount "github.com/polynetwork/poly/account"
+	polycommon "github.com/polynetwork/poly/common"
+	vconfig "github.com/polynetwork/poly/consensus/vbft/config"
+	polysig "github.com/polynetwork/poly/core/signature"
+	polytype "github.com/polynetwork/poly/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newPolyAccounts(n int) []*polyaccount.Account {
+	accounts := make([]*polyaccount.Account, n)
+	for i := range accounts {
+		accounts[i] = polyaccount.NewAccount("")
+	}
+	return accounts
+}
+
+// makeSignedHeader builds a hex encoded poly header signed by signers, carrying the NewChainConfig of nextPeers if not empty
+func makeSignedHeader(t *testing.T, chainId uint64, height uint32, timestamp uint32, signers []*polyaccount.Account, nextPeers []*polyaccount.Account) string {
+	blkInfo := &vconfig.VbftBlockInfo{}
+	if len(nextPeers) != 0 {
+		blkInfo.NewChainConfig = &vconfig.ChainConfig{}
+		for i, acc := range nextPeers {
+			blkInfo.NewChainConfig.Peers = append(blkInfo.NewChainConfig.Peers, &vconfig.PeerConfig{Index: uint32(i), ID: vconfig.PubkeyID(acc.PublicKey)})
+		}
+	}
+	payload, err := json.Marshal(blkInfo)
+	require.NoError(t, err)
+
+	header := &polytype.Header{
+		ChainID:          chainId,
+		Height:           height,
+		Timestamp:        timestamp,
+		ConsensusPayload: payload,
+	}
+	hash := header.Hash()
+	for _, acc := range signers {
+		sig, err := polysig.Sign(acc, hash[:])
+		require.NoError(t, err)
+		header.Bookkeepers = append(header.Bookkeepers, acc.PublicKey)
+		header.SigData = append(header.SigData, sig)
+	}
+	sink := polycommon.NewZeroCopySink(nil)
+	require.NoError(t, header.Serialization(sink))
+	return hex.EncodeToString(sink.Bytes())
+}
+
+func Test_headersync_SubmitEquivocationEvidence(t *testing.T) {
+	app, ctx := createTestApp(false)
+	var chainId uint64 = 1
+	peers := newPolyAccounts(4)
+
+	err := app.HeaderSyncKeeper.SyncGenesisHeader(ctx, makeSignedHeader(t, chainId, 0, 0, nil, peers))
+	require.NoError(t, err)
+
+	headerA := makeSignedHeader(t, chainId, 10, 100, peers, nil)
+	headerB := makeSignedHeader(t, chainId, 10, 200, peers, nil)
+	unsignedHeaderB := makeSignedHeader(t, chainId, 10, 200, newPolyAccounts(4), nil)
+	otherHeightHeaderB := makeSignedHeader(t, chainId, 11, 200, peers, nil)
+	submitter := sdk.AccAddress([]byte("submitter"))
+
+	tcs := []struct {
+		headerA string
+		headerB string
+		expErr  bool
+	}{
+		{headerA, headerA, true},
+		{headerA, unsignedHeaderB, true},
+		{headerA, otherHeightHeaderB, true},
+		{headerA, headerB, false},
+		{headerB, headerA, true},
+	}
+	for i, tc := range tcs {
+		err := app.HeaderSyncKeeper.SubmitEquivocationEvidence(ctx, submitter, tc.headerA, tc.headerB)
+		if tc.expErr {
+			assert.Error(t, err, "case %d", i)
+		} else {
+			assert.NoError(t, err, "case %d", i)
+		}
+	}
+
+	require.True(t, app.HeaderSyncKeeper.IsChainFrozen(ctx, chainId))
+	evidences := app.HeaderSyncKeeper.GetAllEquivocationEvidence(ctx, chainId)
+	require.Equal(t, 1, len(evidences))
+	assert.Equal(t, uint32(10), evidences[0].Height)
+	assert.Equal(t, submitter, evidences[0].Submitter)
+
+	// frozen chain rejects any header until governance unfreezes it
+	err = app.HeaderSyncKeeper.SyncBlockHeaders(ctx, nil, []string{makeSignedHeader(t, chainId, 12, 300, peers, nil)})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), types.ErrChainFrozen(chainId).Error())
+
+	proposalHandler := headersync.NewProposalHandler(app.HeaderSyncKeeper)
+	err = proposalHandler(ctx, types.NewUnfreezeChainProposal("title", "description", chainId))
+	require.NoError(t, err)
+	assert.False(t, app.HeaderSyncKeeper.IsChainFrozen(ctx, chainId))
+	err = proposalHandler(ctx, types.NewUnfreezeChainProposal("title", "description", chainId))
+	assert.Error(t, err)
+
+	err = app.HeaderSyncKeeper.SyncBlockHeaders(ctx, nil, []string{makeSignedHeader(t, chainId, 12, 300, peers, nil)})
+	assert.NoError(t, err)
+}
+
+func Test_headersync_SubmitEquivocationEvidence_OldEpoch(t *testing.T) {
+	app, ctx := createTestApp(false)
+	var chainId uint64 = 1
+	oldPeers := newPolyAccounts(4)
+	newPeers := newPolyAccounts(4)
+
+	err := app.HeaderSyncKeeper.SyncGenesisHeader(ctx, makeSignedHeader(t, chainId, 0, 0, nil, oldPeers))
+	require.NoError(t, err)
+	err = app.HeaderSyncKeeper.SyncBlockHeaders(ctx, nil, []string{makeSignedHeader(t, chainId, 20, 100, oldPeers, newPeers)})
+	require.NoError(t, err)
+
+	submitter := sdk.AccAddress([]byte("submitter"))
+	// retired peers of the previous epoch equivocate
+	err = app.HeaderSyncKeeper.SubmitEquivocationEvidence(ctx, submitter,
+		makeSignedHeader(t, chainId, 10, 100, oldPeers, nil), makeSignedHeader(t, chainId, 10, 200, oldPeers, nil))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "below the start height")
+	assert.False(t, app.HeaderSyncKeeper.IsChainFrozen(ctx, chainId))
+	assert.Equal(t, 0, len(app.HeaderSyncKeeper.GetAllEquivocationEvidence(ctx, chainId)))
+
+	err = app.HeaderSyncKeeper.SubmitEquivocationEvidence(ctx, submitter,
+		makeSignedHeader(t, chainId, 30, 100, newPeers, nil), makeSignedHeader(t, chainId, 30, 200, newPeers, nil))
+	require.NoError(t, err)
+	assert.True(t, app.HeaderSyncKeeper.IsChainFrozen(ctx, chainId))
+}
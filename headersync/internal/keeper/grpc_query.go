@@ -0,0 +1,99 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/polynetwork/cosmos-poly-module/common"
+	"github.com/polynetwork/cosmos-poly-module/headersync/internal/types"
+	headersyncpb "github.com/polynetwork/cosmos-poly-module/headersync/pb"
+)
+
+type queryServer struct {
+	keeper Keeper
+}
+
+var _ headersyncpb.QueryServer = queryServer{}
+
+// NewQueryServer returns the gRPC query service of headersync module, it serves the legacy queries with typed messages
+func NewQueryServer(keeper Keeper) headersyncpb.QueryServer {
+	return queryServer{keeper}
+}
+
+func (q queryServer) ConsensusPeers(goCtx context.Context, req *headersyncpb.QueryConsensusPeersRequest) (*headersyncpb.QueryConsensusPeersResponse, error) {
+	ctx := common.UnwrapSDKContext(goCtx)
+	consensusPeers, err := q.keeper.GetConsensusPeers(ctx, req.ChainId)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "failed to get consensus peers for chainId: %d, Error: %s", req.ChainId, err)
+	}
+	return &headersyncpb.QueryConsensusPeersResponse{ConsensusPeers: types.ConsensusPeersToProto(*consensusPeers)}, nil
+}
+
+func (q queryServer) Params(goCtx context.Context, _ *headersyncpb.QueryParamsRequest) (*headersyncpb.QueryParamsResponse, error) {
+	ctx := common.UnwrapSDKContext(goCtx)
+	return &headersyncpb.QueryParamsResponse{Params: types.ParamsToProto(q.keeper.GetParams(ctx))}, nil
+}
+
+func (q queryServer) FrozenChain(goCtx context.Context, req *headersyncpb.QueryFrozenChainRequest) (*headersyncpb.QueryFrozenChainResponse, error) {
+	ctx := common.UnwrapSDKContext(goCtx)
+	return &headersyncpb.QueryFrozenChainResponse{ChainId: req.ChainId, Frozen: q.keeper.IsChainFrozen(ctx, req.ChainId)}, nil
+}
+
+func (q queryServer) Evidence(goCtx context.Context, req *headersyncpb.QueryEvidenceRequest) (*headersyncpb.QueryEvidenceResponse, error) {
+	ctx := common.UnwrapSDKContext(goCtx)
+	evidences := q.keeper.GetAllEquivocationEvidence(ctx, req.ChainId)
+	return &headersyncpb.QueryEvidenceResponse{Evidences: types.EquivocationEvidencesToProto(evidences)}, nil
+}
+
+func (q queryServer) ConsensusEpochs(goCtx context.Context, req *headersyncpb.QueryConsensusEpochsRequest) (*headersyncpb.QueryConsensusEpochsResponse, error) {
+	ctx := common.UnwrapSDKContext(goCtx)
+	return &headersyncpb.QueryConsensusEpochsResponse{Heights: q.keeper.GetConsensusEpochs(ctx, req.ChainId)}, nil
+}
+
+func (q queryServer) ConsensusPeersByHeight(goCtx context.Context, req *headersyncpb.QueryConsensusPeersByHeightRequest) (*headersyncpb.QueryConsensusPeersByHeightResponse, error) {
+	ctx := common.UnwrapSDKContext(goCtx)
+	consensusPeers, err := q.keeper.GetConsensusPeersByHeight(ctx, req.ChainId, req.Height)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "failed to get consensus peers for chainId: %d at height: %d, Error: %s", req.ChainId, req.Height, err)
+	}
+	return &headersyncpb.QueryConsensusPeersByHeightResponse{ConsensusPeers: types.ConsensusPeersToProto(*consensusPeers)}, nil
+}
+
+func (q queryServer) RelayerReward(goCtx context.Context, req *headersyncpb.QueryRelayerRewardRequest) (*headersyncpb.QueryRelayerRewardResponse, error) {
+	ctx := common.UnwrapSDKContext(goCtx)
+	relayer, err := sdk.AccAddressFromBech32(req.Relayer)
+	if err != nil {
+		return nil, err
+	}
+	return &headersyncpb.QueryRelayerRewardResponse{Reward: common.CoinsToProto(q.keeper.GetRelayerReward(ctx, relayer))}, nil
+}
+
+func (q queryServer) RelayerPool(goCtx context.Context, _ *headersyncpb.QueryRelayerPoolRequest) (*headersyncpb.QueryRelayerPoolResponse, error) {
+	ctx := common.UnwrapSDKContext(goCtx)
+	pool := q.keeper.GetRelayerPool(ctx)
+	return &headersyncpb.QueryRelayerPoolResponse{Balance: common.CoinsToProto(pool.Balance), Outstanding: common.CoinsToProto(pool.Outstanding)}, nil
+}
+
+func (q queryServer) SyncedChainIds(goCtx context.Context, req *headersyncpb.QuerySyncedChainIdsRequest) (*headersyncpb.QuerySyncedChainIdsResponse, error) {
+	ctx := common.UnwrapSDKContext(goCtx)
+	page, limit := common.PageFromProto(req.Pagination)
+	return &headersyncpb.QuerySyncedChainIdsResponse{ChainIds: q.keeper.GetSyncedChainIds(ctx, page, limit)}, nil
+}
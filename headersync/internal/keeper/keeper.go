@@ -19,44 +19,65 @@ package keeper
 
 import (
 	"bytes"
+	"encoding/binary"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/polynetwork/cosmos-poly-module/common"
 	"github.com/polynetwork/cosmos-poly-module/headersync/internal/types"
+	headersyncpb "github.com/polynetwork/cosmos-poly-module/headersync/pb"
 	polycommon "github.com/polynetwork/poly/common"
 	vconfig "github.com/polynetwork/poly/consensus/vbft/config"
 	polysig "github.com/polynetwork/poly/core/signature"
 	polytype "github.com/polynetwork/poly/core/types"
 	"github.com/polynetwork/poly/merkle"
+	"github.com/tendermint/tendermint/libs/log"
 )
 
 // Keeper of the mint store
 type Keeper struct {
-	cdc      *codec.Codec
-	storeKey sdk.StoreKey
+	cdc          *codec.Codec
+	storeKey     sdk.StoreKey
+	paramSpace   params.Subspace
+	supplyKeeper types.SupplyKeeper
 }
 
 // NewKeeper creates a new mint Keeper instance
 func NewKeeper(
-	cdc *codec.Codec, key sdk.StoreKey) Keeper {
+	cdc *codec.Codec, key sdk.StoreKey, paramSpace params.Subspace, supplyKeeper types.SupplyKeeper) Keeper {
 	return Keeper{
-		cdc:      cdc,
-		storeKey: key,
+		cdc:          cdc,
+		storeKey:     key,
+		paramSpace:   paramSpace.WithKeyTable(types.ParamKeyTable()),
+		supplyKeeper: supplyKeeper,
 	}
 }
 
-func (keeper Keeper) SyncGenesisHeader(ctx sdk.Context, genesisHeaderStr string) error {
-	genesisHeader := &polytype.Header{}
+func (keeper Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
 
-	genesisHeaderBytes, err := hex.DecodeString(genesisHeaderStr)
-	if err != nil {
-		return types.ErrSyncGenesisHeader(fmt.Sprintf("hex.DecodeString error: %s", err.Error()))
+// GetParams returns the total set of headersync parameters, the parameters missing in a chain upgraded to
+// introduce them read as zero values
+func (keeper Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	for _, pair := range params.ParamSetPairs() {
+		keeper.paramSpace.GetIfExists(ctx, pair.Key, pair.Value)
 	}
-	source := polycommon.NewZeroCopySource(genesisHeaderBytes)
-	if err := genesisHeader.Deserialization(source); err != nil {
-		return types.ErrDeserializeHeader(err)
+	return params
+}
+
+// SetParams sets the total set of headersync parameters.
+func (keeper Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	keeper.paramSpace.SetParamSet(ctx, &params)
+}
+
+func (keeper Keeper) SyncGenesisHeader(ctx sdk.Context, genesisHeaderStr string) error {
+	genesisHeader, err := deserializeGenesisHeader(genesisHeaderStr)
+	if err != nil {
+		return err
 	}
 	if consensusPeer, _ := keeper.GetConsensusPeers(ctx, genesisHeader.ChainID); consensusPeer != nil {
 		return types.ErrSyncGenesisHeader(fmt.Sprintf("Genesis Header already synced, ConsensusPeers exists: %s", consensusPeer.String()))
@@ -71,7 +92,52 @@ func (keeper Keeper) SyncGenesisHeader(ctx sdk.Context, genesisHeaderStr string)
 	return nil
 }
 
-func (keeper Keeper) SyncBlockHeaders(ctx sdk.Context, headerStrs []string) error {
+// ResetGenesisHeader overwrites the consensus peers and key header hash of the header's chainId
+// no matter whether a genesis header has been synced before, it should only be reached through governance
+func (keeper Keeper) ResetGenesisHeader(ctx sdk.Context, genesisHeaderStr string) error {
+	genesisHeader, err := deserializeGenesisHeader(genesisHeaderStr)
+	if err != nil {
+		return err
+	}
+	// Make sure the header contains poly.NewChainConfig info before dropping the consensus peers synced so far
+	blkInfo := &vconfig.VbftBlockInfo{}
+	if err := json.Unmarshal(genesisHeader.ConsensusPayload, blkInfo); err != nil {
+		return types.ErrUnmarshalSpecificTypeFail(blkInfo, err)
+	}
+	if blkInfo.NewChainConfig == nil {
+		return types.ErrSyncGenesisHeader(fmt.Sprintf("Header of chainId: %d, height: %d contains no NewChainConfig", genesisHeader.ChainID, genesisHeader.Height))
+	}
+	keeper.clearConsensusPeersHistory(ctx, genesisHeader.ChainID)
+	if err := keeper.UpdateConsensusPeer(ctx, genesisHeader); err != nil {
+		return err
+	}
+	keyHeaderHash := genesisHeader.Hash()
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeResetGenesisHeader,
+			sdk.NewAttribute(types.AttributeKeyChainId, fmt.Sprintf("%d", genesisHeader.ChainID)),
+			sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprintf("%d", genesisHeader.Height)),
+			sdk.NewAttribute(types.AttributeKeyBlockHash, keyHeaderHash.ToHexString()),
+		),
+	})
+	return nil
+}
+
+func deserializeGenesisHeader(genesisHeaderStr string) (*polytype.Header, error) {
+	genesisHeader := &polytype.Header{}
+
+	genesisHeaderBytes, err := hex.DecodeString(genesisHeaderStr)
+	if err != nil {
+		return nil, types.ErrSyncGenesisHeader(fmt.Sprintf("hex.DecodeString error: %s", err.Error()))
+	}
+	source := polycommon.NewZeroCopySource(genesisHeaderBytes)
+	if err := genesisHeader.Deserialization(source); err != nil {
+		return nil, types.ErrDeserializeHeader(err)
+	}
+	return genesisHeader, nil
+}
+
+func (keeper Keeper) SyncBlockHeaders(ctx sdk.Context, syncer sdk.AccAddress, headerStrs []string) error {
 	for _, headerStr := range headerStrs {
 		header := &polytype.Header{}
 		headerBs, err := hex.DecodeString(headerStr)
@@ -82,14 +148,24 @@ func (keeper Keeper) SyncBlockHeaders(ctx sdk.Context, headerStrs []string) erro
 		if err := header.Deserialization(source); err != nil {
 			return types.ErrDeserializeHeader(err)
 		}
+		var epochBefore uint32
+		if consensusPeers, err := keeper.GetConsensusPeers(ctx, header.ChainID); err == nil {
+			epochBefore = consensusPeers.Height
+		}
 		if err := keeper.ProcessHeader(ctx, header, nil, nil); err != nil {
 			return types.ErrSyncBlockHeader("ProcessHeader", header.ChainID, header.Height, err)
 		}
+		if consensusPeers, err := keeper.GetConsensusPeers(ctx, header.ChainID); err == nil && consensusPeers.Height != epochBefore {
+			keeper.creditRelayerReward(ctx, syncer, keeper.getRelayerRewardParam(ctx, types.KeyRelayerRewardPerEpoch), types.AttributeValueRewardReasonEpoch)
+		}
 	}
 	return nil
 }
 
 func (keeper Keeper) ProcessHeader(ctx sdk.Context, header *polytype.Header, headerProof []byte, curHeader *polytype.Header) error {
+	if keeper.IsChainFrozen(ctx, header.ChainID) {
+		return types.ErrChainFrozen(header.ChainID)
+	}
 	// header to be checked if containing valid NewChainConfig
 	var cpHeader *polytype.Header
 	if curHeader == nil || headerProof == nil {
@@ -97,6 +173,8 @@ func (keeper Keeper) ProcessHeader(ctx sdk.Context, header *polytype.Header, hea
 			if err := keeper.VerifyHeaderByKeyHeaderHash(ctx, header); err == nil {
 				return nil
 			}
+			// header of an older epoch is never trusted by the peers of its own epoch, the peers retired may sign
+			// any header of their epoch, so it goes through VerifyHistoricalHeader with a proof to a current header
 			return err
 		}
 		cpHeader = header
@@ -122,7 +200,11 @@ func (keeper Keeper) VerifyHeaderSig(ctx sdk.Context, header *polytype.Header) e
 		return types.ErrSyncBlockHeader("Compare height", header.ChainID, header.Height,
 			fmt.Errorf("Stored consensus header.Height: %d, trying to sync height:%d", consensusPeer.Height, header.Height))
 	}
+	return verifyHeaderSigByPeers(header, consensusPeer)
+}
 
+// verifyHeaderSigByPeers checks the header is signed by more than 2/3 of the consensus peers
+func verifyHeaderSigByPeers(header *polytype.Header, consensusPeer *types.ConsensusPeers) error {
 	if len(header.Bookkeepers)*3 < len(consensusPeer.PeerMap)*2 {
 		return types.ErrBookKeeperNum(len(header.Bookkeepers), len(consensusPeer.PeerMap))
 	}
@@ -135,10 +217,25 @@ func (keeper Keeper) VerifyHeaderSig(ctx sdk.Context, header *polytype.Header) e
 	}
 	hash := header.Hash()
 	if e := polysig.VerifyMultiSignature(hash[:], header.Bookkeepers, len(header.Bookkeepers), header.SigData); e != nil {
-		return types.ErrVerifyMultiSigFail(err, header.Height)
+		return types.ErrVerifyMultiSigFail(e, header.Height)
 	}
 	return nil
 }
+
+// VerifyHeaderSigByEpoch checks the header is signed by the consensus peers active when it was produced, it only
+// attributes the signatures of an equivocation and must not be used to trust the roots of the header
+func (keeper Keeper) VerifyHeaderSigByEpoch(ctx sdk.Context, header *polytype.Header) error {
+	if header.Height == 0 {
+		return types.ErrSyncBlockHeader("GetConsensusPeersByHeight", header.ChainID, header.Height, fmt.Errorf("no epoch before height 0"))
+	}
+	// the header at the start height of an epoch is still signed by the peers of the previous epoch
+	consensusPeer, err := keeper.GetConsensusPeersByHeight(ctx, header.ChainID, header.Height-1)
+	if err != nil {
+		return types.ErrSyncBlockHeader("GetConsensusPeersByHeight", header.ChainID, header.Height, err)
+	}
+	return verifyHeaderSigByPeers(header, consensusPeer)
+}
+
 func (keeper Keeper) VerifyHeaderByKeyHeaderHash(ctx sdk.Context, header *polytype.Header) error {
 	headerHash := header.Hash()
 	keyHeaderHash, err := keeper.GetKeyHeaderHash(ctx, header.ChainID)
@@ -178,23 +275,66 @@ func (keeper Keeper) UpdateConsensusPeer(ctx sdk.Context, header *polytype.Heade
 
 func (keeper Keeper) SetConsensusPeers(ctx sdk.Context, consensusPeers types.ConsensusPeers) error {
 	store := ctx.KVStore(keeper.storeKey)
-	sink := polycommon.NewZeroCopySink(nil)
-	consensusPeers.Serialization(sink)
-	store.Set(GetConsensusPeerKey(consensusPeers.ChainID), sink.Bytes())
+	bz := common.MustMarshalProto(types.ConsensusPeersToProto(consensusPeers))
+	store.Set(GetConsensusPeerKey(consensusPeers.ChainID), bz)
+	store.Set(GetConsensusPeerHistoryKey(consensusPeers.ChainID, consensusPeers.Height), bz)
 	return nil
 }
 
+// GetConsensusPeersByHeight returns the consensus peers of the epoch with the largest start height not above height
+func (keeper Keeper) GetConsensusPeersByHeight(ctx sdk.Context, chainId uint64, height uint32) (*types.ConsensusPeers, error) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := store.ReverseIterator(GetConsensusPeerHistoryPrefix(chainId), sdk.PrefixEndBytes(GetConsensusPeerHistoryKey(chainId, height)))
+	defer iterator.Close()
+	if !iterator.Valid() {
+		return nil, types.ErrGetConsensusPeers(chainId)
+	}
+	return decodeConsensusPeers(iterator.Value())
+}
+
+// GetConsensusEpochs returns the start heights of all the epochs synced of chainId in ascending order
+func (keeper Keeper) GetConsensusEpochs(ctx sdk.Context, chainId uint64) []uint32 {
+	store := ctx.KVStore(keeper.storeKey)
+	prefix := GetConsensusPeerHistoryPrefix(chainId)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	heights := make([]uint32, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		heights = append(heights, binary.BigEndian.Uint32(iterator.Key()[len(prefix):]))
+	}
+	return heights
+}
+
+func (keeper Keeper) clearConsensusPeersHistory(ctx sdk.Context, chainId uint64) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, GetConsensusPeerHistoryPrefix(chainId))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
 func (keeper Keeper) GetConsensusPeers(ctx sdk.Context, chainId uint64) (*types.ConsensusPeers, error) {
 	store := ctx.KVStore(keeper.storeKey)
 	consensusPeerBytes := store.Get(GetConsensusPeerKey(chainId))
 	if consensusPeerBytes == nil {
 		return nil, types.ErrGetConsensusPeers(chainId)
 	}
-	consensusPeers := new(types.ConsensusPeers)
-	if err := consensusPeers.Deserialization(polycommon.NewZeroCopySource(consensusPeerBytes)); err != nil {
+	return decodeConsensusPeers(consensusPeerBytes)
+}
+
+func decodeConsensusPeers(bz []byte) (*types.ConsensusPeers, error) {
+	var pb headersyncpb.ConsensusPeers
+	if err := pb.Unmarshal(bz); err != nil {
 		return nil, types.ErrDeserializeConsensusPeer(err)
 	}
-	return consensusPeers, nil
+	return types.ConsensusPeersFromProto(&pb), nil
 }
 
 func (keeper Keeper) SetKeyHeaderHash(ctx sdk.Context, chainId uint64, keyHeaderHash polycommon.Uint256) error {
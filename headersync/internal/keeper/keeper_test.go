@@ -22,12 +22,15 @@ import (
 	"encoding/json"
 	"fmt"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/polynetwork/cosmos-poly-module/headersync"
 	"github.com/polynetwork/cosmos-poly-module/headersync/internal/types"
 	"github.com/polynetwork/cosmos-poly-module/simapp"
 	polycommon "github.com/polynetwork/poly/common"
 	vconfig "github.com/polynetwork/poly/consensus/vbft/config"
 	polytype "github.com/polynetwork/poly/core/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"testing"
 )
@@ -85,7 +88,7 @@ func Test_headersync_SyncHeaders(t *testing.T) {
 	consensusPeers.Serialization(resSink)
 	assert.Equal(t, cpBs, resSink.Bytes())
 
-	err = app.HeaderSyncKeeper.SyncBlockHeaders(ctx, []string{header1, header789, header100, header60000, header60005})
+	err = app.HeaderSyncKeeper.SyncBlockHeaders(ctx, nil, []string{header1, header789, header100, header60000, header60005})
 	assert.Nil(t, err, "Sync Poly Chain block headers fail")
 
 	consensusPeers, err = app.HeaderSyncKeeper.GetConsensusPeers(ctx, chainId)
@@ -106,6 +109,74 @@ func Test_headersync_SyncHeaders(t *testing.T) {
 	assert.Equal(t, cpBs, resSink.Bytes())
 }
 
+func Test_headersync_ResetGenesisHeader(t *testing.T) {
+	app, ctx := createTestApp(true)
+
+	err := app.HeaderSyncKeeper.SyncGenesisHeader(ctx, header0)
+	assert.Nil(t, err, "Sync genesis header fail")
+	err = app.HeaderSyncKeeper.SyncBlockHeaders(ctx, nil, []string{header1, header789, header100, header60000})
+	assert.Nil(t, err, "Sync Poly Chain block headers fail")
+
+	// the permissionless way refuses to overwrite the synced genesis header
+	err = app.HeaderSyncKeeper.SyncGenesisHeader(ctx, header0)
+	assert.NotNil(t, err)
+
+	// header without NewChainConfig cannot be used as genesis header
+	err = app.HeaderSyncKeeper.ResetGenesisHeader(ctx, header1)
+	assert.NotNil(t, err)
+
+	err = app.HeaderSyncKeeper.ResetGenesisHeader(ctx, header0)
+	assert.Nil(t, err, "Reset genesis header fail")
+
+	h0s, _ := hex.DecodeString(header0)
+	header := new(polytype.Header)
+	err = header.Deserialization(polycommon.NewZeroCopySource(h0s))
+	assert.Nil(t, err)
+	consensusPeers, err := app.HeaderSyncKeeper.GetConsensusPeers(ctx, header.ChainID)
+	assert.Nil(t, err)
+	assert.Equal(t, header.Height, consensusPeers.Height)
+	keyHeaderHash, err := app.HeaderSyncKeeper.GetKeyHeaderHash(ctx, header.ChainID)
+	assert.Nil(t, err)
+	assert.Equal(t, header.Hash(), *keyHeaderHash)
+}
+
+func Test_headersync_DisablePermissionlessGenesisSync(t *testing.T) {
+	app, ctx := createTestApp(false)
+	assert.False(t, app.HeaderSyncKeeper.GetParams(ctx).AllowPermissionlessGenesisSync)
+
+	handler := headersync.NewHandler(app.HeaderSyncKeeper)
+	_, err := handler(ctx, types.NewMsgSyncGenesisParam(sdk.AccAddress([]byte("syncer")), header0))
+	assert.True(t, types.ErrGenesisSyncDisabledType.Is(err))
+
+	proposalHandler := headersync.NewProposalHandler(app.HeaderSyncKeeper)
+	err = proposalHandler(ctx, types.NewSyncGenesisHeaderProposal("title", "description", header0))
+	assert.Nil(t, err, "Sync genesis header through proposal fail")
+}
+
+func Test_headersync_EnablePermissionlessGenesisSync(t *testing.T) {
+	app, ctx := createTestApp(false)
+	app.HeaderSyncKeeper.SetParams(ctx, types.Params{AllowPermissionlessGenesisSync: true})
+	handler := headersync.NewHandler(app.HeaderSyncKeeper)
+	_, err := handler(ctx, types.NewMsgSyncGenesisParam(sdk.AccAddress([]byte("syncer")), header0))
+	assert.Nil(t, err)
+}
+
+func Test_headersync_GetParams_Missing(t *testing.T) {
+	app, ctx := createTestApp(false)
+	// a chain upgraded to this module version has none of the params introduced by it
+	paramStore := ctx.KVStore(app.GetKey(params.StoreKey))
+	for _, key := range [][]byte{types.KeyAllowPermissionlessGenesisSync, types.KeyRelayerRewardPerEpoch, types.KeyRelayerRewardPerProof} {
+		paramStore.Delete(append([]byte(types.DefaultParamspace+"/"), key...))
+	}
+	var p types.Params
+	require.NotPanics(t, func() { p = app.HeaderSyncKeeper.GetParams(ctx) })
+	assert.False(t, p.AllowPermissionlessGenesisSync)
+	assert.True(t, p.RelayerRewardPerEpoch.IsZero())
+
+	_, err := headersync.NewHandler(app.HeaderSyncKeeper)(ctx, types.NewMsgSyncGenesisParam(sdk.AccAddress([]byte("syncer")), header0))
+	assert.True(t, types.ErrGenesisSyncDisabledType.Is(err))
+}
+
 func ExtractChainConfig(header *polytype.Header) ([]byte, error) {
 	blkInfo := &vconfig.VbftBlockInfo{}
 	if err := json.Unmarshal(header.ConsensusPayload, blkInfo); err != nil {
@@ -127,3 +198,57 @@ func ExtractChainConfig(header *polytype.Header) ([]byte, error) {
 
 	return nil, fmt.Errorf("No new chain config")
 }
+
+func Test_headersync_ConsensusPeersHistory(t *testing.T) {
+	app, ctx := createTestApp(false)
+	var chainId uint64 = 1
+	peersA, peersB, peersC := newPolyAccounts(4), newPolyAccounts(4), newPolyAccounts(7)
+
+	err := app.HeaderSyncKeeper.SyncGenesisHeader(ctx, makeSignedHeader(t, chainId, 0, 0, nil, peersA))
+	require.NoError(t, err)
+	err = app.HeaderSyncKeeper.SyncBlockHeaders(ctx, nil, []string{
+		makeSignedHeader(t, chainId, 100, 100, peersA, peersB),
+		makeSignedHeader(t, chainId, 200, 200, peersB, peersC),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []uint32{0, 100, 200}, app.HeaderSyncKeeper.GetConsensusEpochs(ctx, chainId))
+
+	tcs := []struct {
+		height   uint32
+		epoch    uint32
+		peersNum int
+	}{
+		{0, 0, 4},
+		{99, 0, 4},
+		{100, 100, 4},
+		{150, 100, 4},
+		{200, 200, 7},
+		{1000, 200, 7},
+	}
+	for _, tc := range tcs {
+		consensusPeers, err := app.HeaderSyncKeeper.GetConsensusPeersByHeight(ctx, chainId, tc.height)
+		require.NoError(t, err)
+		assert.Equal(t, tc.epoch, consensusPeers.Height, "height %d", tc.height)
+		assert.Equal(t, tc.peersNum, len(consensusPeers.PeerMap), "height %d", tc.height)
+	}
+
+	// header of older epoch signed by its own retired peers is not synced, though its signatures are attributable
+	oldHeaderStr := makeSignedHeader(t, chainId, 150, 150, peersB, nil)
+	err = app.HeaderSyncKeeper.SyncBlockHeaders(ctx, nil, []string{oldHeaderStr})
+	assert.Error(t, err)
+	err = app.HeaderSyncKeeper.SyncBlockHeaders(ctx, nil, []string{makeSignedHeader(t, chainId, 150, 151, peersC, nil)})
+	assert.Error(t, err)
+	oldHeaderBs, err := hex.DecodeString(oldHeaderStr)
+	require.NoError(t, err)
+	oldHeader := &polytype.Header{}
+	require.NoError(t, oldHeader.Deserialization(polycommon.NewZeroCopySource(oldHeaderBs)))
+	assert.NoError(t, app.HeaderSyncKeeper.VerifyHeaderSigByEpoch(ctx, oldHeader))
+	consensusPeers, err := app.HeaderSyncKeeper.GetConsensusPeers(ctx, chainId)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(200), consensusPeers.Height)
+
+	// resetting genesis header drops the epochs synced before
+	err = app.HeaderSyncKeeper.ResetGenesisHeader(ctx, makeSignedHeader(t, chainId, 300, 300, nil, peersA))
+	require.NoError(t, err)
+	assert.Equal(t, []uint32{300}, app.HeaderSyncKeeper.GetConsensusEpochs(ctx, chainId))
+}
@@ -19,12 +19,22 @@ package keeper
 
 import (
 	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 var (
 	ConsensusPeerPrefix = []byte{0x01}
 	// To help store the header hash at height where the poly chain switch epoch consensus public keys
 	KeyHeaderHashPrefix = []byte{0x02}
+	// Chains frozen due to the equivocation of poly chain bookkeepers
+	FrozenChainPrefix = []byte{0x03}
+	EvidencePrefix    = []byte{0x04}
+	// Consensus peers of every epoch, keyed by chainId and the start height of the epoch
+	ConsensusPeerHistoryPrefix = []byte{0x05}
+	// Rewards credited to relayers, and the sum of them not claimed yet
+	RelayerRewardPrefix     = []byte{0x06}
+	OutstandingRewardPrefix = []byte{0x07}
 )
 
 func GetConsensusPeerKey(chainId uint64) []byte {
@@ -38,3 +48,37 @@ func GetKeyHeaderHashKey(chainId uint64) []byte {
 	binary.LittleEndian.PutUint64(b, chainId)
 	return append(KeyHeaderHashPrefix, b...)
 }
+
+func GetFrozenChainKey(chainId uint64) []byte {
+	b := make([]byte, 8)
+	binary.LittleEndian.PutUint64(b, chainId)
+	return append(FrozenChainPrefix, b...)
+}
+
+func GetEvidencePrefix(chainId uint64) []byte {
+	b := make([]byte, 8)
+	binary.LittleEndian.PutUint64(b, chainId)
+	return append(EvidencePrefix, b...)
+}
+
+func GetEvidenceKey(chainId uint64, height uint32) []byte {
+	b := make([]byte, 4)
+	binary.BigEndian.PutUint32(b, height)
+	return append(GetEvidencePrefix(chainId), b...)
+}
+
+func GetConsensusPeerHistoryPrefix(chainId uint64) []byte {
+	b := make([]byte, 8)
+	binary.LittleEndian.PutUint64(b, chainId)
+	return append(ConsensusPeerHistoryPrefix, b...)
+}
+
+func GetConsensusPeerHistoryKey(chainId uint64, height uint32) []byte {
+	b := make([]byte, 4)
+	binary.BigEndian.PutUint32(b, height)
+	return append(GetConsensusPeerHistoryPrefix(chainId), b...)
+}
+
+func GetRelayerRewardKey(relayer sdk.AccAddress) []byte {
+	return append(RelayerRewardPrefix, relayer.Bytes()...)
+}
@@ -0,0 +1,37 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package keeper
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/polynetwork/cosmos-poly-module/common"
+)
+
+// GetSyncedChainIds returns the page-th page of the chainIds whose genesis header has been synced
+func (keeper Keeper) GetSyncedChainIds(ctx sdk.Context, page, limit int) []uint64 {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(keeper.storeKey), ConsensusPeerPrefix)
+	defer iterator.Close()
+
+	chainIds := make([]uint64, 0)
+	common.Paginate(iterator, page, limit, nil, func(key, value []byte) {
+		chainIds = append(chainIds, binary.LittleEndian.Uint64(key[len(ConsensusPeerPrefix):]))
+	})
+	return chainIds
+}
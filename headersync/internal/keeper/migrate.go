@@ -0,0 +1,54 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/polynetwork/cosmos-poly-module/headersync/internal/types"
+	polycommon "github.com/polynetwork/poly/common"
+)
+
+// MigrateStoreToProtobuf re-encodes the consensus peers of headersync module stored by zero-copy encoding into
+// protobuf, it is run once by the upgrade handler switching the store encoding, the records kept as raw bytes
+// are left untouched
+func (keeper Keeper) MigrateStoreToProtobuf(ctx sdk.Context) {
+	keeper.seedConsensusPeersHistory(ctx)
+}
+
+// seedConsensusPeersHistory re-encodes the latest consensus peers of every chain and records them as the first
+// entry of the epoch history of the chain, so the heights of the current epoch stay verifiable once the next
+// epoch is synced
+func (keeper Keeper) seedConsensusPeersHistory(ctx sdk.Context) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, ConsensusPeerPrefix)
+	var peersList []types.ConsensusPeers
+	for ; iterator.Valid(); iterator.Next() {
+		consensusPeers := new(types.ConsensusPeers)
+		if err := consensusPeers.Deserialization(polycommon.NewZeroCopySource(iterator.Value())); err != nil {
+			panic(types.ErrDeserializeConsensusPeer(err))
+		}
+		peersList = append(peersList, *consensusPeers)
+	}
+	iterator.Close()
+
+	for _, consensusPeers := range peersList {
+		if err := keeper.SetConsensusPeers(ctx, consensusPeers); err != nil {
+			panic(err)
+		}
+	}
+}
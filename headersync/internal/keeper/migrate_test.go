@@ -0,0 +1,69 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/x/upgrade"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polynetwork/cosmos-poly-module/headersync/internal/keeper"
+	"github.com/polynetwork/cosmos-poly-module/headersync/internal/types"
+	"github.com/polynetwork/cosmos-poly-module/simapp"
+	polycommon "github.com/polynetwork/poly/common"
+)
+
+func Test_headersync_MigrateStoreToProtobuf(t *testing.T) {
+	app, ctx := createTestApp(true)
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+	var chainId uint64 = 1
+
+	// consensus peers stored by zero-copy encoding before the upgrade
+	peers := types.ConsensusPeers{ChainID: chainId, Height: 100, PeerMap: map[string]*types.Peer{
+		"pubkeyA": {Index: 1, PeerPubkey: "pubkeyA"},
+		"pubkeyB": {Index: 0, PeerPubkey: "pubkeyB"},
+	}}
+	sink := polycommon.NewZeroCopySink(nil)
+	peers.Serialization(sink)
+	store.Set(keeper.GetConsensusPeerKey(chainId), sink.Bytes())
+
+	app.UpgradeKeeper.ApplyUpgrade(ctx, upgrade.Plan{Name: simapp.ProtobufStoreUpgrade, Height: ctx.BlockHeight()})
+
+	gotPeers, err := app.HeaderSyncKeeper.GetConsensusPeers(ctx, chainId)
+	require.NoError(t, err)
+	require.Equal(t, peers, *gotPeers)
+	gotPeers, err = app.HeaderSyncKeeper.GetConsensusPeersByHeight(ctx, chainId, 120)
+	require.NoError(t, err)
+	require.Equal(t, peers, *gotPeers)
+	require.Equal(t, []uint32{100}, app.HeaderSyncKeeper.GetConsensusEpochs(ctx, chainId))
+
+	// the epoch synced before the upgrade stays resolvable once the next epoch is synced
+	nextPeers := types.ConsensusPeers{ChainID: chainId, Height: 200, PeerMap: map[string]*types.Peer{
+		"pubkeyC": {Index: 0, PeerPubkey: "pubkeyC"},
+	}}
+	require.NoError(t, app.HeaderSyncKeeper.SetConsensusPeers(ctx, nextPeers))
+	gotPeers, err = app.HeaderSyncKeeper.GetConsensusPeersByHeight(ctx, chainId, 150)
+	require.NoError(t, err)
+	require.Equal(t, peers, *gotPeers)
+	gotPeers, err = app.HeaderSyncKeeper.GetConsensusPeersByHeight(ctx, chainId, 200)
+	require.NoError(t, err)
+	require.Equal(t, nextPeers, *gotPeers)
+	_, err = app.HeaderSyncKeeper.GetConsensusPeersByHeight(ctx, chainId, 99)
+	require.Error(t, err)
+}
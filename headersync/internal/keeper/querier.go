@@ -18,6 +18,7 @@
 package keeper
 
 import (
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	polycommon "github.com/polynetwork/poly/common"
 
@@ -33,6 +34,22 @@ func NewQuerier(k Keeper) sdk.Querier {
 		switch path[0] {
 		case types.QueryConsensusPeers:
 			return queryConsensusPeers(ctx, req, k)
+		case types.QueryParameters:
+			return queryParams(ctx, k)
+		case types.QueryFrozenChain:
+			return queryFrozenChain(ctx, req, k)
+		case types.QueryEvidence:
+			return queryEvidence(ctx, req, k)
+		case types.QueryConsensusEpochs:
+			return queryConsensusEpochs(ctx, req, k)
+		case types.QueryConsensusPeersByHeight:
+			return queryConsensusPeersByHeight(ctx, req, k)
+		case types.QueryRelayerReward:
+			return queryRelayerReward(ctx, req, k)
+		case types.QueryRelayerPool:
+			return queryRelayerPool(ctx, k)
+		case types.QuerySyncedChainIds:
+			return querySyncedChainIds(ctx, req, k)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown query path: %s", path[0])
 
@@ -54,3 +71,115 @@ func queryConsensusPeers(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]by
 	consensusPeers.Serialization(sink)
 	return sink.Bytes(), nil
 }
+
+func queryParams(ctx sdk.Context, k Keeper) ([]byte, error) {
+
+	params := k.GetParams(ctx)
+	bz, e := codec.MarshalJSONIndent(types.ModuleCdc, params)
+	if e != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONMarshal, "could not marshal value: %+v to JSON", params)
+	}
+
+	return bz, nil
+}
+
+func queryFrozenChain(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryFrozenChainParams
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONUnmarshal, "failed to parse params: %s", err)
+	}
+	res := types.QueryFrozenChainRes{ChainId: params.ChainId, Frozen: k.IsChainFrozen(ctx, params.ChainId)}
+	bz, e := codec.MarshalJSONIndent(types.ModuleCdc, res)
+	if e != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONMarshal, "could not marshal value: %+v to JSON", res)
+	}
+
+	return bz, nil
+}
+
+func queryEvidence(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryEvidenceParams
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONUnmarshal, "failed to parse params: %s", err)
+	}
+	evidences := k.GetAllEquivocationEvidence(ctx, params.ChainId)
+	bz, e := codec.MarshalJSONIndent(types.ModuleCdc, evidences)
+	if e != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONMarshal, "could not marshal value: %+v to JSON", evidences)
+	}
+
+	return bz, nil
+}
+
+func queryConsensusEpochs(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryConsensusEpochsParams
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONUnmarshal, "failed to parse params: %s", err)
+	}
+	epochs := k.GetConsensusEpochs(ctx, params.ChainId)
+	bz, e := codec.MarshalJSONIndent(types.ModuleCdc, epochs)
+	if e != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONMarshal, "could not marshal value: %+v to JSON", epochs)
+	}
+
+	return bz, nil
+}
+
+func queryConsensusPeersByHeight(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryConsensusPeersByHeightParams
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONUnmarshal, "failed to parse params: %s", err)
+	}
+	consensusPeers, err := k.GetConsensusPeersByHeight(ctx, params.ChainId, params.Height)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "failed to get consensus peers for chainId: %d at height: %d, Error: %s", params.ChainId, params.Height, err)
+	}
+	sink := polycommon.NewZeroCopySink(nil)
+	consensusPeers.Serialization(sink)
+	return sink.Bytes(), nil
+}
+
+func queryRelayerReward(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryRelayerRewardParams
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONUnmarshal, "failed to parse params: %s", err)
+	}
+	reward := k.GetRelayerReward(ctx, params.Relayer)
+	bz, e := codec.MarshalJSONIndent(types.ModuleCdc, reward)
+	if e != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONMarshal, "could not marshal value: %+v to JSON", reward)
+	}
+
+	return bz, nil
+}
+
+func queryRelayerPool(ctx sdk.Context, k Keeper) ([]byte, error) {
+
+	pool := k.GetRelayerPool(ctx)
+	bz, e := codec.MarshalJSONIndent(types.ModuleCdc, pool)
+	if e != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONMarshal, "could not marshal value: %+v to JSON", pool)
+	}
+
+	return bz, nil
+}
+
+func querySyncedChainIds(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QuerySyncedChainIdsParams
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONUnmarshal, "failed to parse params: %s", err)
+	}
+	chainIds := k.GetSyncedChainIds(ctx, params.Page, params.Limit)
+	bz, e := codec.MarshalJSONIndent(types.ModuleCdc, chainIds)
+	if e != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONMarshal, "could not marshal value: %+v to JSON", chainIds)
+	}
+
+	return bz, nil
+}
@@ -58,3 +58,29 @@ func TestN_headersync_Querier(t *testing.T) {
 	assert.Nil(t, err)
 	require.Equal(t, consensusPeersBs, cpBs, "Synced consensus 0 is not equal to the querier result")
 }
+
+func Test_headersync_QuerySyncedChainIds(t *testing.T) {
+	app, ctx := createTestApp(true)
+
+	h0s, _ := hex.DecodeString(header0)
+	h0 := new(polytype.Header)
+	require.Nil(t, h0.Deserialization(polycommon.NewZeroCopySource(h0s)))
+
+	querier := keep.NewQuerier(app.HeaderSyncKeeper)
+	query := abci.RequestQuery{
+		Path: fmt.Sprintf("custom/%s/%s", headersync.StoreKey, types.QuerySyncedChainIds),
+		Data: app.Codec().MustMarshalJSON(types.NewQuerySyncedChainIdsParams(1, 0)),
+	}
+	bz, err := querier(ctx, []string{types.QuerySyncedChainIds}, query)
+	require.NoError(t, err)
+	var chainIds []uint64
+	types.ModuleCdc.MustUnmarshalJSON(bz, &chainIds)
+	require.Empty(t, chainIds)
+
+	require.Nil(t, app.HeaderSyncKeeper.SyncGenesisHeader(ctx, header0))
+	bz, err = querier(ctx, []string{types.QuerySyncedChainIds}, query)
+	require.NoError(t, err)
+	types.ModuleCdc.MustUnmarshalJSON(bz, &chainIds)
+	require.Equal(t, []uint64{h0.ChainID}, chainIds)
+	require.Empty(t, app.HeaderSyncKeeper.GetSyncedChainIds(ctx, 2, 1))
+}
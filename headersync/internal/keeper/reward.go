@@ -0,0 +1,131 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package keeper
+
+import (
+	"fmt"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/polynetwork/cosmos-poly-module/common"
+	"github.com/polynetwork/cosmos-poly-module/headersync/internal/types"
+	headersyncpb "github.com/polynetwork/cosmos-poly-module/headersync/pb"
+)
+
+// FundRelayerPool moves coins of depositor into the module account to reward relayers
+func (keeper Keeper) FundRelayerPool(ctx sdk.Context, depositor sdk.AccAddress, amount sdk.Coins) error {
+	if err := keeper.supplyKeeper.SendCoinsFromAccountToModule(ctx, depositor, types.ModuleName, amount); err != nil {
+		return types.ErrRelayerReward(fmt.Sprintf("supplyKeeper.SendCoinsFromAccountToModule Error: %s", err.Error()))
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeFundRelayerPool,
+			sdk.NewAttribute(types.AttributeKeyDepositor, depositor.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+		),
+	})
+	return nil
+}
+
+// RewardProofRelayer credits the relayer who submitted a valid cross chain proof
+func (keeper Keeper) RewardProofRelayer(ctx sdk.Context, relayer sdk.AccAddress) {
+	keeper.creditRelayerReward(ctx, relayer, keeper.getRelayerRewardParam(ctx, types.KeyRelayerRewardPerProof), types.AttributeValueRewardReasonProof)
+}
+
+// getRelayerRewardParam returns no reward if the param has not been set, e.g. for the chain upgraded without it
+func (keeper Keeper) getRelayerRewardParam(ctx sdk.Context, key []byte) sdk.Coins {
+	reward := sdk.NewCoins()
+	keeper.paramSpace.GetIfExists(ctx, key, &reward)
+	return reward
+}
+
+// creditRelayerReward credits reward to relayer only if the pool still holds enough coins not credited to others
+func (keeper Keeper) creditRelayerReward(ctx sdk.Context, relayer sdk.AccAddress, reward sdk.Coins, reason string) {
+	if relayer.Empty() || reward.Empty() {
+		return
+	}
+	pool := keeper.GetRelayerPool(ctx)
+	available, negative := pool.Balance.SafeSub(pool.Outstanding)
+	if negative || !available.IsAllGTE(reward) {
+		keeper.Logger(ctx).Info(fmt.Sprintf("relayer pool: %s is not enough to reward: %s", available.String(), reward.String()))
+		return
+	}
+	keeper.setRelayerReward(ctx, relayer, keeper.GetRelayerReward(ctx, relayer).Add(reward...))
+	keeper.setOutstandingReward(ctx, pool.Outstanding.Add(reward...))
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCreditRelayerReward,
+			sdk.NewAttribute(types.AttributeKeyRelayer, relayer.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, reward.String()),
+			sdk.NewAttribute(types.AttributeKeyRewardReason, reason),
+		),
+	})
+}
+
+// ClaimRelayerReward sends all the rewards credited to relayer from the module account
+func (keeper Keeper) ClaimRelayerReward(ctx sdk.Context, relayer sdk.AccAddress) error {
+	reward := keeper.GetRelayerReward(ctx, relayer)
+	if reward.Empty() {
+		return types.ErrRelayerReward(fmt.Sprintf("no reward for relayer: %s", relayer.String()))
+	}
+	if err := keeper.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, relayer, reward); err != nil {
+		return types.ErrRelayerReward(fmt.Sprintf("supplyKeeper.SendCoinsFromModuleToAccount Error: %s", err.Error()))
+	}
+	ctx.KVStore(keeper.storeKey).Delete(GetRelayerRewardKey(relayer))
+	keeper.setOutstandingReward(ctx, keeper.GetRelayerPool(ctx).Outstanding.Sub(reward))
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeClaimRelayerReward,
+			sdk.NewAttribute(types.AttributeKeyRelayer, relayer.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, reward.String()),
+		),
+	})
+	return nil
+}
+
+func (keeper Keeper) GetRelayerReward(ctx sdk.Context, relayer sdk.AccAddress) sdk.Coins {
+	bz := ctx.KVStore(keeper.storeKey).Get(GetRelayerRewardKey(relayer))
+	if bz == nil {
+		return sdk.NewCoins()
+	}
+	var reward headersyncpb.RelayerReward
+	common.MustUnmarshalProto(bz, &reward)
+	return common.CoinsFromProto(reward.Amount)
+}
+
+func (keeper Keeper) setRelayerReward(ctx sdk.Context, relayer sdk.AccAddress, reward sdk.Coins) {
+	ctx.KVStore(keeper.storeKey).Set(GetRelayerRewardKey(relayer), common.MustMarshalProto(&headersyncpb.RelayerReward{Amount: common.CoinsToProto(reward)}))
+}
+
+// GetRelayerPool returns the balance of the module account and the rewards not claimed yet
+func (keeper Keeper) GetRelayerPool(ctx sdk.Context) types.RelayerPool {
+	pool := types.RelayerPool{Balance: sdk.NewCoins(), Outstanding: sdk.NewCoins()}
+	if moduleAcct := keeper.supplyKeeper.GetModuleAccount(ctx, types.ModuleName); moduleAcct != nil {
+		pool.Balance = moduleAcct.GetCoins()
+	}
+	if bz := ctx.KVStore(keeper.storeKey).Get(OutstandingRewardPrefix); bz != nil {
+		var outstanding headersyncpb.RelayerReward
+		common.MustUnmarshalProto(bz, &outstanding)
+		pool.Outstanding = common.CoinsFromProto(outstanding.Amount)
+	}
+	return pool
+}
+
+func (keeper Keeper) setOutstandingReward(ctx sdk.Context, outstanding sdk.Coins) {
+	ctx.KVStore(keeper.storeKey).Set(OutstandingRewardPrefix, common.MustMarshalProto(&headersyncpb.RelayerReward{Amount: common.CoinsToProto(outstanding)}))
+}
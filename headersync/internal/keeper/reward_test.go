@@ -0,0 +1,75 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/polynetwork/cosmos-poly-module/headersync/internal/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func Test_headersync_RelayerReward(t *testing.T) {
+	app, ctx := createTestApp(false)
+	var chainId uint64 = 1
+	peersA, peersB, peersC := newPolyAccounts(4), newPolyAccounts(4), newPolyAccounts(4)
+	depositor := sdk.AccAddress([]byte("depositor"))
+	relayer := sdk.AccAddress([]byte("relayer"))
+
+	params := app.HeaderSyncKeeper.GetParams(ctx)
+	params.RelayerRewardPerEpoch = sdk.NewCoins(sdk.NewInt64Coin("stake", 60))
+	params.RelayerRewardPerProof = sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+	app.HeaderSyncKeeper.SetParams(ctx, params)
+
+	_, err := app.BankKeeper.AddCoins(ctx, depositor, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+	require.NoError(t, err)
+	require.NoError(t, app.HeaderSyncKeeper.FundRelayerPool(ctx, depositor, sdk.NewCoins(sdk.NewInt64Coin("stake", 100))))
+	assert.True(t, app.BankKeeper.GetCoins(ctx, depositor).IsZero())
+
+	err = app.HeaderSyncKeeper.SyncGenesisHeader(ctx, makeSignedHeader(t, chainId, 0, 0, nil, peersA))
+	require.NoError(t, err)
+
+	// header within the same epoch earns nothing
+	err = app.HeaderSyncKeeper.SyncBlockHeaders(ctx, relayer, []string{makeSignedHeader(t, chainId, 50, 50, peersA, nil)})
+	require.NoError(t, err)
+	assert.True(t, app.HeaderSyncKeeper.GetRelayerReward(ctx, relayer).IsZero())
+
+	err = app.HeaderSyncKeeper.SyncBlockHeaders(ctx, relayer, []string{makeSignedHeader(t, chainId, 100, 100, peersA, peersB)})
+	require.NoError(t, err)
+	assert.Equal(t, params.RelayerRewardPerEpoch, app.HeaderSyncKeeper.GetRelayerReward(ctx, relayer))
+
+	// the pool only holds 40 not credited yet, the second epoch change is not rewarded
+	err = app.HeaderSyncKeeper.SyncBlockHeaders(ctx, relayer, []string{makeSignedHeader(t, chainId, 200, 200, peersB, peersC)})
+	require.NoError(t, err)
+	assert.Equal(t, params.RelayerRewardPerEpoch, app.HeaderSyncKeeper.GetRelayerReward(ctx, relayer))
+
+	app.HeaderSyncKeeper.RewardProofRelayer(ctx, relayer)
+	reward := sdk.NewCoins(sdk.NewInt64Coin("stake", 70))
+	assert.Equal(t, reward, app.HeaderSyncKeeper.GetRelayerReward(ctx, relayer))
+	assert.Equal(t, types.RelayerPool{Balance: sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), Outstanding: reward}, app.HeaderSyncKeeper.GetRelayerPool(ctx))
+
+	require.NoError(t, app.HeaderSyncKeeper.ClaimRelayerReward(ctx, relayer))
+	assert.Equal(t, reward, app.BankKeeper.GetCoins(ctx, relayer))
+	assert.True(t, app.HeaderSyncKeeper.GetRelayerReward(ctx, relayer).IsZero())
+	pool := app.HeaderSyncKeeper.GetRelayerPool(ctx)
+	assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 30)), pool.Balance)
+	assert.True(t, pool.Outstanding.IsZero())
+
+	assert.Error(t, app.HeaderSyncKeeper.ClaimRelayerReward(ctx, relayer))
+}
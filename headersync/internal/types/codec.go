@@ -27,6 +27,11 @@ var ModuleCdc *codec.Codec
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgSyncGenesisParam{}, ModuleName+"/MsgSyncGenesisParam", nil)
 	cdc.RegisterConcrete(MsgSyncHeadersParam{}, ModuleName+"/MsgSyncHeadersParam", nil)
+	cdc.RegisterConcrete(MsgSubmitEquivocationEvidence{}, ModuleName+"/MsgSubmitEquivocationEvidence", nil)
+	cdc.RegisterConcrete(MsgFundRelayerPool{}, ModuleName+"/MsgFundRelayerPool", nil)
+	cdc.RegisterConcrete(MsgClaimRelayerReward{}, ModuleName+"/MsgClaimRelayerReward", nil)
+	cdc.RegisterConcrete(SyncGenesisHeaderProposal{}, ModuleName+"/SyncGenesisHeaderProposal", nil)
+	cdc.RegisterConcrete(UnfreezeChainProposal{}, ModuleName+"/UnfreezeChainProposal", nil)
 }
 
 func init() {
@@ -37,6 +37,10 @@ var (
 	ErrDeserializeConsensusPeerType = sdkerrors.Register(ModuleName, 10, "ErrDeserializeConsensusPeerType")
 	ErrSyncGenesisHeaderType        = sdkerrors.Register(ModuleName, 11, "ErrSyncGenesisHeaderType")
 	ErrSyncBlockHeaderType          = sdkerrors.Register(ModuleName, 12, "ErrSyncBlockHeaderType")
+	ErrGenesisSyncDisabledType      = sdkerrors.Register(ModuleName, 13, "ErrGenesisSyncDisabledType")
+	ErrChainFrozenType              = sdkerrors.Register(ModuleName, 14, "ErrChainFrozenType")
+	ErrInvalidEvidenceType          = sdkerrors.Register(ModuleName, 15, "ErrInvalidEvidenceType")
+	ErrRelayerRewardType            = sdkerrors.Register(ModuleName, 16, "ErrRelayerRewardType")
 )
 
 func ErrSyncBlockHeader(operation string, chainId uint64, height uint32, err error) error {
@@ -86,3 +90,19 @@ func ErrVerifyMultiSigFail(err error, height uint32) error {
 func ErrSyncGenesisHeader(reason string) error {
 	return sdkerrors.Wrapf(ErrSyncGenesisHeaderType, fmt.Sprintf("Reason: %s", reason))
 }
+
+func ErrGenesisSyncDisabled(reason string) error {
+	return sdkerrors.Wrapf(ErrGenesisSyncDisabledType, fmt.Sprintf("Reason: %s", reason))
+}
+
+func ErrChainFrozen(chainId uint64) error {
+	return sdkerrors.Wrap(ErrChainFrozenType, fmt.Sprintf("ChainId: %d is frozen due to equivocation evidence, waiting for governance", chainId))
+}
+
+func ErrInvalidEvidence(reason string) error {
+	return sdkerrors.Wrapf(ErrInvalidEvidenceType, fmt.Sprintf("Reason: %s", reason))
+}
+
+func ErrRelayerReward(reason string) error {
+	return sdkerrors.Wrapf(ErrRelayerRewardType, fmt.Sprintf("Reason: %s", reason))
+}
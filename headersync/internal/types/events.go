@@ -22,8 +22,22 @@ const (
 	AttributeValueCategory = ModuleName
 
 	EventTypeSyncHeader           = "sync_header"
+	EventTypeResetGenesisHeader   = "reset_genesis_header"
+	EventTypeFreezeChain          = "freeze_chain"
+	EventTypeUnfreezeChain        = "unfreeze_chain"
+	EventTypeFundRelayerPool      = "fund_relayer_pool"
+	EventTypeCreditRelayerReward  = "credit_relayer_reward"
+	EventTypeClaimRelayerReward   = "claim_relayer_reward"
 	AttributeKeyChainId           = "chain_id"
 	AttributeKeyHeight            = "height"
 	AttributeKeyBlockHash         = "block_hash"
 	AttributeKeyNativeChainHeight = "native_chain_height"
+	AttributeKeySubmitter         = "submitter"
+	AttributeKeyDepositor         = "depositor"
+	AttributeKeyRelayer           = "relayer"
+	AttributeKeyAmount            = "amount"
+	AttributeKeyRewardReason      = "reward_reason"
+
+	AttributeValueRewardReasonEpoch = "epoch_change"
+	AttributeValueRewardReasonProof = "cross_chain_proof"
 )
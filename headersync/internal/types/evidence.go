@@ -0,0 +1,46 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EquivocationEvidence records two different headers signed by the bookkeepers at the same height
+type EquivocationEvidence struct {
+	ChainID     uint64         `json:"chain_id" yaml:"chain_id"`
+	Height      uint32         `json:"height" yaml:"height"`
+	HeaderA     []byte         `json:"header_a" yaml:"header_a"`
+	HeaderB     []byte         `json:"header_b" yaml:"header_b"`
+	Submitter   sdk.AccAddress `json:"submitter" yaml:"submitter"`
+	SubmittedAt int64          `json:"submitted_at" yaml:"submitted_at"` // block height of current chain when the evidence is submitted
+}
+
+func (this EquivocationEvidence) String() string {
+	return fmt.Sprintf(`
+  ChainID:       %d,
+  Height:        %d,
+  HeaderA:       %s,
+  HeaderB:       %s,
+  Submitter:     %s,
+  SubmittedAt:   %d,
+`, this.ChainID, this.Height, hex.EncodeToString(this.HeaderA), hex.EncodeToString(this.HeaderB), this.Submitter.String(), this.SubmittedAt)
+}
@@ -25,8 +25,11 @@ import (
 
 // Governance message types and routes
 const (
-	TypeMsgSyncGenesis = "sync_genesis"
-	TypeMsgSyncHeaders = "sync_headers"
+	TypeMsgSyncGenesis        = "sync_genesis"
+	TypeMsgSyncHeaders        = "sync_headers"
+	TypeMsgSubmitEvidence     = "submit_evidence"
+	TypeMsgFundRelayerPool    = "fund_relayer_pool"
+	TypeMsgClaimRelayerReward = "claim_relayer_reward"
 )
 
 // MsgSend - high level transaction of the coin module
@@ -104,3 +107,114 @@ func (msg MsgSyncHeadersParam) GetSignBytes() []byte {
 func (msg MsgSyncHeadersParam) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Syncer}
 }
+
+// MsgSubmitEquivocationEvidence - submit two conflicting headers signed at the same height to freeze the chain
+type MsgSubmitEquivocationEvidence struct {
+	Submitter sdk.AccAddress
+	HeaderA   string
+	HeaderB   string
+}
+
+func NewMsgSubmitEquivocationEvidence(submitter sdk.AccAddress, headerA, headerB string) MsgSubmitEquivocationEvidence {
+	return MsgSubmitEquivocationEvidence{Submitter: submitter, HeaderA: headerA, HeaderB: headerB}
+}
+
+// Route Implements Msg
+func (msg MsgSubmitEquivocationEvidence) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgSubmitEquivocationEvidence) Type() string { return TypeMsgSubmitEvidence }
+
+// ValidateBasic Implements Msg.
+func (msg MsgSubmitEquivocationEvidence) ValidateBasic() error {
+	if msg.Submitter.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("address:%s", msg.Submitter.String()))
+	}
+	if len(msg.HeaderA) == 0 || len(msg.HeaderB) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "missing conflicting header string")
+	}
+	if msg.HeaderA == msg.HeaderB {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "the two headers are identical")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgSubmitEquivocationEvidence) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgSubmitEquivocationEvidence) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Submitter}
+}
+
+// MsgFundRelayerPool - deposit coins into the relayer reward pool
+type MsgFundRelayerPool struct {
+	Depositor sdk.AccAddress
+	Amount    sdk.Coins
+}
+
+func NewMsgFundRelayerPool(depositor sdk.AccAddress, amount sdk.Coins) MsgFundRelayerPool {
+	return MsgFundRelayerPool{Depositor: depositor, Amount: amount}
+}
+
+// Route Implements Msg
+func (msg MsgFundRelayerPool) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgFundRelayerPool) Type() string { return TypeMsgFundRelayerPool }
+
+// ValidateBasic Implements Msg.
+func (msg MsgFundRelayerPool) ValidateBasic() error {
+	if msg.Depositor.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("address:%s", msg.Depositor.String()))
+	}
+	if !msg.Amount.IsValid() || msg.Amount.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgFundRelayerPool) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgFundRelayerPool) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Depositor}
+}
+
+// MsgClaimRelayerReward - withdraw all the rewards credited to the relayer
+type MsgClaimRelayerReward struct {
+	Relayer sdk.AccAddress
+}
+
+func NewMsgClaimRelayerReward(relayer sdk.AccAddress) MsgClaimRelayerReward {
+	return MsgClaimRelayerReward{Relayer: relayer}
+}
+
+// Route Implements Msg
+func (msg MsgClaimRelayerReward) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgClaimRelayerReward) Type() string { return TypeMsgClaimRelayerReward }
+
+// ValidateBasic Implements Msg.
+func (msg MsgClaimRelayerReward) ValidateBasic() error {
+	if msg.Relayer.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("address:%s", msg.Relayer.String()))
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgClaimRelayerReward) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgClaimRelayerReward) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Relayer}
+}
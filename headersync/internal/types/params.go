@@ -0,0 +1,104 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package types
+
+import (
+	"fmt"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+// Parameter store keys
+var (
+	KeyAllowPermissionlessGenesisSync = []byte("AllowPermissionlessGenesisSync")
+	KeyRelayerRewardPerEpoch          = []byte("RelayerRewardPerEpoch")
+	KeyRelayerRewardPerProof          = []byte("RelayerRewardPerProof")
+)
+
+type Params struct {
+	AllowPermissionlessGenesisSync bool      `json:"allow_permissionless_genesis_sync" yaml:"allow_permissionless_genesis_sync"` // whether anyone can sync the genesis header through MsgSyncGenesisParam
+	RelayerRewardPerEpoch          sdk.Coins `json:"relayer_reward_per_epoch" yaml:"relayer_reward_per_epoch"`                   // reward credited to the relayer syncing a header that switches consensus epoch
+	RelayerRewardPerProof          sdk.Coins `json:"relayer_reward_per_proof" yaml:"relayer_reward_per_proof"`                   // reward credited to the relayer submitting a valid cross chain proof
+}
+
+// ParamTable for headersync module.
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// default headersync module parameters, the genesis header is synced through SyncGenesisHeaderProposal unless
+// governance allows anyone to sync it
+func DefaultParams() Params {
+	return Params{
+		AllowPermissionlessGenesisSync: false,
+		RelayerRewardPerEpoch:          sdk.NewCoins(),
+		RelayerRewardPerProof:          sdk.NewCoins(),
+	}
+}
+
+// validate params
+func (p Params) Validate() error {
+	if err := validateAllowPermissionlessGenesisSync(p.AllowPermissionlessGenesisSync); err != nil {
+		return err
+	}
+	if err := validateRelayerReward(p.RelayerRewardPerEpoch); err != nil {
+		return err
+	}
+	if err := validateRelayerReward(p.RelayerRewardPerProof); err != nil {
+		return err
+	}
+	return nil
+}
+
+func validateAllowPermissionlessGenesisSync(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateRelayerReward(i interface{}) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if !v.IsValid() {
+		return fmt.Errorf("invalid relayer reward: %s", v.String())
+	}
+	return nil
+}
+
+func (p Params) String() string {
+	return fmt.Sprintf(`HeaderSync Params:
+  Allow Permissionless Genesis Sync: %t
+  Relayer Reward Per Epoch:          %s
+  Relayer Reward Per Proof:          %s
+`,
+		p.AllowPermissionlessGenesisSync, p.RelayerRewardPerEpoch, p.RelayerRewardPerProof,
+	)
+}
+
+// Implements params.ParamSet
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		params.NewParamSetPair(KeyAllowPermissionlessGenesisSync, &p.AllowPermissionlessGenesisSync, validateAllowPermissionlessGenesisSync),
+		params.NewParamSetPair(KeyRelayerRewardPerEpoch, &p.RelayerRewardPerEpoch, validateRelayerReward),
+		params.NewParamSetPair(KeyRelayerRewardPerProof, &p.RelayerRewardPerProof, validateRelayerReward),
+	}
+}
@@ -0,0 +1,126 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package types
+
+import (
+	"fmt"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeSyncGenesisHeader defines the type for a SyncGenesisHeaderProposal
+	ProposalTypeSyncGenesisHeader = "SyncGenesisHeader"
+	// ProposalTypeUnfreezeChain defines the type for a UnfreezeChainProposal
+	ProposalTypeUnfreezeChain = "UnfreezeChain"
+)
+
+// Assert proposals implement govtypes.Content at compile-time
+var (
+	_ govtypes.Content = SyncGenesisHeaderProposal{}
+	_ govtypes.Content = UnfreezeChainProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeSyncGenesisHeader)
+	govtypes.RegisterProposalTypeCodec(SyncGenesisHeaderProposal{}, ModuleName+"/SyncGenesisHeaderProposal")
+	govtypes.RegisterProposalType(ProposalTypeUnfreezeChain)
+	govtypes.RegisterProposalTypeCodec(UnfreezeChainProposal{}, ModuleName+"/UnfreezeChainProposal")
+}
+
+// SyncGenesisHeaderProposal sets, or resets if already synced, the genesis header of a chain
+// through governance, overwriting the consensus peers stored for the header's chainId
+type SyncGenesisHeaderProposal struct {
+	Title         string `json:"title" yaml:"title"`
+	Description   string `json:"description" yaml:"description"`
+	GenesisHeader string `json:"genesis_header" yaml:"genesis_header"`
+}
+
+func NewSyncGenesisHeaderProposal(title, description, genesisHeader string) SyncGenesisHeaderProposal {
+	return SyncGenesisHeaderProposal{Title: title, Description: description, GenesisHeader: genesisHeader}
+}
+
+// GetTitle returns the title of a sync genesis header proposal.
+func (p SyncGenesisHeaderProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a sync genesis header proposal.
+func (p SyncGenesisHeaderProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a sync genesis header proposal.
+func (p SyncGenesisHeaderProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a sync genesis header proposal.
+func (p SyncGenesisHeaderProposal) ProposalType() string { return ProposalTypeSyncGenesisHeader }
+
+// ValidateBasic validates the sync genesis header proposal
+func (p SyncGenesisHeaderProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+	if len(p.GenesisHeader) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "missing GenesisHeader string")
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p SyncGenesisHeaderProposal) String() string {
+	return fmt.Sprintf(`Sync Genesis Header Proposal:
+  Title:          %s
+  Description:    %s
+  GenesisHeader:  %s
+`, p.Title, p.Description, p.GenesisHeader)
+}
+
+// UnfreezeChainProposal lifts the freeze of a chain caused by equivocation evidence
+type UnfreezeChainProposal struct {
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+	ChainId     uint64 `json:"chain_id" yaml:"chain_id"`
+}
+
+func NewUnfreezeChainProposal(title, description string, chainId uint64) UnfreezeChainProposal {
+	return UnfreezeChainProposal{Title: title, Description: description, ChainId: chainId}
+}
+
+// GetTitle returns the title of an unfreeze chain proposal.
+func (p UnfreezeChainProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of an unfreeze chain proposal.
+func (p UnfreezeChainProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of an unfreeze chain proposal.
+func (p UnfreezeChainProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of an unfreeze chain proposal.
+func (p UnfreezeChainProposal) ProposalType() string { return ProposalTypeUnfreezeChain }
+
+// ValidateBasic validates the unfreeze chain proposal
+func (p UnfreezeChainProposal) ValidateBasic() error {
+	return govtypes.ValidateAbstract(p)
+}
+
+// String implements the Stringer interface.
+func (p UnfreezeChainProposal) String() string {
+	return fmt.Sprintf(`Unfreeze Chain Proposal:
+  Title:          %s
+  Description:    %s
+  ChainId:        %d
+`, p.Title, p.Description, p.ChainId)
+}
@@ -0,0 +1,88 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package types
+
+import (
+	"sort"
+
+	"github.com/polynetwork/cosmos-poly-module/common"
+	headersyncpb "github.com/polynetwork/cosmos-poly-module/headersync/pb"
+)
+
+// ConsensusPeersToProto lists the peers of cp ordered by index then by public key, so that the encoding of cp
+// is deterministic
+func ConsensusPeersToProto(cp ConsensusPeers) *headersyncpb.ConsensusPeers {
+	peers := make([]*headersyncpb.Peer, 0, len(cp.PeerMap))
+	for _, peer := range cp.PeerMap {
+		peers = append(peers, &headersyncpb.Peer{Index: peer.Index, PeerPubkey: peer.PeerPubkey})
+	}
+	sort.Slice(peers, func(i, j int) bool {
+		if peers[i].Index != peers[j].Index {
+			return peers[i].Index < peers[j].Index
+		}
+		return peers[i].PeerPubkey < peers[j].PeerPubkey
+	})
+	return &headersyncpb.ConsensusPeers{ChainId: cp.ChainID, Height: cp.Height, Peers: peers}
+}
+
+// ConsensusPeersFromProto keys the peers of pb by public key as Deserialization does
+func ConsensusPeersFromProto(pb *headersyncpb.ConsensusPeers) *ConsensusPeers {
+	peerMap := make(map[string]*Peer, len(pb.Peers))
+	for _, peer := range pb.Peers {
+		peerMap[peer.PeerPubkey] = &Peer{Index: peer.Index, PeerPubkey: peer.PeerPubkey}
+	}
+	return &ConsensusPeers{ChainID: pb.ChainId, Height: pb.Height, PeerMap: peerMap}
+}
+
+func ParamsToProto(p Params) *headersyncpb.Params {
+	return &headersyncpb.Params{
+		AllowPermissionlessGenesisSync: p.AllowPermissionlessGenesisSync,
+		RelayerRewardPerEpoch:          common.CoinsToProto(p.RelayerRewardPerEpoch),
+		RelayerRewardPerProof:          common.CoinsToProto(p.RelayerRewardPerProof),
+	}
+}
+
+func EquivocationEvidenceToProto(e EquivocationEvidence) *headersyncpb.EquivocationEvidence {
+	return &headersyncpb.EquivocationEvidence{
+		ChainId:     e.ChainID,
+		Height:      e.Height,
+		HeaderA:     e.HeaderA,
+		HeaderB:     e.HeaderB,
+		Submitter:   e.Submitter.String(),
+		SubmittedAt: e.SubmittedAt,
+	}
+}
+
+func EquivocationEvidenceFromProto(pb *headersyncpb.EquivocationEvidence) EquivocationEvidence {
+	return EquivocationEvidence{
+		ChainID:     pb.ChainId,
+		Height:      pb.Height,
+		HeaderA:     pb.HeaderA,
+		HeaderB:     pb.HeaderB,
+		Submitter:   common.AccAddressFromProto(pb.Submitter),
+		SubmittedAt: pb.SubmittedAt,
+	}
+}
+
+func EquivocationEvidencesToProto(evidences []EquivocationEvidence) []*headersyncpb.EquivocationEvidence {
+	pbs := make([]*headersyncpb.EquivocationEvidence, len(evidences))
+	for i, e := range evidences {
+		pbs[i] = EquivocationEvidenceToProto(e)
+	}
+	return pbs
+}
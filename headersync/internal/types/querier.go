@@ -17,8 +17,25 @@
 
 package types
 
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	QueryConsensusPeers = "consensus_peers"
+	QueryParameters     = "parameters"
+	QueryFrozenChain    = "frozen_chain"
+	QueryEvidence       = "evidence"
+
+	QueryConsensusEpochs        = "consensus_epochs"
+	QueryConsensusPeersByHeight = "consensus_peers_by_height"
+
+	QueryRelayerReward = "relayer_reward"
+	QueryRelayerPool   = "relayer_pool"
+
+	QuerySyncedChainIds = "synced_chain_ids"
 )
 
 // QueryBalanceParams defines the params for querying an account balance.
@@ -30,3 +47,65 @@ type QueryConsensusPeersParams struct {
 func NewQueryConsensusPeersParams(chainId uint64) QueryConsensusPeersParams {
 	return QueryConsensusPeersParams{ChainId: chainId}
 }
+
+type QueryFrozenChainParams struct {
+	ChainId uint64
+}
+
+func NewQueryFrozenChainParams(chainId uint64) QueryFrozenChainParams {
+	return QueryFrozenChainParams{ChainId: chainId}
+}
+
+type QueryFrozenChainRes struct {
+	ChainId uint64
+	Frozen  bool
+}
+
+func (this QueryFrozenChainRes) String() string {
+	return fmt.Sprintf(`
+  ChainId:				%d,
+  Frozen:				%t,
+`, this.ChainId, this.Frozen)
+}
+
+type QueryEvidenceParams struct {
+	ChainId uint64
+}
+
+func NewQueryEvidenceParams(chainId uint64) QueryEvidenceParams {
+	return QueryEvidenceParams{ChainId: chainId}
+}
+
+type QueryConsensusEpochsParams struct {
+	ChainId uint64
+}
+
+func NewQueryConsensusEpochsParams(chainId uint64) QueryConsensusEpochsParams {
+	return QueryConsensusEpochsParams{ChainId: chainId}
+}
+
+type QueryConsensusPeersByHeightParams struct {
+	ChainId uint64
+	Height  uint32
+}
+
+func NewQueryConsensusPeersByHeightParams(chainId uint64, height uint32) QueryConsensusPeersByHeightParams {
+	return QueryConsensusPeersByHeightParams{ChainId: chainId, Height: height}
+}
+
+type QueryRelayerRewardParams struct {
+	Relayer sdk.AccAddress
+}
+
+func NewQueryRelayerRewardParams(relayer sdk.AccAddress) QueryRelayerRewardParams {
+	return QueryRelayerRewardParams{Relayer: relayer}
+}
+
+type QuerySyncedChainIdsParams struct {
+	Page  int
+	Limit int
+}
+
+func NewQuerySyncedChainIdsParams(page, limit int) QuerySyncedChainIdsParams {
+	return QuerySyncedChainIdsParams{Page: page, Limit: limit}
+}
@@ -0,0 +1,37 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RelayerPool describes the relayer reward pool held by the headersync module account
+type RelayerPool struct {
+	Balance     sdk.Coins `json:"balance" yaml:"balance"`         // coins held by the module account
+	Outstanding sdk.Coins `json:"outstanding" yaml:"outstanding"` // coins credited to relayers but not claimed yet
+}
+
+func (this RelayerPool) String() string {
+	return fmt.Sprintf(`
+  Balance:       %s,
+  Outstanding:   %s,
+`, this.Balance.String(), this.Outstanding.String())
+}
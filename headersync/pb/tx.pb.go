@@ -0,0 +1,2143 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: polynetwork/headersync/v1/tx.proto
+
+package headersyncpb
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	pb "github.com/polynetwork/cosmos-poly-module/common/pb"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type MsgSyncGenesisParam struct {
+	Syncer        string `protobuf:"bytes,1,opt,name=syncer,proto3" json:"syncer,omitempty"`
+	GenesisHeader string `protobuf:"bytes,2,opt,name=genesis_header,json=genesisHeader,proto3" json:"genesis_header,omitempty"`
+}
+
+func (m *MsgSyncGenesisParam) Reset()         { *m = MsgSyncGenesisParam{} }
+func (m *MsgSyncGenesisParam) String() string { return proto.CompactTextString(m) }
+func (*MsgSyncGenesisParam) ProtoMessage()    {}
+func (*MsgSyncGenesisParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d352d18de48ba971, []int{0}
+}
+func (m *MsgSyncGenesisParam) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSyncGenesisParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSyncGenesisParam.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSyncGenesisParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSyncGenesisParam.Merge(m, src)
+}
+func (m *MsgSyncGenesisParam) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSyncGenesisParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSyncGenesisParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSyncGenesisParam proto.InternalMessageInfo
+
+func (m *MsgSyncGenesisParam) GetSyncer() string {
+	if m != nil {
+		return m.Syncer
+	}
+	return ""
+}
+
+func (m *MsgSyncGenesisParam) GetGenesisHeader() string {
+	if m != nil {
+		return m.GenesisHeader
+	}
+	return ""
+}
+
+type MsgSyncGenesisParamResponse struct {
+}
+
+func (m *MsgSyncGenesisParamResponse) Reset()         { *m = MsgSyncGenesisParamResponse{} }
+func (m *MsgSyncGenesisParamResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSyncGenesisParamResponse) ProtoMessage()    {}
+func (*MsgSyncGenesisParamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d352d18de48ba971, []int{1}
+}
+func (m *MsgSyncGenesisParamResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSyncGenesisParamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSyncGenesisParamResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSyncGenesisParamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSyncGenesisParamResponse.Merge(m, src)
+}
+func (m *MsgSyncGenesisParamResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSyncGenesisParamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSyncGenesisParamResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSyncGenesisParamResponse proto.InternalMessageInfo
+
+type MsgSyncHeadersParam struct {
+	Syncer  string   `protobuf:"bytes,1,opt,name=syncer,proto3" json:"syncer,omitempty"`
+	Headers []string `protobuf:"bytes,2,rep,name=headers,proto3" json:"headers,omitempty"`
+}
+
+func (m *MsgSyncHeadersParam) Reset()         { *m = MsgSyncHeadersParam{} }
+func (m *MsgSyncHeadersParam) String() string { return proto.CompactTextString(m) }
+func (*MsgSyncHeadersParam) ProtoMessage()    {}
+func (*MsgSyncHeadersParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d352d18de48ba971, []int{2}
+}
+func (m *MsgSyncHeadersParam) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSyncHeadersParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSyncHeadersParam.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSyncHeadersParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSyncHeadersParam.Merge(m, src)
+}
+func (m *MsgSyncHeadersParam) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSyncHeadersParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSyncHeadersParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSyncHeadersParam proto.InternalMessageInfo
+
+func (m *MsgSyncHeadersParam) GetSyncer() string {
+	if m != nil {
+		return m.Syncer
+	}
+	return ""
+}
+
+func (m *MsgSyncHeadersParam) GetHeaders() []string {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
+type MsgSyncHeadersParamResponse struct {
+}
+
+func (m *MsgSyncHeadersParamResponse) Reset()         { *m = MsgSyncHeadersParamResponse{} }
+func (m *MsgSyncHeadersParamResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSyncHeadersParamResponse) ProtoMessage()    {}
+func (*MsgSyncHeadersParamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d352d18de48ba971, []int{3}
+}
+func (m *MsgSyncHeadersParamResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSyncHeadersParamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSyncHeadersParamResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSyncHeadersParamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSyncHeadersParamResponse.Merge(m, src)
+}
+func (m *MsgSyncHeadersParamResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSyncHeadersParamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSyncHeadersParamResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSyncHeadersParamResponse proto.InternalMessageInfo
+
+type MsgSubmitEquivocationEvidence struct {
+	Submitter string `protobuf:"bytes,1,opt,name=submitter,proto3" json:"submitter,omitempty"`
+	HeaderA   string `protobuf:"bytes,2,opt,name=header_a,json=headerA,proto3" json:"header_a,omitempty"`
+	HeaderB   string `protobuf:"bytes,3,opt,name=header_b,json=headerB,proto3" json:"header_b,omitempty"`
+}
+
+func (m *MsgSubmitEquivocationEvidence) Reset()         { *m = MsgSubmitEquivocationEvidence{} }
+func (m *MsgSubmitEquivocationEvidence) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitEquivocationEvidence) ProtoMessage()    {}
+func (*MsgSubmitEquivocationEvidence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d352d18de48ba971, []int{4}
+}
+func (m *MsgSubmitEquivocationEvidence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitEquivocationEvidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitEquivocationEvidence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitEquivocationEvidence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitEquivocationEvidence.Merge(m, src)
+}
+func (m *MsgSubmitEquivocationEvidence) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitEquivocationEvidence) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitEquivocationEvidence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitEquivocationEvidence proto.InternalMessageInfo
+
+func (m *MsgSubmitEquivocationEvidence) GetSubmitter() string {
+	if m != nil {
+		return m.Submitter
+	}
+	return ""
+}
+
+func (m *MsgSubmitEquivocationEvidence) GetHeaderA() string {
+	if m != nil {
+		return m.HeaderA
+	}
+	return ""
+}
+
+func (m *MsgSubmitEquivocationEvidence) GetHeaderB() string {
+	if m != nil {
+		return m.HeaderB
+	}
+	return ""
+}
+
+type MsgSubmitEquivocationEvidenceResponse struct {
+}
+
+func (m *MsgSubmitEquivocationEvidenceResponse) Reset()         { *m = MsgSubmitEquivocationEvidenceResponse{} }
+func (m *MsgSubmitEquivocationEvidenceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitEquivocationEvidenceResponse) ProtoMessage()    {}
+func (*MsgSubmitEquivocationEvidenceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d352d18de48ba971, []int{5}
+}
+func (m *MsgSubmitEquivocationEvidenceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitEquivocationEvidenceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitEquivocationEvidenceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitEquivocationEvidenceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitEquivocationEvidenceResponse.Merge(m, src)
+}
+func (m *MsgSubmitEquivocationEvidenceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitEquivocationEvidenceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitEquivocationEvidenceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitEquivocationEvidenceResponse proto.InternalMessageInfo
+
+type MsgFundRelayerPool struct {
+	Depositor string     `protobuf:"bytes,1,opt,name=depositor,proto3" json:"depositor,omitempty"`
+	Amount    []*pb.Coin `protobuf:"bytes,2,rep,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *MsgFundRelayerPool) Reset()         { *m = MsgFundRelayerPool{} }
+func (m *MsgFundRelayerPool) String() string { return proto.CompactTextString(m) }
+func (*MsgFundRelayerPool) ProtoMessage()    {}
+func (*MsgFundRelayerPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d352d18de48ba971, []int{6}
+}
+func (m *MsgFundRelayerPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundRelayerPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundRelayerPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFundRelayerPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundRelayerPool.Merge(m, src)
+}
+func (m *MsgFundRelayerPool) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundRelayerPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundRelayerPool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFundRelayerPool proto.InternalMessageInfo
+
+func (m *MsgFundRelayerPool) GetDepositor() string {
+	if m != nil {
+		return m.Depositor
+	}
+	return ""
+}
+
+func (m *MsgFundRelayerPool) GetAmount() []*pb.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+type MsgFundRelayerPoolResponse struct {
+}
+
+func (m *MsgFundRelayerPoolResponse) Reset()         { *m = MsgFundRelayerPoolResponse{} }
+func (m *MsgFundRelayerPoolResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFundRelayerPoolResponse) ProtoMessage()    {}
+func (*MsgFundRelayerPoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d352d18de48ba971, []int{7}
+}
+func (m *MsgFundRelayerPoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundRelayerPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundRelayerPoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFundRelayerPoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundRelayerPoolResponse.Merge(m, src)
+}
+func (m *MsgFundRelayerPoolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundRelayerPoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundRelayerPoolResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFundRelayerPoolResponse proto.InternalMessageInfo
+
+type MsgClaimRelayerReward struct {
+	Relayer string `protobuf:"bytes,1,opt,name=relayer,proto3" json:"relayer,omitempty"`
+}
+
+func (m *MsgClaimRelayerReward) Reset()         { *m = MsgClaimRelayerReward{} }
+func (m *MsgClaimRelayerReward) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimRelayerReward) ProtoMessage()    {}
+func (*MsgClaimRelayerReward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d352d18de48ba971, []int{8}
+}
+func (m *MsgClaimRelayerReward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimRelayerReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimRelayerReward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimRelayerReward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimRelayerReward.Merge(m, src)
+}
+func (m *MsgClaimRelayerReward) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimRelayerReward) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimRelayerReward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimRelayerReward proto.InternalMessageInfo
+
+func (m *MsgClaimRelayerReward) GetRelayer() string {
+	if m != nil {
+		return m.Relayer
+	}
+	return ""
+}
+
+type MsgClaimRelayerRewardResponse struct {
+}
+
+func (m *MsgClaimRelayerRewardResponse) Reset()         { *m = MsgClaimRelayerRewardResponse{} }
+func (m *MsgClaimRelayerRewardResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimRelayerRewardResponse) ProtoMessage()    {}
+func (*MsgClaimRelayerRewardResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d352d18de48ba971, []int{9}
+}
+func (m *MsgClaimRelayerRewardResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimRelayerRewardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimRelayerRewardResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimRelayerRewardResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimRelayerRewardResponse.Merge(m, src)
+}
+func (m *MsgClaimRelayerRewardResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimRelayerRewardResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimRelayerRewardResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimRelayerRewardResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgSyncGenesisParam)(nil), "polynetwork.headersync.v1.MsgSyncGenesisParam")
+	proto.RegisterType((*MsgSyncGenesisParamResponse)(nil), "polynetwork.headersync.v1.MsgSyncGenesisParamResponse")
+	proto.RegisterType((*MsgSyncHeadersParam)(nil), "polynetwork.headersync.v1.MsgSyncHeadersParam")
+	proto.RegisterType((*MsgSyncHeadersParamResponse)(nil), "polynetwork.headersync.v1.MsgSyncHeadersParamResponse")
+	proto.RegisterType((*MsgSubmitEquivocationEvidence)(nil), "polynetwork.headersync.v1.MsgSubmitEquivocationEvidence")
+	proto.RegisterType((*MsgSubmitEquivocationEvidenceResponse)(nil), "polynetwork.headersync.v1.MsgSubmitEquivocationEvidenceResponse")
+	proto.RegisterType((*MsgFundRelayerPool)(nil), "polynetwork.headersync.v1.MsgFundRelayerPool")
+	proto.RegisterType((*MsgFundRelayerPoolResponse)(nil), "polynetwork.headersync.v1.MsgFundRelayerPoolResponse")
+	proto.RegisterType((*MsgClaimRelayerReward)(nil), "polynetwork.headersync.v1.MsgClaimRelayerReward")
+	proto.RegisterType((*MsgClaimRelayerRewardResponse)(nil), "polynetwork.headersync.v1.MsgClaimRelayerRewardResponse")
+}
+
+func init() {
+	proto.RegisterFile("polynetwork/headersync/v1/tx.proto", fileDescriptor_d352d18de48ba971)
+}
+
+var fileDescriptor_d352d18de48ba971 = []byte{
+	// 523 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xbf, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0xeb, 0x46, 0x04, 0xf2, 0x2a, 0x40, 0x3a, 0x04, 0x72, 0xdd, 0xc6, 0x54, 0x96, 0x2a,
+	0xba, 0xc4, 0x21, 0xad, 0xa8, 0x2a, 0xb1, 0x40, 0x4b, 0x29, 0x4b, 0xa4, 0xca, 0x30, 0x31, 0x50,
+	0xf9, 0xc7, 0xc9, 0xb5, 0xb0, 0xef, 0x8c, 0xef, 0xec, 0x90, 0x8d, 0x99, 0x89, 0x91, 0x3f, 0x89,
+	0xb1, 0x23, 0x23, 0x4a, 0xfe, 0x11, 0x64, 0xfb, 0x7c, 0xb9, 0xb6, 0x69, 0x4a, 0xd8, 0x72, 0xef,
+	0x7d, 0xbf, 0xef, 0xf3, 0x6c, 0x7f, 0x73, 0x60, 0xa5, 0x34, 0x1e, 0x13, 0xcc, 0x47, 0x34, 0xfb,
+	0xdc, 0x3f, 0xc7, 0x6e, 0x80, 0x33, 0x36, 0x26, 0x7e, 0xbf, 0x18, 0xf4, 0xf9, 0x57, 0x3b, 0xcd,
+	0x28, 0xa7, 0x68, 0x5d, 0xd1, 0xd8, 0x33, 0x8d, 0x5d, 0x0c, 0x8c, 0x4b, 0x76, 0x9f, 0x26, 0x09,
+	0x25, 0xa5, 0xb5, 0xfe, 0x55, 0xdb, 0xad, 0x0f, 0xf0, 0x68, 0xc8, 0xc2, 0xf7, 0x63, 0xe2, 0x9f,
+	0x60, 0x82, 0x59, 0xc4, 0x4e, 0xdd, 0xcc, 0x4d, 0xd0, 0x13, 0x68, 0x97, 0x53, 0x70, 0xa6, 0x6b,
+	0x5b, 0xda, 0x4e, 0xc7, 0x11, 0x27, 0xb4, 0x0d, 0x0f, 0xc2, 0x5a, 0x77, 0x56, 0xb3, 0xf4, 0xd5,
+	0xaa, 0x7f, 0x5f, 0x54, 0xdf, 0x55, 0x45, 0xab, 0x0b, 0x1b, 0x73, 0xa6, 0x3a, 0x98, 0xa5, 0x94,
+	0x30, 0x6c, 0x9d, 0x48, 0x68, 0xad, 0xbf, 0x05, 0xaa, 0xc3, 0x5d, 0xf1, 0x60, 0xfa, 0xea, 0x56,
+	0x6b, 0xa7, 0xe3, 0x34, 0x47, 0x85, 0xa3, 0x0e, 0x92, 0x1c, 0x06, 0xdd, 0xb2, 0x9d, 0x7b, 0x49,
+	0xc4, 0x8f, 0xbf, 0xe4, 0x51, 0x41, 0x7d, 0x97, 0x47, 0x94, 0x1c, 0x17, 0x51, 0x80, 0x89, 0x8f,
+	0xd1, 0x26, 0x74, 0x58, 0xd5, 0xe5, 0x12, 0x3a, 0x2b, 0xa0, 0x75, 0xb8, 0x57, 0x83, 0xce, 0x5c,
+	0xf1, 0x98, 0x02, 0xfc, 0x5a, 0x69, 0x79, 0x7a, 0x4b, 0x6d, 0x1d, 0x5a, 0xcf, 0x60, 0x7b, 0x21,
+	0x54, 0x6e, 0x17, 0x02, 0x1a, 0xb2, 0xf0, 0x6d, 0x4e, 0x02, 0x07, 0xc7, 0xee, 0x18, 0x67, 0xa7,
+	0x94, 0xc6, 0xe5, 0x4a, 0x01, 0x4e, 0x29, 0x8b, 0x38, 0x95, 0x2b, 0xc9, 0x02, 0xda, 0x83, 0xb6,
+	0x9b, 0xd0, 0x9c, 0xf0, 0xea, 0x4d, 0xac, 0xed, 0x6e, 0xd8, 0xea, 0xe7, 0x17, 0x5f, 0xb6, 0x18,
+	0xd8, 0x47, 0x34, 0x22, 0x8e, 0x90, 0x5a, 0x9b, 0x60, 0x5c, 0x07, 0xc9, 0x35, 0x06, 0xf0, 0x78,
+	0xc8, 0xc2, 0xa3, 0xd8, 0x8d, 0x12, 0xd1, 0x76, 0xf0, 0xc8, 0xcd, 0x82, 0xf2, 0xb5, 0x67, 0x75,
+	0x41, 0xec, 0xd1, 0x1c, 0xad, 0xa7, 0xd0, 0x9d, 0x6b, 0x69, 0x66, 0xee, 0x7e, 0xbf, 0x03, 0xad,
+	0x21, 0x0b, 0x51, 0x0e, 0x6b, 0x4a, 0x08, 0x90, 0x6d, 0xdf, 0x18, 0x56, 0x7b, 0x4e, 0x5e, 0x8c,
+	0xfd, 0xe5, 0xf4, 0x0d, 0xbe, 0xc1, 0x8a, 0x4c, 0xfc, 0x0b, 0x56, 0x8d, 0x8f, 0xb1, 0xbf, 0x9c,
+	0x5e, 0x62, 0x7f, 0x6a, 0x60, 0x2c, 0x08, 0xdb, 0xc1, 0x2d, 0x63, 0x6f, 0x74, 0x1a, 0xaf, 0xfe,
+	0xd7, 0x29, 0x57, 0x1b, 0xc1, 0xc3, 0xab, 0x41, 0xeb, 0x2d, 0x1e, 0x7a, 0x45, 0x6e, 0xbc, 0x58,
+	0x4a, 0x2e, 0xc1, 0xdf, 0x34, 0x40, 0x73, 0xb2, 0xf5, 0x7c, 0xf1, 0xb4, 0xeb, 0x0e, 0xe3, 0x60,
+	0x59, 0x47, 0xb3, 0xc2, 0xe1, 0xa7, 0x5f, 0x13, 0x53, 0xbb, 0x98, 0x98, 0xda, 0x9f, 0x89, 0xa9,
+	0xfd, 0x98, 0x9a, 0x2b, 0x17, 0x53, 0x73, 0xe5, 0xf7, 0xd4, 0x5c, 0xf9, 0xf8, 0x26, 0x8c, 0xf8,
+	0x79, 0xee, 0x95, 0xff, 0x9b, 0xfe, 0xe5, 0xbb, 0x92, 0x25, 0x94, 0xf5, 0xca, 0x52, 0x2f, 0xa1,
+	0x41, 0x1e, 0x63, 0xf5, 0xf6, 0x4d, 0xbd, 0x97, 0xb3, 0x53, 0xea, 0x79, 0xed, 0xea, 0x26, 0xdd,
+	0xfb, 0x3b, 0x00, 0xba, 0xaa, 0xe6, 0x78, 0xae, 0x05, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	SyncGenesis(ctx context.Context, in *MsgSyncGenesisParam, opts ...grpc.CallOption) (*MsgSyncGenesisParamResponse, error)
+	SyncHeaders(ctx context.Context, in *MsgSyncHeadersParam, opts ...grpc.CallOption) (*MsgSyncHeadersParamResponse, error)
+	SubmitEquivocationEvidence(ctx context.Context, in *MsgSubmitEquivocationEvidence, opts ...grpc.CallOption) (*MsgSubmitEquivocationEvidenceResponse, error)
+	FundRelayerPool(ctx context.Context, in *MsgFundRelayerPool, opts ...grpc.CallOption) (*MsgFundRelayerPoolResponse, error)
+	ClaimRelayerReward(ctx context.Context, in *MsgClaimRelayerReward, opts ...grpc.CallOption) (*MsgClaimRelayerRewardResponse, error)
+}
+
+type msgClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewMsgClient(cc *grpc.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) SyncGenesis(ctx context.Context, in *MsgSyncGenesisParam, opts ...grpc.CallOption) (*MsgSyncGenesisParamResponse, error) {
+	out := new(MsgSyncGenesisParamResponse)
+	err := c.cc.Invoke(ctx, "/polynetwork.headersync.v1.Msg/SyncGenesis", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SyncHeaders(ctx context.Context, in *MsgSyncHeadersParam, opts ...grpc.CallOption) (*MsgSyncHeadersParamResponse, error) {
+	out := new(MsgSyncHeadersParamResponse)
+	err := c.cc.Invoke(ctx, "/polynetwork.headersync.v1.Msg/SyncHeaders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SubmitEquivocationEvidence(ctx context.Context, in *MsgSubmitEquivocationEvidence, opts ...grpc.CallOption) (*MsgSubmitEquivocationEvidenceResponse, error) {
+	out := new(MsgSubmitEquivocationEvidenceResponse)
+	err := c.cc.Invoke(ctx, "/polynetwork.headersync.v1.Msg/SubmitEquivocationEvidence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FundRelayerPool(ctx context.Context, in *MsgFundRelayerPool, opts ...grpc.CallOption) (*MsgFundRelayerPoolResponse, error) {
+	out := new(MsgFundRelayerPoolResponse)
+	err := c.cc.Invoke(ctx, "/polynetwork.headersync.v1.Msg/FundRelayerPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimRelayerReward(ctx context.Context, in *MsgClaimRelayerReward, opts ...grpc.CallOption) (*MsgClaimRelayerRewardResponse, error) {
+	out := new(MsgClaimRelayerRewardResponse)
+	err := c.cc.Invoke(ctx, "/polynetwork.headersync.v1.Msg/ClaimRelayerReward", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	SyncGenesis(context.Context, *MsgSyncGenesisParam) (*MsgSyncGenesisParamResponse, error)
+	SyncHeaders(context.Context, *MsgSyncHeadersParam) (*MsgSyncHeadersParamResponse, error)
+	SubmitEquivocationEvidence(context.Context, *MsgSubmitEquivocationEvidence) (*MsgSubmitEquivocationEvidenceResponse, error)
+	FundRelayerPool(context.Context, *MsgFundRelayerPool) (*MsgFundRelayerPoolResponse, error)
+	ClaimRelayerReward(context.Context, *MsgClaimRelayerReward) (*MsgClaimRelayerRewardResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) SyncGenesis(ctx context.Context, req *MsgSyncGenesisParam) (*MsgSyncGenesisParamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncGenesis not implemented")
+}
+func (*UnimplementedMsgServer) SyncHeaders(ctx context.Context, req *MsgSyncHeadersParam) (*MsgSyncHeadersParamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncHeaders not implemented")
+}
+func (*UnimplementedMsgServer) SubmitEquivocationEvidence(ctx context.Context, req *MsgSubmitEquivocationEvidence) (*MsgSubmitEquivocationEvidenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitEquivocationEvidence not implemented")
+}
+func (*UnimplementedMsgServer) FundRelayerPool(ctx context.Context, req *MsgFundRelayerPool) (*MsgFundRelayerPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundRelayerPool not implemented")
+}
+func (*UnimplementedMsgServer) ClaimRelayerReward(ctx context.Context, req *MsgClaimRelayerReward) (*MsgClaimRelayerRewardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimRelayerReward not implemented")
+}
+
+func RegisterMsgServer(s *grpc.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_SyncGenesis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSyncGenesisParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SyncGenesis(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/polynetwork.headersync.v1.Msg/SyncGenesis",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SyncGenesis(ctx, req.(*MsgSyncGenesisParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SyncHeaders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSyncHeadersParam)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SyncHeaders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/polynetwork.headersync.v1.Msg/SyncHeaders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SyncHeaders(ctx, req.(*MsgSyncHeadersParam))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitEquivocationEvidence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitEquivocationEvidence)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitEquivocationEvidence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/polynetwork.headersync.v1.Msg/SubmitEquivocationEvidence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitEquivocationEvidence(ctx, req.(*MsgSubmitEquivocationEvidence))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FundRelayerPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFundRelayerPool)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FundRelayerPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/polynetwork.headersync.v1.Msg/FundRelayerPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FundRelayerPool(ctx, req.(*MsgFundRelayerPool))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimRelayerReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimRelayerReward)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimRelayerReward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/polynetwork.headersync.v1.Msg/ClaimRelayerReward",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimRelayerReward(ctx, req.(*MsgClaimRelayerReward))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "polynetwork.headersync.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SyncGenesis",
+			Handler:    _Msg_SyncGenesis_Handler,
+		},
+		{
+			MethodName: "SyncHeaders",
+			Handler:    _Msg_SyncHeaders_Handler,
+		},
+		{
+			MethodName: "SubmitEquivocationEvidence",
+			Handler:    _Msg_SubmitEquivocationEvidence_Handler,
+		},
+		{
+			MethodName: "FundRelayerPool",
+			Handler:    _Msg_FundRelayerPool_Handler,
+		},
+		{
+			MethodName: "ClaimRelayerReward",
+			Handler:    _Msg_ClaimRelayerReward_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "polynetwork/headersync/v1/tx.proto",
+}
+
+func (m *MsgSyncGenesisParam) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSyncGenesisParam) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSyncGenesisParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GenesisHeader) > 0 {
+		i -= len(m.GenesisHeader)
+		copy(dAtA[i:], m.GenesisHeader)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GenesisHeader)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Syncer) > 0 {
+		i -= len(m.Syncer)
+		copy(dAtA[i:], m.Syncer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Syncer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSyncGenesisParamResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSyncGenesisParamResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSyncGenesisParamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSyncHeadersParam) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSyncHeadersParam) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSyncHeadersParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Headers) > 0 {
+		for iNdEx := len(m.Headers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Headers[iNdEx])
+			copy(dAtA[i:], m.Headers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Headers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Syncer) > 0 {
+		i -= len(m.Syncer)
+		copy(dAtA[i:], m.Syncer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Syncer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSyncHeadersParamResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSyncHeadersParamResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSyncHeadersParamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitEquivocationEvidence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitEquivocationEvidence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitEquivocationEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.HeaderB) > 0 {
+		i -= len(m.HeaderB)
+		copy(dAtA[i:], m.HeaderB)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.HeaderB)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.HeaderA) > 0 {
+		i -= len(m.HeaderA)
+		copy(dAtA[i:], m.HeaderA)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.HeaderA)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitEquivocationEvidenceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitEquivocationEvidenceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitEquivocationEvidenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundRelayerPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundRelayerPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundRelayerPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundRelayerPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundRelayerPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundRelayerPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimRelayerReward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimRelayerReward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimRelayerReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Relayer) > 0 {
+		i -= len(m.Relayer)
+		copy(dAtA[i:], m.Relayer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Relayer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimRelayerRewardResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimRelayerRewardResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimRelayerRewardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSyncGenesisParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Syncer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.GenesisHeader)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSyncGenesisParamResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSyncHeadersParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Syncer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Headers) > 0 {
+		for _, s := range m.Headers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSyncHeadersParamResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitEquivocationEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.HeaderA)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.HeaderB)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitEquivocationEvidenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFundRelayerPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgFundRelayerPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimRelayerReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Relayer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimRelayerRewardResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSyncGenesisParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSyncGenesisParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSyncGenesisParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Syncer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Syncer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisHeader", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GenesisHeader = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSyncGenesisParamResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSyncGenesisParamResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSyncGenesisParamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSyncHeadersParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSyncHeadersParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSyncHeadersParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Syncer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Syncer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Headers = append(m.Headers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSyncHeadersParamResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSyncHeadersParamResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSyncHeadersParamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitEquivocationEvidence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitEquivocationEvidence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitEquivocationEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submitter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeaderA", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HeaderA = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeaderB", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HeaderB = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitEquivocationEvidenceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitEquivocationEvidenceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitEquivocationEvidenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFundRelayerPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundRelayerPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundRelayerPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, &pb.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFundRelayerPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundRelayerPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundRelayerPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimRelayerReward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimRelayerReward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimRelayerReward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimRelayerRewardResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimRelayerRewardResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimRelayerRewardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
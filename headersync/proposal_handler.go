@@ -0,0 +1,49 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package headersync
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/polynetwork/cosmos-poly-module/headersync/internal/keeper"
+	"github.com/polynetwork/cosmos-poly-module/headersync/internal/types"
+)
+
+// NewProposalHandler creates a new governance Handler for headersync proposals
+func NewProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case types.SyncGenesisHeaderProposal:
+			return handleSyncGenesisHeaderProposal(ctx, k, c)
+		case types.UnfreezeChainProposal:
+			return handleUnfreezeChainProposal(ctx, k, c)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s proposal content type: %T", types.ModuleName, c)
+		}
+	}
+}
+
+func handleSyncGenesisHeaderProposal(ctx sdk.Context, k keeper.Keeper, p types.SyncGenesisHeaderProposal) error {
+	return k.ResetGenesisHeader(ctx, p.GenesisHeader)
+}
+
+func handleUnfreezeChainProposal(ctx sdk.Context, k keeper.Keeper, p types.UnfreezeChainProposal) error {
+	return k.UnfreezeChain(ctx, p.ChainId)
+}
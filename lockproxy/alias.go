@@ -35,8 +35,18 @@ const (
 	EventTypeCreateAndDelegateCoinToProxy = types.EventTypeCreateAndDelegateCoinToProxy
 	EventTypeBindProxy                    = types.EventTypeBindProxy
 	EventTypeBindAsset                    = types.EventTypeBindAsset
+	EventTypeUnbindProxy                  = types.EventTypeUnbindProxy
+	EventTypeUnbindAsset                  = types.EventTypeUnbindAsset
 	EventTypeLock                         = types.EventTypeLock
 	EventTypeUnlock                       = types.EventTypeUnlock
+	EventTypeUnlockAction                 = types.EventTypeUnlockAction
+	EventTypeUnlockActionFailed           = types.EventTypeUnlockActionFailed
+	EventTypeProposeBindingChange         = types.EventTypeProposeBindingChange
+	EventTypeApplyBindingChange           = types.EventTypeApplyBindingChange
+	EventTypeCancelBindingChange          = types.EventTypeCancelBindingChange
+	EventTypeSettleAssetDecimals          = types.EventTypeSettleAssetDecimals
+	EventTypeApproveOperatorAction        = types.EventTypeApproveOperatorAction
+	EventTypeTransferOwnership            = types.EventTypeTransferOwnership
 	AttributeKeyCreator                   = types.AttributeKeyCreator
 	AttributeKeyLockProxy                 = types.AttributeKeyLockProxy
 	AttributeKeyToChainId                 = types.AttributeKeyToChainId
@@ -47,17 +57,47 @@ const (
 	AttributeKeyFromAddress               = types.AttributeKeyFromAddress
 	AttributeKeyToAddress                 = types.AttributeKeyToAddress
 	AttributeKeyAmount                    = types.AttributeKeyAmount
+	AttributeKeyDust                      = types.AttributeKeyDust
+	AttributeKeyAction                    = types.AttributeKeyAction
+	AttributeKeyPendingId                 = types.AttributeKeyPendingId
+	AttributeKeyEffectiveHeight           = types.AttributeKeyEffectiveHeight
+	AttributeKeyBindingType               = types.AttributeKeyBindingType
+	AttributeKeyCanceller                 = types.AttributeKeyCanceller
+	AttributeKeyNewValue                  = types.AttributeKeyNewValue
+	AttributeKeyOperatorAction            = types.AttributeKeyOperatorAction
+	AttributeKeyApprovals                 = types.AttributeKeyApprovals
+	AttributeKeyThreshold                 = types.AttributeKeyThreshold
+	AttributeKeyMembers                   = types.AttributeKeyMembers
+	OperatorActionBindProxy               = types.OperatorActionBindProxy
+	OperatorActionBindAsset               = types.OperatorActionBindAsset
+	OperatorActionUnbindProxy             = types.OperatorActionUnbindProxy
+	OperatorActionUnbindAsset             = types.OperatorActionUnbindAsset
+	OperatorActionSettleDecimals          = types.OperatorActionSettleDecimals
+	OperatorActionTransferOwnership       = types.OperatorActionTransferOwnership
+	OperatorActionCancel                  = types.OperatorActionCancel
+	UnlockActionSend                      = types.UnlockActionSend
+	UnlockActionDelegate                  = types.UnlockActionDelegate
 )
 
 var (
 	RegisterCodec                      = types.RegisterCodec
 	NewKeeper                          = keeper.NewKeeper
 	NewQuerier                         = keeper.NewQuerier
+	NewQueryServer                     = keeper.NewQueryServer
 	ModuleCdc                          = types.ModuleCdc
 	NewMsgCreateLockProxy              = types.NewMsgCreateLockProxy
 	NewMsgCreateCoinAndDelegateToProxy = types.NewMsgCreateCoinAndDelegateToProxy
 	NewMsgBindAssetHash                = types.NewMsgBindAssetHash
 	NewMsgBindProxyHash                = types.NewMsgBindProxyHash
+	NewMsgUnbindProxyHash              = types.NewMsgUnbindProxyHash
+	NewMsgUnbindAssetHash              = types.NewMsgUnbindAssetHash
+	NewMsgSettleAssetDecimals          = types.NewMsgSettleAssetDecimals
+	NewMsgCancelBindingChange          = types.NewMsgCancelBindingChange
+	NewMsgSetUnlockActionOptIn         = types.NewMsgSetUnlockActionOptIn
+	NewMsgApproveOperatorAction        = types.NewMsgApproveOperatorAction
+	NewMsgTransferOwnership            = types.NewMsgTransferOwnership
+	NewOperatorGroup                   = types.NewOperatorGroup
+	ValidateOperatorGroup              = types.ValidateOperatorGroup
 	NewMsgLock                         = types.NewMsgLock
 	ErrInvalidChainId                  = types.ErrInvalidChainId
 	ErrMsgBindAssetHash                = types.ErrMsgBindAssetHash
@@ -70,18 +110,63 @@ var (
 	ErrUnLock                          = types.ErrUnLock
 	ErrMsgBindProxyHash                = types.ErrMsgBindProxyHash
 	ErrCreateCoinAndDelegateToProxy    = types.ErrCreateCoinAndDelegateToProxy
+	ErrUnlockAction                    = types.ErrUnlockAction
+	ErrInvalidToAddress                = types.ErrInvalidToAddress
+	ErrUnbindProxyHash                 = types.ErrUnbindProxyHash
+	ErrUnbindAssetHash                 = types.ErrUnbindAssetHash
+	ErrCancelBindingChange             = types.ErrCancelBindingChange
+	ErrOperatorGroup                   = types.ErrOperatorGroup
+	ErrSettleAssetDecimals             = types.ErrSettleAssetDecimals
+	NewSendUnlockAction                = keeper.NewSendUnlockAction
+	NewDelegateUnlockAction            = keeper.NewDelegateUnlockAction
 	OperatorToLockProxyKey             = keeper.OperatorToLockProxyKey
 	BindProxyPrefix                    = keeper.BindProxyPrefix
 	BindAssetPrefix                    = keeper.BindAssetPrefix
+	BindAssetDecimalsPrefix            = keeper.BindAssetDecimalsPrefix
+	BindingChangePrefix                = keeper.BindingChangePrefix
+	BindingChangeCountKey              = keeper.BindingChangeCountKey
+	PendingBindingPrefix               = keeper.PendingBindingPrefix
+	PendingBindingIdKey                = keeper.PendingBindingIdKey
+	PendingBindingQueuePrefix          = keeper.PendingBindingQueuePrefix
+	OperatorGroupPrefix                = keeper.OperatorGroupPrefix
+	OperatorApprovalPrefix             = keeper.OperatorApprovalPrefix
+	UnlockAssetDecimalsPrefix          = keeper.UnlockAssetDecimalsPrefix
 	GetOperatorToLockProxyKey          = keeper.GetOperatorToLockProxyKey
 	GetBindProxyKey                    = keeper.GetBindProxyKey
 	GetBindAssetHashKey                = keeper.GetBindAssetHashKey
+	GetBindAssetDecimalsKey            = keeper.GetBindAssetDecimalsKey
+	GetBindingChangeKey                = keeper.GetBindingChangeKey
+	GetPendingBindingKey               = keeper.GetPendingBindingKey
+	GetPendingBindingQueueKey          = keeper.GetPendingBindingQueueKey
+	GetOperatorGroupKey                = keeper.GetOperatorGroupKey
+	GetOperatorApprovalKey             = keeper.GetOperatorApprovalKey
+	GetUnlockAssetDecimalsKey          = keeper.GetUnlockAssetDecimalsKey
 	QueryProxyByOperator               = types.QueryProxyByOperator
 	QueryProxyHash                     = types.QueryProxyHash
 	QueryAssetHash                     = types.QueryAssetHash
+	QueryBindingHistory                = types.QueryBindingHistory
+	QueryPendingBindingChanges         = types.QueryPendingBindingChanges
+	QueryOperatorGroup                 = types.QueryOperatorGroup
+	QueryOperatorApprovals             = types.QueryOperatorApprovals
+	QueryLockProxies                   = types.QueryLockProxies
+	QueryProxyBindings                 = types.QueryProxyBindings
+	QueryAssetBindings                 = types.QueryAssetBindings
 	NewQueryProxyByOperatorParam       = types.NewQueryProxyByOperatorParam
 	NewQueryProxyHashParam             = types.NewQueryProxyHashParam
 	NewQueryAssetHashParam             = types.NewQueryAssetHashParam
+	NewQueryBindingHistoryParam        = types.NewQueryBindingHistoryParam
+	NewQueryPendingBindingChangesParam = types.NewQueryPendingBindingChangesParam
+	NewQueryOperatorGroupParam         = types.NewQueryOperatorGroupParam
+	NewQueryLockProxiesParam           = types.NewQueryLockProxiesParam
+	NewQueryProxyBindingsParam         = types.NewQueryProxyBindingsParam
+	NewQueryAssetBindingsParam         = types.NewQueryAssetBindingsParam
+	ParamKeyTable                      = types.ParamKeyTable
+	DefaultParams                      = types.DefaultParams
+	NewGenesisState                    = types.NewGenesisState
+	DefaultGenesisState                = types.DefaultGenesisState
+	ValidateGenesis                    = types.ValidateGenesis
+	KeyBindingChangeDelay              = types.KeyBindingChangeDelay
+	KeyGuardian                        = types.KeyGuardian
 )
 
 type (
@@ -90,7 +175,25 @@ type (
 	MsgCreateCoinAndDelegateToProxy = types.MsgCreateCoinAndDelegateToProxy
 	MsgBindProxyHash                = types.MsgBindProxyHash
 	MsgBindAssetHash                = types.MsgBindAssetHash
+	MsgUnbindProxyHash              = types.MsgUnbindProxyHash
+	MsgUnbindAssetHash              = types.MsgUnbindAssetHash
+	MsgSettleAssetDecimals          = types.MsgSettleAssetDecimals
+	MsgCancelBindingChange          = types.MsgCancelBindingChange
+	MsgSetUnlockActionOptIn         = types.MsgSetUnlockActionOptIn
+	MsgApproveOperatorAction        = types.MsgApproveOperatorAction
+	MsgTransferOwnership            = types.MsgTransferOwnership
 	MsgLock                         = types.MsgLock
+	BindingChange                   = types.BindingChange
+	PendingBindingChange            = types.PendingBindingChange
+	OperatorGroup                   = types.OperatorGroup
+	OperatorAction                  = types.OperatorAction
+	OperatorApproval                = types.OperatorApproval
+	Params                          = types.Params
+	GenesisState                    = types.GenesisState
 	TxArgs                          = types.TxArgs
+	UnlockAction                    = types.UnlockAction
+	UnlockActionHandler             = types.UnlockActionHandler
 	UnlockKeeper                    = exported.UnlockKeeper
+	LockProxy                       = types.LockProxy
+	Binding                         = types.Binding
 )
@@ -31,6 +31,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	polycommon "github.com/polynetwork/cosmos-poly-module/common"
 	"github.com/polynetwork/cosmos-poly-module/lockproxy/client/common"
 	"github.com/polynetwork/cosmos-poly-module/lockproxy/internal/types"
 	"strconv"
@@ -51,6 +52,13 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 			GetCmdQueryProxyByOperator(queryRoute, cdc),
 			GetCmdQueryProxyHash(queryRoute, cdc),
 			GetCmdQueryAssetHash(queryRoute, cdc),
+			GetCmdQueryBindingHistory(queryRoute, cdc),
+			GetCmdQueryPendingBindingChanges(queryRoute, cdc),
+			GetCmdQueryOperatorGroup(queryRoute, cdc),
+			GetCmdQueryOperatorApprovals(queryRoute, cdc),
+			GetCmdQueryLockProxies(queryRoute, cdc),
+			GetCmdQueryProxyBindings(queryRoute, cdc),
+			GetCmdQueryAssetBindings(queryRoute, cdc),
 		)...,
 	)
 
@@ -189,3 +197,318 @@ $ %s query %s proxy-hash cosmos1ayc6faczpj42eu7wjsjkwcj7h0q2p2e4vrlkzf stake 2
 		},
 	}
 }
+
+const (
+	FlagLockProxy = "lock-proxy"
+	FlagDenom     = "denom"
+	FlagToChainId = "to-chain-id"
+)
+
+func GetCmdQueryBindingHistory(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "binding-history",
+		Short: "Query the history of proxy hash and asset hash binding changes",
+		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query every bind, rebind and unbind of proxy hashes and asset hashes page by page, optionally filtered
+by lock proxy, denom and target chainId. The changes are ordered by binding, and the changes of one binding in the
+order they happened
+
+Example:
+$ %s query %s binding-history --lock-proxy e931a4f7020caaacf3ce942567625ebbc0a0ab35 --denom stake --to-chain-id 2 --page 2 --limit 50
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			lockProxyStr, err := cmd.Flags().GetString(FlagLockProxy)
+			if err != nil {
+				return err
+			}
+			var lockProxy []byte
+			if lockProxyStr != "" {
+				lockProxy, err = sdk.AccAddressFromBech32(lockProxyStr)
+				if err != nil {
+					lockProxyBs, err1 := hex.DecodeString(lockProxyStr)
+					if err1 != nil {
+						return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, fmt.Sprintf("lockproxy: %s or operator decord Error: %s", err, err1))
+					}
+					lockProxy = lockProxyBs
+				}
+			}
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+			chainId, err := cmd.Flags().GetUint64(FlagToChainId)
+			if err != nil {
+				return err
+			}
+			page, limit, err := getPageFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := common.QueryBindingHistory(cliCtx, queryRoute, lockProxy, denom, chainId, page, limit)
+			if err != nil {
+				return err
+			}
+			var changes []types.BindingChange
+			cdc.MustUnmarshalJSON(res, &changes)
+			return cliCtx.PrintOutput(changes)
+		},
+	}
+	cmd.Flags().String(FlagLockProxy, "", "lock proxy hash or its operator address, all lock proxies if empty")
+	cmd.Flags().String(FlagDenom, "", "source asset denom, all denoms if empty")
+	cmd.Flags().Uint64(FlagToChainId, 0, "target chainId, all chains if zero")
+	addPageFlags(cmd)
+	return cmd
+}
+
+func GetCmdQueryPendingBindingChanges(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-binding-changes",
+		Short: "Query the proxy hash and asset hash binding changes waiting to take effect",
+		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the proposed binding changes which have not reached their effective height yet,
+optionally filtered by lock proxy
+
+Example:
+$ %s query %s pending-binding-changes --lock-proxy e931a4f7020caaacf3ce942567625ebbc0a0ab35
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			lockProxyStr, err := cmd.Flags().GetString(FlagLockProxy)
+			if err != nil {
+				return err
+			}
+			var lockProxy []byte
+			if lockProxyStr != "" {
+				lockProxy, err = sdk.AccAddressFromBech32(lockProxyStr)
+				if err != nil {
+					lockProxyBs, err1 := hex.DecodeString(lockProxyStr)
+					if err1 != nil {
+						return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, fmt.Sprintf("lockproxy: %s or operator decord Error: %s", err, err1))
+					}
+					lockProxy = lockProxyBs
+				}
+			}
+
+			res, err := common.QueryPendingBindingChanges(cliCtx, queryRoute, lockProxy)
+			if err != nil {
+				return err
+			}
+			var changes []types.PendingBindingChange
+			cdc.MustUnmarshalJSON(res, &changes)
+			return cliCtx.PrintOutput(changes)
+		},
+	}
+	cmd.Flags().String(FlagLockProxy, "", "lock proxy hash or its operator address, all lock proxies if empty")
+	return cmd
+}
+
+// parseLockProxy accepts either the lock proxy hash in hex or the address of its creator
+func parseLockProxy(lockProxyStr string) ([]byte, error) {
+	lockProxy, err := sdk.AccAddressFromBech32(lockProxyStr)
+	if err != nil {
+		lockProxyBs, err1 := hex.DecodeString(lockProxyStr)
+		if err1 != nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, fmt.Sprintf("lockproxy: %s or operator decord Error: %s", err, err1))
+		}
+		return lockProxyBs, nil
+	}
+	return lockProxy, nil
+}
+
+func GetCmdQueryOperatorGroup(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "operator-group [lock_proxy_hash/proxy_creator_address]",
+		Short: "Query the operator group controlling the lock proxy",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the members and threshold of the operator group controlling the lock proxy,
+the lock proxy never transferred is controlled by its creator alone
+
+Example:
+$ %s query %s operator-group e931a4f7020caaacf3ce942567625ebbc0a0ab35
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			lockProxy, err := parseLockProxy(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := common.QueryOperatorGroup(cliCtx, queryRoute, lockProxy)
+			if err != nil {
+				return err
+			}
+			var group types.OperatorGroup
+			cdc.MustUnmarshalJSON(res, &group)
+			return cliCtx.PrintOutput(group)
+		},
+	}
+}
+
+func GetCmdQueryOperatorApprovals(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "operator-approvals [lock_proxy_hash/proxy_creator_address]",
+		Short: "Query the operator actions of the lock proxy waiting for more approvals",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the operator actions of the lock proxy approved by part of its operator group
+
+Example:
+$ %s query %s operator-approvals e931a4f7020caaacf3ce942567625ebbc0a0ab35
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			lockProxy, err := parseLockProxy(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := common.QueryOperatorApprovals(cliCtx, queryRoute, lockProxy)
+			if err != nil {
+				return err
+			}
+			var approvals []types.OperatorApproval
+			cdc.MustUnmarshalJSON(res, &approvals)
+			return cliCtx.PrintOutput(approvals)
+		},
+	}
+}
+
+func GetCmdQueryLockProxies(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lock-proxies",
+		Short: "Query all the lock proxies page by page",
+		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+
+Example:
+$ %s query %s lock-proxies --page 2 --limit 50
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			page, limit, err := getPageFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := common.QueryLockProxies(cliCtx, queryRoute, page, limit)
+			if err != nil {
+				return err
+			}
+			var proxies []types.LockProxy
+			cdc.MustUnmarshalJSON(res, &proxies)
+			return cliCtx.PrintOutput(proxies)
+		},
+	}
+	addPageFlags(cmd)
+	return cmd
+}
+
+func GetCmdQueryProxyBindings(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proxy-bindings [lock_proxy_hash/proxy_creator_address]",
+		Short: "Query all the proxy hashes bound by the lock proxy page by page",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+
+Example:
+$ %s query %s proxy-bindings e931a4f7020caaacf3ce942567625ebbc0a0ab35 --page 1 --limit 50
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			lockProxy, err := parseLockProxy(args[0])
+			if err != nil {
+				return err
+			}
+			page, limit, err := getPageFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := common.QueryProxyBindings(cliCtx, queryRoute, lockProxy, page, limit)
+			if err != nil {
+				return err
+			}
+			var bindings []types.Binding
+			cdc.MustUnmarshalJSON(res, &bindings)
+			return cliCtx.PrintOutput(bindings)
+		},
+	}
+	addPageFlags(cmd)
+	return cmd
+}
+
+func GetCmdQueryAssetBindings(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "asset-bindings [lock_proxy_hash/proxy_creator_address] [sourceassetdenom]",
+		Short: "Query all the asset hashes bound to sourceAssetDenom by the lock proxy page by page",
+		Args:  cobra.ExactArgs(2),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+
+Example:
+$ %s query %s asset-bindings e931a4f7020caaacf3ce942567625ebbc0a0ab35 stake --page 1 --limit 50
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			lockProxy, err := parseLockProxy(args[0])
+			if err != nil {
+				return err
+			}
+			page, limit, err := getPageFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := common.QueryAssetBindings(cliCtx, queryRoute, lockProxy, args[1], page, limit)
+			if err != nil {
+				return err
+			}
+			var bindings []types.Binding
+			cdc.MustUnmarshalJSON(res, &bindings)
+			return cliCtx.PrintOutput(bindings)
+		},
+	}
+	addPageFlags(cmd)
+	return cmd
+}
+
+func addPageFlags(cmd *cobra.Command) {
+	cmd.Flags().Int(flags.FlagPage, 1, "page of the results to query, starting from 1")
+	cmd.Flags().Int(flags.FlagLimit, polycommon.DefaultPageLimit, "number of results per page")
+}
+
+func getPageFlags(cmd *cobra.Command) (page, limit int, err error) {
+	if page, err = cmd.Flags().GetInt(flags.FlagPage); err != nil {
+		return
+	}
+	limit, err = cmd.Flags().GetInt(flags.FlagLimit)
+	return
+}
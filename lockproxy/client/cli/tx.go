@@ -34,6 +34,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
 	"github.com/polynetwork/cosmos-poly-module/lockproxy/internal/types"
+	polycommon "github.com/polynetwork/poly/common"
 	"math/big"
 	"strconv"
 )
@@ -52,6 +53,13 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 		SendCreateCoinAndDelegateToProxyTxCmd(cdc),
 		SendBindProxyHashTxCmd(cdc),
 		SendBindAssetHashTxCmd(cdc),
+		SendUnbindProxyHashTxCmd(cdc),
+		SendUnbindAssetHashTxCmd(cdc),
+		SendSettleAssetDecimalsTxCmd(cdc),
+		SendCancelBindingChangeTxCmd(cdc),
+		SendApproveOperatorActionTxCmd(cdc),
+		SendTransferOwnershipTxCmd(cdc),
+		SendSetUnlockActionOptInTxCmd(cdc),
 		SendLockTxCmd(cdc),
 	)...)
 	return txCmd
@@ -169,6 +177,11 @@ $ %s tx %s bind-proxy-hash 3 0x11223344556677889900
 	return cmd
 }
 
+const (
+	FlagSourceDecimals = "source-decimals"
+	FlagToDecimals     = "to-decimals"
+)
+
 func SendBindAssetHashTxCmd(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "bind-asset-hash [source_asset_denom] [to_chainId] [to_asset_hash]",
@@ -203,24 +216,304 @@ $ %s tx %s bind-asset-hash ont 3 00000000000000000001 100000
 				return fmt.Errorf("decode hex string 'targetProxyHash' error:%v", err)
 			}
 
+			sourceDecimals, err := cmd.Flags().GetUint8(FlagSourceDecimals)
+			if err != nil {
+				return err
+			}
+			toDecimals, err := cmd.Flags().GetUint8(FlagToDecimals)
+			if err != nil {
+				return err
+			}
+
 			// build and sign the transaction, then broadcast to Tendermint
-			msg := types.NewMsgBindAssetHash(cliCtx.GetFromAddress(), sourceAssetDenom, toChainId, toAssetHash)
+			msg := types.NewMsgBindAssetHash(cliCtx.GetFromAddress(), sourceAssetDenom, toChainId, toAssetHash, sourceDecimals, toDecimals)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().Uint8(FlagSourceDecimals, 0, "decimals of source_asset_denom in current chain")
+	cmd.Flags().Uint8(FlagToDecimals, 0, "decimals of to_asset_hash in to_chainId, amount is scaled when differs from source decimals")
+	return cmd
+}
+
+func SendUnbindProxyHashTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unbind-proxy-hash [to_chain_id]",
+		Short: "remove the proxy hash of to_chain_id bound by the operator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+Example:
+$ %s tx %s unbind-proxy-hash 3
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			toChainId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUnbindProxyHash(cliCtx.GetFromAddress(), toChainId)
 			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
 	return cmd
 }
 
+func SendCancelBindingChangeTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-binding-change [pending_id]",
+		Short: "approve the cancellation of the pending binding change as an operator, or cancel it at once as the guardian",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+Example:
+$ %s tx %s cancel-binding-change 0
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelBindingChange(cliCtx.GetFromAddress(), id)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
+
+func SendSetUnlockActionOptInTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-unlock-action-opt-in [action] [true|false]",
+		Short: "opt in or out of the unlock action performed on behalf of the sender when receiving cross chain coins",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+Example:
+$ %s tx %s set-unlock-action-opt-in %s true
+`,
+				version.ClientName, types.ModuleName, types.UnlockActionDelegate,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			optIn, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetUnlockActionOptIn(cliCtx.GetFromAddress(), args[0], optIn)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
+
+const FlagValue = "value"
+
+func SendApproveOperatorActionTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve-operator-action [lock_proxy_hash/proxy_creator_address] [bind_proxy_hash|bind_asset_hash|unbind_proxy_hash|unbind_asset_hash|settle_asset_decimals]",
+		Short: "approve the binding change of the lock proxy as a member of its operator group",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`The binding change takes effect once enough members of the operator group have approved the same change
+
+Example:
+$ %s tx %s approve-operator-action e931a4f7020caaacf3ce942567625ebbc0a0ab35 bind_asset_hash --denom ont --to-chain-id 3 --value 11223344556677889900 --source-decimals 0 --to-decimals 9
+$ %s tx %s approve-operator-action e931a4f7020caaacf3ce942567625ebbc0a0ab35 unbind_proxy_hash --to-chain-id 3
+`,
+				version.ClientName, types.ModuleName, version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			lockProxy, err := parseLockProxy(args[0])
+			if err != nil {
+				return err
+			}
+			action := types.OperatorAction{Type: args[1]}
+			if action.Denom, err = cmd.Flags().GetString(FlagDenom); err != nil {
+				return err
+			}
+			if action.ChainId, err = cmd.Flags().GetUint64(FlagToChainId); err != nil {
+				return err
+			}
+			valueStr, err := cmd.Flags().GetString(FlagValue)
+			if err != nil {
+				return err
+			}
+			if action.Value, err = hex.DecodeString(strings.TrimPrefix(valueStr, "0x")); err != nil {
+				return fmt.Errorf("decode hex string 'value' error:%v", err)
+			}
+			if action.SourceDecimals, err = cmd.Flags().GetUint8(FlagSourceDecimals); err != nil {
+				return err
+			}
+			if action.ToDecimals, err = cmd.Flags().GetUint8(FlagToDecimals); err != nil {
+				return err
+			}
+
+			msg := types.NewMsgApproveOperatorAction(cliCtx.GetFromAddress(), lockProxy, action)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().String(FlagDenom, "", "source asset denom of asset binding")
+	cmd.Flags().Uint64(FlagToChainId, 0, "target chainId of the binding")
+	cmd.Flags().String(FlagValue, "", "proxy hash or asset hash in hex to bind")
+	cmd.Flags().Uint8(FlagSourceDecimals, 0, "decimals of the source asset in current chain")
+	cmd.Flags().Uint8(FlagToDecimals, 0, "decimals of the asset in target chain")
+	return cmd
+}
+
+func SendTransferOwnershipTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-ownership [lock_proxy_hash/proxy_creator_address] [threshold] [member_address]...",
+		Short: "approve to transfer the lock proxy to a new operator group as a member of its current operator group",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`The new operator group of members controls the lock proxy once enough members of the current operator group
+have approved the same transfer and the binding change delay has passed, the pending transfer is cancellable by
+cancel-binding-change in the meantime. The binding changes still pending at the transfer are dropped, after which
+each binding change needs approvals of threshold members
+
+Example:
+$ %s tx %s transfer-ownership e931a4f7020caaacf3ce942567625ebbc0a0ab35 2 cosmos1ayc6faczpj42eu7wjsjkwcj7h0q2p2e4vrlkzf cosmos1c0n2e6kuzp03pqm3av9q2v0fqn6ql3z5c5ddw7 cosmos1wxeyh7zgn4tctjzs0vtqpc6p5cxq5t2muzl7ng
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.MinimumNArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			lockProxy, err := parseLockProxy(args[0])
+			if err != nil {
+				return err
+			}
+			threshold, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			members := make([]sdk.AccAddress, 0, len(args)-2)
+			for _, memberStr := range args[2:] {
+				member, err := sdk.AccAddressFromBech32(memberStr)
+				if err != nil {
+					return err
+				}
+				members = append(members, member)
+			}
+
+			msg := types.NewMsgTransferOwnership(cliCtx.GetFromAddress(), lockProxy, members, threshold)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
+
+func SendUnbindAssetHashTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unbind-asset-hash [source_asset_denom] [to_chain_id]",
+		Short: "remove the asset hash of source_asset_denom in to_chain_id bound by the operator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+Example:
+$ %s tx %s unbind-asset-hash ont 3
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			toChainId, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUnbindAssetHash(cliCtx.GetFromAddress(), args[0], toChainId)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
+
+func SendSettleAssetDecimalsTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "settle-asset-decimals [source_asset_denom] [chain_id]",
+		Short: "let the unlock from chain_id scale with the decimals of the asset binding after they changed",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`The unlock keeps scaling with the decimals before a change of the asset binding, as the transfers in flight have
+been sent under them, settle the change only once every transfer sent from chain_id under the former decimals has
+been unlocked
+
+Example:
+$ %s tx %s settle-asset-decimals ont 3
+`,
+				version.ClientName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			chainId, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSettleAssetDecimals(cliCtx.GetFromAddress(), args[0], chainId)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
+
+// nolint
+const (
+	FlagAction       = "action"
+	FlagActionParams = "action-params"
+)
+
 func SendLockTxCmd(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "lock [lock_proxy_hash] [source_asset_denom] [to_chain_id] [to_address] [amount]",
 		Short: "lock amount of source_asset_denom and aim to release amount in to_chain_id chain to to_address",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`
+			fmt.Sprintf(`The optional action is performed by to_address right after the unlock in to_chain_id, it is only sent to
+a to_chain_id registered to perform it, and a delegate action only carries the bond asset of to_chain_id.
+The unlock waits until to_address has opted into the action there, any other failure of the action leaves
+the coins unlocked to to_address
+
 Example:
 $ %s tx %s lock 12341234 ont 3 616f2a4a38396ff203ea01e6c070ae421bb8ce2d 123 
+$ %s tx %s lock 12341234 stake 3 616f2a4a38396ff203ea01e6c070ae421bb8ce2d 123 --action %s --action-params 7ab42c9a6a5bb1b7e2dd3c31f9c1e2f4a1b6c0de
 `,
-				version.ClientName, types.ModuleName,
+				version.ClientName, types.ModuleName, version.ClientName, types.ModuleName, types.UnlockActionDelegate,
 			),
 		),
 		Args: cobra.ExactArgs(5),
@@ -254,10 +547,31 @@ $ %s tx %s lock 12341234 ont 3 616f2a4a38396ff203ea01e6c070ae421bb8ce2d 123
 			}
 			value := sdk.NewIntFromBigInt(valueBigInt)
 
+			var actionBs []byte
+			actionName, err := cmd.Flags().GetString(FlagAction)
+			if err != nil {
+				return err
+			}
+			if actionName != "" {
+				paramsStr, err := cmd.Flags().GetString(FlagActionParams)
+				if err != nil {
+					return err
+				}
+				params, err := hex.DecodeString(strings.TrimPrefix(paramsStr, "0x"))
+				if err != nil {
+					return fmt.Errorf("decode hex string 'action-params' error:%v", err)
+				}
+				sink := polycommon.NewZeroCopySink(nil)
+				(&types.UnlockAction{Name: actionName, Params: params}).Serialization(sink)
+				actionBs = sink.Bytes()
+			}
+
 			// build and sign the transaction, then broadcast to Tendermint
-			msg := types.NewMsgLock(lockProxyHash, cliCtx.GetFromAddress(), sourceAssetDenom, toChainId, toAddress, value)
+			msg := types.NewMsgLock(lockProxyHash, cliCtx.GetFromAddress(), sourceAssetDenom, toChainId, toAddress, value, actionBs)
 			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
+	cmd.Flags().String(FlagAction, "", "name of the unlock action performed by to_address in to_chain_id")
+	cmd.Flags().String(FlagActionParams, "", "params of the unlock action in hex")
 	return cmd
 }
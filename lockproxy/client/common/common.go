@@ -53,3 +53,66 @@ func QueryAssetHash(cliCtx context.CLIContext, queryRoute string, lockProxy []by
 	)
 	return res, err
 }
+
+func QueryBindingHistory(cliCtx context.CLIContext, queryRoute string, lockProxy []byte, sourceAssetDenom string, chainId uint64, page, limit int) ([]byte, error) {
+
+	res, _, err := cliCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryBindingHistory),
+		cliCtx.Codec.MustMarshalJSON(types.NewQueryBindingHistoryParam(lockProxy, sourceAssetDenom, chainId, page, limit)),
+	)
+	return res, err
+}
+
+func QueryPendingBindingChanges(cliCtx context.CLIContext, queryRoute string, lockProxy []byte) ([]byte, error) {
+
+	res, _, err := cliCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryPendingBindingChanges),
+		cliCtx.Codec.MustMarshalJSON(types.NewQueryPendingBindingChangesParam(lockProxy)),
+	)
+	return res, err
+}
+
+func QueryOperatorGroup(cliCtx context.CLIContext, queryRoute string, lockProxy []byte) ([]byte, error) {
+
+	res, _, err := cliCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryOperatorGroup),
+		cliCtx.Codec.MustMarshalJSON(types.NewQueryOperatorGroupParam(lockProxy)),
+	)
+	return res, err
+}
+
+func QueryOperatorApprovals(cliCtx context.CLIContext, queryRoute string, lockProxy []byte) ([]byte, error) {
+
+	res, _, err := cliCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryOperatorApprovals),
+		cliCtx.Codec.MustMarshalJSON(types.NewQueryOperatorGroupParam(lockProxy)),
+	)
+	return res, err
+}
+
+func QueryLockProxies(cliCtx context.CLIContext, queryRoute string, page, limit int) ([]byte, error) {
+
+	res, _, err := cliCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryLockProxies),
+		cliCtx.Codec.MustMarshalJSON(types.NewQueryLockProxiesParam(page, limit)),
+	)
+	return res, err
+}
+
+func QueryProxyBindings(cliCtx context.CLIContext, queryRoute string, lockProxy []byte, page, limit int) ([]byte, error) {
+
+	res, _, err := cliCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryProxyBindings),
+		cliCtx.Codec.MustMarshalJSON(types.NewQueryProxyBindingsParam(lockProxy, page, limit)),
+	)
+	return res, err
+}
+
+func QueryAssetBindings(cliCtx context.CLIContext, queryRoute string, lockProxy []byte, sourceAssetDenom string, page, limit int) ([]byte, error) {
+
+	res, _, err := cliCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryAssetBindings),
+		cliCtx.Codec.MustMarshalJSON(types.NewQueryAssetBindingsParam(lockProxy, sourceAssetDenom, page, limit)),
+	)
+	return res, err
+}
@@ -0,0 +1,173 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package rest
+
+import (
+	"fmt"
+
+	"github.com/gorilla/mux"
+
+	"github.com/polynetwork/cosmos-poly-module/common"
+	"github.com/polynetwork/cosmos-poly-module/common/openapi"
+	"github.com/polynetwork/cosmos-poly-module/lockproxy/internal/types"
+)
+
+func registerOpenAPIRoute(r *mux.Router) {
+	r.HandleFunc("/lockproxy/"+openapi.FileName, openapi.Handler("lockproxy REST API", openAPIRoutes())).Methods("GET")
+}
+
+// openAPIRoutes describes the routes of RegisterRoutes
+func openAPIRoutes() []openapi.Route {
+	lockProxyParam := openapi.PathParam(LockProxyHash, "string", "hex encoded lock proxy hash")
+	toChainIdParam := openapi.PathParam(ToChainId, "integer", "poly chain id of the target chain")
+	return []openapi.Route{
+		{
+			Method:  "GET",
+			Path:    fmt.Sprintf("/lockproxy/proxy_hash_by_operator/{%s}", Operator),
+			Summary: "Lock proxy hash created by the operator",
+			Params:  []openapi.Param{openapi.PathParam(Operator, "string", "bech32 account address")},
+			Result:  []byte{},
+		},
+		{
+			Method:  "GET",
+			Path:    fmt.Sprintf("/lockproxy/proxy_hash/{%s}/{%s}", LockProxyHash, ToChainId),
+			Summary: "Proxy hash in the target chain bound to the lock proxy",
+			Params:  []openapi.Param{lockProxyParam, toChainIdParam},
+			Result:  []byte{},
+		},
+		{
+			Method:  "GET",
+			Path:    fmt.Sprintf("/lockproxy/asset_hash/{%s}/{%s}/{%s}", LockProxyHash, AssetDenom, ToChainId),
+			Summary: "Asset hash in the target chain bound to the denom of the lock proxy",
+			Params:  []openapi.Param{lockProxyParam, toChainIdParam},
+			Result:  []byte{},
+		},
+		{
+			Method:  "GET",
+			Path:    "/lockproxy/binding_history",
+			Summary: "Binding changes of the lock proxies, filtered by the optional parameters",
+			Params: append([]openapi.Param{
+				openapi.QueryParam(LockProxyHash, "string", "hex encoded lock proxy hash"),
+				openapi.QueryParam(AssetDenom, "string", "denom of the asset bindings"),
+				openapi.QueryParam(ToChainId, "integer", "poly chain id of the target chain"),
+			}, openapi.PageParams()...),
+			Result: []common.BindingChange{},
+		},
+		{
+			Method:  "GET",
+			Path:    "/lockproxy/pending_binding_changes",
+			Summary: "Binding changes waiting for their effective height",
+			Params:  []openapi.Param{openapi.QueryParam(LockProxyHash, "string", "hex encoded lock proxy hash")},
+			Result:  []types.PendingBindingChange{},
+		},
+		{
+			Method:  "GET",
+			Path:    fmt.Sprintf("/lockproxy/operator_group/{%s}", LockProxyHash),
+			Summary: "Operator group owning the lock proxy",
+			Params:  []openapi.Param{lockProxyParam},
+			Result:  types.OperatorGroup{},
+		},
+		{
+			Method:  "GET",
+			Path:    fmt.Sprintf("/lockproxy/operator_approvals/{%s}", LockProxyHash),
+			Summary: "Approvals of the operator group members not executed yet",
+			Params:  []openapi.Param{lockProxyParam},
+			Result:  []types.OperatorApproval{},
+		},
+		{Method: "GET", Path: "/lockproxy/lock_proxies", Summary: "Lock proxies and their operators", Params: openapi.PageParams(), Result: []types.LockProxy{}},
+		{
+			Method:  "GET",
+			Path:    fmt.Sprintf("/lockproxy/proxy_bindings/{%s}", LockProxyHash),
+			Summary: "Proxy hashes bound to the lock proxy per chain",
+			Params:  append([]openapi.Param{lockProxyParam}, openapi.PageParams()...),
+			Result:  []common.Binding{},
+		},
+		{
+			Method:  "GET",
+			Path:    fmt.Sprintf("/lockproxy/asset_bindings/{%s}/{%s}", LockProxyHash, AssetDenom),
+			Summary: "Asset hashes bound to the denom of the lock proxy per chain",
+			Params:  append([]openapi.Param{lockProxyParam}, openapi.PageParams()...),
+			Result:  []common.Binding{},
+		},
+		{Method: "POST", Path: "/lockproxy/create_lock_proxy", Summary: "Create the lock proxy operated by the sender", Body: BaseReq{}},
+		{
+			Method:  "POST",
+			Path:    fmt.Sprintf("/lockproxy/create_and_delegate/{%s}/{%s}", Coin, LockProxyHash),
+			Summary: "Create the coin and delegate its supply to the lock proxy",
+			Params:  []openapi.Param{openapi.PathParam(Coin, "string", "coin to create, e.g. 1000000stake"), lockProxyParam},
+			Body:    BaseReq{},
+		},
+		{
+			Method:  "POST",
+			Path:    fmt.Sprintf("/lockproxy/bind_proxy/{%s}/{%s}", ToChainId, ToLockProxyHash),
+			Summary: "Bind the proxy hash of the target chain to the lock proxy of the sender",
+			Params:  []openapi.Param{toChainIdParam, openapi.PathParam(ToLockProxyHash, "string", "hex encoded proxy hash in the target chain")},
+			Body:    BaseReq{},
+		},
+		{Method: "POST", Path: "/lockproxy/bind_asset", Summary: "Bind the asset hash of the target chain to a denom", Body: BindAssetHashReq{}},
+		{
+			Method:  "POST",
+			Path:    fmt.Sprintf("/lockproxy/unbind_proxy/{%s}", ToChainId),
+			Summary: "Unbind the proxy hash of the target chain",
+			Params:  []openapi.Param{toChainIdParam},
+			Body:    BaseReq{},
+		},
+		{
+			Method:  "POST",
+			Path:    fmt.Sprintf("/lockproxy/unbind_asset/{%s}/{%s}", AssetDenom, ToChainId),
+			Summary: "Unbind the asset hash of the target chain from the denom",
+			Params:  []openapi.Param{toChainIdParam},
+			Body:    BaseReq{},
+		},
+		{
+			Method:  "POST",
+			Path:    fmt.Sprintf("/lockproxy/settle_asset_decimals/{%s}/{%s}", AssetDenom, ToChainId),
+			Summary: "Let the unlock from the chain scale with the decimals of the asset binding after they changed",
+			Params:  []openapi.Param{toChainIdParam},
+			Body:    BaseReq{},
+		},
+		{
+			Method:  "POST",
+			Path:    fmt.Sprintf("/lockproxy/cancel_binding_change/{%s}", PendingId),
+			Summary: "Approve the cancellation of a pending binding change, the guardian cancels it at once",
+			Params:  []openapi.Param{openapi.PathParam(PendingId, "integer", "id of the pending binding change")},
+			Body:    BaseReq{},
+		},
+		{
+			Method:  "POST",
+			Path:    fmt.Sprintf("/lockproxy/approve_operator_action/{%s}", LockProxyHash),
+			Summary: "Approve an action of the operator group owning the lock proxy",
+			Params:  []openapi.Param{lockProxyParam},
+			Body:    ApproveOperatorActionReq{},
+		},
+		{
+			Method:  "POST",
+			Path:    fmt.Sprintf("/lockproxy/transfer_ownership/{%s}", LockProxyHash),
+			Summary: "Hand the lock proxy over to an operator group",
+			Params:  []openapi.Param{lockProxyParam},
+			Body:    TransferOwnershipReq{},
+		},
+		{
+			Method:  "POST",
+			Path:    "/lockproxy/unlock_action_opt_in",
+			Summary: "Opt the sender in or out of an unlock action performed on its behalf",
+			Body:    SetUnlockActionOptInReq{},
+		},
+		{Method: "POST", Path: "/lockproxy/lock", Summary: "Lock coins to cross them to the target chain", Body: LockReq{}},
+	}
+}
@@ -27,6 +27,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/types/rest"
+	polycommon "github.com/polynetwork/cosmos-poly-module/common"
 	"github.com/polynetwork/cosmos-poly-module/lockproxy/client/common"
 	"strconv"
 )
@@ -47,6 +48,41 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router, queryRoute st
 		queryAssetHashHandlerFn(cliCtx, queryRoute),
 	).Methods("GET")
 
+	r.HandleFunc(
+		"/lockproxy/binding_history",
+		queryBindingHistoryHandlerFn(cliCtx, queryRoute),
+	).Methods("GET")
+
+	r.HandleFunc(
+		"/lockproxy/pending_binding_changes",
+		queryPendingBindingChangesHandlerFn(cliCtx, queryRoute),
+	).Methods("GET")
+
+	r.HandleFunc(
+		fmt.Sprintf("/lockproxy/operator_group/{%s}", LockProxyHash),
+		queryOperatorGroupHandlerFn(cliCtx, queryRoute, common.QueryOperatorGroup),
+	).Methods("GET")
+
+	r.HandleFunc(
+		fmt.Sprintf("/lockproxy/operator_approvals/{%s}", LockProxyHash),
+		queryOperatorGroupHandlerFn(cliCtx, queryRoute, common.QueryOperatorApprovals),
+	).Methods("GET")
+
+	r.HandleFunc(
+		"/lockproxy/lock_proxies",
+		queryLockProxiesHandlerFn(cliCtx, queryRoute),
+	).Methods("GET")
+
+	r.HandleFunc(
+		fmt.Sprintf("/lockproxy/proxy_bindings/{%s}", LockProxyHash),
+		queryProxyBindingsHandlerFn(cliCtx, queryRoute),
+	).Methods("GET")
+
+	r.HandleFunc(
+		fmt.Sprintf("/lockproxy/asset_bindings/{%s}/{%s}", LockProxyHash, AssetDenom),
+		queryAssetBindingsHandlerFn(cliCtx, queryRoute),
+	).Methods("GET")
+
 }
 
 func queryProxyHashByOperatorHandlerFn(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
@@ -159,3 +195,168 @@ func checkResponseQueryAssetHashResponse(
 
 	return res, true
 }
+
+// queryBindingHistoryHandlerFn accepts the optional lock_proxy_hash, asset_denom and to_chain_id url query parameters as filters,
+// along with the optional page and limit
+func queryBindingHistoryHandlerFn(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		_, page, limit, err := rest.ParseHTTPArgsWithLimit(r, polycommon.DefaultPageLimit)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+		query := r.URL.Query()
+		lockproxy, err := hex.DecodeString(query.Get(LockProxyHash))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		var chainId uint64
+		if chainIdStr := query.Get(ToChainId); chainIdStr != "" {
+			chainId, err = strconv.ParseUint(chainIdStr, 10, 64)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+
+		res, err := common.QueryBindingHistory(cliCtx, queryRoute, lockproxy, query.Get(AssetDenom), chainId, page, limit)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// queryPendingBindingChangesHandlerFn accepts the optional lock_proxy_hash url query parameter as filter
+func queryPendingBindingChangesHandlerFn(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+		lockproxy, err := hex.DecodeString(r.URL.Query().Get(LockProxyHash))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, err := common.QueryPendingBindingChanges(cliCtx, queryRoute, lockproxy)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// queryOperatorGroupHandlerFn serves both the operator group and the operator approvals of the lock proxy
+func queryOperatorGroupHandlerFn(cliCtx context.CLIContext, queryRoute string,
+	query func(context.CLIContext, string, []byte) ([]byte, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+		lockproxy, err := hex.DecodeString(mux.Vars(r)[LockProxyHash])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, err := query(cliCtx, queryRoute, lockproxy)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// queryLockProxiesHandlerFn accepts the optional page and limit url query parameters
+func queryLockProxiesHandlerFn(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		_, page, limit, err := rest.ParseHTTPArgsWithLimit(r, polycommon.DefaultPageLimit)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, err := common.QueryLockProxies(cliCtx, queryRoute, page, limit)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// queryProxyBindingsHandlerFn accepts the optional page and limit url query parameters
+func queryProxyBindingsHandlerFn(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		_, page, limit, err := rest.ParseHTTPArgsWithLimit(r, polycommon.DefaultPageLimit)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+		lockproxy, err := hex.DecodeString(mux.Vars(r)[LockProxyHash])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, err := common.QueryProxyBindings(cliCtx, queryRoute, lockproxy, page, limit)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// queryAssetBindingsHandlerFn accepts the optional page and limit url query parameters
+func queryAssetBindingsHandlerFn(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		_, page, limit, err := rest.ParseHTTPArgsWithLimit(r, polycommon.DefaultPageLimit)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+		vars := mux.Vars(r)
+		lockproxy, err := hex.DecodeString(vars[LockProxyHash])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, err := common.QueryAssetBindings(cliCtx, queryRoute, lockproxy, vars[AssetDenom], page, limit)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
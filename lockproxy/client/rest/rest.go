@@ -30,10 +30,12 @@ const (
 	ToChainId       = "to_chain_id"
 	AssetDenom      = "asset_denom"
 	ToLockProxyHash = "to_lock_proxy_hash"
+	PendingId       = "pending_id"
 )
 
 // RegisterRoutes registers minting module REST handlers on the provided router.
 func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, queryRoute string) {
 	registerQueryRoutes(cliCtx, r, queryRoute)
 	registerTxRoutes(cliCtx, r)
+	registerOpenAPIRoute(r)
 }
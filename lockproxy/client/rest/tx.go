@@ -41,6 +41,13 @@ func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc(fmt.Sprintf("/lockproxy/create_and_delegate/{%s}/{%s}", Coin, LockProxyHash), CreateAndDelegateCoinRequestHandlerFn(cliCtx)).Methods("POST")
 	r.HandleFunc(fmt.Sprintf("/lockproxy/bind_proxy/{%s}/{%s}", ToChainId, ToLockProxyHash), bindProxyRequestHandlerFn(cliCtx)).Methods("POST")
 	r.HandleFunc(fmt.Sprintf("/lockproxy/bind_asset"), bindAssetRequestHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/lockproxy/unbind_proxy/{%s}", ToChainId), unbindProxyRequestHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/lockproxy/unbind_asset/{%s}/{%s}", AssetDenom, ToChainId), unbindAssetRequestHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/lockproxy/settle_asset_decimals/{%s}/{%s}", AssetDenom, ToChainId), settleAssetDecimalsRequestHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/lockproxy/cancel_binding_change/{%s}", PendingId), cancelBindingChangeRequestHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/lockproxy/approve_operator_action/{%s}", LockProxyHash), approveOperatorActionRequestHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/lockproxy/transfer_ownership/{%s}", LockProxyHash), transferOwnershipRequestHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/lockproxy/unlock_action_opt_in", setUnlockActionOptInRequestHandlerFn(cliCtx)).Methods("POST")
 	r.HandleFunc(fmt.Sprintf("/lockproxy/lock"), lockRequestHandlerFn(cliCtx)).Methods("POST")
 
 }
@@ -50,11 +57,13 @@ type BaseReq struct {
 }
 
 type BindAssetHashReq struct {
-	BaseReq     rest.BaseReq `json:"base_req" yaml:"base_req"`
-	Denom       string       `json:"denom" yaml:"denom"`
-	ToChainId   uint64       `json:"to_chain_id" yaml:"to_chain_id"`
-	ToAssetHash []byte       `json:"to_asset_hash" yaml:"to_asset_hash"`
-	InitialAmt  *big.Int     `json:"initial_amt" yaml:"initial_amt"`
+	BaseReq        rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Denom          string       `json:"denom" yaml:"denom"`
+	ToChainId      uint64       `json:"to_chain_id" yaml:"to_chain_id"`
+	ToAssetHash    []byte       `json:"to_asset_hash" yaml:"to_asset_hash"`
+	InitialAmt     *big.Int     `json:"initial_amt" yaml:"initial_amt"`
+	SourceDecimals uint8        `json:"source_decimals" yaml:"source_decimals"`
+	ToDecimals     uint8        `json:"to_decimals" yaml:"to_decimals"`
 }
 
 type LockReq struct {
@@ -64,6 +73,7 @@ type LockReq struct {
 	ToChainId uint64       `json:"to_chain_id" yaml:"to_chain_id"`
 	ToAddress []byte       `json:"to_address" yaml:"to_address"`
 	Amount    *big.Int     `json:"amount" yaml:"amount"`
+	Action    []byte       `json:"action" yaml:"action"` // optional serialized UnlockAction
 }
 
 // SendRequestHandlerFn - http request handler to send coins to a address.
@@ -162,7 +172,220 @@ func bindAssetRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
-		msg := types.NewMsgBindAssetHash(cliCtx.GetFromAddress(), req.Denom, req.ToChainId, req.ToAssetHash)
+		msg := types.NewMsgBindAssetHash(cliCtx.GetFromAddress(), req.Denom, req.ToChainId, req.ToAssetHash, req.SourceDecimals, req.ToDecimals)
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func unbindProxyRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		toChainId, err := strconv.ParseUint(mux.Vars(r)[ToChainId], 10, 64)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var req BaseReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+		operator, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgUnbindProxyHash(operator, toChainId)
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func cancelBindingChangeRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, err := strconv.ParseUint(mux.Vars(r)[PendingId], 10, 64)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var req BaseReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+		signer, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgCancelBindingChange(signer, id)
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+type SetUnlockActionOptInReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Action  string       `json:"action" yaml:"action"`
+	OptIn   bool         `json:"opt_in" yaml:"opt_in"`
+}
+
+func setUnlockActionOptInRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req SetUnlockActionOptInReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+		receiver, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgSetUnlockActionOptIn(receiver, req.Action, req.OptIn)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+type ApproveOperatorActionReq struct {
+	BaseReq rest.BaseReq         `json:"base_req" yaml:"base_req"`
+	Action  types.OperatorAction `json:"action" yaml:"action"`
+}
+
+type TransferOwnershipReq struct {
+	BaseReq   rest.BaseReq     `json:"base_req" yaml:"base_req"`
+	Members   []sdk.AccAddress `json:"members" yaml:"members"`
+	Threshold uint64           `json:"threshold" yaml:"threshold"`
+}
+
+func approveOperatorActionRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		lockProxy, err := hex.DecodeString(mux.Vars(r)[LockProxyHash])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var req ApproveOperatorActionReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+		member, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgApproveOperatorAction(member, lockProxy, req.Action)
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func transferOwnershipRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		lockProxy, err := hex.DecodeString(mux.Vars(r)[LockProxyHash])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var req TransferOwnershipReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+		member, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgTransferOwnership(member, lockProxy, req.Members, req.Threshold)
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func unbindAssetRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		toChainId, err := strconv.ParseUint(vars[ToChainId], 10, 64)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var req BaseReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+		operator, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgUnbindAssetHash(operator, vars[AssetDenom], toChainId)
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func settleAssetDecimalsRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		chainId, err := strconv.ParseUint(vars[ToChainId], 10, 64)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var req BaseReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+		operator, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgSettleAssetDecimals(operator, vars[AssetDenom], chainId)
 		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
 	}
 }
@@ -179,7 +402,7 @@ func lockRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
-		msg := types.NewMsgLock(cliCtx.GetFromAddress(), req.LockProxy, req.Denom, req.ToChainId, req.ToAddress, sdk.NewIntFromBigInt(req.Amount))
+		msg := types.NewMsgLock(cliCtx.GetFromAddress(), req.LockProxy, req.Denom, req.ToChainId, req.ToAddress, sdk.NewIntFromBigInt(req.Amount), req.Action)
 		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
 	}
 }
@@ -24,11 +24,17 @@ import (
 )
 
 // InitGenesis new mint genesis
-func InitGenesis(ctx sdk.Context, keeper Keeper) {
+func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
 	// check if the module account exists
 	moduleAcc := keeper.GetModuleAccount(ctx)
 	if moduleAcc == nil {
 		panic(fmt.Sprintf("initGenesis error: %s module account has not been set", types.ModuleName))
 	}
+	keeper.SetParams(ctx, data.Params)
+}
 
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
+	params := keeper.GetParams(ctx)
+	return NewGenesisState(params)
 }
@@ -37,6 +37,20 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			return handleMsgBindProxyHash(ctx, k, msg)
 		case types.MsgBindAssetHash:
 			return handleMsgBindAssetHash(ctx, k, msg)
+		case types.MsgUnbindProxyHash:
+			return handleMsgUnbindProxyHash(ctx, k, msg)
+		case types.MsgUnbindAssetHash:
+			return handleMsgUnbindAssetHash(ctx, k, msg)
+		case types.MsgSettleAssetDecimals:
+			return handleMsgSettleAssetDecimals(ctx, k, msg)
+		case types.MsgCancelBindingChange:
+			return handleMsgCancelBindingChange(ctx, k, msg)
+		case types.MsgApproveOperatorAction:
+			return handleMsgApproveOperatorAction(ctx, k, msg)
+		case types.MsgTransferOwnership:
+			return handleMsgTransferOwnership(ctx, k, msg)
+		case types.MsgSetUnlockActionOptIn:
+			return handleMsgSetUnlockActionOptIn(ctx, k, msg)
 		case types.MsgLock:
 			return handleMsgLock(ctx, k, msg)
 		default:
@@ -94,7 +108,7 @@ func handleMsgBindProxyHash(ctx sdk.Context, k keeper.Keeper, msg types.MsgBindP
 
 func handleMsgBindAssetHash(ctx sdk.Context, k keeper.Keeper, msg types.MsgBindAssetHash) (*sdk.Result, error) {
 
-	err := k.BindAssetHash(ctx, msg.Operator, msg.SourceAssetDenom, msg.ToChainId, msg.ToAssetHash)
+	err := k.BindAssetHash(ctx, msg.Operator, msg.SourceAssetDenom, msg.ToChainId, msg.ToAssetHash, msg.SourceDecimals, msg.ToDecimals)
 	if err != nil {
 		return nil, err
 	}
@@ -108,9 +122,100 @@ func handleMsgBindAssetHash(ctx sdk.Context, k keeper.Keeper, msg types.MsgBindA
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
 
+func handleMsgUnbindProxyHash(ctx sdk.Context, k keeper.Keeper, msg types.MsgUnbindProxyHash) (*sdk.Result, error) {
+	if err := k.UnbindProxyHash(ctx, msg.Operator, msg.ToChainId); err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgUnbindAssetHash(ctx sdk.Context, k keeper.Keeper, msg types.MsgUnbindAssetHash) (*sdk.Result, error) {
+	if err := k.UnbindAssetHash(ctx, msg.Operator, msg.SourceAssetDenom, msg.ToChainId); err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgSettleAssetDecimals(ctx sdk.Context, k keeper.Keeper, msg types.MsgSettleAssetDecimals) (*sdk.Result, error) {
+	if err := k.SettleAssetDecimals(ctx, msg.Operator, msg.SourceAssetDenom, msg.ChainId); err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgCancelBindingChange(ctx sdk.Context, k keeper.Keeper, msg types.MsgCancelBindingChange) (*sdk.Result, error) {
+	if err := k.CancelBindingChange(ctx, msg.Signer, msg.Id); err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgApproveOperatorAction(ctx sdk.Context, k keeper.Keeper, msg types.MsgApproveOperatorAction) (*sdk.Result, error) {
+	if err := k.ApproveOperatorAction(ctx, msg.Member, msg.LockProxyHash, msg.Action); err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgTransferOwnership(ctx sdk.Context, k keeper.Keeper, msg types.MsgTransferOwnership) (*sdk.Result, error) {
+	if err := k.ApproveOperatorAction(ctx, msg.Member, msg.LockProxyHash, msg.Action()); err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgSetUnlockActionOptIn(ctx sdk.Context, k keeper.Keeper, msg types.MsgSetUnlockActionOptIn) (*sdk.Result, error) {
+	if err := k.SetUnlockActionOptIn(ctx, msg.Receiver, msg.Action, msg.OptIn); err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
 func handleMsgLock(ctx sdk.Context, k keeper.Keeper, msg types.MsgLock) (*sdk.Result, error) {
 
-	err := k.Lock(ctx, msg.LockProxyHash, msg.FromAddress, msg.SourceAssetDenom, msg.ToChainId, msg.ToAddressBs, msg.Value)
+	err := k.Lock(ctx, msg.LockProxyHash, msg.FromAddress, msg.SourceAssetDenom, msg.ToChainId, msg.ToAddressBs, msg.Value, msg.Action)
 	if err != nil {
 		return nil, err
 	}
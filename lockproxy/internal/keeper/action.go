@@ -0,0 +1,139 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package keeper
+
+import (
+	"fmt"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/polynetwork/cosmos-poly-module/lockproxy/internal/types"
+	"strconv"
+)
+
+// RegisterUnlockAction registers the handler of the follow-up action carried by TxArgs.Action,
+// it should be called before the keeper is mounted to ccm keeper
+func (k Keeper) RegisterUnlockAction(name string, handler types.UnlockActionHandler) {
+	if _, ok := k.actions[name]; ok {
+		panic(fmt.Sprintf("unlock action: %s already registered", name))
+	}
+	k.actions[name] = handler
+}
+
+// SetUnlockActionOptIn records whether receiver accepts the registered action to be performed on its behalf
+func (k Keeper) SetUnlockActionOptIn(ctx sdk.Context, receiver sdk.AccAddress, name string, optIn bool) error {
+	if _, ok := k.actions[name]; !ok {
+		return types.ErrUnlockAction(fmt.Sprintf("unlock action: %s not registered", name))
+	}
+	store := ctx.KVStore(k.storeKey)
+	if optIn {
+		store.Set(GetUnlockActionOptInKey(receiver, name), []byte{0x01})
+	} else {
+		store.Delete(GetUnlockActionOptInKey(receiver, name))
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetUnlockActionOptIn,
+			sdk.NewAttribute(types.AttributeKeyToAddress, receiver.String()),
+			sdk.NewAttribute(types.AttributeKeyAction, name),
+			sdk.NewAttribute(types.AttributeKeyOptIn, strconv.FormatBool(optIn)),
+		),
+	})
+	return nil
+}
+
+func (k Keeper) IsUnlockActionOptedIn(ctx sdk.Context, receiver sdk.AccAddress, name string) bool {
+	return ctx.KVStore(k.storeKey).Has(GetUnlockActionOptInKey(receiver, name))
+}
+
+// PerformUnlockAction executes the follow-up action on behalf of receiver with the coins just unlocked, the
+// action is chosen by the sender on source chain so it only runs if receiver has opted into it. Only the missing
+// opt-in fails the unlock, any other failure is terminal and skips the action, see types.UnlockActionHandler
+func (k Keeper) PerformUnlockAction(ctx sdk.Context, receiver sdk.AccAddress, amt sdk.Coins, actionBs []byte) error {
+	action, err := types.ParseUnlockAction(actionBs)
+	if err != nil {
+		k.skipUnlockAction(ctx, receiver, "", amt, fmt.Sprintf("Deserialization action: %x, Error: %s", actionBs, err.Error()))
+		return nil
+	}
+	handler, ok := k.actions[action.Name]
+	if !ok {
+		k.skipUnlockAction(ctx, receiver, action.Name, amt, fmt.Sprintf("unlock action: %s not registered", action.Name))
+		return nil
+	}
+	if !k.IsUnlockActionOptedIn(ctx, receiver, action.Name) {
+		return types.ErrUnlockAction(fmt.Sprintf("receiver: %s has not opted into unlock action: %s", receiver.String(), action.Name))
+	}
+	// the writes and events of a failed handler are dropped with its cached context
+	cacheCtx, write := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+	if err := handler(cacheCtx, receiver, amt, action.Params); err != nil {
+		k.skipUnlockAction(ctx, receiver, action.Name, amt, err.Error())
+		return nil
+	}
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUnlockAction,
+			sdk.NewAttribute(types.AttributeKeyAction, action.Name),
+			sdk.NewAttribute(types.AttributeKeyFromAddress, receiver.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, amt.String()),
+		),
+	})
+	return nil
+}
+
+func (k Keeper) skipUnlockAction(ctx sdk.Context, receiver sdk.AccAddress, name string, amt sdk.Coins, reason string) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUnlockActionFailed,
+			sdk.NewAttribute(types.AttributeKeyAction, name),
+			sdk.NewAttribute(types.AttributeKeyFromAddress, receiver.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, amt.String()),
+			sdk.NewAttribute(types.AttributeKeyReason, reason),
+		),
+	})
+}
+
+// NewSendUnlockAction forwards the unlocked coins to the address given by params
+func NewSendUnlockAction(bankKeeper types.BankKeeper) types.UnlockActionHandler {
+	return func(ctx sdk.Context, receiver sdk.AccAddress, amt sdk.Coins, params []byte) error {
+		toAddr := sdk.AccAddress(params)
+		if err := sdk.VerifyAddressFormat(toAddr); err != nil {
+			return err
+		}
+		if bankKeeper.BlacklistedAddr(toAddr) {
+			return fmt.Errorf("%s is not allowed to receive coins", toAddr.String())
+		}
+		return bankKeeper.SendCoins(ctx, receiver, toAddr, amt)
+	}
+}
+
+// NewDelegateUnlockAction delegates the unlocked bond denom coins to the validator given by params
+func NewDelegateUnlockAction(stakingKeeper types.StakingKeeper) types.UnlockActionHandler {
+	return func(ctx sdk.Context, receiver sdk.AccAddress, amt sdk.Coins, params []byte) error {
+		validator, found := stakingKeeper.GetValidator(ctx, sdk.ValAddress(params))
+		if !found {
+			return fmt.Errorf("validator: %s not exist", sdk.ValAddress(params).String())
+		}
+		bondDenom := stakingKeeper.BondDenom(ctx)
+		if len(amt) != 1 || amt[0].Denom != bondDenom {
+			return fmt.Errorf("only %s can be delegated, got: %s", bondDenom, amt.String())
+		}
+		_, err := stakingKeeper.Delegate(ctx, receiver, amt[0].Amount, sdk.Unbonded, validator, true)
+		return err
+	}
+}
@@ -0,0 +1,184 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+	"github.com/polynetwork/cosmos-poly-module/common"
+	"github.com/polynetwork/cosmos-poly-module/lockproxy/internal/types"
+	polycommon "github.com/polynetwork/poly/common"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func serializeUnlockArgs(t *testing.T, denom string, toAddr sdk.AccAddress, amount int64, action *types.UnlockAction) []byte {
+	args := types.TxArgs{
+		ToAssetHash: []byte(denom),
+		ToAddress:   toAddr,
+		Amount:      sdk.NewInt(amount).BigInt(),
+	}
+	if action != nil {
+		actionSink := polycommon.NewZeroCopySink(nil)
+		action.Serialization(actionSink)
+		args.Action = actionSink.Bytes()
+	}
+	sink := polycommon.NewZeroCopySink(nil)
+	require.Nil(t, args.Serialization(sink, 32))
+	return sink.Bytes()
+}
+
+func Test_lockproxy_UnlockAction(t *testing.T) {
+	app, ctx := createTestApp(true)
+	app.SupplyKeeper.SetSupply(ctx, supply.NewSupply(sdk.Coins{sdk.NewCoin("stake", sdk.NewInt(100))}))
+
+	lockProxy := sdk.AccAddress([]byte("lockProxy"))
+	fromProxy := []byte{1, 2, 3, 4}
+	var fromChainId uint64 = 2
+	require.Nil(t, app.LockProxyKeeper.CreateLockProxy(ctx, lockProxy))
+	require.Nil(t, app.LockProxyKeeper.CreateCoinAndDelegateToProxy(ctx, lockProxy, sdk.NewInt64Coin("coin1", 100), lockProxy))
+	require.Nil(t, app.LockProxyKeeper.BindProxyHash(ctx, lockProxy, fromChainId, fromProxy))
+	require.Nil(t, app.LockProxyKeeper.BindAssetHash(ctx, lockProxy, "coin1", fromChainId, []byte{5, 6}, 0, 0))
+
+	receiver := sdk.AccAddress([]byte("receiverAddress12345"))
+	finalReceiver := sdk.AccAddress([]byte("finalReceiverAddress"))
+
+	require.Error(t, app.LockProxyKeeper.SetUnlockActionOptIn(ctx, receiver, "unknown", true))
+
+	// the send action is chosen by the sender on source chain, so the unlock fails until receiver opts in. The
+	// unlock runs in the cached context of the tx processing the cross chain tx, which is dropped on failure
+	sendAction := &types.UnlockAction{Name: types.UnlockActionSend, Params: finalReceiver}
+	cacheCtx, _ := ctx.CacheContext()
+	require.True(t, types.ErrUnlockActionType.Is(app.LockProxyKeeper.Unlock(cacheCtx, fromChainId, fromProxy, lockProxy, serializeUnlockArgs(t, "coin1", receiver, 10, sendAction))))
+	require.True(t, app.BankKeeper.GetCoins(ctx, receiver).IsZero())
+	require.True(t, app.BankKeeper.GetCoins(ctx, finalReceiver).IsZero())
+
+	require.Nil(t, app.LockProxyKeeper.SetUnlockActionOptIn(ctx, receiver, types.UnlockActionSend, true))
+	require.Nil(t, app.LockProxyKeeper.SetUnlockActionOptIn(ctx, receiver, types.UnlockActionDelegate, true))
+	require.True(t, app.LockProxyKeeper.IsUnlockActionOptedIn(ctx, receiver, types.UnlockActionSend))
+
+	// once opted in every other failure is terminal, the action is skipped and the coins stay at receiver
+	testCases := []struct {
+		action      *types.UnlockAction
+		expectEvent string
+	}{
+		{&types.UnlockAction{Name: "unknown", Params: finalReceiver}, types.EventTypeUnlockActionFailed},
+		{&types.UnlockAction{Name: types.UnlockActionSend, Params: []byte{}}, types.EventTypeUnlockActionFailed},
+		{&types.UnlockAction{Name: types.UnlockActionSend, Params: supply.NewModuleAddress(types.ModuleName)}, types.EventTypeUnlockActionFailed},
+		{&types.UnlockAction{Name: types.UnlockActionDelegate, Params: sdk.ValAddress([]byte("validator"))}, types.EventTypeUnlockActionFailed},
+		{sendAction, types.EventTypeUnlockAction},
+	}
+	for i, testCase := range testCases {
+		cacheCtx, _ := ctx.CacheContext()
+		cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+		require.Nil(t, app.LockProxyKeeper.Unlock(cacheCtx, fromChainId, fromProxy, lockProxy, serializeUnlockArgs(t, "coin1", receiver, 10, testCase.action)), "case %d", i)
+		require.True(t, hasEvent(cacheCtx, testCase.expectEvent), "case %d", i)
+		if testCase.expectEvent == types.EventTypeUnlockAction {
+			require.True(t, app.BankKeeper.GetCoins(cacheCtx, receiver).IsZero(), "case %d", i)
+			require.Equal(t, "10coin1", app.BankKeeper.GetCoins(cacheCtx, finalReceiver).String(), "case %d", i)
+		} else {
+			require.Equal(t, "10coin1", app.BankKeeper.GetCoins(cacheCtx, receiver).String(), "case %d", i)
+			require.True(t, app.BankKeeper.GetCoins(cacheCtx, finalReceiver).IsZero(), "case %d", i)
+		}
+	}
+
+	// opting out makes the unlock with the action wait for the opt-in again
+	require.Nil(t, app.LockProxyKeeper.SetUnlockActionOptIn(ctx, receiver, types.UnlockActionSend, false))
+	cacheCtx, _ = ctx.CacheContext()
+	require.True(t, types.ErrUnlockActionType.Is(app.LockProxyKeeper.Unlock(cacheCtx, fromChainId, fromProxy, lockProxy, serializeUnlockArgs(t, "coin1", receiver, 10, sendAction))))
+
+	// TxArgs without action is a plain unlock keeping the coins at receiver
+	require.Nil(t, app.LockProxyKeeper.Unlock(ctx, fromChainId, fromProxy, lockProxy, serializeUnlockArgs(t, "coin1", receiver, 20, nil)))
+	require.Equal(t, "20coin1", app.BankKeeper.GetCoins(ctx, receiver).String())
+}
+
+func hasEvent(ctx sdk.Context, eventType string) bool {
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+func Test_lockproxy_LockWithAction(t *testing.T) {
+	app, ctx := createTestApp(true)
+	app.SupplyKeeper.SetSupply(ctx, supply.NewSupply(sdk.Coins{sdk.NewCoin("stake", sdk.NewInt(100))}))
+
+	lockProxy := sdk.AccAddress([]byte("lockProxy"))
+	var toChainId uint64 = 2
+	require.Nil(t, app.LockProxyKeeper.CreateLockProxy(ctx, lockProxy))
+	require.Nil(t, app.LockProxyKeeper.CreateCoinAndDelegateToProxy(ctx, lockProxy, sdk.NewInt64Coin("coin1", 100), lockProxy))
+	require.Nil(t, app.LockProxyKeeper.BindProxyHash(ctx, lockProxy, toChainId, []byte{1, 2, 3, 4}))
+	require.Nil(t, app.LockProxyKeeper.BindAssetHash(ctx, lockProxy, "coin1", toChainId, []byte{5, 6}, 0, 0))
+	sender := sdk.AccAddress([]byte("senderAddress1234567"))
+	require.Nil(t, app.LockProxyKeeper.Unlock(ctx, toChainId, []byte{1, 2, 3, 4}, lockProxy, serializeUnlockArgs(t, "coin1", sender, 50, nil)))
+
+	serializeAction := func(action types.UnlockAction) []byte {
+		sink := polycommon.NewZeroCopySink(nil)
+		action.Serialization(sink)
+		return sink.Bytes()
+	}
+	sendAction := serializeAction(types.UnlockAction{Name: types.UnlockActionSend, Params: sdk.AccAddress([]byte("finalReceiverAddress"))})
+	delegateAction := serializeAction(types.UnlockAction{Name: types.UnlockActionDelegate, Params: sdk.ValAddress([]byte("validatorAddress1234"))})
+
+	// the action runs in toChainId, so it is only sent once toChainId is registered to perform it
+	cacheCtx, _ := ctx.CacheContext()
+	require.True(t, types.ErrUnlockActionType.Is(app.LockProxyKeeper.Lock(cacheCtx, lockProxy, sender, "coin1", toChainId, []byte{7, 8}, sdk.NewInt(10), sendAction)))
+	info := common.NewChainInfo(toChainId, "target", common.ChainFamilyCosmos, common.EncodingRaw, 0, common.EncodingRaw, 0, 0)
+	info.UnlockActions = []string{types.UnlockActionSend, types.UnlockActionDelegate, "custom"}
+	info.BondAssetHash = []byte("stake")
+	require.Nil(t, info.ValidateBasic())
+	app.CcmKeeper.SetChainInfo(ctx, info)
+
+	testCases := []struct {
+		action        []byte
+		expectSucceed bool
+	}{
+		{[]byte{0xff}, false},
+		{serializeAction(types.UnlockAction{Name: "", Params: []byte{1}}), false},
+		// the params of the shipped actions are checked when decoded
+		{serializeAction(types.UnlockAction{Name: types.UnlockActionSend, Params: []byte{}}), false},
+		{serializeAction(types.UnlockAction{Name: types.UnlockActionDelegate, Params: []byte{}}), false},
+		// actions toChainId does not perform, and a delegate of an asset other than the bond asset of toChainId
+		{serializeAction(types.UnlockAction{Name: "unknown", Params: []byte{1}}), false},
+		{delegateAction, false},
+		// an action registered only in toChainId is relayed as is
+		{serializeAction(types.UnlockAction{Name: "custom", Params: []byte{1}}), true},
+		{sendAction, true},
+		{nil, true},
+	}
+	for i, testCase := range testCases {
+		msg := types.NewMsgLock(lockProxy, sender, "coin1", toChainId, []byte{7, 8}, sdk.NewInt(10), testCase.action)
+		cacheCtx, write := ctx.CacheContext()
+		err := app.LockProxyKeeper.Lock(cacheCtx, lockProxy, sender, "coin1", toChainId, []byte{7, 8}, sdk.NewInt(10), testCase.action)
+		if testCase.expectSucceed {
+			require.Nil(t, msg.ValidateBasic(), "case %d", i)
+			require.Nil(t, err, "case %d", i)
+			write()
+		} else {
+			require.True(t, types.ErrUnlockActionType.Is(err), "case %d", i)
+		}
+	}
+	require.Equal(t, "20coin1", app.BankKeeper.GetCoins(ctx, sender).String())
+	require.True(t, types.ErrMsgLockType.Is(types.NewMsgLock(lockProxy, sender, "coin1", toChainId, []byte{7, 8}, sdk.NewInt(10), []byte{0xff}).ValidateBasic()))
+
+	// the bond asset of toChainId can be delegated there
+	require.Nil(t, app.LockProxyKeeper.BindAssetHash(ctx, lockProxy, "coin1", toChainId, []byte("stake"), 0, 0))
+	require.Nil(t, app.LockProxyKeeper.Lock(ctx, lockProxy, sender, "coin1", toChainId, []byte{7, 8}, sdk.NewInt(10), delegateAction))
+}
@@ -0,0 +1,208 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package keeper
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/polynetwork/cosmos-poly-module/common"
+	"github.com/polynetwork/cosmos-poly-module/lockproxy/internal/types"
+	lockproxypb "github.com/polynetwork/cosmos-poly-module/lockproxy/pb"
+	"strconv"
+)
+
+// HasOperatorGroup returns whether the ownership of the lock proxy has been transferred to an operator group
+func (k Keeper) HasOperatorGroup(ctx sdk.Context, lockProxyHash []byte) bool {
+	return ctx.KVStore(k.storeKey).Has(GetOperatorGroupKey(lockProxyHash))
+}
+
+// GetOperatorGroup returns the operator group of the lock proxy, the lock proxy never transferred is
+// controlled by its creator alone
+func (k Keeper) GetOperatorGroup(ctx sdk.Context, lockProxyHash []byte) types.OperatorGroup {
+	bz := ctx.KVStore(k.storeKey).Get(GetOperatorGroupKey(lockProxyHash))
+	if bz == nil {
+		return types.NewOperatorGroup([]sdk.AccAddress{lockProxyHash}, 1)
+	}
+	var pb lockproxypb.OperatorGroup
+	common.MustUnmarshalProto(bz, &pb)
+	return types.OperatorGroupFromProto(&pb)
+}
+
+// GetOperatorApprovals returns the actions of the lock proxy which have not collected enough approvals yet
+func (k Keeper) GetOperatorApprovals(ctx sdk.Context, lockProxyHash []byte) []types.OperatorApproval {
+	version := k.GetOperatorGroup(ctx, lockProxyHash).Version
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), GetOperatorApprovalsPrefix(lockProxyHash))
+	defer iterator.Close()
+
+	approvals := make([]types.OperatorApproval, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var pb lockproxypb.OperatorApproval
+		common.MustUnmarshalProto(iterator.Value(), &pb)
+		approval := types.OperatorApprovalFromProto(&pb)
+		if bytes.Equal(approval.LockProxy, lockProxyHash) && approval.Version == version {
+			approvals = append(approvals, approval)
+		}
+	}
+	return approvals
+}
+
+// ApproveOperatorAction records the approval of member on action, the action is executed once the threshold of
+// the operator group is reached
+func (k Keeper) ApproveOperatorAction(ctx sdk.Context, member sdk.AccAddress, lockProxyHash []byte, action types.OperatorAction) error {
+	if !k.EnsureLockProxyExist(ctx, lockProxyHash) {
+		return types.ErrOperatorGroup(fmt.Sprintf("lockproxy: %x has NOT been created", lockProxyHash))
+	}
+	if err := action.ValidateBasic(); err != nil {
+		return types.ErrOperatorGroup(err.Error())
+	}
+	group := k.GetOperatorGroup(ctx, lockProxyHash)
+	if !group.IsMember(member) {
+		return types.ErrOperatorGroup(fmt.Sprintf("%s is not member of the operator group of lockproxy: %x", member.String(), lockProxyHash))
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	key := GetOperatorApprovalKey(lockProxyHash, group.Version, common.MustMarshalProto(types.OperatorActionToProto(action)))
+	approval := types.OperatorApproval{LockProxy: lockProxyHash, Version: group.Version, Action: action}
+	if bz := store.Get(key); bz != nil {
+		var pb lockproxypb.OperatorApproval
+		common.MustUnmarshalProto(bz, &pb)
+		approval = types.OperatorApprovalFromProto(&pb)
+	}
+	for _, approver := range approval.Approvers {
+		if approver.Equals(member) {
+			return types.ErrOperatorGroup(fmt.Sprintf("%s has already approved the action", member.String()))
+		}
+	}
+	approval.Approvers = append(approval.Approvers, member)
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeApproveOperatorAction,
+			sdk.NewAttribute(types.AttributeKeyLockProxy, hex.EncodeToString(lockProxyHash)),
+			sdk.NewAttribute(types.AttributeKeyOperator, member.String()),
+			sdk.NewAttribute(types.AttributeKeyOperatorAction, action.Type),
+			sdk.NewAttribute(types.AttributeKeyApprovals, strconv.Itoa(len(approval.Approvers))),
+			sdk.NewAttribute(types.AttributeKeyThreshold, strconv.FormatUint(group.Threshold, 10)),
+		),
+	})
+	if uint64(len(approval.Approvers)) < group.Threshold {
+		store.Set(key, common.MustMarshalProto(types.OperatorApprovalToProto(approval)))
+		return nil
+	}
+	store.Delete(key)
+	return k.executeOperatorAction(ctx, lockProxyHash, action, approval.Approvers)
+}
+
+// deleteCancelApprovals drops the approvals of the lock proxy to cancel the pending binding change with id which
+// have not reached the threshold
+func (k Keeper) deleteCancelApprovals(ctx sdk.Context, lockProxyHash []byte, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, GetOperatorApprovalsPrefix(lockProxyHash))
+	staleKeys := make([][]byte, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var pb lockproxypb.OperatorApproval
+		common.MustUnmarshalProto(iterator.Value(), &pb)
+		approval := types.OperatorApprovalFromProto(&pb)
+		if bytes.Equal(approval.LockProxy, lockProxyHash) && approval.Action.Type == types.OperatorActionCancel && approval.Action.PendingId == id {
+			staleKeys = append(staleKeys, iterator.Key())
+		}
+	}
+	iterator.Close()
+	for _, key := range staleKeys {
+		store.Delete(key)
+	}
+}
+
+func (k Keeper) executeOperatorAction(ctx sdk.Context, lockProxyHash []byte, action types.OperatorAction, approvers []sdk.AccAddress) error {
+	lockProxy := sdk.AccAddress(lockProxyHash)
+	switch action.Type {
+	case types.OperatorActionBindProxy:
+		return k.bindProxyHash(ctx, lockProxy, action.ChainId, action.Value, approvers)
+	case types.OperatorActionUnbindProxy:
+		return k.unbindProxyHash(ctx, lockProxy, action.ChainId, approvers)
+	case types.OperatorActionBindAsset:
+		return k.bindAssetHash(ctx, lockProxy, action.Denom, action.ChainId, action.Value, action.SourceDecimals, action.ToDecimals, approvers)
+	case types.OperatorActionUnbindAsset:
+		return k.unbindAssetHash(ctx, lockProxy, action.Denom, action.ChainId, approvers)
+	case types.OperatorActionSettleDecimals:
+		return k.settleAssetDecimals(ctx, lockProxy, action.Denom, action.ChainId)
+	case types.OperatorActionTransferOwnership:
+		if delay := k.GetBindingChangeDelay(ctx); delay > 0 {
+			k.proposeBindingChange(ctx, types.PendingBindingChange{
+				BindingType: types.BindingTypeOwnership,
+				LockProxy:   lockProxyHash,
+				Members:     action.Members,
+				Threshold:   action.Threshold,
+				Operator:    bindingOperator(lockProxy, approvers),
+				Approvers:   approvers,
+			}, delay)
+			return nil
+		}
+		k.transferOwnership(ctx, lockProxyHash, action.Members, action.Threshold)
+		return nil
+	case types.OperatorActionCancel:
+		return k.cancelBindingChange(ctx, lockProxyHash, action.PendingId, bindingOperator(lockProxy, approvers))
+	}
+	return types.ErrOperatorGroup(fmt.Sprintf("unknown operator action type: %s", action.Type))
+}
+
+// transferOwnership replaces the operator group of the lock proxy, the approvals collected by the former group and
+// the binding changes it has left pending are dropped so that the new group starts from a clean state
+func (k Keeper) transferOwnership(ctx sdk.Context, lockProxyHash []byte, members []sdk.AccAddress, threshold uint64) {
+	store := ctx.KVStore(k.storeKey)
+	group := k.GetOperatorGroup(ctx, lockProxyHash)
+	newGroup := types.OperatorGroup{Members: members, Threshold: threshold, Version: group.Version + 1}
+	store.Set(GetOperatorGroupKey(lockProxyHash), common.MustMarshalProto(types.OperatorGroupToProto(newGroup)))
+
+	iterator := sdk.KVStorePrefixIterator(store, GetOperatorApprovalsPrefix(lockProxyHash))
+	staleKeys := make([][]byte, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var approval lockproxypb.OperatorApproval
+		common.MustUnmarshalProto(iterator.Value(), &approval)
+		if bytes.Equal(approval.LockProxy, lockProxyHash) {
+			staleKeys = append(staleKeys, iterator.Key())
+		}
+	}
+	iterator.Close()
+	for _, key := range staleKeys {
+		store.Delete(key)
+	}
+
+	for _, change := range k.GetPendingBindingChanges(ctx, lockProxyHash) {
+		k.deletePendingBindingChange(ctx, change)
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeCancelBindingChange,
+				sdk.NewAttribute(types.AttributeKeyPendingId, strconv.FormatUint(change.Id, 10)),
+				sdk.NewAttribute(types.AttributeKeyBindingType, change.BindingType),
+				sdk.NewAttribute(types.AttributeKeyLockProxy, hex.EncodeToString(change.LockProxy)),
+				sdk.NewAttribute(types.AttributeKeyReason, types.EventTypeTransferOwnership),
+			),
+		})
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeTransferOwnership,
+			sdk.NewAttribute(types.AttributeKeyLockProxy, hex.EncodeToString(lockProxyHash)),
+			sdk.NewAttribute(types.AttributeKeyThreshold, strconv.FormatUint(threshold, 10)),
+			sdk.NewAttribute(types.AttributeKeyMembers, fmt.Sprintf("%v", members)),
+		),
+	})
+}
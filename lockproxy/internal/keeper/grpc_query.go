@@ -0,0 +1,110 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/polynetwork/cosmos-poly-module/common"
+	"github.com/polynetwork/cosmos-poly-module/lockproxy/internal/types"
+	lockproxypb "github.com/polynetwork/cosmos-poly-module/lockproxy/pb"
+)
+
+type queryServer struct {
+	k Keeper
+}
+
+var _ lockproxypb.QueryServer = queryServer{}
+
+// NewQueryServer returns the gRPC query service of lockproxy module, it serves the legacy queries with typed messages
+func NewQueryServer(k Keeper) lockproxypb.QueryServer {
+	return queryServer{k}
+}
+
+func (q queryServer) Params(goCtx context.Context, _ *lockproxypb.QueryParamsRequest) (*lockproxypb.QueryParamsResponse, error) {
+	ctx := common.UnwrapSDKContext(goCtx)
+	return &lockproxypb.QueryParamsResponse{Params: types.ParamsToProto(q.k.GetParams(ctx))}, nil
+}
+
+func (q queryServer) ProxyByOperator(goCtx context.Context, req *lockproxypb.QueryProxyByOperatorRequest) (*lockproxypb.QueryProxyByOperatorResponse, error) {
+	ctx := common.UnwrapSDKContext(goCtx)
+	operator, err := sdk.AccAddressFromBech32(req.Operator)
+	if err != nil {
+		return nil, err
+	}
+	return &lockproxypb.QueryProxyByOperatorResponse{LockProxyHash: q.k.GetLockProxyByOperator(ctx, operator)}, nil
+}
+
+func (q queryServer) ProxyHash(goCtx context.Context, req *lockproxypb.QueryProxyHashRequest) (*lockproxypb.QueryProxyHashResponse, error) {
+	ctx := common.UnwrapSDKContext(goCtx)
+	return &lockproxypb.QueryProxyHashResponse{ProxyHash: q.k.GetProxyHash(ctx, req.LockProxyHash, req.ChainId)}, nil
+}
+
+func (q queryServer) AssetHash(goCtx context.Context, req *lockproxypb.QueryAssetHashRequest) (*lockproxypb.QueryAssetHashResponse, error) {
+	ctx := common.UnwrapSDKContext(goCtx)
+	return &lockproxypb.QueryAssetHashResponse{AssetHash: q.k.GetAssetHash(ctx, req.LockProxyHash, req.SourceAssetDenom, req.ChainId)}, nil
+}
+
+func (q queryServer) BindingHistory(goCtx context.Context, req *lockproxypb.QueryBindingHistoryRequest) (*lockproxypb.QueryBindingHistoryResponse, error) {
+	ctx := common.UnwrapSDKContext(goCtx)
+	page, limit := common.PageFromProto(req.Pagination)
+	changes := q.k.GetBindingHistory(ctx, req.LockProxyHash, req.SourceAssetDenom, req.ChainId, page, limit)
+	return &lockproxypb.QueryBindingHistoryResponse{Changes: common.BindingChangesToProto(changes)}, nil
+}
+
+func (q queryServer) PendingBindingChanges(goCtx context.Context, req *lockproxypb.QueryPendingBindingChangesRequest) (*lockproxypb.QueryPendingBindingChangesResponse, error) {
+	ctx := common.UnwrapSDKContext(goCtx)
+	changes := q.k.GetPendingBindingChanges(ctx, req.LockProxyHash)
+	return &lockproxypb.QueryPendingBindingChangesResponse{Changes: types.PendingBindingChangesToProto(changes)}, nil
+}
+
+func (q queryServer) OperatorGroup(goCtx context.Context, req *lockproxypb.QueryOperatorGroupRequest) (*lockproxypb.QueryOperatorGroupResponse, error) {
+	ctx := common.UnwrapSDKContext(goCtx)
+	if !q.k.EnsureLockProxyExist(ctx, req.LockProxyHash) {
+		return nil, types.ErrOperatorGroup(fmt.Sprintf("lockproxy: %x has NOT been created", req.LockProxyHash))
+	}
+	return &lockproxypb.QueryOperatorGroupResponse{Group: types.OperatorGroupToProto(q.k.GetOperatorGroup(ctx, req.LockProxyHash))}, nil
+}
+
+func (q queryServer) OperatorApprovals(goCtx context.Context, req *lockproxypb.QueryOperatorApprovalsRequest) (*lockproxypb.QueryOperatorApprovalsResponse, error) {
+	ctx := common.UnwrapSDKContext(goCtx)
+	approvals := q.k.GetOperatorApprovals(ctx, req.LockProxyHash)
+	return &lockproxypb.QueryOperatorApprovalsResponse{Approvals: types.OperatorApprovalsToProto(approvals)}, nil
+}
+
+func (q queryServer) LockProxies(goCtx context.Context, req *lockproxypb.QueryLockProxiesRequest) (*lockproxypb.QueryLockProxiesResponse, error) {
+	ctx := common.UnwrapSDKContext(goCtx)
+	page, limit := common.PageFromProto(req.Pagination)
+	return &lockproxypb.QueryLockProxiesResponse{LockProxies: types.LockProxiesToProto(q.k.GetLockProxies(ctx, page, limit))}, nil
+}
+
+func (q queryServer) ProxyBindings(goCtx context.Context, req *lockproxypb.QueryProxyBindingsRequest) (*lockproxypb.QueryProxyBindingsResponse, error) {
+	ctx := common.UnwrapSDKContext(goCtx)
+	page, limit := common.PageFromProto(req.Pagination)
+	bindings := q.k.GetProxyBindings(ctx, req.LockProxyHash, page, limit)
+	return &lockproxypb.QueryProxyBindingsResponse{Bindings: common.BindingsToProto(bindings)}, nil
+}
+
+func (q queryServer) AssetBindings(goCtx context.Context, req *lockproxypb.QueryAssetBindingsRequest) (*lockproxypb.QueryAssetBindingsResponse, error) {
+	ctx := common.UnwrapSDKContext(goCtx)
+	page, limit := common.PageFromProto(req.Pagination)
+	bindings := q.k.GetAssetBindings(ctx, req.LockProxyHash, req.SourceAssetDenom, page, limit)
+	return &lockproxypb.QueryAssetBindingsResponse{Bindings: common.BindingsToProto(bindings)}, nil
+}
@@ -23,7 +23,9 @@ import (
 	"fmt"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
 	"github.com/cosmos/cosmos-sdk/x/supply/exported"
+	"github.com/polynetwork/cosmos-poly-module/common"
 	selfexported "github.com/polynetwork/cosmos-poly-module/lockproxy/exported"
 	"github.com/polynetwork/cosmos-poly-module/lockproxy/internal/types"
 	polycommon "github.com/polynetwork/poly/common"
@@ -34,15 +36,18 @@ import (
 type Keeper struct {
 	cdc          *codec.Codec
 	storeKey     sdk.StoreKey
+	paramSpace   params.Subspace
 	authKeeper   types.AccountKeeper
 	supplyKeeper types.SupplyKeeper
 	ccmKeeper    types.CrossChainManager
+	hooks        common.CrossChainHooks
+	actions      map[string]types.UnlockActionHandler // shared by all the copies of the keeper
 	selfexported.UnlockKeeper
 }
 
 // NewKeeper creates a new mint Keeper instance
 func NewKeeper(
-	cdc *codec.Codec, key sdk.StoreKey, ak types.AccountKeeper, supplyKeeper types.SupplyKeeper, ccmKeeper types.CrossChainManager) Keeper {
+	cdc *codec.Codec, key sdk.StoreKey, paramSpace params.Subspace, ak types.AccountKeeper, supplyKeeper types.SupplyKeeper, ccmKeeper types.CrossChainManager) Keeper {
 
 	// ensure mint module account is set
 	if addr := supplyKeeper.GetModuleAddress(types.ModuleName); addr == nil {
@@ -52,12 +57,39 @@ func NewKeeper(
 	return Keeper{
 		cdc:          cdc,
 		storeKey:     key,
+		paramSpace:   paramSpace.WithKeyTable(types.ParamKeyTable()),
 		authKeeper:   ak,
 		supplyKeeper: supplyKeeper,
 		ccmKeeper:    ccmKeeper,
+		actions:      make(map[string]types.UnlockActionHandler),
 	}
 }
 
+// GetParams returns the total set of lockproxy parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the total set of lockproxy parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetBindingChangeDelay returns zero if the param has not been set, e.g. for the chain upgraded without it
+func (k Keeper) GetBindingChangeDelay(ctx sdk.Context) uint64 {
+	var delay uint64
+	k.paramSpace.GetIfExists(ctx, types.KeyBindingChangeDelay, &delay)
+	return delay
+}
+
+// GetGuardian returns nil if the param has not been set
+func (k Keeper) GetGuardian(ctx sdk.Context) sdk.AccAddress {
+	var guardian sdk.AccAddress
+	k.paramSpace.GetIfExists(ctx, types.KeyGuardian, &guardian)
+	return guardian
+}
+
 func (k Keeper) GetModuleAccount(ctx sdk.Context) exported.ModuleAccountI {
 	return k.supplyKeeper.GetModuleAccount(ctx, types.ModuleName)
 }
@@ -134,8 +166,38 @@ func (k Keeper) BindProxyHash(ctx sdk.Context, operator sdk.AccAddress, toChainI
 	if !k.EnsureLockProxyExist(ctx, operator) {
 		return types.ErrBindProxyHash(fmt.Sprintf("operator:%s have NOT created lockproxy contract: %s", operator.String(), operator.Bytes()))
 	}
+	if k.HasOperatorGroup(ctx, operator) {
+		return types.ErrBindProxyHash(fmt.Sprintf("lockproxy: %x is controlled by operator group, binding needs approvals of its members", operator.Bytes()))
+	}
+	return k.bindProxyHash(ctx, operator, toChainId, toProxyHash, nil)
+}
+
+func (k Keeper) bindProxyHash(ctx sdk.Context, operator sdk.AccAddress, toChainId uint64, toProxyHash []byte, approvers []sdk.AccAddress) error {
+	// the proxy is a contract on toChainId, its hash is encoded as the addresses of the chain
+	if info, found := k.ccmKeeper.GetChainInfo(ctx, toChainId); found {
+		if err := info.ValidateAddress(toProxyHash); err != nil {
+			return types.ErrBindProxyHash(err.Error())
+		}
+	}
+	if delay := k.GetBindingChangeDelay(ctx); delay > 0 {
+		k.proposeBindingChange(ctx, types.PendingBindingChange{
+			BindingType: common.BindingTypeProxy,
+			LockProxy:   operator,
+			ChainId:     toChainId,
+			NewValue:    toProxyHash,
+			Operator:    bindingOperator(operator, approvers),
+			Approvers:   approvers,
+		}, delay)
+		return nil
+	}
+	k.setProxyHash(ctx, operator, toChainId, toProxyHash, approvers)
+	return nil
+}
+
+func (k Keeper) setProxyHash(ctx sdk.Context, operator sdk.AccAddress, toChainId uint64, toProxyHash []byte, approvers []sdk.AccAddress) {
 	store := ctx.KVStore(k.storeKey)
 
+	oldProxyHash := store.Get(GetBindProxyKey(operator, toChainId))
 	store.Set(GetBindProxyKey(operator, toChainId), toProxyHash)
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
@@ -145,26 +207,130 @@ func (k Keeper) BindProxyHash(ctx sdk.Context, operator sdk.AccAddress, toChainI
 			sdk.NewAttribute(types.AttributeKeyToChainProxyHash, hex.EncodeToString(toProxyHash)),
 		),
 	})
+	k.recordBindingChange(ctx, common.BindingChange{
+		BindingType: common.BindingTypeProxy,
+		LockProxy:   operator,
+		ChainId:     toChainId,
+		OldValue:    oldProxyHash,
+		NewValue:    toProxyHash,
+		Operator:    bindingOperator(operator, approvers),
+		Approvers:   approvers,
+	})
+}
+
+func (k Keeper) UnbindProxyHash(ctx sdk.Context, operator sdk.AccAddress, toChainId uint64) error {
+	if !k.EnsureLockProxyExist(ctx, operator) {
+		return types.ErrUnbindProxyHash(fmt.Sprintf("operator:%s have NOT created lockproxy contract: %s", operator.String(), operator.Bytes()))
+	}
+	if k.HasOperatorGroup(ctx, operator) {
+		return types.ErrUnbindProxyHash(fmt.Sprintf("lockproxy: %x is controlled by operator group, unbinding needs approvals of its members", operator.Bytes()))
+	}
+	return k.unbindProxyHash(ctx, operator, toChainId, nil)
+}
+
+func (k Keeper) unbindProxyHash(ctx sdk.Context, operator sdk.AccAddress, toChainId uint64, approvers []sdk.AccAddress) error {
+	if len(k.GetProxyHash(ctx, operator, toChainId)) == 0 {
+		return types.ErrUnbindProxyHash(fmt.Sprintf("lockproxy: %x has not bound proxy hash of toChainId: %d", operator.Bytes(), toChainId))
+	}
+	if delay := k.GetBindingChangeDelay(ctx); delay > 0 {
+		k.proposeBindingChange(ctx, types.PendingBindingChange{
+			BindingType: common.BindingTypeProxy,
+			LockProxy:   operator,
+			ChainId:     toChainId,
+			Operator:    bindingOperator(operator, approvers),
+			Approvers:   approvers,
+		}, delay)
+		return nil
+	}
+	k.deleteProxyHash(ctx, operator, toChainId, approvers)
 	return nil
 }
 
+// deleteProxyHash removes the proxy hash binding and returns false if nothing has been bound
+func (k Keeper) deleteProxyHash(ctx sdk.Context, operator sdk.AccAddress, toChainId uint64, approvers []sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	oldProxyHash := store.Get(GetBindProxyKey(operator, toChainId))
+	if len(oldProxyHash) == 0 {
+		return false
+	}
+	store.Delete(GetBindProxyKey(operator, toChainId))
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUnbindProxy,
+			sdk.NewAttribute(types.AttributeKeyLockProxy, hex.EncodeToString(operator.Bytes())),
+			sdk.NewAttribute(types.AttributeKeyToChainId, strconv.FormatUint(toChainId, 10)),
+			sdk.NewAttribute(types.AttributeKeyToChainProxyHash, hex.EncodeToString(oldProxyHash)),
+		),
+	})
+	k.recordBindingChange(ctx, common.BindingChange{
+		BindingType: common.BindingTypeProxy,
+		LockProxy:   operator,
+		ChainId:     toChainId,
+		OldValue:    oldProxyHash,
+		Operator:    bindingOperator(operator, approvers),
+		Approvers:   approvers,
+	})
+	return true
+}
+
 func (k Keeper) GetProxyHash(ctx sdk.Context, operator sdk.AccAddress, toChainId uint64) []byte {
 	store := ctx.KVStore(k.storeKey)
 	return store.Get(GetBindProxyKey(operator, toChainId))
 }
 
-func (k Keeper) BindAssetHash(ctx sdk.Context, operator sdk.AccAddress, sourceAssetDenom string, toChainId uint64, toAssetHash []byte) error {
+func (k Keeper) BindAssetHash(ctx sdk.Context, operator sdk.AccAddress, sourceAssetDenom string, toChainId uint64, toAssetHash []byte, sourceDecimals, toDecimals uint8) error {
 	// ensure the operator has created the lockproxy contract
 	if !k.EnsureLockProxyExist(ctx, operator) {
 		return types.ErrBindAssetHash(fmt.Sprintf("operator:%s have NOT created lockproxy contract: %s", operator.String(), operator.Bytes()))
 	}
+	if k.HasOperatorGroup(ctx, operator) {
+		return types.ErrBindAssetHash(fmt.Sprintf("lockproxy: %x is controlled by operator group, binding needs approvals of its members", operator.Bytes()))
+	}
+	return k.bindAssetHash(ctx, operator, sourceAssetDenom, toChainId, toAssetHash, sourceDecimals, toDecimals, nil)
+}
+
+func (k Keeper) bindAssetHash(ctx sdk.Context, operator sdk.AccAddress, sourceAssetDenom string, toChainId uint64, toAssetHash []byte, sourceDecimals, toDecimals uint8, approvers []sdk.AccAddress) error {
 	// ensure the sourceAssetDenom has already been created with non-zero supply
 	if _, exist := k.ccmKeeper.ExistDenom(ctx, sourceAssetDenom); !exist {
 		return types.ErrBindAssetHash(fmt.Sprintf("sourceAssetDenom: %s not exist", sourceAssetDenom))
 	}
+	if info, found := k.ccmKeeper.GetChainInfo(ctx, toChainId); found {
+		if err := info.ValidateAssetHash(toAssetHash); err != nil {
+			return types.ErrBindAssetHash(err.Error())
+		}
+	}
+	if delay := k.GetBindingChangeDelay(ctx); delay > 0 {
+		k.proposeBindingChange(ctx, types.PendingBindingChange{
+			BindingType:    common.BindingTypeAsset,
+			LockProxy:      operator,
+			Denom:          sourceAssetDenom,
+			ChainId:        toChainId,
+			NewValue:       toAssetHash,
+			SourceDecimals: sourceDecimals,
+			ToDecimals:     toDecimals,
+			Operator:       bindingOperator(operator, approvers),
+			Approvers:      approvers,
+		}, delay)
+		return nil
+	}
+	k.setAssetHash(ctx, operator, sourceAssetDenom, toChainId, toAssetHash, sourceDecimals, toDecimals, approvers)
+	return nil
+}
+
+func (k Keeper) setAssetHash(ctx sdk.Context, operator sdk.AccAddress, sourceAssetDenom string, toChainId uint64, toAssetHash []byte, sourceDecimals, toDecimals uint8, approvers []sdk.AccAddress) {
 	store := ctx.KVStore(k.storeKey)
 	// store the to asset hash based on the lockproxy contract (operator) and sourceAssetHash + toChainId
+	oldAssetHash := store.Get(GetBindAssetHashKey(operator, []byte(sourceAssetDenom), toChainId))
+	oldSourceDecimals, oldToDecimals := k.GetAssetDecimals(ctx, operator, sourceAssetDenom, toChainId)
 	store.Set(GetBindAssetHashKey(operator, []byte(sourceAssetDenom), toChainId), toAssetHash)
+	// only the binding with different decimals needs the amount to be scaled
+	if sourceDecimals != toDecimals {
+		store.Set(GetBindAssetDecimalsKey(operator, []byte(sourceAssetDenom), toChainId), []byte{sourceDecimals, toDecimals})
+	} else {
+		store.Delete(GetBindAssetDecimalsKey(operator, []byte(sourceAssetDenom), toChainId))
+	}
+	newSourceDecimals, newToDecimals := k.GetAssetDecimals(ctx, operator, sourceAssetDenom, toChainId)
+	k.updateUnlockAssetDecimals(ctx, operator, sourceAssetDenom, toChainId, len(oldAssetHash) != 0, oldSourceDecimals, oldToDecimals, true, newSourceDecimals, newToDecimals)
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeBindAsset,
@@ -173,6 +339,156 @@ func (k Keeper) BindAssetHash(ctx sdk.Context, operator sdk.AccAddress, sourceAs
 			sdk.NewAttribute(types.AttributeKeyFromAssetHash, hex.EncodeToString([]byte(sourceAssetDenom))),
 			sdk.NewAttribute(types.AttributeKeyToChainId, strconv.FormatUint(toChainId, 10)),
 			sdk.NewAttribute(types.AttributeKeyToAssetHash, hex.EncodeToString(toAssetHash)),
+			sdk.NewAttribute(types.AttributeKeySourceDecimals, strconv.FormatUint(uint64(sourceDecimals), 10)),
+			sdk.NewAttribute(types.AttributeKeyToDecimals, strconv.FormatUint(uint64(toDecimals), 10)),
+		),
+	})
+	k.recordBindingChange(ctx, common.BindingChange{
+		BindingType: common.BindingTypeAsset,
+		LockProxy:   operator,
+		Denom:       sourceAssetDenom,
+		ChainId:     toChainId,
+		OldValue:    oldAssetHash,
+		NewValue:    toAssetHash,
+		Operator:    bindingOperator(operator, approvers),
+		Approvers:   approvers,
+
+		OldSourceDecimals: oldSourceDecimals,
+		OldToDecimals:     oldToDecimals,
+		SourceDecimals:    newSourceDecimals,
+		ToDecimals:        newToDecimals,
+	})
+}
+
+func (k Keeper) UnbindAssetHash(ctx sdk.Context, operator sdk.AccAddress, sourceAssetDenom string, toChainId uint64) error {
+	if !k.EnsureLockProxyExist(ctx, operator) {
+		return types.ErrUnbindAssetHash(fmt.Sprintf("operator:%s have NOT created lockproxy contract: %s", operator.String(), operator.Bytes()))
+	}
+	if k.HasOperatorGroup(ctx, operator) {
+		return types.ErrUnbindAssetHash(fmt.Sprintf("lockproxy: %x is controlled by operator group, unbinding needs approvals of its members", operator.Bytes()))
+	}
+	return k.unbindAssetHash(ctx, operator, sourceAssetDenom, toChainId, nil)
+}
+
+func (k Keeper) unbindAssetHash(ctx sdk.Context, operator sdk.AccAddress, sourceAssetDenom string, toChainId uint64, approvers []sdk.AccAddress) error {
+	if len(k.GetAssetHash(ctx, operator, sourceAssetDenom, toChainId)) == 0 {
+		return types.ErrUnbindAssetHash(fmt.Sprintf("lockproxy: %x has not bound asset hash of denom: %s and toChainId: %d", operator.Bytes(), sourceAssetDenom, toChainId))
+	}
+	if delay := k.GetBindingChangeDelay(ctx); delay > 0 {
+		k.proposeBindingChange(ctx, types.PendingBindingChange{
+			BindingType: common.BindingTypeAsset,
+			LockProxy:   operator,
+			Denom:       sourceAssetDenom,
+			ChainId:     toChainId,
+			Operator:    bindingOperator(operator, approvers),
+			Approvers:   approvers,
+		}, delay)
+		return nil
+	}
+	k.deleteAssetHash(ctx, operator, sourceAssetDenom, toChainId, approvers)
+	return nil
+}
+
+// deleteAssetHash removes the asset hash binding and returns false if nothing has been bound
+func (k Keeper) deleteAssetHash(ctx sdk.Context, operator sdk.AccAddress, sourceAssetDenom string, toChainId uint64, approvers []sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	oldAssetHash := store.Get(GetBindAssetHashKey(operator, []byte(sourceAssetDenom), toChainId))
+	if len(oldAssetHash) == 0 {
+		return false
+	}
+	oldSourceDecimals, oldToDecimals := k.GetAssetDecimals(ctx, operator, sourceAssetDenom, toChainId)
+	store.Delete(GetBindAssetHashKey(operator, []byte(sourceAssetDenom), toChainId))
+	store.Delete(GetBindAssetDecimalsKey(operator, []byte(sourceAssetDenom), toChainId))
+	k.updateUnlockAssetDecimals(ctx, operator, sourceAssetDenom, toChainId, true, oldSourceDecimals, oldToDecimals, false, 0, 0)
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUnbindAsset,
+			sdk.NewAttribute(types.AttributeKeyLockProxy, hex.EncodeToString(operator.Bytes())),
+			sdk.NewAttribute(types.AttributeKeySourceAssetDenom, sourceAssetDenom),
+			sdk.NewAttribute(types.AttributeKeyToChainId, strconv.FormatUint(toChainId, 10)),
+			sdk.NewAttribute(types.AttributeKeyToAssetHash, hex.EncodeToString(oldAssetHash)),
+		),
+	})
+	k.recordBindingChange(ctx, common.BindingChange{
+		BindingType: common.BindingTypeAsset,
+		LockProxy:   operator,
+		Denom:       sourceAssetDenom,
+		ChainId:     toChainId,
+		OldValue:    oldAssetHash,
+		Operator:    bindingOperator(operator, approvers),
+		Approvers:   approvers,
+
+		OldSourceDecimals: oldSourceDecimals,
+		OldToDecimals:     oldToDecimals,
+	})
+	return true
+}
+
+// GetAssetDecimals returns the decimals of sourceAssetDenom in current chain and of the asset bound in toChainId
+func (k Keeper) GetAssetDecimals(ctx sdk.Context, lockProxyHash []byte, sourceAssetDenom string, toChainId uint64) (sourceDecimals, toDecimals uint8) {
+	bz := ctx.KVStore(k.storeKey).Get(GetBindAssetDecimalsKey(lockProxyHash, []byte(sourceAssetDenom), toChainId))
+	if len(bz) != 2 {
+		return 0, 0
+	}
+	return bz[0], bz[1]
+}
+
+// GetUnlockAssetDecimals returns the decimals Unlock scales the amount from fromChainId with, they are those of the
+// binding except while a decimals change of the binding is not settled, then they are the decimals before the change
+// under which the transfers in flight have been sent
+func (k Keeper) GetUnlockAssetDecimals(ctx sdk.Context, lockProxyHash []byte, sourceAssetDenom string, fromChainId uint64) (sourceDecimals, fromDecimals uint8, settling bool) {
+	bz := ctx.KVStore(k.storeKey).Get(GetUnlockAssetDecimalsKey(lockProxyHash, []byte(sourceAssetDenom), fromChainId))
+	if len(bz) != 2 {
+		sourceDecimals, fromDecimals = k.GetAssetDecimals(ctx, lockProxyHash, sourceAssetDenom, fromChainId)
+		return sourceDecimals, fromDecimals, false
+	}
+	return bz[0], bz[1], true
+}
+
+// updateUnlockAssetDecimals keeps the decimals of a binding for Unlock when they change or the binding is removed, the
+// kept decimals are dropped once the binding gets the same decimals again or the change is settled
+func (k Keeper) updateUnlockAssetDecimals(ctx sdk.Context, lockProxyHash []byte, sourceAssetDenom string, chainId uint64, wasBound bool, oldSourceDecimals, oldToDecimals uint8, isBound bool, newSourceDecimals, newToDecimals uint8) {
+	store := ctx.KVStore(k.storeKey)
+	key := GetUnlockAssetDecimalsKey(lockProxyHash, []byte(sourceAssetDenom), chainId)
+	if bz := store.Get(key); len(bz) == 2 {
+		if isBound && bz[0] == newSourceDecimals && bz[1] == newToDecimals {
+			store.Delete(key)
+		}
+		return
+	}
+	if wasBound && (!isBound || oldSourceDecimals != newSourceDecimals || oldToDecimals != newToDecimals) {
+		store.Set(key, []byte{oldSourceDecimals, oldToDecimals})
+	}
+}
+
+// SettleAssetDecimals lets Unlock scale with the decimals of the binding after they changed, it should only be called
+// once every transfer sent from chainId under the former decimals has been unlocked
+func (k Keeper) SettleAssetDecimals(ctx sdk.Context, operator sdk.AccAddress, sourceAssetDenom string, chainId uint64) error {
+	if !k.EnsureLockProxyExist(ctx, operator) {
+		return types.ErrSettleAssetDecimals(fmt.Sprintf("operator:%s have NOT created lockproxy contract: %s", operator.String(), operator.Bytes()))
+	}
+	if k.HasOperatorGroup(ctx, operator) {
+		return types.ErrSettleAssetDecimals(fmt.Sprintf("lockproxy: %x is controlled by operator group, settling needs approvals of its members", operator.Bytes()))
+	}
+	return k.settleAssetDecimals(ctx, operator, sourceAssetDenom, chainId)
+}
+
+func (k Keeper) settleAssetDecimals(ctx sdk.Context, operator sdk.AccAddress, sourceAssetDenom string, chainId uint64) error {
+	store := ctx.KVStore(k.storeKey)
+	key := GetUnlockAssetDecimalsKey(operator, []byte(sourceAssetDenom), chainId)
+	if !store.Has(key) {
+		return types.ErrSettleAssetDecimals(fmt.Sprintf("lockproxy: %x has no decimals change of denom: %s and chainId: %d to settle", operator.Bytes(), sourceAssetDenom, chainId))
+	}
+	store.Delete(key)
+	sourceDecimals, toDecimals := k.GetAssetDecimals(ctx, operator, sourceAssetDenom, chainId)
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSettleAssetDecimals,
+			sdk.NewAttribute(types.AttributeKeyLockProxy, hex.EncodeToString(operator.Bytes())),
+			sdk.NewAttribute(types.AttributeKeySourceAssetDenom, sourceAssetDenom),
+			sdk.NewAttribute(types.AttributeKeyToChainId, strconv.FormatUint(chainId, 10)),
+			sdk.NewAttribute(types.AttributeKeySourceDecimals, strconv.FormatUint(uint64(sourceDecimals), 10)),
+			sdk.NewAttribute(types.AttributeKeyToDecimals, strconv.FormatUint(uint64(toDecimals), 10)),
 		),
 	})
 	return nil
@@ -183,7 +499,22 @@ func (k Keeper) GetAssetHash(ctx sdk.Context, lockProxyHash []byte, sourceAssetD
 	return store.Get(GetBindAssetHashKey(lockProxyHash, []byte(sourceAssetDenom), toChainId))
 }
 
-func (k Keeper) Lock(ctx sdk.Context, lockProxyHash []byte, fromAddress sdk.AccAddress, sourceAssetDenom string, toChainId uint64, toAddressBs []byte, value sdk.Int) error {
+// Lock locks value of sourceAssetDenom from fromAddress and creates the cross chain tx unlocking it to toAddressBs in
+// toChainId, the optional action is carried by TxArgs.Action and only sent to a chain registered to perform it
+func (k Keeper) Lock(ctx sdk.Context, lockProxyHash []byte, fromAddress sdk.AccAddress, sourceAssetDenom string, toChainId uint64, toAddressBs []byte, value sdk.Int, action []byte) error {
+	var unlockAction *types.UnlockAction
+	if len(action) != 0 {
+		var err error
+		if unlockAction, err = types.ParseUnlockAction(action); err != nil {
+			return types.ErrUnlockAction(fmt.Sprintf("Deserialization action: %x, Error: %s", action, err.Error()))
+		}
+	}
+	info, hasInfo := k.ccmKeeper.GetChainInfo(ctx, toChainId)
+	if hasInfo {
+		if err := info.ValidateAddress(toAddressBs); err != nil {
+			return types.ErrLock(err.Error())
+		}
+	}
 	// send coin of sourceAssetDenom from fromAddress to module account address
 	amt := sdk.NewCoins(sdk.NewCoin(sourceAssetDenom, value))
 	if err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, fromAddress, types.ModuleName, amt); err != nil {
@@ -196,14 +527,34 @@ func (k Keeper) Lock(ctx sdk.Context, lockProxyHash []byte, fromAddress sdk.AccA
 	if len(toChainAssetHash) == 0 {
 		return types.ErrLock(fmt.Sprintf("toChainAssetHash not exist for lockproxyHash: %x / %s, denom: %s, toChainId: %d", lockProxyHash, sdk.AccAddress(lockProxyHash).String(), sourceAssetDenom, toChainId))
 	}
+	// the action runs in toChainId, so it is checked against the registered info of toChainId
+	if unlockAction != nil {
+		if !hasInfo {
+			return types.ErrUnlockAction(fmt.Sprintf("toChainId: %d is not registered, the unlock actions it performs are unknown", toChainId))
+		}
+		if err := unlockAction.ValidateOnChain(info, toChainAssetHash); err != nil {
+			return types.ErrUnlockAction(err.Error())
+		}
+	}
+	sourceDecimals, toDecimals := k.GetAssetDecimals(ctx, lockProxyHash, sourceAssetDenom, toChainId)
+	toAmount, err := common.ScaleAmount(value.BigInt(), sourceDecimals, toDecimals)
+	if err != nil {
+		return types.ErrLock(fmt.Sprintf("amount cannot be crossed to toChainId: %d, Error: %s", toChainId, err.Error()))
+	}
+	if hasInfo {
+		if err := info.ValidateAmount(toAmount); err != nil {
+			return types.ErrLock(err.Error())
+		}
+	}
 	// get target asset hash from storage
 	sink := polycommon.NewZeroCopySink(nil)
 	args := types.TxArgs{
 		ToAssetHash: toChainAssetHash,
 		ToAddress:   toAddressBs,
-		Amount:      value.BigInt(),
+		Amount:      toAmount,
+		Action:      action,
 	}
-	if err := args.Serialization(sink, 32); err != nil {
+	if err := args.Serialization(sink, common.DefaultAmountWidth); err != nil {
 		return types.ErrLock(fmt.Sprintf("TxArgs Serialization Error:%v", err))
 	}
 	// get target chain proxy hash from storage
@@ -230,6 +581,16 @@ func (k Keeper) Lock(ctx sdk.Context, lockProxyHash []byte, fromAddress sdk.AccA
 			sdk.NewAttribute(types.AttributeKeyLockProxy, hex.EncodeToString(fromContractHash)),
 		),
 	})
+	k.AfterLock(ctx, common.LockInfo{
+		Module:        types.ModuleName,
+		LockProxyHash: fromContractHash,
+		FromAddress:   fromAddress,
+		Denom:         sourceAssetDenom,
+		ToChainId:     toChainId,
+		ToAssetHash:   toChainAssetHash,
+		ToAddress:     toAddressBs,
+		Amount:        value,
+	})
 	return nil
 }
 
@@ -243,28 +604,35 @@ func (k Keeper) Unlock(ctx sdk.Context, fromChainId uint64, fromContractAddr sdk
 		return types.ErrUnLock(fmt.Sprintf("stored proxyHash correlated with lockproxyHash: %x and chainId: %d is not equal to fromContractAddress, expect:%x, got:%x", toContractAddr, fromChainId, fromProxyHash, fromContractAddr))
 	}
 	args := new(types.TxArgs)
-	if err := args.Deserialization(polycommon.NewZeroCopySource(argsBs), 32); err != nil {
+	if err := args.Deserialization(polycommon.NewZeroCopySource(argsBs), common.DefaultAmountWidth); err != nil {
 		return types.ErrUnLock(fmt.Sprintf("unlock, Deserialization args error:%s", err))
 	}
 	toAssetHash := args.ToAssetHash
 	toAddress := args.ToAddress
-	amount := args.Amount
 
 	// to asset hash should be the hex format string of source asset denom name, NOT Module account address
 	toAssetDenom := string(toAssetHash)
 	if len(k.GetAssetHash(ctx, toContractAddr, toAssetDenom, fromChainId)) == 0 {
 		return types.ErrUnLock(fmt.Sprintf("toAssetHash: %x of denom: %s doesnot belong to the current lock proxy hash: %x", toAssetHash, toAssetDenom, toContractAddr))
 	}
+	// the dust below the precision of current chain stays locked in fromChainId, the unlock event reports it so that
+	// it can be refunded there
+	sourceDecimals, fromDecimals, _ := k.GetUnlockAssetDecimals(ctx, toContractAddr, toAssetDenom, fromChainId)
+	amount, dust, err := common.TruncateAmount(args.Amount, fromDecimals, sourceDecimals)
+	if err != nil {
+		return types.ErrUnLock(fmt.Sprintf("amount cannot be crossed from fromChainId: %d, Error: %s", fromChainId, err.Error()))
+	}
 
 	// mint coin of sourceAssetDenom
 	amt := sdk.NewCoins(sdk.NewCoin(toAssetDenom, sdk.NewIntFromBigInt(amount)))
 
 	toAcctAddress := make(sdk.AccAddress, len(toAddress))
 	copy(toAcctAddress, toAddress)
-
-	if err := k.EnsureAccountExist(ctx, toAddress); err != nil {
-		return err
+	if err := sdk.VerifyAddressFormat(toAcctAddress); err != nil {
+		return types.ErrInvalidToAddress(fmt.Sprintf("toAddress: %x, Error: %s", toAddress, err.Error()))
 	}
+
+	// the account of toAcctAddress is created by bank keeper if it has not been seen before
 	if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, toAcctAddress, amt); err != nil {
 		return types.ErrUnLock(fmt.Sprintf("supplyKeeper.SendCoinsFromModuleToAccount, Error: send coins:%s from Module account:%s to receiver account:%s error", amt.String(), k.GetModuleAccount(ctx).GetAddress().String(), toAcctAddress.String()))
 	}
@@ -275,7 +643,21 @@ func (k Keeper) Unlock(ctx sdk.Context, fromChainId uint64, fromContractAddr sdk
 			sdk.NewAttribute(types.AttributeKeyToAssetHash, hex.EncodeToString([]byte(toAssetDenom))),
 			sdk.NewAttribute(types.AttributeKeyToAddress, toAcctAddress.String()),
 			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyDust, dust.String()),
 		),
 	})
+	k.AfterUnlock(ctx, common.UnlockInfo{
+		Module:           types.ModuleName,
+		FromChainId:      fromChainId,
+		FromContractHash: fromContractAddr,
+		ToContractHash:   toContractAddr,
+		Denom:            toAssetDenom,
+		ToAddress:        toAcctAddress,
+		Amount:           sdk.NewIntFromBigInt(amount),
+	})
+	// the unlock waits for the receiver to opt into the action, any other failure leaves the coins at receiver
+	if len(args.Action) != 0 {
+		return k.PerformUnlockAction(ctx, toAcctAddress, amt, args.Action)
+	}
 	return nil
 }
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/supply"
+	"github.com/polynetwork/cosmos-poly-module/lockproxy/internal/types"
 	"github.com/polynetwork/cosmos-poly-module/simapp"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -157,7 +158,7 @@ func Test_lockproxy_BindProxyHash(t *testing.T) {
 			}
 		}
 
-		err = app.LockProxyKeeper.BindAssetHash(ctx, proxyCreator, coin.Denom, testCase.toChainId, testCase.toAssetHash)
+		err = app.LockProxyKeeper.BindAssetHash(ctx, proxyCreator, coin.Denom, testCase.toChainId, testCase.toAssetHash, 0, 0)
 		if testCase.bindSucceed {
 			require.Nil(t, err)
 		} else {
@@ -166,3 +167,358 @@ func Test_lockproxy_BindProxyHash(t *testing.T) {
 
 	}
 }
+
+func Test_lockproxy_UnlockToNewAccount(t *testing.T) {
+	app, ctx := createTestApp(true)
+	app.SupplyKeeper.SetSupply(ctx, supply.NewSupply(sdk.Coins{sdk.NewCoin("stake", sdk.NewInt(100))}))
+
+	lockProxy := sdk.AccAddress([]byte("lockProxy"))
+	fromProxy := []byte{1, 2, 3, 4}
+	var fromChainId uint64 = 2
+	require.Nil(t, app.LockProxyKeeper.CreateLockProxy(ctx, lockProxy))
+	require.Nil(t, app.LockProxyKeeper.CreateCoinAndDelegateToProxy(ctx, lockProxy, sdk.NewInt64Coin("coin1", 100), lockProxy))
+	require.Nil(t, app.LockProxyKeeper.BindProxyHash(ctx, lockProxy, fromChainId, fromProxy))
+	require.Nil(t, app.LockProxyKeeper.BindAssetHash(ctx, lockProxy, "coin1", fromChainId, []byte{5, 6}, 0, 0))
+
+	err := app.LockProxyKeeper.Unlock(ctx, fromChainId, fromProxy, lockProxy, serializeUnlockArgs(t, "coin1", []byte{1, 2, 3}, 10, nil))
+	require.True(t, types.ErrInvalidToAddressType.Is(err), "malformed toAddress should be rejected")
+
+	newAccount := sdk.AccAddress([]byte("newAccountAddress123"))
+	require.Nil(t, app.AccountKeeper.GetAccount(ctx, newAccount))
+	err = app.LockProxyKeeper.Unlock(ctx, fromChainId, fromProxy, lockProxy, serializeUnlockArgs(t, "coin1", newAccount, 10, nil))
+	require.Nil(t, err)
+	require.NotNil(t, app.AccountKeeper.GetAccount(ctx, newAccount))
+	require.Equal(t, "10coin1", app.BankKeeper.GetCoins(ctx, newAccount).String())
+}
+
+func Test_lockproxy_UnlockScaleDecimals(t *testing.T) {
+	app, ctx := createTestApp(true)
+	app.SupplyKeeper.SetSupply(ctx, supply.NewSupply(sdk.Coins{sdk.NewCoin("stake", sdk.NewInt(100))}))
+
+	lockProxy := sdk.AccAddress([]byte("lockProxy"))
+	fromProxy := []byte{1, 2, 3, 4}
+	var fromChainId uint64 = 2
+	require.Nil(t, app.LockProxyKeeper.CreateLockProxy(ctx, lockProxy))
+	require.Nil(t, app.LockProxyKeeper.CreateCoinAndDelegateToProxy(ctx, lockProxy, sdk.NewInt64Coin("coin1", 100), lockProxy))
+	require.Nil(t, app.LockProxyKeeper.BindProxyHash(ctx, lockProxy, fromChainId, fromProxy))
+	require.Nil(t, app.LockProxyKeeper.BindAssetHash(ctx, lockProxy, "coin1", fromChainId, []byte{5, 6}, 6, 8))
+	sourceDecimals, toDecimals := app.LockProxyKeeper.GetAssetDecimals(ctx, lockProxy, "coin1", fromChainId)
+	require.Equal(t, uint8(6), sourceDecimals)
+	require.Equal(t, uint8(8), toDecimals)
+
+	// the inbound amount is truncated to the precision of current chain, the unlock event reports the dust left in
+	// the source chain
+	receiver := sdk.AccAddress([]byte("receiverAddress12345"))
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.Nil(t, app.LockProxyKeeper.Unlock(ctx, fromChainId, fromProxy, lockProxy, serializeUnlockArgs(t, "coin1", receiver, 1001, nil)))
+	require.Equal(t, "10coin1", app.BankKeeper.GetCoins(ctx, receiver).String())
+	require.Equal(t, "1", eventAttribute(ctx, types.EventTypeUnlock, types.AttributeKeyDust))
+	require.Nil(t, app.LockProxyKeeper.Unlock(ctx, fromChainId, fromProxy, lockProxy, serializeUnlockArgs(t, "coin1", receiver, 1000, nil)))
+	require.Equal(t, "20coin1", app.BankKeeper.GetCoins(ctx, receiver).String())
+
+	// rebinding with equal decimals disables the scaling of Lock at once, Unlock keeps the former decimals for the
+	// transfers in flight until the change is settled
+	require.True(t, types.ErrSettleAssetDecimalsType.Is(app.LockProxyKeeper.SettleAssetDecimals(ctx, lockProxy, "coin1", fromChainId)))
+	require.Nil(t, app.LockProxyKeeper.BindAssetHash(ctx, lockProxy, "coin1", fromChainId, []byte{5, 6}, 8, 8))
+	sourceDecimals, toDecimals = app.LockProxyKeeper.GetAssetDecimals(ctx, lockProxy, "coin1", fromChainId)
+	require.Equal(t, uint8(0), sourceDecimals)
+	require.Equal(t, uint8(0), toDecimals)
+	sourceDecimals, toDecimals, settling := app.LockProxyKeeper.GetUnlockAssetDecimals(ctx, lockProxy, "coin1", fromChainId)
+	require.True(t, settling)
+	require.Equal(t, uint8(6), sourceDecimals)
+	require.Equal(t, uint8(8), toDecimals)
+	require.Nil(t, app.LockProxyKeeper.Unlock(ctx, fromChainId, fromProxy, lockProxy, serializeUnlockArgs(t, "coin1", receiver, 1000, nil)))
+	require.Equal(t, "30coin1", app.BankKeeper.GetCoins(ctx, receiver).String())
+
+	require.Nil(t, app.LockProxyKeeper.SettleAssetDecimals(ctx, lockProxy, "coin1", fromChainId))
+	_, _, settling = app.LockProxyKeeper.GetUnlockAssetDecimals(ctx, lockProxy, "coin1", fromChainId)
+	require.False(t, settling)
+	require.Nil(t, app.LockProxyKeeper.Unlock(ctx, fromChainId, fromProxy, lockProxy, serializeUnlockArgs(t, "coin1", receiver, 10, nil)))
+	require.Equal(t, "40coin1", app.BankKeeper.GetCoins(ctx, receiver).String())
+
+	// unbinding keeps the decimals for the transfers in flight as well, and binding the same decimals again has
+	// nothing left to settle
+	require.Nil(t, app.LockProxyKeeper.UnbindAssetHash(ctx, lockProxy, "coin1", fromChainId))
+	_, _, settling = app.LockProxyKeeper.GetUnlockAssetDecimals(ctx, lockProxy, "coin1", fromChainId)
+	require.True(t, settling)
+	require.Nil(t, app.LockProxyKeeper.BindAssetHash(ctx, lockProxy, "coin1", fromChainId, []byte{5, 6}, 6, 6))
+	_, _, settling = app.LockProxyKeeper.GetUnlockAssetDecimals(ctx, lockProxy, "coin1", fromChainId)
+	require.False(t, settling)
+
+	// the outbound amount is never truncated, it is rejected if it loses precision
+	require.Nil(t, app.LockProxyKeeper.BindAssetHash(ctx, lockProxy, "coin1", fromChainId, []byte{5, 6}, 7, 6))
+	cacheCtx, _ := ctx.CacheContext()
+	require.True(t, types.ErrLockType.Is(app.LockProxyKeeper.Lock(cacheCtx, lockProxy, receiver, "coin1", fromChainId, []byte{7, 8}, sdk.NewInt(11), nil)))
+	require.Nil(t, app.LockProxyKeeper.Lock(ctx, lockProxy, receiver, "coin1", fromChainId, []byte{7, 8}, sdk.NewInt(10), nil))
+	require.Equal(t, "30coin1", app.BankKeeper.GetCoins(ctx, receiver).String())
+}
+
+func eventAttribute(ctx sdk.Context, eventType, key string) string {
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != eventType {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == key {
+				return string(attr.Value)
+			}
+		}
+	}
+	return ""
+}
+
+func Test_lockproxy_BindingHistory(t *testing.T) {
+	app, ctx := createTestApp(true)
+	app.SupplyKeeper.SetSupply(ctx, supply.NewSupply(sdk.Coins{sdk.NewCoin("stake", sdk.NewInt(100))}))
+
+	lockProxy := sdk.AccAddress([]byte("lockProxy"))
+	var toChainId uint64 = 2
+	require.Nil(t, app.LockProxyKeeper.CreateLockProxy(ctx, lockProxy))
+	require.Nil(t, app.LockProxyKeeper.CreateCoinAndDelegateToProxy(ctx, lockProxy, sdk.NewInt64Coin("coin1", 100), lockProxy))
+
+	require.True(t, types.ErrUnbindProxyHashType.Is(app.LockProxyKeeper.UnbindProxyHash(ctx, lockProxy, toChainId)))
+	require.True(t, types.ErrUnbindAssetHashType.Is(app.LockProxyKeeper.UnbindAssetHash(ctx, lockProxy, "coin1", toChainId)))
+
+	ctx = ctx.WithBlockHeight(10)
+	require.Nil(t, app.LockProxyKeeper.BindProxyHash(ctx, lockProxy, toChainId, []byte{1, 2}))
+	require.Nil(t, app.LockProxyKeeper.BindAssetHash(ctx, lockProxy, "coin1", toChainId, []byte{3, 4}, 6, 8))
+	ctx = ctx.WithBlockHeight(11)
+	require.Nil(t, app.LockProxyKeeper.BindProxyHash(ctx, lockProxy, toChainId, []byte{1, 3}))
+	ctx = ctx.WithBlockHeight(12)
+	require.Nil(t, app.LockProxyKeeper.UnbindProxyHash(ctx, lockProxy, toChainId))
+	require.Nil(t, app.LockProxyKeeper.UnbindAssetHash(ctx, lockProxy, "coin1", toChainId))
+	require.Empty(t, app.LockProxyKeeper.GetProxyHash(ctx, lockProxy, toChainId))
+	require.Empty(t, app.LockProxyKeeper.GetAssetHash(ctx, lockProxy, "coin1", toChainId))
+	sourceDecimals, toDecimals := app.LockProxyKeeper.GetAssetDecimals(ctx, lockProxy, "coin1", toChainId)
+	require.Equal(t, uint8(0), sourceDecimals)
+	require.Equal(t, uint8(0), toDecimals)
+
+	// the history is ordered by binding, the proxy binding changes come before the asset binding changes
+	history := app.LockProxyKeeper.GetBindingHistory(ctx, nil, "", 0, 1, 0)
+	require.Equal(t, 5, len(history))
+	require.Equal(t, types.BindingChange{
+		BindingType: "proxy",
+		LockProxy:   lockProxy,
+		ChainId:     toChainId,
+		OldValue:    []byte{1, 2},
+		NewValue:    []byte{1, 3},
+		Height:      11,
+		Operator:    lockProxy,
+	}, history[1])
+	require.Equal(t, "proxy", history[2].BindingType)
+	require.Equal(t, "asset", history[3].BindingType)
+	require.Equal(t, history, app.LockProxyKeeper.GetBindingHistory(ctx, lockProxy, "", toChainId, 1, 0))
+	require.Equal(t, history[2:4], app.LockProxyKeeper.GetBindingHistory(ctx, nil, "", 0, 2, 2))
+	require.Equal(t, history[4:], app.LockProxyKeeper.GetBindingHistory(ctx, nil, "", 0, 3, 2))
+
+	assetHistory := app.LockProxyKeeper.GetBindingHistory(ctx, lockProxy, "coin1", toChainId, 1, 0)
+	require.Equal(t, 2, len(assetHistory))
+	require.Equal(t, []byte{3, 4}, assetHistory[0].NewValue)
+	require.Empty(t, assetHistory[0].OldValue)
+	require.Equal(t, []byte{3, 4}, assetHistory[1].OldValue)
+	require.Empty(t, assetHistory[1].NewValue)
+	require.Equal(t, int64(12), assetHistory[1].Height)
+	// the decimals of the binding are kept in its history
+	require.Equal(t, uint8(6), assetHistory[0].SourceDecimals)
+	require.Equal(t, uint8(8), assetHistory[0].ToDecimals)
+	require.Equal(t, uint8(6), assetHistory[1].OldSourceDecimals)
+	require.Equal(t, uint8(8), assetHistory[1].OldToDecimals)
+	require.Equal(t, uint8(0), assetHistory[1].SourceDecimals)
+	require.Empty(t, app.LockProxyKeeper.GetBindingHistory(ctx, lockProxy, "coin1", 3, 1, 0))
+}
+
+func Test_lockproxy_PendingBindingChange(t *testing.T) {
+	app, ctx := createTestApp(true)
+	app.SupplyKeeper.SetSupply(ctx, supply.NewSupply(sdk.Coins{sdk.NewCoin("stake", sdk.NewInt(100))}))
+
+	lockProxy := sdk.AccAddress([]byte("lockProxyAddress1234"))
+	guardian := sdk.AccAddress([]byte("guardianAddress12345"))
+	other := sdk.AccAddress([]byte("otherAddress12345678"))
+	var toChainId uint64 = 2
+	require.Nil(t, app.LockProxyKeeper.CreateLockProxy(ctx, lockProxy))
+	require.Nil(t, app.LockProxyKeeper.CreateCoinAndDelegateToProxy(ctx, lockProxy, sdk.NewInt64Coin("coin1", 100), lockProxy))
+	app.LockProxyKeeper.SetParams(ctx, types.Params{BindingChangeDelay: 5, Guardian: guardian})
+
+	ctx = ctx.WithBlockHeight(10)
+	require.Nil(t, app.LockProxyKeeper.BindProxyHash(ctx, lockProxy, toChainId, []byte{1, 2}))
+	require.Nil(t, app.LockProxyKeeper.BindAssetHash(ctx, lockProxy, "coin1", toChainId, []byte{3, 4}, 6, 8))
+	require.Nil(t, app.LockProxyKeeper.BindProxyHash(ctx, lockProxy, 3, []byte{5, 6}))
+	require.Empty(t, app.LockProxyKeeper.GetProxyHash(ctx, lockProxy, toChainId))
+	require.Empty(t, app.LockProxyKeeper.GetAssetHash(ctx, lockProxy, "coin1", toChainId))
+
+	pending := app.LockProxyKeeper.GetPendingBindingChanges(ctx, lockProxy)
+	require.Equal(t, 3, len(pending))
+	require.Equal(t, int64(15), pending[1].EffectiveHeight)
+	require.Equal(t, []byte{3, 4}, pending[1].NewValue)
+	require.Empty(t, app.LockProxyKeeper.GetPendingBindingChanges(ctx, other))
+
+	// only the operator or the guardian is able to cancel
+	require.True(t, types.ErrCancelBindingChangeType.Is(app.LockProxyKeeper.CancelBindingChange(ctx, other, 2)))
+	require.True(t, types.ErrCancelBindingChangeType.Is(app.LockProxyKeeper.CancelBindingChange(ctx, lockProxy, 5)))
+	require.Nil(t, app.LockProxyKeeper.CancelBindingChange(ctx, guardian, 2))
+	require.Equal(t, 2, len(app.LockProxyKeeper.GetPendingBindingChanges(ctx, nil)))
+
+	ctx = ctx.WithBlockHeight(14)
+	app.LockProxyKeeper.ApplyPendingBindingChanges(ctx)
+	require.Empty(t, app.LockProxyKeeper.GetProxyHash(ctx, lockProxy, toChainId))
+
+	ctx = ctx.WithBlockHeight(15)
+	app.LockProxyKeeper.ApplyPendingBindingChanges(ctx)
+	require.Equal(t, []byte{1, 2}, app.LockProxyKeeper.GetProxyHash(ctx, lockProxy, toChainId))
+	require.Equal(t, []byte{3, 4}, app.LockProxyKeeper.GetAssetHash(ctx, lockProxy, "coin1", toChainId))
+	require.Empty(t, app.LockProxyKeeper.GetProxyHash(ctx, lockProxy, 3))
+	sourceDecimals, toDecimals := app.LockProxyKeeper.GetAssetDecimals(ctx, lockProxy, "coin1", toChainId)
+	require.Equal(t, uint8(6), sourceDecimals)
+	require.Equal(t, uint8(8), toDecimals)
+	require.Empty(t, app.LockProxyKeeper.GetPendingBindingChanges(ctx, nil))
+	require.Equal(t, int64(15), app.LockProxyKeeper.GetBindingHistory(ctx, lockProxy, "", toChainId, 1, 0)[0].Height)
+
+	// unbinding waits for the delay as well and is cancellable by the operator
+	require.Nil(t, app.LockProxyKeeper.UnbindProxyHash(ctx, lockProxy, toChainId))
+	require.Nil(t, app.LockProxyKeeper.UnbindAssetHash(ctx, lockProxy, "coin1", toChainId))
+	require.Nil(t, app.LockProxyKeeper.CancelBindingChange(ctx, lockProxy, 3))
+	ctx = ctx.WithBlockHeight(20)
+	app.LockProxyKeeper.ApplyPendingBindingChanges(ctx)
+	require.Equal(t, []byte{1, 2}, app.LockProxyKeeper.GetProxyHash(ctx, lockProxy, toChainId))
+	require.Empty(t, app.LockProxyKeeper.GetAssetHash(ctx, lockProxy, "coin1", toChainId))
+}
+
+func Test_lockproxy_OperatorGroup(t *testing.T) {
+	app, ctx := createTestApp(true)
+	app.SupplyKeeper.SetSupply(ctx, supply.NewSupply(sdk.Coins{sdk.NewCoin("stake", sdk.NewInt(100))}))
+
+	lockProxy := sdk.AccAddress([]byte("lockProxyAddress1234"))
+	member1 := sdk.AccAddress([]byte("memberAddress1234561"))
+	member2 := sdk.AccAddress([]byte("memberAddress1234562"))
+	member3 := sdk.AccAddress([]byte("memberAddress1234563"))
+	var toChainId uint64 = 2
+	require.Nil(t, app.LockProxyKeeper.CreateLockProxy(ctx, lockProxy))
+	require.Nil(t, app.LockProxyKeeper.CreateCoinAndDelegateToProxy(ctx, lockProxy, sdk.NewInt64Coin("coin1", 100), lockProxy))
+
+	// the lock proxy never transferred is controlled by its creator alone
+	require.False(t, app.LockProxyKeeper.HasOperatorGroup(ctx, lockProxy))
+	require.Equal(t, types.NewOperatorGroup([]sdk.AccAddress{lockProxy}, 1), app.LockProxyKeeper.GetOperatorGroup(ctx, lockProxy))
+	transfer := types.OperatorAction{Type: types.OperatorActionTransferOwnership, Members: []sdk.AccAddress{member1, member2, member3}, Threshold: 2}
+	require.True(t, types.ErrOperatorGroupType.Is(app.LockProxyKeeper.ApproveOperatorAction(ctx, member1, lockProxy, transfer)))
+	require.Nil(t, app.LockProxyKeeper.ApproveOperatorAction(ctx, lockProxy, lockProxy, transfer))
+	require.True(t, app.LockProxyKeeper.HasOperatorGroup(ctx, lockProxy))
+	require.Equal(t, uint64(1), app.LockProxyKeeper.GetOperatorGroup(ctx, lockProxy).Version)
+
+	// the former single operator key is not able to bind any more
+	require.True(t, types.ErrBindProxyHashType.Is(app.LockProxyKeeper.BindProxyHash(ctx, lockProxy, toChainId, []byte{1, 2})))
+	require.True(t, types.ErrOperatorGroupType.Is(app.LockProxyKeeper.ApproveOperatorAction(ctx, lockProxy, lockProxy,
+		types.OperatorAction{Type: types.OperatorActionBindProxy, ChainId: toChainId, Value: []byte{1, 2}})))
+
+	bindAsset := types.OperatorAction{Type: types.OperatorActionBindAsset, Denom: "coin1", ChainId: toChainId, Value: []byte{3, 4}, SourceDecimals: 6, ToDecimals: 8}
+	require.Nil(t, app.LockProxyKeeper.ApproveOperatorAction(ctx, member1, lockProxy, bindAsset))
+	require.True(t, types.ErrOperatorGroupType.Is(app.LockProxyKeeper.ApproveOperatorAction(ctx, member1, lockProxy, bindAsset)))
+	require.Empty(t, app.LockProxyKeeper.GetAssetHash(ctx, lockProxy, "coin1", toChainId))
+	approvals := app.LockProxyKeeper.GetOperatorApprovals(ctx, lockProxy)
+	require.Equal(t, 1, len(approvals))
+	require.Equal(t, []sdk.AccAddress{member1}, approvals[0].Approvers)
+
+	// a different action collects its own approvals
+	otherAsset := bindAsset
+	otherAsset.Value = []byte{5, 6}
+	require.Nil(t, app.LockProxyKeeper.ApproveOperatorAction(ctx, member2, lockProxy, otherAsset))
+	require.Empty(t, app.LockProxyKeeper.GetAssetHash(ctx, lockProxy, "coin1", toChainId))
+	require.Nil(t, app.LockProxyKeeper.ApproveOperatorAction(ctx, member3, lockProxy, bindAsset))
+	require.Equal(t, []byte{3, 4}, app.LockProxyKeeper.GetAssetHash(ctx, lockProxy, "coin1", toChainId))
+	require.Equal(t, 1, len(app.LockProxyKeeper.GetOperatorApprovals(ctx, lockProxy)))
+	history := app.LockProxyKeeper.GetBindingHistory(ctx, lockProxy, "coin1", toChainId, 1, 0)
+	require.Equal(t, 1, len(history))
+	require.Equal(t, member3, history[0].Operator)
+	require.Equal(t, []sdk.AccAddress{member1, member3}, history[0].Approvers)
+
+	// the members cancel the pending binding change once the threshold is reached, the former operator is not able to
+	app.LockProxyKeeper.SetParams(ctx, types.Params{BindingChangeDelay: 5})
+	unbindAsset := types.OperatorAction{Type: types.OperatorActionUnbindAsset, Denom: "coin1", ChainId: toChainId}
+	require.Nil(t, app.LockProxyKeeper.ApproveOperatorAction(ctx, member1, lockProxy, unbindAsset))
+	require.Nil(t, app.LockProxyKeeper.ApproveOperatorAction(ctx, member2, lockProxy, unbindAsset))
+	pending := app.LockProxyKeeper.GetPendingBindingChanges(ctx, lockProxy)
+	require.Equal(t, 1, len(pending))
+	require.Equal(t, []sdk.AccAddress{member1, member2}, pending[0].Approvers)
+	require.True(t, types.ErrCancelBindingChangeType.Is(app.LockProxyKeeper.CancelBindingChange(ctx, lockProxy, pending[0].Id)))
+	require.Nil(t, app.LockProxyKeeper.CancelBindingChange(ctx, member3, pending[0].Id))
+	require.Equal(t, 1, len(app.LockProxyKeeper.GetPendingBindingChanges(ctx, lockProxy)))
+	require.True(t, types.ErrOperatorGroupType.Is(app.LockProxyKeeper.CancelBindingChange(ctx, member3, pending[0].Id)))
+	require.Nil(t, app.LockProxyKeeper.CancelBindingChange(ctx, member1, pending[0].Id))
+	require.Empty(t, app.LockProxyKeeper.GetPendingBindingChanges(ctx, lockProxy))
+
+	// the cancel approvals below the threshold are dropped once the pending binding change is applied
+	bindProxy := types.OperatorAction{Type: types.OperatorActionBindProxy, ChainId: 3, Value: []byte{7, 8}}
+	require.Nil(t, app.LockProxyKeeper.ApproveOperatorAction(ctx, member1, lockProxy, bindProxy))
+	require.Nil(t, app.LockProxyKeeper.ApproveOperatorAction(ctx, member2, lockProxy, bindProxy))
+	pending = app.LockProxyKeeper.GetPendingBindingChanges(ctx, lockProxy)
+	require.Equal(t, 1, len(pending))
+	require.Nil(t, app.LockProxyKeeper.CancelBindingChange(ctx, member3, pending[0].Id))
+	require.Equal(t, 2, len(app.LockProxyKeeper.GetOperatorApprovals(ctx, lockProxy)))
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 5)
+	app.LockProxyKeeper.ApplyPendingBindingChanges(ctx)
+	require.Equal(t, []byte{7, 8}, app.LockProxyKeeper.GetProxyHash(ctx, lockProxy, 3))
+	approvals = app.LockProxyKeeper.GetOperatorApprovals(ctx, lockProxy)
+	require.Equal(t, 1, len(approvals))
+	require.Equal(t, otherAsset, approvals[0].Action)
+
+	// transferring the ownership waits for the delay as well, a single member is not able to cancel it
+	newTransfer := types.OperatorAction{Type: types.OperatorActionTransferOwnership, Members: []sdk.AccAddress{member1}, Threshold: 1}
+	require.Nil(t, app.LockProxyKeeper.ApproveOperatorAction(ctx, member2, lockProxy, newTransfer))
+	require.Nil(t, app.LockProxyKeeper.ApproveOperatorAction(ctx, member3, lockProxy, newTransfer))
+	pending = app.LockProxyKeeper.GetPendingBindingChanges(ctx, lockProxy)
+	require.Equal(t, 1, len(pending))
+	require.Equal(t, types.BindingTypeOwnership, pending[0].BindingType)
+	require.Equal(t, 3, len(app.LockProxyKeeper.GetOperatorGroup(ctx, lockProxy).Members))
+	require.Nil(t, app.LockProxyKeeper.CancelBindingChange(ctx, member1, pending[0].Id))
+	require.Equal(t, 1, len(app.LockProxyKeeper.GetPendingBindingChanges(ctx, lockProxy)))
+	require.Nil(t, app.LockProxyKeeper.CancelBindingChange(ctx, member2, pending[0].Id))
+	require.Empty(t, app.LockProxyKeeper.GetPendingBindingChanges(ctx, lockProxy))
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 5)
+	app.LockProxyKeeper.ApplyPendingBindingChanges(ctx)
+	require.Equal(t, 3, len(app.LockProxyKeeper.GetOperatorGroup(ctx, lockProxy).Members))
+
+	// the transfer drops the approvals and the pending binding changes of the former group
+	require.Nil(t, app.LockProxyKeeper.ApproveOperatorAction(ctx, member2, lockProxy, newTransfer))
+	require.Nil(t, app.LockProxyKeeper.ApproveOperatorAction(ctx, member3, lockProxy, newTransfer))
+	require.Nil(t, app.LockProxyKeeper.ApproveOperatorAction(ctx, member1, lockProxy, unbindAsset))
+	require.Nil(t, app.LockProxyKeeper.ApproveOperatorAction(ctx, member2, lockProxy, unbindAsset))
+	require.Equal(t, 2, len(app.LockProxyKeeper.GetPendingBindingChanges(ctx, lockProxy)))
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 5)
+	app.LockProxyKeeper.ApplyPendingBindingChanges(ctx)
+	require.Equal(t, []sdk.AccAddress{member1}, app.LockProxyKeeper.GetOperatorGroup(ctx, lockProxy).Members)
+	require.Empty(t, app.LockProxyKeeper.GetOperatorApprovals(ctx, lockProxy))
+	require.Empty(t, app.LockProxyKeeper.GetPendingBindingChanges(ctx, lockProxy))
+	require.Equal(t, []byte{3, 4}, app.LockProxyKeeper.GetAssetHash(ctx, lockProxy, "coin1", toChainId))
+	require.True(t, types.ErrOperatorGroupType.Is(app.LockProxyKeeper.ApproveOperatorAction(ctx, member2, lockProxy, otherAsset)))
+}
+
+func Test_lockproxy_Listing(t *testing.T) {
+	app, ctx := createTestApp(true)
+	app.SupplyKeeper.SetSupply(ctx, supply.NewSupply(sdk.Coins{sdk.NewCoin("stake", sdk.NewInt(100))}))
+
+	lp1 := sdk.AccAddress([]byte("lockProxy1"))
+	lp2 := sdk.AccAddress([]byte("lockProxy2"))
+	require.Nil(t, app.LockProxyKeeper.CreateLockProxy(ctx, lp1))
+	require.Nil(t, app.LockProxyKeeper.CreateLockProxy(ctx, lp2))
+	require.Nil(t, app.LockProxyKeeper.CreateCoinAndDelegateToProxy(ctx, lp1, sdk.NewInt64Coin("coin1", 100), lp1))
+	require.Nil(t, app.LockProxyKeeper.CreateCoinAndDelegateToProxy(ctx, lp1, sdk.NewInt64Coin("coin10", 100), lp1))
+
+	for _, chainId := range []uint64{2, 3, 4} {
+		require.Nil(t, app.LockProxyKeeper.BindProxyHash(ctx, lp1, chainId, []byte{1, byte(chainId)}))
+		require.Nil(t, app.LockProxyKeeper.BindAssetHash(ctx, lp1, "coin1", chainId, []byte{2, byte(chainId)}, 0, 0))
+	}
+	require.Nil(t, app.LockProxyKeeper.BindAssetHash(ctx, lp1, "coin10", 5, []byte{3, 5}, 0, 0))
+
+	proxies := app.LockProxyKeeper.GetLockProxies(ctx, 1, 0)
+	require.Equal(t, 2, len(proxies))
+	require.Equal(t, 1, len(app.LockProxyKeeper.GetLockProxies(ctx, 2, 1)))
+	require.Empty(t, app.LockProxyKeeper.GetLockProxies(ctx, 2, 2))
+
+	require.Equal(t, []types.Binding{{ChainId: 2, Hash: "0102"}, {ChainId: 3, Hash: "0103"}, {ChainId: 4, Hash: "0104"}},
+		app.LockProxyKeeper.GetProxyBindings(ctx, lp1, 1, 10))
+	require.Empty(t, app.LockProxyKeeper.GetProxyBindings(ctx, lp2, 1, 10))
+
+	require.Equal(t, []types.Binding{{ChainId: 2, Hash: "0202"}, {ChainId: 3, Hash: "0203"}, {ChainId: 4, Hash: "0204"}},
+		app.LockProxyKeeper.GetAssetBindings(ctx, lp1, "coin1", 1, 10))
+	require.Equal(t, []types.Binding{{ChainId: 4, Hash: "0204"}}, app.LockProxyKeeper.GetAssetBindings(ctx, lp1, "coin1", 2, 2))
+	require.Equal(t, []types.Binding{{ChainId: 5, Hash: "0305"}}, app.LockProxyKeeper.GetAssetBindings(ctx, lp1, "coin10", 1, 10))
+}
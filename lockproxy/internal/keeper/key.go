@@ -18,6 +18,7 @@
 package keeper
 
 import (
+	"crypto/sha256"
 	"encoding/binary"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -30,9 +31,19 @@ const (
 )
 
 var (
-	OperatorToLockProxyKey = []byte{0x01}
-	BindProxyPrefix        = []byte{0x02}
-	BindAssetPrefix        = []byte{0x03}
+	OperatorToLockProxyKey    = []byte{0x01}
+	BindProxyPrefix           = []byte{0x02}
+	BindAssetPrefix           = []byte{0x03}
+	BindAssetDecimalsPrefix   = []byte{0x04}
+	BindingChangePrefix       = []byte{0x05}
+	BindingChangeCountKey     = []byte{0x06}
+	PendingBindingPrefix      = []byte{0x07}
+	PendingBindingIdKey       = []byte{0x08}
+	PendingBindingQueuePrefix = []byte{0x09}
+	OperatorGroupPrefix       = []byte{0x0a}
+	OperatorApprovalPrefix    = []byte{0x0b}
+	UnlockActionOptInPrefix   = []byte{0x0c}
+	UnlockAssetDecimalsPrefix = []byte{0x0d}
 )
 
 func GetOperatorToLockProxyKey(operator sdk.AccAddress) []byte {
@@ -50,3 +61,68 @@ func GetBindAssetHashKey(lockProxyHash []byte, sourceAssetHash []byte, targetCha
 	binary.LittleEndian.PutUint64(b, targetChainId)
 	return append(append(append(BindAssetPrefix, lockProxyHash...), sourceAssetHash...), b...)
 }
+
+func GetBindAssetDecimalsKey(lockProxyHash []byte, sourceAssetHash []byte, targetChainId uint64) []byte {
+	b := make([]byte, 8)
+	binary.LittleEndian.PutUint64(b, targetChainId)
+	return append(append(append(BindAssetDecimalsPrefix, lockProxyHash...), sourceAssetHash...), b...)
+}
+
+func GetUnlockAssetDecimalsKey(lockProxyHash []byte, sourceAssetHash []byte, fromChainId uint64) []byte {
+	b := make([]byte, 8)
+	binary.LittleEndian.PutUint64(b, fromChainId)
+	return append(append(append(append([]byte{}, UnlockAssetDecimalsPrefix...), lockProxyHash...), sourceAssetHash...), b...)
+}
+
+// GetBindingChangeKey orders the binding history by lockProxyHash, denom, chainId and index, lockProxyHash and denom
+// are prefixed by their lengths so that the history of any leading parts can be prefix-iterated
+func GetBindingChangeKey(lockProxyHash []byte, denom string, chainId uint64, index uint64) []byte {
+	prefix := append(append(append([]byte{}, BindingChangePrefix...), byte(len(lockProxyHash))), lockProxyHash...)
+	prefix = append(append(append(prefix, byte(len(denom))), []byte(denom)...), sdk.Uint64ToBigEndian(chainId)...)
+	return append(prefix, sdk.Uint64ToBigEndian(index)...)
+}
+
+// GetBindingChangeIteratorPrefix returns the longest key prefix shared by the binding history of lockProxyHash, denom
+// and chainId, empty lockProxyHash, empty denom and zero chainId match any value
+func GetBindingChangeIteratorPrefix(lockProxyHash []byte, denom string, chainId uint64) []byte {
+	if len(lockProxyHash) == 0 {
+		return BindingChangePrefix
+	}
+	prefix := append(append(append([]byte{}, BindingChangePrefix...), byte(len(lockProxyHash))), lockProxyHash...)
+	if denom == "" {
+		return prefix
+	}
+	prefix = append(append(prefix, byte(len(denom))), []byte(denom)...)
+	if chainId == 0 {
+		return prefix
+	}
+	return append(prefix, sdk.Uint64ToBigEndian(chainId)...)
+}
+
+func GetPendingBindingKey(id uint64) []byte {
+	return append(PendingBindingPrefix, sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetPendingBindingQueueKey orders the pending binding changes by their effective height
+func GetPendingBindingQueueKey(effectiveHeight int64, id uint64) []byte {
+	return append(append(PendingBindingQueuePrefix, sdk.Uint64ToBigEndian(uint64(effectiveHeight))...), sdk.Uint64ToBigEndian(id)...)
+}
+
+func GetOperatorGroupKey(lockProxyHash []byte) []byte {
+	return append(OperatorGroupPrefix, lockProxyHash...)
+}
+
+// GetOperatorApprovalKey identifies the action of lock proxy to be approved by the operator group with version,
+// the approvals of a lock proxy share the prefix of GetOperatorApprovalsPrefix
+func GetOperatorApprovalKey(lockProxyHash []byte, version uint64, actionBs []byte) []byte {
+	hash := sha256.Sum256(append(sdk.Uint64ToBigEndian(version), actionBs...))
+	return append(GetOperatorApprovalsPrefix(lockProxyHash), hash[:]...)
+}
+
+func GetOperatorApprovalsPrefix(lockProxyHash []byte) []byte {
+	return append(append([]byte{}, OperatorApprovalPrefix...), lockProxyHash...)
+}
+
+func GetUnlockActionOptInKey(receiver sdk.AccAddress, action string) []byte {
+	return append(append(append([]byte{}, UnlockActionOptInPrefix...), receiver...), []byte(action)...)
+}
@@ -0,0 +1,67 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package keeper
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/polynetwork/cosmos-poly-module/common"
+	"github.com/polynetwork/cosmos-poly-module/lockproxy/internal/types"
+)
+
+// GetLockProxies returns the page-th page of the lock proxies ordered by their hashes
+func (k Keeper) GetLockProxies(ctx sdk.Context, page, limit int) []types.LockProxy {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), OperatorToLockProxyKey)
+	defer iterator.Close()
+
+	proxies := make([]types.LockProxy, 0)
+	common.Paginate(iterator, page, limit, func(key, value []byte) bool {
+		return bytes.Equal(key[len(OperatorToLockProxyKey):], value)
+	}, func(key, value []byte) {
+		proxies = append(proxies, types.LockProxy{Hash: hex.EncodeToString(value), Creator: value})
+	})
+	return proxies
+}
+
+// GetProxyBindings returns the page-th page of the proxy hashes bound by lockProxyHash in other chains
+func (k Keeper) GetProxyBindings(ctx sdk.Context, lockProxyHash []byte, page, limit int) []common.Binding {
+	return k.getBindings(ctx, append(append([]byte{}, BindProxyPrefix...), lockProxyHash...), page, limit)
+}
+
+// GetAssetBindings returns the page-th page of the asset hashes bound to sourceAssetDenom by lockProxyHash in other chains
+func (k Keeper) GetAssetBindings(ctx sdk.Context, lockProxyHash []byte, sourceAssetDenom string, page, limit int) []common.Binding {
+	return k.getBindings(ctx, append(append(append([]byte{}, BindAssetPrefix...), lockProxyHash...), sourceAssetDenom...), page, limit)
+}
+
+// getBindings lists the bindings keyed by prefix followed by the chainId, the keys sharing prefix with
+// a longer lock proxy hash or denom are skipped
+func (k Keeper) getBindings(ctx sdk.Context, prefix []byte, page, limit int) []common.Binding {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer iterator.Close()
+
+	bindings := make([]common.Binding, 0)
+	common.Paginate(iterator, page, limit, func(key, value []byte) bool {
+		return len(key) == len(prefix)+8 && len(value) != 0
+	}, func(key, value []byte) {
+		bindings = append(bindings, common.NewBinding(binary.LittleEndian.Uint64(key[len(prefix):]), value))
+	})
+	return bindings
+}
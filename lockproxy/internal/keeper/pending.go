@@ -0,0 +1,176 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package keeper
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/polynetwork/cosmos-poly-module/common"
+	"github.com/polynetwork/cosmos-poly-module/lockproxy/internal/types"
+	lockproxypb "github.com/polynetwork/cosmos-poly-module/lockproxy/pb"
+	"strconv"
+)
+
+// proposeBindingChange queues the change to take effect delay blocks later
+func (k Keeper) proposeBindingChange(ctx sdk.Context, change types.PendingBindingChange, delay uint64) {
+	store := ctx.KVStore(k.storeKey)
+	var id uint64
+	if bz := store.Get(PendingBindingIdKey); bz != nil {
+		id = binary.BigEndian.Uint64(bz)
+	}
+	change.Id = id
+	change.ProposeHeight = ctx.BlockHeight()
+	change.EffectiveHeight = ctx.BlockHeight() + int64(delay)
+	store.Set(GetPendingBindingKey(id), common.MustMarshalProto(types.PendingBindingChangeToProto(change)))
+	store.Set(GetPendingBindingQueueKey(change.EffectiveHeight, id), sdk.Uint64ToBigEndian(id))
+	store.Set(PendingBindingIdKey, sdk.Uint64ToBigEndian(id+1))
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeProposeBindingChange,
+			sdk.NewAttribute(types.AttributeKeyPendingId, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyBindingType, change.BindingType),
+			sdk.NewAttribute(types.AttributeKeyLockProxy, hex.EncodeToString(change.LockProxy)),
+			sdk.NewAttribute(types.AttributeKeySourceAssetDenom, change.Denom),
+			sdk.NewAttribute(types.AttributeKeyToChainId, strconv.FormatUint(change.ChainId, 10)),
+			sdk.NewAttribute(types.AttributeKeyNewValue, hex.EncodeToString(change.NewValue)),
+			sdk.NewAttribute(types.AttributeKeyEffectiveHeight, strconv.FormatInt(change.EffectiveHeight, 10)),
+		),
+	})
+}
+
+// GetPendingBindingChange returns false if no pending binding change with id exists
+func (k Keeper) GetPendingBindingChange(ctx sdk.Context, id uint64) (types.PendingBindingChange, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(GetPendingBindingKey(id))
+	if bz == nil {
+		return types.PendingBindingChange{}, false
+	}
+	var pb lockproxypb.PendingBindingChange
+	common.MustUnmarshalProto(bz, &pb)
+	return types.PendingBindingChangeFromProto(&pb), true
+}
+
+// GetPendingBindingChanges returns the pending binding changes of lockProxyHash ordered by id, empty lockProxyHash matches any lock proxy
+func (k Keeper) GetPendingBindingChanges(ctx sdk.Context, lockProxyHash []byte) []types.PendingBindingChange {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), PendingBindingPrefix)
+	defer iterator.Close()
+
+	changes := make([]types.PendingBindingChange, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var pb lockproxypb.PendingBindingChange
+		common.MustUnmarshalProto(iterator.Value(), &pb)
+		change := types.PendingBindingChangeFromProto(&pb)
+		if len(lockProxyHash) == 0 || bytes.Equal(change.LockProxy, lockProxyHash) {
+			changes = append(changes, change)
+		}
+	}
+	return changes
+}
+
+// deletePendingBindingChange drops the pending binding change along with the approvals collected to cancel it
+func (k Keeper) deletePendingBindingChange(ctx sdk.Context, change types.PendingBindingChange) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(GetPendingBindingKey(change.Id))
+	store.Delete(GetPendingBindingQueueKey(change.EffectiveHeight, change.Id))
+	k.deleteCancelApprovals(ctx, change.LockProxy, change.Id)
+}
+
+// CancelBindingChange drops the pending binding change at once if signer is the guardian, otherwise the signer
+// approves the cancellation as a member of the operator group of the lock proxy and it takes effect once the
+// threshold of the group is reached
+func (k Keeper) CancelBindingChange(ctx sdk.Context, signer sdk.AccAddress, id uint64) error {
+	change, exist := k.GetPendingBindingChange(ctx, id)
+	if !exist {
+		return types.ErrCancelBindingChange(fmt.Sprintf("pending binding change with id: %d not exist", id))
+	}
+	if guardian := k.GetGuardian(ctx); !guardian.Empty() && signer.Equals(guardian) {
+		return k.cancelBindingChange(ctx, change.LockProxy, id, signer)
+	}
+	if !k.GetOperatorGroup(ctx, change.LockProxy).IsMember(signer) {
+		return types.ErrCancelBindingChange(fmt.Sprintf("signer: %s is neither the operator of lockproxy: %x nor the guardian", signer.String(), change.LockProxy))
+	}
+	return k.ApproveOperatorAction(ctx, signer, change.LockProxy, types.OperatorAction{Type: types.OperatorActionCancel, PendingId: id})
+}
+
+func (k Keeper) cancelBindingChange(ctx sdk.Context, lockProxyHash []byte, id uint64, canceller sdk.AccAddress) error {
+	change, exist := k.GetPendingBindingChange(ctx, id)
+	if !exist || !bytes.Equal(change.LockProxy, lockProxyHash) {
+		return types.ErrCancelBindingChange(fmt.Sprintf("pending binding change with id: %d of lockproxy: %x not exist", id, lockProxyHash))
+	}
+	k.deletePendingBindingChange(ctx, change)
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCancelBindingChange,
+			sdk.NewAttribute(types.AttributeKeyPendingId, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyBindingType, change.BindingType),
+			sdk.NewAttribute(types.AttributeKeyLockProxy, hex.EncodeToString(change.LockProxy)),
+			sdk.NewAttribute(types.AttributeKeyCanceller, canceller.String()),
+		),
+	})
+	return nil
+}
+
+// ApplyPendingBindingChanges applies the pending binding changes and ownership transfers whose effective height
+// has been reached, an unbinding is dropped if the binding has already gone
+func (k Keeper) ApplyPendingBindingChanges(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	end := GetPendingBindingQueueKey(ctx.BlockHeight()+1, 0)
+	iterator := store.Iterator(PendingBindingQueuePrefix, end)
+	ids := make([]uint64, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		ids = append(ids, binary.BigEndian.Uint64(iterator.Value()))
+	}
+	iterator.Close()
+
+	for _, id := range ids {
+		change, exist := k.GetPendingBindingChange(ctx, id)
+		if !exist {
+			continue
+		}
+		k.deletePendingBindingChange(ctx, change)
+		operator := sdk.AccAddress(change.LockProxy)
+		applied := true
+		switch {
+		case change.BindingType == types.BindingTypeOwnership:
+			k.transferOwnership(ctx, change.LockProxy, change.Members, change.Threshold)
+		case change.BindingType == common.BindingTypeProxy && len(change.NewValue) != 0:
+			k.setProxyHash(ctx, operator, change.ChainId, change.NewValue, change.Approvers)
+		case change.BindingType == common.BindingTypeProxy:
+			applied = k.deleteProxyHash(ctx, operator, change.ChainId, change.Approvers)
+		case len(change.NewValue) != 0:
+			k.setAssetHash(ctx, operator, change.Denom, change.ChainId, change.NewValue, change.SourceDecimals, change.ToDecimals, change.Approvers)
+		default:
+			applied = k.deleteAssetHash(ctx, operator, change.Denom, change.ChainId, change.Approvers)
+		}
+		if !applied {
+			ctx.Logger().Info(fmt.Sprintf("pending binding change: %d dropped as nothing is bound", id), "module", types.ModuleName)
+			continue
+		}
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeApplyBindingChange,
+				sdk.NewAttribute(types.AttributeKeyPendingId, strconv.FormatUint(id, 10)),
+				sdk.NewAttribute(types.AttributeKeyBindingType, change.BindingType),
+				sdk.NewAttribute(types.AttributeKeyLockProxy, hex.EncodeToString(change.LockProxy)),
+			),
+		})
+	}
+}
@@ -18,6 +18,8 @@
 package keeper
 
 import (
+	"fmt"
+
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -37,6 +39,20 @@ func NewQuerier(k Keeper) sdk.Querier {
 			return queryProxyHash(ctx, req, k)
 		case types.QueryAssetHash:
 			return queryAssetHash(ctx, req, k)
+		case types.QueryBindingHistory:
+			return queryBindingHistory(ctx, req, k)
+		case types.QueryPendingBindingChanges:
+			return queryPendingBindingChanges(ctx, req, k)
+		case types.QueryOperatorGroup:
+			return queryOperatorGroup(ctx, req, k)
+		case types.QueryOperatorApprovals:
+			return queryOperatorApprovals(ctx, req, k)
+		case types.QueryLockProxies:
+			return queryLockProxies(ctx, req, k)
+		case types.QueryProxyBindings:
+			return queryProxyBindings(ctx, req, k)
+		case types.QueryAssetBindings:
+			return queryAssetBindings(ctx, req, k)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown query path: %s", path[0])
 		}
@@ -93,3 +109,111 @@ func queryAssetHash(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, e
 
 	return bz, nil
 }
+
+func queryBindingHistory(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryBindingHistoryParam
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONUnmarshal, "failed to parse params: %s", err)
+	}
+	changes := k.GetBindingHistory(ctx, params.LockProxyHash, params.SourceAssetDenom, params.ChainId, params.Page, params.Limit)
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, changes)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONMarshal, "could not marshal binding history to JSON: %s", err)
+	}
+
+	return bz, nil
+}
+
+func queryPendingBindingChanges(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryPendingBindingChangesParam
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONUnmarshal, "failed to parse params: %s", err)
+	}
+	changes := k.GetPendingBindingChanges(ctx, params.LockProxyHash)
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, changes)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONMarshal, "could not marshal pending binding changes to JSON: %s", err)
+	}
+
+	return bz, nil
+}
+
+func queryOperatorGroup(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryOperatorGroupParam
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONUnmarshal, "failed to parse params: %s", err)
+	}
+	if !k.EnsureLockProxyExist(ctx, params.LockProxyHash) {
+		return nil, types.ErrOperatorGroup(fmt.Sprintf("lockproxy: %x has NOT been created", params.LockProxyHash))
+	}
+	group := k.GetOperatorGroup(ctx, params.LockProxyHash)
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, group)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONMarshal, "could not marshal operator group to JSON: %s", err)
+	}
+
+	return bz, nil
+}
+
+func queryOperatorApprovals(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryOperatorGroupParam
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONUnmarshal, "failed to parse params: %s", err)
+	}
+	approvals := k.GetOperatorApprovals(ctx, params.LockProxyHash)
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, approvals)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONMarshal, "could not marshal operator approvals to JSON: %s", err)
+	}
+
+	return bz, nil
+}
+
+func queryLockProxies(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryLockProxiesParam
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONUnmarshal, "failed to parse params: %s", err)
+	}
+	proxies := k.GetLockProxies(ctx, params.Page, params.Limit)
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, proxies)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONMarshal, "could not marshal lock proxies: %+v to JSON", proxies)
+	}
+
+	return bz, nil
+}
+
+func queryProxyBindings(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryProxyBindingsParam
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONUnmarshal, "failed to parse params: %s", err)
+	}
+	bindings := k.GetProxyBindings(ctx, params.LockProxyHash, params.Page, params.Limit)
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, bindings)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONMarshal, "could not marshal proxy bindings: %+v to JSON", bindings)
+	}
+
+	return bz, nil
+}
+
+func queryAssetBindings(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryAssetBindingsParam
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONUnmarshal, "failed to parse params: %s", err)
+	}
+	bindings := k.GetAssetBindings(ctx, params.LockProxyHash, params.SourceAssetDenom, params.Page, params.Limit)
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, bindings)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrJSONMarshal, "could not marshal asset bindings: %+v to JSON", bindings)
+	}
+
+	return bz, nil
+}
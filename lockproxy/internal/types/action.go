@@ -0,0 +1,102 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package types
+
+import (
+	"bytes"
+	"fmt"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/polynetwork/cosmos-poly-module/common"
+	polycommon "github.com/polynetwork/poly/common"
+)
+
+// names of the unlock actions shipped with lockproxy module
+const (
+	UnlockActionSend     = "send"
+	UnlockActionDelegate = "delegate"
+)
+
+// UnlockActionHandler performs the follow-up action with the coins just unlocked to receiver, it only
+// runs if the receiver has opted in.
+//
+// Failures are split by whether relaying the proof again can ever succeed:
+//   - the receiver has not opted into the action: the unlock fails and the cross chain tx stays unprocessed,
+//     it succeeds once the receiver opts in and the proof is relayed again
+//   - everything else is terminal, e.g. a malformed action, an action not registered in current chain, a
+//     removed validator or a delegate of a non bond asset: the action is skipped and its writes are dropped,
+//     the coins stay unlocked to receiver and an unlock_action_failed event carries the reason
+//
+// The terminal failures known before the coins leave the source chain are rejected there by Lock, see
+// ValidateOnChain
+type UnlockActionHandler func(ctx sdk.Context, receiver sdk.AccAddress, amt sdk.Coins, params []byte) error
+
+// UnlockAction is carried by TxArgs.Action to name the registered handler and its params
+type UnlockAction struct {
+	Name   string
+	Params []byte
+}
+
+// ParseUnlockAction decodes the serialized UnlockAction carried by TxArgs.Action, the params of the actions
+// shipped with lockproxy module are checked as well, those of other actions are left to the chain running them
+func ParseUnlockAction(actionBs []byte) (*UnlockAction, error) {
+	action := new(UnlockAction)
+	if err := action.Deserialization(polycommon.NewZeroCopySource(actionBs)); err != nil {
+		return nil, err
+	}
+	if action.Name == "" {
+		return nil, fmt.Errorf("UnlockAction with empty Name")
+	}
+	switch action.Name {
+	case UnlockActionSend, UnlockActionDelegate:
+		if err := sdk.VerifyAddressFormat(action.Params); err != nil {
+			return nil, fmt.Errorf("invalid params: %x of unlock action: %s, err: %v", action.Params, action.Name, err)
+		}
+	}
+	return action, nil
+}
+
+// ValidateOnChain checks the action can run in the chain described by info with the asset toAssetHash, the
+// lock proxy of the chain must perform the action and the delegate action only accepts the bond asset
+func (this *UnlockAction) ValidateOnChain(info common.ChainInfo, toAssetHash []byte) error {
+	if !info.HasUnlockAction(this.Name) {
+		return fmt.Errorf("unlock action: %s is not performed by chain: %d", this.Name, info.ChainId)
+	}
+	if this.Name == UnlockActionDelegate && (len(info.BondAssetHash) == 0 || !bytes.Equal(toAssetHash, info.BondAssetHash)) {
+		return fmt.Errorf("asset: %x is not the bond asset of chain: %d", toAssetHash, info.ChainId)
+	}
+	return nil
+}
+
+func (this *UnlockAction) Serialization(sink *polycommon.ZeroCopySink) {
+	sink.WriteString(this.Name)
+	sink.WriteVarBytes(this.Params)
+}
+
+func (this *UnlockAction) Deserialization(source *polycommon.ZeroCopySource) error {
+	name, eof := source.NextString()
+	if eof {
+		return fmt.Errorf("UnlockAction deserialize Name error")
+	}
+	params, eof := source.NextVarBytes()
+	if eof {
+		return fmt.Errorf("UnlockAction deserialize Params error")
+	}
+	this.Name = name
+	this.Params = params
+	return nil
+}
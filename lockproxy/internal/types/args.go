@@ -28,6 +28,7 @@ type TxArgs struct {
 	ToAssetHash []byte
 	ToAddress   []byte
 	Amount      *big.Int
+	Action      []byte // optional serialized UnlockAction performed by ToAddress right after unlock, see UnlockActionHandler
 }
 
 func (this *TxArgs) Serialization(sink *polycommon.ZeroCopySink, intBsLen int) error {
@@ -38,6 +39,10 @@ func (this *TxArgs) Serialization(sink *polycommon.ZeroCopySink, intBsLen int) e
 		return fmt.Errorf("TxArgs Serialization error:%v", err)
 	}
 	sink.WriteBytes(paddedAmountBs)
+	// the action is appended only if present to keep compatible with the lock proxies of other chains
+	if len(this.Action) != 0 {
+		sink.WriteVarBytes(this.Action)
+	}
 	return nil
 }
 
@@ -59,8 +64,17 @@ func (this *TxArgs) Deserialization(source *polycommon.ZeroCopySource, intBsLen
 		return fmt.Errorf("TxArgs Deserialization error:%v", err)
 	}
 
+	var action []byte
+	if source.Len() != 0 {
+		action, eof = source.NextVarBytes()
+		if eof {
+			return fmt.Errorf("TxArgs deserialize Action error")
+		}
+	}
+
 	this.ToAssetHash = txHash
 	this.ToAddress = toAddress
 	this.Amount = amount
+	this.Action = action
 	return nil
 }
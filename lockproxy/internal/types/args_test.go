@@ -47,3 +47,33 @@ func TestTxArgs_Serialization(t *testing.T) {
 	require.Nil(t, err)
 	fmt.Printf("sink.Bytes are %x\n", sink.Bytes())
 }
+
+func TestTxArgs_Action(t *testing.T) {
+	txArgs := types.TxArgs{
+		ToAssetHash: []byte("coin1"),
+		ToAddress:   []byte{1, 2, 3},
+		Amount:      big.NewInt(100),
+	}
+	sink := polycommon.NewZeroCopySink(nil)
+	require.Nil(t, txArgs.Serialization(sink, 32))
+	withoutAction := sink.Bytes()
+
+	action := types.UnlockAction{Name: types.UnlockActionSend, Params: []byte{4, 5, 6}}
+	actionSink := polycommon.NewZeroCopySink(nil)
+	action.Serialization(actionSink)
+	txArgs.Action = actionSink.Bytes()
+	sink = polycommon.NewZeroCopySink(nil)
+	require.Nil(t, txArgs.Serialization(sink, 32))
+
+	res := new(types.TxArgs)
+	require.Nil(t, res.Deserialization(polycommon.NewZeroCopySource(sink.Bytes()), 32))
+	require.Equal(t, txArgs, *res)
+	resAction := new(types.UnlockAction)
+	require.Nil(t, resAction.Deserialization(polycommon.NewZeroCopySource(res.Action)))
+	require.Equal(t, action, *resAction)
+
+	res = new(types.TxArgs)
+	require.Nil(t, res.Deserialization(polycommon.NewZeroCopySource(withoutAction), 32))
+	require.Nil(t, res.Action)
+	require.Error(t, res.Deserialization(polycommon.NewZeroCopySource(append(withoutAction, 0x05)), 32))
+}
@@ -29,6 +29,13 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgCreateCoinAndDelegateToProxy{}, ModuleName+"/MsgCreateCoinAndDelegateToProxy", nil)
 	cdc.RegisterConcrete(MsgBindProxyHash{}, ModuleName+"/MsgBindProxyHash", nil)
 	cdc.RegisterConcrete(MsgBindAssetHash{}, ModuleName+"/MsgBindAssetHash", nil)
+	cdc.RegisterConcrete(MsgUnbindProxyHash{}, ModuleName+"/MsgUnbindProxyHash", nil)
+	cdc.RegisterConcrete(MsgUnbindAssetHash{}, ModuleName+"/MsgUnbindAssetHash", nil)
+	cdc.RegisterConcrete(MsgSettleAssetDecimals{}, ModuleName+"/MsgSettleAssetDecimals", nil)
+	cdc.RegisterConcrete(MsgCancelBindingChange{}, ModuleName+"/MsgCancelBindingChange", nil)
+	cdc.RegisterConcrete(MsgApproveOperatorAction{}, ModuleName+"/MsgApproveOperatorAction", nil)
+	cdc.RegisterConcrete(MsgTransferOwnership{}, ModuleName+"/MsgTransferOwnership", nil)
+	cdc.RegisterConcrete(MsgSetUnlockActionOptIn{}, ModuleName+"/MsgSetUnlockActionOptIn", nil)
 	cdc.RegisterConcrete(MsgLock{}, ModuleName+"/MsgLock", nil)
 }
 
@@ -35,6 +35,13 @@ var (
 	ErrUnLockType                       = sdkerrors.Register(ModuleName, 9, "ErrUnLockType")
 	ErrMsgBindProxyHashType             = sdkerrors.Register(ModuleName, 10, "ErrMsgBindProxyHashType")
 	ErrCreateCoinAndDelegateToProxyType = sdkerrors.Register(ModuleName, 11, "ErrCreateCoinAndDelegateToProxyType")
+	ErrUnlockActionType                 = sdkerrors.Register(ModuleName, 12, "ErrUnlockActionType")
+	ErrInvalidToAddressType             = sdkerrors.Register(ModuleName, 13, "ErrInvalidToAddressType")
+	ErrUnbindProxyHashType              = sdkerrors.Register(ModuleName, 14, "ErrUnbindProxyHashType")
+	ErrUnbindAssetHashType              = sdkerrors.Register(ModuleName, 15, "ErrUnbindAssetHashType")
+	ErrCancelBindingChangeType          = sdkerrors.Register(ModuleName, 16, "ErrCancelBindingChangeType")
+	ErrOperatorGroupType                = sdkerrors.Register(ModuleName, 17, "ErrOperatorGroupType")
+	ErrSettleAssetDecimalsType          = sdkerrors.Register(ModuleName, 18, "ErrSettleAssetDecimalsType")
 )
 
 func ErrInvalidChainId(chainId uint64) error {
@@ -78,3 +85,31 @@ func ErrMsgBindProxyHash(reason string) error {
 func ErrCreateCoinAndDelegateToProxy(reason string) error {
 	return sdkerrors.Wrapf(ErrCreateCoinAndDelegateToProxyType, fmt.Sprintf("Reason: %s", reason))
 }
+
+func ErrUnlockAction(reason string) error {
+	return sdkerrors.Wrapf(ErrUnlockActionType, fmt.Sprintf("Reason: %s", reason))
+}
+
+func ErrInvalidToAddress(reason string) error {
+	return sdkerrors.Wrapf(ErrInvalidToAddressType, fmt.Sprintf("Reason: %s", reason))
+}
+
+func ErrUnbindProxyHash(reason string) error {
+	return sdkerrors.Wrapf(ErrUnbindProxyHashType, fmt.Sprintf("Reason: %s", reason))
+}
+
+func ErrUnbindAssetHash(reason string) error {
+	return sdkerrors.Wrapf(ErrUnbindAssetHashType, fmt.Sprintf("Reason: %s", reason))
+}
+
+func ErrCancelBindingChange(reason string) error {
+	return sdkerrors.Wrapf(ErrCancelBindingChangeType, fmt.Sprintf("Reason: %s", reason))
+}
+
+func ErrOperatorGroup(reason string) error {
+	return sdkerrors.Wrapf(ErrOperatorGroupType, fmt.Sprintf("Reason: %s", reason))
+}
+
+func ErrSettleAssetDecimals(reason string) error {
+	return sdkerrors.Wrapf(ErrSettleAssetDecimalsType, fmt.Sprintf("Reason: %s", reason))
+}
@@ -24,8 +24,19 @@ const (
 	EventTypeCreateAndDelegateCoinToProxy = "create_and_delegate_coin_to_proxy"
 	EventTypeBindProxy                    = "bind_proxy_hash"
 	EventTypeBindAsset                    = "bind_asset_hash"
+	EventTypeUnbindProxy                  = "unbind_proxy_hash"
+	EventTypeUnbindAsset                  = "unbind_asset_hash"
 	EventTypeLock                         = "lock"
 	EventTypeUnlock                       = "unlock"
+	EventTypeUnlockAction                 = "unlock_action"
+	EventTypeUnlockActionFailed           = "unlock_action_failed"
+	EventTypeSetUnlockActionOptIn         = "set_unlock_action_opt_in"
+	EventTypeProposeBindingChange         = "propose_binding_change"
+	EventTypeApplyBindingChange           = "apply_binding_change"
+	EventTypeCancelBindingChange          = "cancel_binding_change"
+	EventTypeSettleAssetDecimals          = "settle_asset_decimals"
+	EventTypeApproveOperatorAction        = "approve_operator_action"
+	EventTypeTransferOwnership            = "transfer_ownership"
 	AttributeKeyCreator                   = "creator"
 	AttributeKeyLockProxy                 = "lock_proxy_hash"
 	AttributeKeyToChainId                 = "to_chain_id"
@@ -36,4 +47,20 @@ const (
 	AttributeKeyFromAddress               = "from_address"
 	AttributeKeyToAddress                 = "to_address"
 	AttributeKeyAmount                    = "amount"
+	AttributeKeyDust                      = "dust"
+	AttributeKeyAction                    = "action"
+	AttributeKeySourceDecimals            = "source_decimals"
+	AttributeKeyToDecimals                = "to_decimals"
+	AttributeKeyOperator                  = "operator"
+	AttributeKeyPendingId                 = "pending_id"
+	AttributeKeyEffectiveHeight           = "effective_height"
+	AttributeKeyBindingType               = "binding_type"
+	AttributeKeyCanceller                 = "canceller"
+	AttributeKeyNewValue                  = "new_value"
+	AttributeKeyOperatorAction            = "operator_action"
+	AttributeKeyApprovals                 = "approvals"
+	AttributeKeyThreshold                 = "threshold"
+	AttributeKeyMembers                   = "members"
+	AttributeKeyReason                    = "reason"
+	AttributeKeyOptIn                     = "opt_in"
 )
@@ -20,7 +20,9 @@ package types // noalias
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
+	"github.com/cosmos/cosmos-sdk/x/staking"
 	supplyexported "github.com/cosmos/cosmos-sdk/x/supply/exported"
+	"github.com/polynetwork/cosmos-poly-module/common"
 )
 
 type AccountKeeper interface {
@@ -41,4 +43,18 @@ type CrossChainManager interface {
 	SetDenomCreator(ctx sdk.Context, denom string, creator sdk.AccAddress)
 	GetDenomCreator(ctx sdk.Context, denom string) sdk.AccAddress
 	ExistDenom(ctx sdk.Context, denom string) (string, bool)
+	GetChainInfo(ctx sdk.Context, chainId uint64) (common.ChainInfo, bool)
+}
+
+// BankKeeper defines the expected bank keeper used by the send unlock action
+type BankKeeper interface {
+	SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
+	BlacklistedAddr(addr sdk.AccAddress) bool
+}
+
+// StakingKeeper defines the expected staking keeper used by the delegate unlock action
+type StakingKeeper interface {
+	BondDenom(ctx sdk.Context) string
+	GetValidator(ctx sdk.Context, addr sdk.ValAddress) (validator staking.Validator, found bool)
+	Delegate(ctx sdk.Context, delAddr sdk.AccAddress, bondAmt sdk.Int, tokenSrc sdk.BondStatus, validator staking.Validator, subtractAccount bool) (newShares sdk.Dec, err error)
 }
@@ -0,0 +1,170 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/polynetwork/cosmos-poly-module/common"
+	"strings"
+)
+
+// Operator actions approved by the operator group of a lock proxy
+const (
+	OperatorActionBindProxy         = "bind_proxy_hash"
+	OperatorActionBindAsset         = "bind_asset_hash"
+	OperatorActionUnbindProxy       = "unbind_proxy_hash"
+	OperatorActionUnbindAsset       = "unbind_asset_hash"
+	OperatorActionSettleDecimals    = "settle_asset_decimals"
+	OperatorActionTransferOwnership = "transfer_ownership"
+	OperatorActionCancel            = "cancel_binding_change"
+)
+
+// OperatorGroup controls a lock proxy, an action takes effect once Threshold of Members approve it.
+// Version is increased on every ownership transfer so that approvals for the former group get invalid
+type OperatorGroup struct {
+	Members   []sdk.AccAddress
+	Threshold uint64
+	Version   uint64
+}
+
+func NewOperatorGroup(members []sdk.AccAddress, threshold uint64) OperatorGroup {
+	return OperatorGroup{Members: members, Threshold: threshold}
+}
+
+// ValidateOperatorGroup ensures members are distinct and 0 < threshold <= len(members)
+func ValidateOperatorGroup(members []sdk.AccAddress, threshold uint64) error {
+	if len(members) == 0 {
+		return fmt.Errorf("empty operator group members")
+	}
+	if threshold == 0 || threshold > uint64(len(members)) {
+		return fmt.Errorf("threshold: %d should be in range [1, %d]", threshold, len(members))
+	}
+	seen := make(map[string]bool)
+	for _, member := range members {
+		if err := sdk.VerifyAddressFormat(member); err != nil {
+			return fmt.Errorf("invalid member: %s, err: %v", member.String(), err)
+		}
+		if seen[member.String()] {
+			return fmt.Errorf("duplicated member: %s", member.String())
+		}
+		seen[member.String()] = true
+	}
+	return nil
+}
+
+func (g OperatorGroup) IsMember(addr sdk.AccAddress) bool {
+	for _, member := range g.Members {
+		if member.Equals(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+func (g OperatorGroup) String() string {
+	members := make([]string, len(g.Members))
+	for i, member := range g.Members {
+		members[i] = member.String()
+	}
+	return fmt.Sprintf(`OperatorGroup:
+  Members:    %s
+  Threshold:  %d
+  Version:    %d
+`, strings.Join(members, ", "), g.Threshold, g.Version)
+}
+
+// OperatorAction is a binding change, an ownership transfer or the cancellation of a pending one of a lock proxy, the fields not used by Type are left empty
+type OperatorAction struct {
+	Type           string
+	Denom          string // source asset denom of asset binding
+	ChainId        uint64
+	Value          []byte // new proxy hash or asset hash of binding
+	SourceDecimals uint8
+	ToDecimals     uint8
+	Members        []sdk.AccAddress // new members of ownership transfer
+	Threshold      uint64           // new threshold of ownership transfer
+	PendingId      uint64           // id of the pending binding change to cancel
+}
+
+func (a OperatorAction) ValidateBasic() error {
+	switch a.Type {
+	case OperatorActionBindProxy, OperatorActionBindAsset:
+		if len(a.Value) == 0 {
+			return fmt.Errorf("empty hash to bind")
+		}
+	case OperatorActionUnbindProxy, OperatorActionUnbindAsset, OperatorActionSettleDecimals:
+	case OperatorActionTransferOwnership:
+		return ValidateOperatorGroup(a.Members, a.Threshold)
+	case OperatorActionCancel:
+		return nil
+	default:
+		return fmt.Errorf("unknown operator action type: %s", a.Type)
+	}
+	if a.ChainId == 0 {
+		return fmt.Errorf("invalid chainId: %d", a.ChainId)
+	}
+	if a.Type == OperatorActionBindAsset || a.Type == OperatorActionUnbindAsset || a.Type == OperatorActionSettleDecimals {
+		if err := sdk.ValidateDenom(a.Denom); err != nil {
+			return fmt.Errorf("invalid denom: %s, err: %v", a.Denom, err)
+		}
+	}
+	if a.Type == OperatorActionBindAsset {
+		return common.ValidateDecimals(a.SourceDecimals, a.ToDecimals)
+	}
+	return nil
+}
+
+func (a OperatorAction) String() string {
+	members := make([]string, len(a.Members))
+	for i, member := range a.Members {
+		members[i] = member.String()
+	}
+	return fmt.Sprintf(`OperatorAction:
+  Type:           %s
+  Denom:          %s
+  ChainId:        %d
+  Value:          %s
+  SourceDecimals: %d
+  ToDecimals:     %d
+  Members:        %s
+  Threshold:      %d
+  PendingId:      %d
+`, a.Type, a.Denom, a.ChainId, hex.EncodeToString(a.Value), a.SourceDecimals, a.ToDecimals, strings.Join(members, ", "), a.Threshold, a.PendingId)
+}
+
+// OperatorApproval collects the approvals of an action by the members of the operator group with Version
+type OperatorApproval struct {
+	LockProxy []byte
+	Version   uint64
+	Action    OperatorAction
+	Approvers []sdk.AccAddress
+}
+
+func (a OperatorApproval) String() string {
+	approvers := make([]string, len(a.Approvers))
+	for i, approver := range a.Approvers {
+		approvers[i] = approver.String()
+	}
+	return fmt.Sprintf(`OperatorApproval:
+  LockProxy:  %s
+  Version:    %d
+  Approvers:  %s
+  %s`, hex.EncodeToString(a.LockProxy), a.Version, strings.Join(approvers, ", "), a.Action.String())
+}
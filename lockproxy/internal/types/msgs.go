@@ -21,6 +21,7 @@ import (
 	"fmt"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/polynetwork/cosmos-poly-module/common"
 
 	"encoding/hex"
 )
@@ -31,6 +32,13 @@ const (
 	TypeMsgCreateCoinAndDelegateToProxy = "create_delegate_to_proxy"
 	TypeMsgBindProxyHash                = "bind_proxy_hash"
 	TypeMsgBindAssetHash                = "bind_asset_hash"
+	TypeMsgUnbindProxyHash              = "unbind_proxy_hash"
+	TypeMsgUnbindAssetHash              = "unbind_asset_hash"
+	TypeMsgSettleAssetDecimals          = "settle_asset_decimals"
+	TypeMsgCancelBindingChange          = "cancel_binding_change"
+	TypeMsgApproveOperatorAction        = "approve_operator_action"
+	TypeMsgTransferOwnership            = "transfer_ownership"
+	TypeMsgSetUnlockActionOptIn         = "set_unlock_action_opt_in"
 	TypeMsgLock                         = "lock"
 )
 
@@ -165,10 +173,12 @@ type MsgBindAssetHash struct {
 	SourceAssetDenom string
 	ToChainId        uint64
 	ToAssetHash      []byte
+	SourceDecimals   uint8 // decimals of SourceAssetDenom in current chain
+	ToDecimals       uint8 // decimals of ToAssetHash in toChainId, amounts are not scaled if equal to SourceDecimals
 }
 
-func NewMsgBindAssetHash(operator sdk.AccAddress, sourceAssetDenom string, toChainId uint64, toAssetHash []byte) MsgBindAssetHash {
-	return MsgBindAssetHash{operator, sourceAssetDenom, toChainId, toAssetHash}
+func NewMsgBindAssetHash(operator sdk.AccAddress, sourceAssetDenom string, toChainId uint64, toAssetHash []byte, sourceDecimals, toDecimals uint8) MsgBindAssetHash {
+	return MsgBindAssetHash{operator, sourceAssetDenom, toChainId, toAssetHash, sourceDecimals, toDecimals}
 }
 
 //nolint
@@ -194,6 +204,9 @@ func (msg MsgBindAssetHash) ValidateBasic() error {
 		// handler is implemented.
 		return ErrMsgBindAssetHash("Empty MsgBindAssetHash.ToAssetHash")
 	}
+	if err := common.ValidateDecimals(msg.SourceDecimals, msg.ToDecimals); err != nil {
+		return ErrMsgBindAssetHash(fmt.Sprintf("MsgBindAssetHash decimals are invalid, err: %v", err))
+	}
 	return nil
 }
 
@@ -203,7 +216,9 @@ func (msg MsgBindAssetHash) String() string {
   SourceAssetDenom: %s
   ToChainId:  		%d
   ToAssetHash:      %s
-`, msg.Operator.String(), msg.SourceAssetDenom, msg.ToChainId, hex.EncodeToString(msg.ToAssetHash))
+  SourceDecimals:   %d
+  ToDecimals:       %d
+`, msg.Operator.String(), msg.SourceAssetDenom, msg.ToChainId, hex.EncodeToString(msg.ToAssetHash), msg.SourceDecimals, msg.ToDecimals)
 }
 
 // Implements Msg.
@@ -217,6 +232,330 @@ func (msg MsgBindAssetHash) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Operator}
 }
 
+type MsgUnbindProxyHash struct {
+	Operator  sdk.AccAddress
+	ToChainId uint64
+}
+
+func NewMsgUnbindProxyHash(operator sdk.AccAddress, toChainId uint64) MsgUnbindProxyHash {
+	return MsgUnbindProxyHash{operator, toChainId}
+}
+
+//nolint
+func (msg MsgUnbindProxyHash) Route() string { return RouterKey }
+func (msg MsgUnbindProxyHash) Type() string  { return TypeMsgUnbindProxyHash }
+
+// Implements Msg.
+func (msg MsgUnbindProxyHash) ValidateBasic() error {
+	if msg.Operator.Empty() {
+		return sdkerrors.ErrInvalidAddress
+	}
+	if msg.ToChainId == 0 {
+		return ErrInvalidChainId(msg.ToChainId)
+	}
+	return nil
+}
+
+func (msg MsgUnbindProxyHash) String() string {
+	return fmt.Sprintf(`MsgUnbindProxyHash:
+  Operator:       		%s(%x)
+  ToChainId:			%d
+`, msg.Operator.String(), msg.Operator.Bytes(), msg.ToChainId)
+}
+
+// Implements Msg.
+func (msg MsgUnbindProxyHash) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// Implements Msg.
+func (msg MsgUnbindProxyHash) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Operator}
+}
+
+type MsgUnbindAssetHash struct {
+	Operator         sdk.AccAddress
+	SourceAssetDenom string
+	ToChainId        uint64
+}
+
+func NewMsgUnbindAssetHash(operator sdk.AccAddress, sourceAssetDenom string, toChainId uint64) MsgUnbindAssetHash {
+	return MsgUnbindAssetHash{operator, sourceAssetDenom, toChainId}
+}
+
+//nolint
+func (msg MsgUnbindAssetHash) Route() string { return RouterKey }
+func (msg MsgUnbindAssetHash) Type() string  { return TypeMsgUnbindAssetHash }
+
+// Implements Msg.
+func (msg MsgUnbindAssetHash) ValidateBasic() error {
+	if msg.Operator.Empty() {
+		return sdkerrors.ErrInvalidAddress
+	}
+	if err := sdk.ValidateDenom(msg.SourceAssetDenom); err != nil {
+		return ErrMsgBindAssetHash(fmt.Sprintf("MsgUnbindAssetHash.SourceAssetDenom: %s is invalid, err: %v", msg.SourceAssetDenom, err))
+	}
+	if msg.ToChainId == 0 {
+		return ErrInvalidChainId(msg.ToChainId)
+	}
+	return nil
+}
+
+func (msg MsgUnbindAssetHash) String() string {
+	return fmt.Sprintf(`MsgUnbindAssetHash:
+  Operator:         %s
+  SourceAssetDenom: %s
+  ToChainId:        %d
+`, msg.Operator.String(), msg.SourceAssetDenom, msg.ToChainId)
+}
+
+// Implements Msg.
+func (msg MsgUnbindAssetHash) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// Implements Msg.
+func (msg MsgUnbindAssetHash) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Operator}
+}
+
+// MsgSettleAssetDecimals lets Unlock scale with the decimals of the asset binding after they changed, see
+// Keeper.SettleAssetDecimals
+type MsgSettleAssetDecimals struct {
+	Operator         sdk.AccAddress
+	SourceAssetDenom string
+	ChainId          uint64
+}
+
+func NewMsgSettleAssetDecimals(operator sdk.AccAddress, sourceAssetDenom string, chainId uint64) MsgSettleAssetDecimals {
+	return MsgSettleAssetDecimals{operator, sourceAssetDenom, chainId}
+}
+
+//nolint
+func (msg MsgSettleAssetDecimals) Route() string { return RouterKey }
+func (msg MsgSettleAssetDecimals) Type() string  { return TypeMsgSettleAssetDecimals }
+
+// Implements Msg.
+func (msg MsgSettleAssetDecimals) ValidateBasic() error {
+	if msg.Operator.Empty() {
+		return sdkerrors.ErrInvalidAddress
+	}
+	if err := sdk.ValidateDenom(msg.SourceAssetDenom); err != nil {
+		return ErrMsgBindAssetHash(fmt.Sprintf("MsgSettleAssetDecimals.SourceAssetDenom: %s is invalid, err: %v", msg.SourceAssetDenom, err))
+	}
+	if msg.ChainId == 0 {
+		return ErrInvalidChainId(msg.ChainId)
+	}
+	return nil
+}
+
+func (msg MsgSettleAssetDecimals) String() string {
+	return fmt.Sprintf(`MsgSettleAssetDecimals:
+  Operator:         %s
+  SourceAssetDenom: %s
+  ChainId:          %d
+`, msg.Operator.String(), msg.SourceAssetDenom, msg.ChainId)
+}
+
+// Implements Msg.
+func (msg MsgSettleAssetDecimals) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// Implements Msg.
+func (msg MsgSettleAssetDecimals) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Operator}
+}
+
+type MsgCancelBindingChange struct {
+	Signer sdk.AccAddress
+	Id     uint64
+}
+
+func NewMsgCancelBindingChange(signer sdk.AccAddress, id uint64) MsgCancelBindingChange {
+	return MsgCancelBindingChange{signer, id}
+}
+
+//nolint
+func (msg MsgCancelBindingChange) Route() string { return RouterKey }
+func (msg MsgCancelBindingChange) Type() string  { return TypeMsgCancelBindingChange }
+
+// Implements Msg.
+func (msg MsgCancelBindingChange) ValidateBasic() error {
+	if msg.Signer.Empty() {
+		return sdkerrors.ErrInvalidAddress
+	}
+	return nil
+}
+
+func (msg MsgCancelBindingChange) String() string {
+	return fmt.Sprintf(`MsgCancelBindingChange:
+  Signer: %s
+  Id:     %d
+`, msg.Signer.String(), msg.Id)
+}
+
+// Implements Msg.
+func (msg MsgCancelBindingChange) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// Implements Msg.
+func (msg MsgCancelBindingChange) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Signer}
+}
+
+// MsgApproveOperatorAction approves a binding change of the lock proxy controlled by an operator group
+type MsgApproveOperatorAction struct {
+	Member        sdk.AccAddress
+	LockProxyHash []byte
+	Action        OperatorAction
+}
+
+func NewMsgApproveOperatorAction(member sdk.AccAddress, lockProxyHash []byte, action OperatorAction) MsgApproveOperatorAction {
+	return MsgApproveOperatorAction{member, lockProxyHash, action}
+}
+
+//nolint
+func (msg MsgApproveOperatorAction) Route() string { return RouterKey }
+func (msg MsgApproveOperatorAction) Type() string  { return TypeMsgApproveOperatorAction }
+
+// Implements Msg.
+func (msg MsgApproveOperatorAction) ValidateBasic() error {
+	if msg.Member.Empty() {
+		return sdkerrors.ErrInvalidAddress
+	}
+	if len(msg.LockProxyHash) == 0 {
+		return ErrOperatorGroup("empty MsgApproveOperatorAction.LockProxyHash")
+	}
+	if err := msg.Action.ValidateBasic(); err != nil {
+		return ErrOperatorGroup(fmt.Sprintf("MsgApproveOperatorAction.Action is invalid, err: %v", err))
+	}
+	return nil
+}
+
+func (msg MsgApproveOperatorAction) String() string {
+	return fmt.Sprintf(`MsgApproveOperatorAction:
+  Member:         %s
+  LockProxyHash:  %x
+  %s`, msg.Member.String(), msg.LockProxyHash, msg.Action.String())
+}
+
+// Implements Msg.
+func (msg MsgApproveOperatorAction) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// Implements Msg.
+func (msg MsgApproveOperatorAction) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Member}
+}
+
+// MsgTransferOwnership approves to transfer the lock proxy to the operator group of Members with Threshold
+type MsgTransferOwnership struct {
+	Member        sdk.AccAddress
+	LockProxyHash []byte
+	Members       []sdk.AccAddress
+	Threshold     uint64
+}
+
+func NewMsgTransferOwnership(member sdk.AccAddress, lockProxyHash []byte, members []sdk.AccAddress, threshold uint64) MsgTransferOwnership {
+	return MsgTransferOwnership{member, lockProxyHash, members, threshold}
+}
+
+//nolint
+func (msg MsgTransferOwnership) Route() string { return RouterKey }
+func (msg MsgTransferOwnership) Type() string  { return TypeMsgTransferOwnership }
+
+// Implements Msg.
+func (msg MsgTransferOwnership) ValidateBasic() error {
+	if msg.Member.Empty() {
+		return sdkerrors.ErrInvalidAddress
+	}
+	if len(msg.LockProxyHash) == 0 {
+		return ErrOperatorGroup("empty MsgTransferOwnership.LockProxyHash")
+	}
+	if err := ValidateOperatorGroup(msg.Members, msg.Threshold); err != nil {
+		return ErrOperatorGroup(fmt.Sprintf("MsgTransferOwnership new operator group is invalid, err: %v", err))
+	}
+	return nil
+}
+
+// Action returns the operator action approved by the msg
+func (msg MsgTransferOwnership) Action() OperatorAction {
+	return OperatorAction{Type: OperatorActionTransferOwnership, Members: msg.Members, Threshold: msg.Threshold}
+}
+
+func (msg MsgTransferOwnership) String() string {
+	return fmt.Sprintf(`MsgTransferOwnership:
+  Member:         %s
+  LockProxyHash:  %x
+  Members:        %v
+  Threshold:      %d
+`, msg.Member.String(), msg.LockProxyHash, msg.Members, msg.Threshold)
+}
+
+// Implements Msg.
+func (msg MsgTransferOwnership) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// Implements Msg.
+func (msg MsgTransferOwnership) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Member}
+}
+
+// MsgSetUnlockActionOptIn opts the receiver in or out of the unlock action carried by TxArgs.Action
+type MsgSetUnlockActionOptIn struct {
+	Receiver sdk.AccAddress
+	Action   string
+	OptIn    bool
+}
+
+func NewMsgSetUnlockActionOptIn(receiver sdk.AccAddress, action string, optIn bool) MsgSetUnlockActionOptIn {
+	return MsgSetUnlockActionOptIn{receiver, action, optIn}
+}
+
+//nolint
+func (msg MsgSetUnlockActionOptIn) Route() string { return RouterKey }
+func (msg MsgSetUnlockActionOptIn) Type() string  { return TypeMsgSetUnlockActionOptIn }
+
+// Implements Msg.
+func (msg MsgSetUnlockActionOptIn) ValidateBasic() error {
+	if msg.Receiver.Empty() {
+		return sdkerrors.ErrInvalidAddress
+	}
+	if msg.Action == "" {
+		return ErrUnlockAction("empty MsgSetUnlockActionOptIn.Action")
+	}
+	return nil
+}
+
+func (msg MsgSetUnlockActionOptIn) String() string {
+	return fmt.Sprintf(`MsgSetUnlockActionOptIn:
+  Receiver: %s
+  Action:   %s
+  OptIn:    %t
+`, msg.Receiver.String(), msg.Action, msg.OptIn)
+}
+
+// Implements Msg.
+func (msg MsgSetUnlockActionOptIn) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// Implements Msg.
+func (msg MsgSetUnlockActionOptIn) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Receiver}
+}
+
 type MsgLock struct {
 	LockProxyHash    []byte
 	FromAddress      sdk.AccAddress
@@ -224,10 +563,11 @@ type MsgLock struct {
 	ToChainId        uint64
 	ToAddressBs      []byte
 	Value            sdk.Int
+	Action           []byte // optional serialized UnlockAction performed by the receiver in the target chain
 }
 
-func NewMsgLock(lockProxyHash []byte, fromAddress sdk.AccAddress, sourceAssetDenom string, toChainId uint64, toAddress []byte, value sdk.Int) MsgLock {
-	return MsgLock{lockProxyHash, fromAddress, sourceAssetDenom, toChainId, toAddress, value}
+func NewMsgLock(lockProxyHash []byte, fromAddress sdk.AccAddress, sourceAssetDenom string, toChainId uint64, toAddress []byte, value sdk.Int, action []byte) MsgLock {
+	return MsgLock{lockProxyHash, fromAddress, sourceAssetDenom, toChainId, toAddress, value, action}
 }
 
 //nolint
@@ -257,6 +597,11 @@ func (msg MsgLock) ValidateBasic() error {
 	if msg.Value.IsNegative() {
 		return ErrMsgLock(fmt.Sprintf("MsgLock.Value: %s should not be negative", msg.Value.String()))
 	}
+	if len(msg.Action) != 0 {
+		if _, err := ParseUnlockAction(msg.Action); err != nil {
+			return ErrMsgLock(fmt.Sprintf("MsgLock.Action: %x is invalid, err: %v", msg.Action, err))
+		}
+	}
 	return nil
 }
 
@@ -268,7 +613,8 @@ func (msg MsgLock) String() string {
   ToChainId:            %d
   ToAddress:            %x
   Value:                %s
-`, msg.LockProxyHash, msg.FromAddress.String(), msg.SourceAssetDenom, msg.ToChainId, msg.ToAddressBs, msg.Value.String())
+  Action:               %x
+`, msg.LockProxyHash, msg.FromAddress.String(), msg.SourceAssetDenom, msg.ToChainId, msg.ToAddressBs, msg.Value.String(), msg.Action)
 }
 
 // Implements Msg.
@@ -0,0 +1,97 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package types
+
+import (
+	"fmt"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+// Parameter store keys
+var (
+	KeyBindingChangeDelay = []byte("BindingChangeDelay")
+	KeyGuardian           = []byte("Guardian")
+)
+
+type Params struct {
+	BindingChangeDelay uint64         `json:"binding_change_delay" yaml:"binding_change_delay"` // number of blocks a proxy or asset binding change or an ownership transfer waits before taking effect, zero applies it immediately
+	Guardian           sdk.AccAddress `json:"guardian" yaml:"guardian"`                         // account able to cancel any pending binding change besides its operator
+}
+
+// ParamTable for lockproxy module.
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// default lockproxy module parameters
+func DefaultParams() Params {
+	return Params{
+		BindingChangeDelay: 0,
+		Guardian:           nil,
+	}
+}
+
+// validate params
+func (p Params) Validate() error {
+	if err := validateBindingChangeDelay(p.BindingChangeDelay); err != nil {
+		return err
+	}
+	if err := validateGuardian(p.Guardian); err != nil {
+		return err
+	}
+	return nil
+}
+
+func validateBindingChangeDelay(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateGuardian(i interface{}) error {
+	v, ok := i.(sdk.AccAddress)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if !v.Empty() {
+		if err := sdk.VerifyAddressFormat(v); err != nil {
+			return fmt.Errorf("invalid guardian: %s", err.Error())
+		}
+	}
+	return nil
+}
+
+func (p Params) String() string {
+	return fmt.Sprintf(`LockProxy Params:
+  Binding Change Delay: %d
+  Guardian:             %s
+`,
+		p.BindingChangeDelay, p.Guardian,
+	)
+}
+
+// Implements params.ParamSet
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		params.NewParamSetPair(KeyBindingChangeDelay, &p.BindingChangeDelay, validateBindingChangeDelay),
+		params.NewParamSetPair(KeyGuardian, &p.Guardian, validateGuardian),
+	}
+}
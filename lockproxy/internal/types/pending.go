@@ -0,0 +1,66 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BindingTypeOwnership is the pending change transferring the lock proxy to the operator group of Members
+const BindingTypeOwnership = "ownership"
+
+// PendingBindingChange is a proxy or asset binding change or an ownership transfer waiting for its EffectiveHeight,
+// an empty NewValue removes the binding
+type PendingBindingChange struct {
+	Id              uint64
+	BindingType     string
+	LockProxy       []byte
+	Denom           string // source asset denom, empty for proxy binding
+	ChainId         uint64
+	NewValue        []byte
+	SourceDecimals  uint8            // only used by asset binding
+	ToDecimals      uint8            // only used by asset binding
+	Members         []sdk.AccAddress // only used by ownership transfer
+	Threshold       uint64           // only used by ownership transfer
+	Operator        sdk.AccAddress
+	Approvers       []sdk.AccAddress // members of the operator group who approved the change, empty if Operator made it alone
+	ProposeHeight   int64
+	EffectiveHeight int64
+}
+
+func (c PendingBindingChange) String() string {
+	return fmt.Sprintf(`PendingBindingChange:
+  Id:               %d
+  BindingType:      %s
+  LockProxy:        %s
+  Denom:            %s
+  ChainId:          %d
+  NewValue:         %s
+  SourceDecimals:   %d
+  ToDecimals:       %d
+  Members:          %v
+  Threshold:        %d
+  Operator:         %s
+  Approvers:        %v
+  ProposeHeight:    %d
+  EffectiveHeight:  %d
+`, c.Id, c.BindingType, hex.EncodeToString(c.LockProxy), c.Denom, c.ChainId, hex.EncodeToString(c.NewValue),
+		c.SourceDecimals, c.ToDecimals, c.Members, c.Threshold, c.Operator.String(), c.Approvers, c.ProposeHeight, c.EffectiveHeight)
+}
@@ -0,0 +1,142 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package types
+
+import (
+	"github.com/polynetwork/cosmos-poly-module/common"
+	lockproxypb "github.com/polynetwork/cosmos-poly-module/lockproxy/pb"
+)
+
+func ParamsToProto(p Params) *lockproxypb.Params {
+	return &lockproxypb.Params{BindingChangeDelay: p.BindingChangeDelay, Guardian: p.Guardian.String()}
+}
+
+func PendingBindingChangeToProto(c PendingBindingChange) *lockproxypb.PendingBindingChange {
+	return &lockproxypb.PendingBindingChange{
+		Id:              c.Id,
+		BindingType:     c.BindingType,
+		LockProxy:       c.LockProxy,
+		Denom:           c.Denom,
+		ChainId:         c.ChainId,
+		NewValue:        c.NewValue,
+		SourceDecimals:  uint32(c.SourceDecimals),
+		ToDecimals:      uint32(c.ToDecimals),
+		Operator:        c.Operator.String(),
+		ProposeHeight:   c.ProposeHeight,
+		EffectiveHeight: c.EffectiveHeight,
+		Approvers:       common.AccAddressesToProto(c.Approvers),
+		Members:         common.AccAddressesToProto(c.Members),
+		Threshold:       c.Threshold,
+	}
+}
+
+func PendingBindingChangeFromProto(pb *lockproxypb.PendingBindingChange) PendingBindingChange {
+	return PendingBindingChange{
+		Id:              pb.Id,
+		BindingType:     pb.BindingType,
+		LockProxy:       pb.LockProxy,
+		Denom:           pb.Denom,
+		ChainId:         pb.ChainId,
+		NewValue:        pb.NewValue,
+		SourceDecimals:  uint8(pb.SourceDecimals),
+		ToDecimals:      uint8(pb.ToDecimals),
+		Members:         common.AccAddressesFromProto(pb.Members),
+		Threshold:       pb.Threshold,
+		Operator:        common.AccAddressFromProto(pb.Operator),
+		Approvers:       common.AccAddressesFromProto(pb.Approvers),
+		ProposeHeight:   pb.ProposeHeight,
+		EffectiveHeight: pb.EffectiveHeight,
+	}
+}
+
+func PendingBindingChangesToProto(changes []PendingBindingChange) []*lockproxypb.PendingBindingChange {
+	pbs := make([]*lockproxypb.PendingBindingChange, len(changes))
+	for i, c := range changes {
+		pbs[i] = PendingBindingChangeToProto(c)
+	}
+	return pbs
+}
+
+func OperatorGroupToProto(g OperatorGroup) *lockproxypb.OperatorGroup {
+	return &lockproxypb.OperatorGroup{Members: common.AccAddressesToProto(g.Members), Threshold: g.Threshold, Version: g.Version}
+}
+
+func OperatorGroupFromProto(pb *lockproxypb.OperatorGroup) OperatorGroup {
+	return OperatorGroup{Members: common.AccAddressesFromProto(pb.Members), Threshold: pb.Threshold, Version: pb.Version}
+}
+
+func OperatorActionToProto(a OperatorAction) *lockproxypb.OperatorAction {
+	return &lockproxypb.OperatorAction{
+		Type:           a.Type,
+		Denom:          a.Denom,
+		ChainId:        a.ChainId,
+		Value:          a.Value,
+		SourceDecimals: uint32(a.SourceDecimals),
+		ToDecimals:     uint32(a.ToDecimals),
+		Members:        common.AccAddressesToProto(a.Members),
+		Threshold:      a.Threshold,
+		PendingId:      a.PendingId,
+	}
+}
+
+func OperatorActionFromProto(pb *lockproxypb.OperatorAction) OperatorAction {
+	return OperatorAction{
+		Type:           pb.Type,
+		Denom:          pb.Denom,
+		ChainId:        pb.ChainId,
+		Value:          pb.Value,
+		SourceDecimals: uint8(pb.SourceDecimals),
+		ToDecimals:     uint8(pb.ToDecimals),
+		Members:        common.AccAddressesFromProto(pb.Members),
+		Threshold:      pb.Threshold,
+		PendingId:      pb.PendingId,
+	}
+}
+
+func OperatorApprovalToProto(a OperatorApproval) *lockproxypb.OperatorApproval {
+	return &lockproxypb.OperatorApproval{
+		LockProxy: a.LockProxy,
+		Version:   a.Version,
+		Action:    OperatorActionToProto(a.Action),
+		Approvers: common.AccAddressesToProto(a.Approvers),
+	}
+}
+
+func OperatorApprovalFromProto(pb *lockproxypb.OperatorApproval) OperatorApproval {
+	approval := OperatorApproval{LockProxy: pb.LockProxy, Version: pb.Version, Approvers: common.AccAddressesFromProto(pb.Approvers)}
+	if pb.Action != nil {
+		approval.Action = OperatorActionFromProto(pb.Action)
+	}
+	return approval
+}
+
+func OperatorApprovalsToProto(approvals []OperatorApproval) []*lockproxypb.OperatorApproval {
+	pbs := make([]*lockproxypb.OperatorApproval, len(approvals))
+	for i, a := range approvals {
+		pbs[i] = OperatorApprovalToProto(a)
+	}
+	return pbs
+}
+
+func LockProxiesToProto(proxies []LockProxy) []*lockproxypb.LockProxy {
+	pbs := make([]*lockproxypb.LockProxy, len(proxies))
+	for i, p := range proxies {
+		pbs[i] = &lockproxypb.LockProxy{Hash: p.Hash, Creator: p.Creator.String()}
+	}
+	return pbs
+}
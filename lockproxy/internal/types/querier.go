@@ -18,13 +18,23 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/polynetwork/cosmos-poly-module/common"
 )
 
 const (
-	QueryProxyByOperator = "query_proxy_by_operator"
-	QueryProxyHash       = "proxy_hash"
-	QueryAssetHash       = "asset_hash"
+	QueryProxyByOperator       = "query_proxy_by_operator"
+	QueryProxyHash             = "proxy_hash"
+	QueryAssetHash             = "asset_hash"
+	QueryBindingHistory        = "binding_history"
+	QueryPendingBindingChanges = "pending_binding_changes"
+	QueryOperatorGroup         = "operator_group"
+	QueryOperatorApprovals     = "operator_approvals"
+	QueryLockProxies           = "lock_proxies"
+	QueryProxyBindings         = "proxy_bindings"
+	QueryAssetBindings         = "asset_bindings"
 )
 
 // QueryBalanceParams defines the params for querying an account balance.
@@ -57,3 +67,84 @@ type QueryAssetHashParam struct {
 func NewQueryAssetHashParam(lockProxyHash []byte, sourceAssetDenom string, chainId uint64) QueryAssetHashParam {
 	return QueryAssetHashParam{LockProxyHash: lockProxyHash, SourceAssetDenom: sourceAssetDenom, ChainId: chainId}
 }
+
+// BindingChange is one record of the binding history returned by QueryBindingHistory
+type BindingChange = common.BindingChange
+
+// QueryBindingHistoryParam filters the binding changes, empty LockProxyHash, empty SourceAssetDenom
+// and zero ChainId match any value
+type QueryBindingHistoryParam struct {
+	LockProxyHash    []byte
+	SourceAssetDenom string
+	ChainId          uint64
+	Page             int
+	Limit            int
+}
+
+func NewQueryBindingHistoryParam(lockProxyHash []byte, sourceAssetDenom string, chainId uint64, page, limit int) QueryBindingHistoryParam {
+	return QueryBindingHistoryParam{LockProxyHash: lockProxyHash, SourceAssetDenom: sourceAssetDenom, ChainId: chainId, Page: page, Limit: limit}
+}
+
+// QueryPendingBindingChangesParam filters the pending binding changes, empty LockProxyHash matches any lock proxy
+type QueryPendingBindingChangesParam struct {
+	LockProxyHash []byte
+}
+
+func NewQueryPendingBindingChangesParam(lockProxyHash []byte) QueryPendingBindingChangesParam {
+	return QueryPendingBindingChangesParam{LockProxyHash: lockProxyHash}
+}
+
+// QueryOperatorGroupParam is used by both QueryOperatorGroup and QueryOperatorApprovals
+type QueryOperatorGroupParam struct {
+	LockProxyHash []byte
+}
+
+func NewQueryOperatorGroupParam(lockProxyHash []byte) QueryOperatorGroupParam {
+	return QueryOperatorGroupParam{LockProxyHash: lockProxyHash}
+}
+
+type QueryLockProxiesParam struct {
+	Page  int
+	Limit int
+}
+
+func NewQueryLockProxiesParam(page, limit int) QueryLockProxiesParam {
+	return QueryLockProxiesParam{Page: page, Limit: limit}
+}
+
+// LockProxy is listed by QueryLockProxies, the hash of a lock proxy is the address of its creator
+type LockProxy struct {
+	Hash    string
+	Creator sdk.AccAddress
+}
+
+func (p LockProxy) String() string {
+	return fmt.Sprintf(`LockProxy:
+  Hash:         %s
+  Creator:      %s
+`, p.Hash, p.Creator.String())
+}
+
+// Binding is one proxy hash or asset hash binding listed by QueryProxyBindings and QueryAssetBindings
+type Binding = common.Binding
+
+type QueryProxyBindingsParam struct {
+	LockProxyHash []byte
+	Page          int
+	Limit         int
+}
+
+func NewQueryProxyBindingsParam(lockProxyHash []byte, page, limit int) QueryProxyBindingsParam {
+	return QueryProxyBindingsParam{LockProxyHash: lockProxyHash, Page: page, Limit: limit}
+}
+
+type QueryAssetBindingsParam struct {
+	LockProxyHash    []byte
+	SourceAssetDenom string
+	Page             int
+	Limit            int
+}
+
+func NewQueryAssetBindingsParam(lockProxyHash []byte, sourceAssetDenom string, page, limit int) QueryAssetBindingsParam {
+	return QueryAssetBindingsParam{LockProxyHash: lockProxyHash, SourceAssetDenom: sourceAssetDenom, Page: page, Limit: limit}
+}
@@ -0,0 +1,7217 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: polynetwork/lockproxy/v1/query.proto
+
+package lockproxypb
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	pb "github.com/polynetwork/cosmos-poly-module/common/pb"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type Params struct {
+	BindingChangeDelay uint64 `protobuf:"varint,1,opt,name=binding_change_delay,json=bindingChangeDelay,proto3" json:"binding_change_delay,omitempty"`
+	Guardian           string `protobuf:"bytes,2,opt,name=guardian,proto3" json:"guardian,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16293311b0c0feb2, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetBindingChangeDelay() uint64 {
+	if m != nil {
+		return m.BindingChangeDelay
+	}
+	return 0
+}
+
+func (m *Params) GetGuardian() string {
+	if m != nil {
+		return m.Guardian
+	}
+	return ""
+}
+
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16293311b0c0feb2, []int{1}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+type QueryParamsResponse struct {
+	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16293311b0c0feb2, []int{2}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() *Params {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
+type QueryProxyByOperatorRequest struct {
+	Operator string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+}
+
+func (m *QueryProxyByOperatorRequest) Reset()         { *m = QueryProxyByOperatorRequest{} }
+func (m *QueryProxyByOperatorRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProxyByOperatorRequest) ProtoMessage()    {}
+func (*QueryProxyByOperatorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16293311b0c0feb2, []int{3}
+}
+func (m *QueryProxyByOperatorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProxyByOperatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProxyByOperatorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProxyByOperatorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProxyByOperatorRequest.Merge(m, src)
+}
+func (m *QueryProxyByOperatorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProxyByOperatorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProxyByOperatorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProxyByOperatorRequest proto.InternalMessageInfo
+
+func (m *QueryProxyByOperatorRequest) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+type QueryProxyByOperatorResponse struct {
+	LockProxyHash []byte `protobuf:"bytes,1,opt,name=lock_proxy_hash,json=lockProxyHash,proto3" json:"lock_proxy_hash,omitempty"`
+}
+
+func (m *QueryProxyByOperatorResponse) Reset()         { *m = QueryProxyByOperatorResponse{} }
+func (m *QueryProxyByOperatorResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProxyByOperatorResponse) ProtoMessage()    {}
+func (*QueryProxyByOperatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16293311b0c0feb2, []int{4}
+}
+func (m *QueryProxyByOperatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProxyByOperatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProxyByOperatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProxyByOperatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProxyByOperatorResponse.Merge(m, src)
+}
+func (m *QueryProxyByOperatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProxyByOperatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProxyByOperatorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProxyByOperatorResponse proto.InternalMessageInfo
+
+func (m *QueryProxyByOperatorResponse) GetLockProxyHash() []byte {
+	if m != nil {
+		return m.LockProxyHash
+	}
+	return nil
+}
+
+type QueryProxyHashRequest struct {
+	LockProxyHash []byte `protobuf:"bytes,1,opt,name=lock_proxy_hash,json=lockProxyHash,proto3" json:"lock_proxy_hash,omitempty"`
+	ChainId       uint64 `protobuf:"varint,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (m *QueryProxyHashRequest) Reset()         { *m = QueryProxyHashRequest{} }
+func (m *QueryProxyHashRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProxyHashRequest) ProtoMessage()    {}
+func (*QueryProxyHashRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16293311b0c0feb2, []int{5}
+}
+func (m *QueryProxyHashRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProxyHashRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProxyHashRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProxyHashRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProxyHashRequest.Merge(m, src)
+}
+func (m *QueryProxyHashRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProxyHashRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProxyHashRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProxyHashRequest proto.InternalMessageInfo
+
+func (m *QueryProxyHashRequest) GetLockProxyHash() []byte {
+	if m != nil {
+		return m.LockProxyHash
+	}
+	return nil
+}
+
+func (m *QueryProxyHashRequest) GetChainId() uint64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+type QueryProxyHashResponse struct {
+	ProxyHash []byte `protobuf:"bytes,1,opt,name=proxy_hash,json=proxyHash,proto3" json:"proxy_hash,omitempty"`
+}
+
+func (m *QueryProxyHashResponse) Reset()         { *m = QueryProxyHashResponse{} }
+func (m *QueryProxyHashResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProxyHashResponse) ProtoMessage()    {}
+func (*QueryProxyHashResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16293311b0c0feb2, []int{6}
+}
+func (m *QueryProxyHashResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProxyHashResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProxyHashResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProxyHashResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProxyHashResponse.Merge(m, src)
+}
+func (m *QueryProxyHashResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProxyHashResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProxyHashResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProxyHashResponse proto.InternalMessageInfo
+
+func (m *QueryProxyHashResponse) GetProxyHash() []byte {
+	if m != nil {
+		return m.ProxyHash
+	}
+	return nil
+}
+
+type QueryAssetHashRequest struct {
+	LockProxyHash    []byte `protobuf:"bytes,1,opt,name=lock_proxy_hash,json=lockProxyHash,proto3" json:"lock_proxy_hash,omitempty"`
+	SourceAssetDenom string `protobuf:"bytes,2,opt,name=source_asset_denom,json=sourceAssetDenom,proto3" json:"source_asset_denom,omitempty"`
+	ChainId          uint64 `protobuf:"varint,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (m *QueryAssetHashRequest) Reset()         { *m = QueryAssetHashRequest{} }
+func (m *QueryAssetHashRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAssetHashRequest) ProtoMessage()    {}
+func (*QueryAssetHashRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16293311b0c0feb2, []int{7}
+}
+func (m *QueryAssetHashRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAssetHashRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAssetHashRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAssetHashRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAssetHashRequest.Merge(m, src)
+}
+func (m *QueryAssetHashRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAssetHashRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAssetHashRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAssetHashRequest proto.InternalMessageInfo
+
+func (m *QueryAssetHashRequest) GetLockProxyHash() []byte {
+	if m != nil {
+		return m.LockProxyHash
+	}
+	return nil
+}
+
+func (m *QueryAssetHashRequest) GetSourceAssetDenom() string {
+	if m != nil {
+		return m.SourceAssetDenom
+	}
+	return ""
+}
+
+func (m *QueryAssetHashRequest) GetChainId() uint64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+type QueryAssetHashResponse struct {
+	AssetHash []byte `protobuf:"bytes,1,opt,name=asset_hash,json=assetHash,proto3" json:"asset_hash,omitempty"`
+}
+
+func (m *QueryAssetHashResponse) Reset()         { *m = QueryAssetHashResponse{} }
+func (m *QueryAssetHashResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAssetHashResponse) ProtoMessage()    {}
+func (*QueryAssetHashResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16293311b0c0feb2, []int{8}
+}
+func (m *QueryAssetHashResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAssetHashResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAssetHashResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAssetHashResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAssetHashResponse.Merge(m, src)
+}
+func (m *QueryAssetHashResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAssetHashResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAssetHashResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAssetHashResponse proto.InternalMessageInfo
+
+func (m *QueryAssetHashResponse) GetAssetHash() []byte {
+	if m != nil {
+		return m.AssetHash
+	}
+	return nil
+}
+
+type QueryBindingHistoryRequest struct {
+	LockProxyHash    []byte          `protobuf:"bytes,1,opt,name=lock_proxy_hash,json=lockProxyHash,proto3" json:"lock_proxy_hash,omitempty"`
+	SourceAssetDenom string          `protobuf:"bytes,2,opt,name=source_asset_denom,json=sourceAssetDenom,proto3" json:"source_asset_denom,omitempty"`
+	ChainId          uint64          `protobuf:"varint,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Pagination       *pb.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBindingHistoryRequest) Reset()         { *m = QueryBindingHistoryRequest{} }
+func (m *QueryBindingHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBindingHistoryRequest) ProtoMessage()    {}
+func (*QueryBindingHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16293311b0c0feb2, []int{9}
+}
+func (m *QueryBindingHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBindingHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBindingHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBindingHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBindingHistoryRequest.Merge(m, src)
+}
+func (m *QueryBindingHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBindingHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBindingHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBindingHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryBindingHistoryRequest) GetLockProxyHash() []byte {
+	if m != nil {
+		return m.LockProxyHash
+	}
+	return nil
+}
+
+func (m *QueryBindingHistoryRequest) GetSourceAssetDenom() string {
+	if m != nil {
+		return m.SourceAssetDenom
+	}
+	return ""
+}
+
+func (m *QueryBindingHistoryRequest) GetChainId() uint64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *QueryBindingHistoryRequest) GetPagination() *pb.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryBindingHistoryResponse struct {
+	Changes []*pb.BindingChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+}
+
+func (m *QueryBindingHistoryResponse) Reset()         { *m = QueryBindingHistoryResponse{} }
+func (m *QueryBindingHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBindingHistoryResponse) ProtoMessage()    {}
+func (*QueryBindingHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16293311b0c0feb2, []int{10}
+}
+func (m *QueryBindingHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBindingHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBindingHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBindingHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBindingHistoryResponse.Merge(m, src)
+}
+func (m *QueryBindingHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBindingHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBindingHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBindingHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryBindingHistoryResponse) GetChanges() []*pb.BindingChange {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
+type PendingBindingChange struct {
+	Id              uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	BindingType     string   `protobuf:"bytes,2,opt,name=binding_type,json=bindingType,proto3" json:"binding_type,omitempty"`
+	LockProxy       []byte   `protobuf:"bytes,3,opt,name=lock_proxy,json=lockProxy,proto3" json:"lock_proxy,omitempty"`
+	Denom           string   `protobuf:"bytes,4,opt,name=denom,proto3" json:"denom,omitempty"`
+	ChainId         uint64   `protobuf:"varint,5,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	NewValue        []byte   `protobuf:"bytes,6,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"`
+	SourceDecimals  uint32   `protobuf:"varint,7,opt,name=source_decimals,json=sourceDecimals,proto3" json:"source_decimals,omitempty"`
+	ToDecimals      uint32   `protobuf:"varint,8,opt,name=to_decimals,json=toDecimals,proto3" json:"to_decimals,omitempty"`
+	Operator        string   `protobuf:"bytes,9,opt,name=operator,proto3" json:"operator,omitempty"`
+	ProposeHeight   int64    `protobuf:"varint,10,opt,name=propose_height,json=proposeHeight,proto3" json:"propose_height,omitempty"`
+	EffectiveHeight int64    `protobuf:"varint,11,opt,name=effective_height,json=effectiveHeight,proto3" json:"effective_height,omitempty"`
+	Approvers       []string `protobuf:"bytes,12,rep,name=approvers,proto3" json:"approvers,omitempty"`
+	Members         []string `protobuf:"bytes,13,rep,name=members,proto3" json:"members,omitempty"`
+	Threshold       uint64   `protobuf:"varint,14,opt,name=threshold,proto3" json:"threshold,omitempty"`
+}
+
+func (m *PendingBindingChange) Reset()         { *m = PendingBindingChange{} }
+func (m *PendingBindingChange) String() string { return proto.CompactTextString(m) }
+func (*PendingBindingChange) ProtoMessage()    {}
+func (*PendingBindingChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16293311b0c0feb2, []int{11}
+}
+func (m *PendingBindingChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingBindingChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingBindingChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingBindingChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingBindingChange.Merge(m, src)
+}
+func (m *PendingBindingChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingBindingChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingBindingChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingBindingChange proto.InternalMessageInfo
+
+func (m *PendingBindingChange) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *PendingBindingChange) GetBindingType() string {
+	if m != nil {
+		return m.BindingType
+	}
+	return ""
+}
+
+func (m *PendingBindingChange) GetLockProxy() []byte {
+	if m != nil {
+		return m.LockProxy
+	}
+	return nil
+}
+
+func (m *PendingBindingChange) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *PendingBindingChange) GetChainId() uint64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *PendingBindingChange) GetNewValue() []byte {
+	if m != nil {
+		return m.NewValue
+	}
+	return nil
+}
+
+func (m *PendingBindingChange) GetSourceDecimals() uint32 {
+	if m != nil {
+		return m.SourceDecimals
+	}
+	return 0
+}
+
+func (m *PendingBindingChange) GetToDecimals() uint32 {
+	if m != nil {
+		return m.ToDecimals
+	}
+	return 0
+}
+
+func (m *PendingBindingChange) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *PendingBindingChange) GetProposeHeight() int64 {
+	if m != nil {
+		return m.ProposeHeight
+	}
+	return 0
+}
+
+func (m *PendingBindingChange) GetEffectiveHeight() int64 {
+	if m != nil {
+		return m.EffectiveHeight
+	}
+	return 0
+}
+
+func (m *PendingBindingChange) GetApprovers() []string {
+	if m != nil {
+		return m.Approvers
+	}
+	return nil
+}
+
+func (m *PendingBindingChange) GetMembers() []string {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
+func (m *PendingBindingChange) GetThreshold() uint64 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+type QueryPendingBindingChangesRequest struct {
+	LockProxyHash []byte `protobuf:"bytes,1,opt,name=lock_proxy_hash,json=lockProxyHash,proto3" json:"lock_proxy_hash,omitempty"`
+}
+
+func (m *QueryPendingBindingChangesRequest) Reset()         { *m = QueryPendingBindingChangesRequest{} }
+func (m *QueryPendingBindingChangesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingBindingChangesRequest) ProtoMessage()    {}
+func (*QueryPendingBindingChangesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16293311b0c0feb2, []int{12}
+}
+func (m *QueryPendingBindingChangesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingBindingChangesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingBindingChangesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingBindingChangesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingBindingChangesRequest.Merge(m, src)
+}
+func (m *QueryPendingBindingChangesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingBindingChangesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingBindingChangesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingBindingChangesRequest proto.InternalMessageInfo
+
+func (m *QueryPendingBindingChangesRequest) GetLockProxyHash() []byte {
+	if m != nil {
+		return m.LockProxyHash
+	}
+	return nil
+}
+
+type QueryPendingBindingChangesResponse struct {
+	Changes []*PendingBindingChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+}
+
+func (m *QueryPendingBindingChangesResponse) Reset()         { *m = QueryPendingBindingChangesResponse{} }
+func (m *QueryPendingBindingChangesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingBindingChangesResponse) ProtoMessage()    {}
+func (*QueryPendingBindingChangesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16293311b0c0feb2, []int{13}
+}
+func (m *QueryPendingBindingChangesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingBindingChangesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingBindingChangesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingBindingChangesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingBindingChangesResponse.Merge(m, src)
+}
+func (m *QueryPendingBindingChangesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingBindingChangesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingBindingChangesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingBindingChangesResponse proto.InternalMessageInfo
+
+func (m *QueryPendingBindingChangesResponse) GetChanges() []*PendingBindingChange {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
+type OperatorGroup struct {
+	Members   []string `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	Threshold uint64   `protobuf:"varint,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Version   uint64   `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (m *OperatorGroup) Reset()         { *m = OperatorGroup{} }
+func (m *OperatorGroup) String() string { return proto.CompactTextString(m) }
+func (*OperatorGroup) ProtoMessage()    {}
+func (*OperatorGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16293311b0c0feb2, []int{14}
+}
+func (m *OperatorGroup) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OperatorGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OperatorGroup.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OperatorGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperatorGroup.Merge(m, src)
+}
+func (m *OperatorGroup) XXX_Size() int {
+	return m.Size()
+}
+func (m *OperatorGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperatorGroup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OperatorGroup proto.InternalMessageInfo
+
+func (m *OperatorGroup) GetMembers() []string {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
+func (m *OperatorGroup) GetThreshold() uint64 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *OperatorGroup) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+type QueryOperatorGroupRequest struct {
+	LockProxyHash []byte `protobuf:"bytes,1,opt,name=lock_proxy_hash,json=lockProxyHash,proto3" json:"lock_proxy_hash,omitempty"`
+}
+
+func (m *QueryOperatorGroupRequest) Reset()         { *m = QueryOperatorGroupRequest{} }
+func (m *QueryOperatorGroupRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOperatorGroupRequest) ProtoMessage()    {}
+func (*QueryOperatorGroupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16293311b0c0feb2, []int{15}
+}
+func (m *QueryOperatorGroupRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOperatorGroupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOperatorGroupRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOperatorGroupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOperatorGroupRequest.Merge(m, src)
+}
+func (m *QueryOperatorGroupRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOperatorGroupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOperatorGroupRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOperatorGroupRequest proto.InternalMessageInfo
+
+func (m *QueryOperatorGroupRequest) GetLockProxyHash() []byte {
+	if m != nil {
+		return m.LockProxyHash
+	}
+	return nil
+}
+
+type QueryOperatorGroupResponse struct {
+	Group *OperatorGroup `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+}
+
+func (m *QueryOperatorGroupResponse) Reset()         { *m = QueryOperatorGroupResponse{} }
+func (m *QueryOperatorGroupResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOperatorGroupResponse) ProtoMessage()    {}
+func (*QueryOperatorGroupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16293311b0c0feb2, []int{16}
+}
+func (m *QueryOperatorGroupResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOperatorGroupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOperatorGroupResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOperatorGroupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOperatorGroupResponse.Merge(m, src)
+}
+func (m *QueryOperatorGroupResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOperatorGroupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOperatorGroupResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOperatorGroupResponse proto.InternalMessageInfo
+
+func (m *QueryOperatorGroupResponse) GetGroup() *OperatorGroup {
+	if m != nil {
+		return m.Group
+	}
+	return nil
+}
+
+type OperatorAction struct {
+	Type           string   `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Denom          string   `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	ChainId        uint64   `protobuf:"varint,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Value          []byte   `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	SourceDecimals uint32   `protobuf:"varint,5,opt,name=source_decimals,json=sourceDecimals,proto3" json:"source_decimals,omitempty"`
+	ToDecimals     uint32   `protobuf:"varint,6,opt,name=to_decimals,json=toDecimals,proto3" json:"to_decimals,omitempty"`
+	Members        []string `protobuf:"bytes,7,rep,name=members,proto3" json:"members,omitempty"`
+	Threshold      uint64   `protobuf:"varint,8,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	PendingId      uint64   `protobuf:"varint,9,opt,name=pending_id,json=pendingId,proto3" json:"pending_id,omitempty"`
+}
+
+func (m *OperatorAction) Reset()         { *m = OperatorAction{} }
+func (m *OperatorAction) String() string { return proto.CompactTextString(m) }
+func (*OperatorAction) ProtoMessage()    {}
+func (*OperatorAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16293311b0c0feb2, []int{17}
+}
+func (m *OperatorAction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OperatorAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OperatorAction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OperatorAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperatorAction.Merge(m, src)
+}
+func (m *OperatorAction) XXX_Size() int {
+	return m.Size()
+}
+func (m *OperatorAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperatorAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OperatorAction proto.InternalMessageInfo
+
+func (m *OperatorAction) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *OperatorAction) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *OperatorAction) GetChainId() uint64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+func (m *OperatorAction) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *OperatorAction) GetSourceDecimals() uint32 {
+	if m != nil {
+		return m.SourceDecimals
+	}
+	return 0
+}
+
+func (m *OperatorAction) GetToDecimals() uint32 {
+	if m != nil {
+		return m.ToDecimals
+	}
+	return 0
+}
+
+func (m *OperatorAction) GetMembers() []string {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
+func (m *OperatorAction) GetThreshold() uint64 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *OperatorAction) GetPendingId() uint64 {
+	if m != nil {
+		return m.PendingId
+	}
+	return 0
+}
+
+type OperatorApproval struct {
+	LockProxy []byte          `protobuf:"bytes,1,opt,name=lock_proxy,json=lockProxy,proto3" json:"lock_proxy,omitempty"`
+	Version   uint64          `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Action    *OperatorAction `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	Approvers []string        `protobuf:"bytes,4,rep,name=approvers,proto3" json:"approvers,omitempty"`
+}
+
+func (m *OperatorApproval) Reset()         { *m = OperatorApproval{} }
+func (m *OperatorApproval) String() string { return proto.CompactTextString(m) }
+func (*OperatorApproval) ProtoMessage()    {}
+func (*OperatorApproval) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16293311b0c0feb2, []int{18}
+}
+func (m *OperatorApproval) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OperatorApproval) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OperatorApproval.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OperatorApproval) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperatorApproval.Merge(m, src)
+}
+func (m *OperatorApproval) XXX_Size() int {
+	return m.Size()
+}
+func (m *OperatorApproval) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperatorApproval.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OperatorApproval proto.InternalMessageInfo
+
+func (m *OperatorApproval) GetLockProxy() []byte {
+	if m != nil {
+		return m.LockProxy
+	}
+	return nil
+}
+
+func (m *OperatorApproval) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *OperatorApproval) GetAction() *OperatorAction {
+	if m != nil {
+		return m.Action
+	}
+	return nil
+}
+
+func (m *OperatorApproval) GetApprovers() []string {
+	if m != nil {
+		return m.Approvers
+	}
+	return nil
+}
+
+type QueryOperatorApprovalsRequest struct {
+	LockProxyHash []byte `protobuf:"bytes,1,opt,name=lock_proxy_hash,json=lockProxyHash,proto3" json:"lock_proxy_hash,omitempty"`
+}
+
+func (m *QueryOperatorApprovalsRequest) Reset()         { *m = QueryOperatorApprovalsRequest{} }
+func (m *QueryOperatorApprovalsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOperatorApprovalsRequest) ProtoMessage()    {}
+func (*QueryOperatorApprovalsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16293311b0c0feb2, []int{19}
+}
+func (m *QueryOperatorApprovalsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOperatorApprovalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOperatorApprovalsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOperatorApprovalsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOperatorApprovalsRequest.Merge(m, src)
+}
+func (m *QueryOperatorApprovalsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOperatorApprovalsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOperatorApprovalsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOperatorApprovalsRequest proto.InternalMessageInfo
+
+func (m *QueryOperatorApprovalsRequest) GetLockProxyHash() []byte {
+	if m != nil {
+		return m.LockProxyHash
+	}
+	return nil
+}
+
+type QueryOperatorApprovalsResponse struct {
+	Approvals []*OperatorApproval `protobuf:"bytes,1,rep,name=approvals,proto3" json:"approvals,omitempty"`
+}
+
+func (m *QueryOperatorApprovalsResponse) Reset()         { *m = QueryOperatorApprovalsResponse{} }
+func (m *QueryOperatorApprovalsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOperatorApprovalsResponse) ProtoMessage()    {}
+func (*QueryOperatorApprovalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16293311b0c0feb2, []int{20}
+}
+func (m *QueryOperatorApprovalsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOperatorApprovalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOperatorApprovalsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOperatorApprovalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOperatorApprovalsResponse.Merge(m, src)
+}
+func (m *QueryOperatorApprovalsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOperatorApprovalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOperatorApprovalsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOperatorApprovalsResponse proto.InternalMessageInfo
+
+func (m *QueryOperatorApprovalsResponse) GetApprovals() []*OperatorApproval {
+	if m != nil {
+		return m.Approvals
+	}
+	return nil
+}
+
+type LockProxy struct {
+	Hash    string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+}
+
+func (m *LockProxy) Reset()         { *m = LockProxy{} }
+func (m *LockProxy) String() string { return proto.CompactTextString(m) }
+func (*LockProxy) ProtoMessage()    {}
+func (*LockProxy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16293311b0c0feb2, []int{21}
+}
+func (m *LockProxy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LockProxy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LockProxy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LockProxy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockProxy.Merge(m, src)
+}
+func (m *LockProxy) XXX_Size() int {
+	return m.Size()
+}
+func (m *LockProxy) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockProxy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockProxy proto.InternalMessageInfo
+
+func (m *LockProxy) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *LockProxy) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+type QueryLockProxiesRequest struct {
+	Pagination *pb.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryLockProxiesRequest) Reset()         { *m = QueryLockProxiesRequest{} }
+func (m *QueryLockProxiesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLockProxiesRequest) ProtoMessage()    {}
+func (*QueryLockProxiesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16293311b0c0feb2, []int{22}
+}
+func (m *QueryLockProxiesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLockProxiesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLockProxiesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLockProxiesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLockProxiesRequest.Merge(m, src)
+}
+func (m *QueryLockProxiesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLockProxiesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLockProxiesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLockProxiesRequest proto.InternalMessageInfo
+
+func (m *QueryLockProxiesRequest) GetPagination() *pb.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryLockProxiesResponse struct {
+	LockProxies []*LockProxy `protobuf:"bytes,1,rep,name=lock_proxies,json=lockProxies,proto3" json:"lock_proxies,omitempty"`
+}
+
+func (m *QueryLockProxiesResponse) Reset()         { *m = QueryLockProxiesResponse{} }
+func (m *QueryLockProxiesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLockProxiesResponse) ProtoMessage()    {}
+func (*QueryLockProxiesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16293311b0c0feb2, []int{23}
+}
+func (m *QueryLockProxiesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLockProxiesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLockProxiesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLockProxiesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLockProxiesResponse.Merge(m, src)
+}
+func (m *QueryLockProxiesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLockProxiesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLockProxiesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLockProxiesResponse proto.InternalMessageInfo
+
+func (m *QueryLockProxiesResponse) GetLockProxies() []*LockProxy {
+	if m != nil {
+		return m.LockProxies
+	}
+	return nil
+}
+
+type QueryProxyBindingsRequest struct {
+	LockProxyHash []byte          `protobuf:"bytes,1,opt,name=lock_proxy_hash,json=lockProxyHash,proto3" json:"lock_proxy_hash,omitempty"`
+	Pagination    *pb.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryProxyBindingsRequest) Reset()         { *m = QueryProxyBindingsRequest{} }
+func (m *QueryProxyBindingsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProxyBindingsRequest) ProtoMessage()    {}
+func (*QueryProxyBindingsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16293311b0c0feb2, []int{24}
+}
+func (m *QueryProxyBindingsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProxyBindingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProxyBindingsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProxyBindingsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProxyBindingsRequest.Merge(m, src)
+}
+func (m *QueryProxyBindingsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProxyBindingsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProxyBindingsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProxyBindingsRequest proto.InternalMessageInfo
+
+func (m *QueryProxyBindingsRequest) GetLockProxyHash() []byte {
+	if m != nil {
+		return m.LockProxyHash
+	}
+	return nil
+}
+
+func (m *QueryProxyBindingsRequest) GetPagination() *pb.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryProxyBindingsResponse struct {
+	Bindings []*pb.Binding `protobuf:"bytes,1,rep,name=bindings,proto3" json:"bindings,omitempty"`
+}
+
+func (m *QueryProxyBindingsResponse) Reset()         { *m = QueryProxyBindingsResponse{} }
+func (m *QueryProxyBindingsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProxyBindingsResponse) ProtoMessage()    {}
+func (*QueryProxyBindingsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16293311b0c0feb2, []int{25}
+}
+func (m *QueryProxyBindingsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProxyBindingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProxyBindingsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProxyBindingsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProxyBindingsResponse.Merge(m, src)
+}
+func (m *QueryProxyBindingsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProxyBindingsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProxyBindingsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProxyBindingsResponse proto.InternalMessageInfo
+
+func (m *QueryProxyBindingsResponse) GetBindings() []*pb.Binding {
+	if m != nil {
+		return m.Bindings
+	}
+	return nil
+}
+
+type QueryAssetBindingsRequest struct {
+	LockProxyHash    []byte          `protobuf:"bytes,1,opt,name=lock_proxy_hash,json=lockProxyHash,proto3" json:"lock_proxy_hash,omitempty"`
+	SourceAssetDenom string          `protobuf:"bytes,2,opt,name=source_asset_denom,json=sourceAssetDenom,proto3" json:"source_asset_denom,omitempty"`
+	Pagination       *pb.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAssetBindingsRequest) Reset()         { *m = QueryAssetBindingsRequest{} }
+func (m *QueryAssetBindingsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAssetBindingsRequest) ProtoMessage()    {}
+func (*QueryAssetBindingsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16293311b0c0feb2, []int{26}
+}
+func (m *QueryAssetBindingsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAssetBindingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAssetBindingsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAssetBindingsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAssetBindingsRequest.Merge(m, src)
+}
+func (m *QueryAssetBindingsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAssetBindingsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAssetBindingsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAssetBindingsRequest proto.InternalMessageInfo
+
+func (m *QueryAssetBindingsRequest) GetLockProxyHash() []byte {
+	if m != nil {
+		return m.LockProxyHash
+	}
+	return nil
+}
+
+func (m *QueryAssetBindingsRequest) GetSourceAssetDenom() string {
+	if m != nil {
+		return m.SourceAssetDenom
+	}
+	return ""
+}
+
+func (m *QueryAssetBindingsRequest) GetPagination() *pb.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAssetBindingsResponse struct {
+	Bindings []*pb.Binding `protobuf:"bytes,1,rep,name=bindings,proto3" json:"bindings,omitempty"`
+}
+
+func (m *QueryAssetBindingsResponse) Reset()         { *m = QueryAssetBindingsResponse{} }
+func (m *QueryAssetBindingsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAssetBindingsResponse) ProtoMessage()    {}
+func (*QueryAssetBindingsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16293311b0c0feb2, []int{27}
+}
+func (m *QueryAssetBindingsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAssetBindingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAssetBindingsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAssetBindingsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAssetBindingsResponse.Merge(m, src)
+}
+func (m *QueryAssetBindingsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAssetBindingsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAssetBindingsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAssetBindingsResponse proto.InternalMessageInfo
+
+func (m *QueryAssetBindingsResponse) GetBindings() []*pb.Binding {
+	if m != nil {
+		return m.Bindings
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "polynetwork.lockproxy.v1.Params")
+	proto.RegisterType((*QueryParamsRequest)(nil), "polynetwork.lockproxy.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "polynetwork.lockproxy.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryProxyByOperatorRequest)(nil), "polynetwork.lockproxy.v1.QueryProxyByOperatorRequest")
+	proto.RegisterType((*QueryProxyByOperatorResponse)(nil), "polynetwork.lockproxy.v1.QueryProxyByOperatorResponse")
+	proto.RegisterType((*QueryProxyHashRequest)(nil), "polynetwork.lockproxy.v1.QueryProxyHashRequest")
+	proto.RegisterType((*QueryProxyHashResponse)(nil), "polynetwork.lockproxy.v1.QueryProxyHashResponse")
+	proto.RegisterType((*QueryAssetHashRequest)(nil), "polynetwork.lockproxy.v1.QueryAssetHashRequest")
+	proto.RegisterType((*QueryAssetHashResponse)(nil), "polynetwork.lockproxy.v1.QueryAssetHashResponse")
+	proto.RegisterType((*QueryBindingHistoryRequest)(nil), "polynetwork.lockproxy.v1.QueryBindingHistoryRequest")
+	proto.RegisterType((*QueryBindingHistoryResponse)(nil), "polynetwork.lockproxy.v1.QueryBindingHistoryResponse")
+	proto.RegisterType((*PendingBindingChange)(nil), "polynetwork.lockproxy.v1.PendingBindingChange")
+	proto.RegisterType((*QueryPendingBindingChangesRequest)(nil), "polynetwork.lockproxy.v1.QueryPendingBindingChangesRequest")
+	proto.RegisterType((*QueryPendingBindingChangesResponse)(nil), "polynetwork.lockproxy.v1.QueryPendingBindingChangesResponse")
+	proto.RegisterType((*OperatorGroup)(nil), "polynetwork.lockproxy.v1.OperatorGroup")
+	proto.RegisterType((*QueryOperatorGroupRequest)(nil), "polynetwork.lockproxy.v1.QueryOperatorGroupRequest")
+	proto.RegisterType((*QueryOperatorGroupResponse)(nil), "polynetwork.lockproxy.v1.QueryOperatorGroupResponse")
+	proto.RegisterType((*OperatorAction)(nil), "polynetwork.lockproxy.v1.OperatorAction")
+	proto.RegisterType((*OperatorApproval)(nil), "polynetwork.lockproxy.v1.OperatorApproval")
+	proto.RegisterType((*QueryOperatorApprovalsRequest)(nil), "polynetwork.lockproxy.v1.QueryOperatorApprovalsRequest")
+	proto.RegisterType((*QueryOperatorApprovalsResponse)(nil), "polynetwork.lockproxy.v1.QueryOperatorApprovalsResponse")
+	proto.RegisterType((*LockProxy)(nil), "polynetwork.lockproxy.v1.LockProxy")
+	proto.RegisterType((*QueryLockProxiesRequest)(nil), "polynetwork.lockproxy.v1.QueryLockProxiesRequest")
+	proto.RegisterType((*QueryLockProxiesResponse)(nil), "polynetwork.lockproxy.v1.QueryLockProxiesResponse")
+	proto.RegisterType((*QueryProxyBindingsRequest)(nil), "polynetwork.lockproxy.v1.QueryProxyBindingsRequest")
+	proto.RegisterType((*QueryProxyBindingsResponse)(nil), "polynetwork.lockproxy.v1.QueryProxyBindingsResponse")
+	proto.RegisterType((*QueryAssetBindingsRequest)(nil), "polynetwork.lockproxy.v1.QueryAssetBindingsRequest")
+	proto.RegisterType((*QueryAssetBindingsResponse)(nil), "polynetwork.lockproxy.v1.QueryAssetBindingsResponse")
+}
+
+func init() {
+	proto.RegisterFile("polynetwork/lockproxy/v1/query.proto", fileDescriptor_16293311b0c0feb2)
+}
+
+var fileDescriptor_16293311b0c0feb2 = []byte{
+	// 1296 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xef, 0xfa, 0x5f, 0xbc, 0xcf, 0x89, 0x13, 0x86, 0x14, 0xdc, 0x4d, 0x6b, 0xdc, 0xa5, 0x50,
+	0x83, 0x1a, 0x27, 0x71, 0x53, 0xda, 0x52, 0x40, 0xd4, 0x8d, 0xda, 0x54, 0x20, 0xb5, 0xac, 0x50,
+	0x85, 0x0a, 0x95, 0xb5, 0xf6, 0x4e, 0xed, 0xa5, 0xf6, 0xce, 0x76, 0x77, 0xed, 0x60, 0x24, 0x24,
+	0x4e, 0xc0, 0x81, 0x03, 0xe2, 0x6b, 0x70, 0xe6, 0x1b, 0x70, 0xe0, 0x58, 0x6e, 0x3d, 0xa2, 0xe4,
+	0x8b, 0xa0, 0x99, 0x9d, 0xfd, 0xeb, 0xf5, 0x9f, 0x4d, 0x0f, 0xdc, 0x3c, 0x6f, 0xe6, 0xf7, 0x7b,
+	0xef, 0xfd, 0x66, 0xde, 0x9b, 0x59, 0xc3, 0x25, 0x93, 0x0c, 0x26, 0x06, 0x76, 0x8e, 0x88, 0xf5,
+	0x6c, 0x67, 0x40, 0xba, 0xcf, 0x4c, 0x8b, 0x7c, 0x37, 0xd9, 0x19, 0xef, 0xed, 0x3c, 0x1f, 0x61,
+	0x6b, 0xd2, 0x30, 0x2d, 0xe2, 0x10, 0x54, 0x09, 0xad, 0x6a, 0xf8, 0xab, 0x1a, 0xe3, 0x3d, 0x49,
+	0x0e, 0xe3, 0xbb, 0x64, 0x38, 0x24, 0x06, 0x05, 0xbb, 0xbf, 0x5c, 0xb4, 0xfc, 0x08, 0x0a, 0x0f,
+	0x55, 0x4b, 0x1d, 0xda, 0x68, 0x17, 0x36, 0x3b, 0xba, 0xa1, 0xe9, 0x46, 0xaf, 0xdd, 0xed, 0xab,
+	0x46, 0x0f, 0xb7, 0x35, 0x3c, 0x50, 0x27, 0x15, 0xa1, 0x26, 0xd4, 0x73, 0x0a, 0xe2, 0x73, 0x77,
+	0xd8, 0xd4, 0x01, 0x9d, 0x41, 0x12, 0x14, 0x7b, 0x23, 0xd5, 0xd2, 0x74, 0xd5, 0xa8, 0x64, 0x6a,
+	0x42, 0x5d, 0x54, 0xfc, 0xb1, 0xbc, 0x09, 0xe8, 0x0b, 0x1a, 0xa4, 0x4b, 0xae, 0xe0, 0xe7, 0x23,
+	0x6c, 0x3b, 0xf2, 0x03, 0x78, 0x3d, 0x62, 0xb5, 0x4d, 0x62, 0xd8, 0x18, 0xdd, 0x80, 0x82, 0xc9,
+	0x2c, 0xcc, 0x59, 0xa9, 0x59, 0x6b, 0xcc, 0xca, 0xa9, 0xc1, 0x91, 0x7c, 0xbd, 0x7c, 0x13, 0xb6,
+	0x5c, 0x42, 0x3a, 0xdf, 0x9a, 0x3c, 0x30, 0xb1, 0xa5, 0x3a, 0xc4, 0xe2, 0xfe, 0x68, 0x84, 0x84,
+	0x9b, 0x18, 0xb5, 0xa8, 0xf8, 0x63, 0xf9, 0x2e, 0x9c, 0x4f, 0x86, 0xf2, 0xa0, 0xde, 0x85, 0x75,
+	0xea, 0xb9, 0xcd, 0x5c, 0xb7, 0xfb, 0xaa, 0xdd, 0x67, 0x14, 0xab, 0xca, 0x1a, 0x35, 0x33, 0xd4,
+	0xa1, 0x6a, 0xf7, 0xe5, 0xc7, 0x70, 0x36, 0xe0, 0xa1, 0x16, 0xcf, 0xf9, 0x92, 0x04, 0xe8, 0x1c,
+	0x14, 0xbb, 0x7d, 0x55, 0x37, 0xda, 0xba, 0xc6, 0x64, 0xcc, 0x29, 0x2b, 0x6c, 0x7c, 0x5f, 0x93,
+	0xaf, 0xc3, 0x1b, 0x71, 0x6e, 0x1e, 0xdd, 0x05, 0x80, 0x29, 0x5e, 0xd1, 0xf4, 0x83, 0xfa, 0x45,
+	0xe0, 0x51, 0xdd, 0xb6, 0x6d, 0xec, 0x9c, 0x26, 0xaa, 0x2b, 0x80, 0x6c, 0x32, 0xb2, 0xba, 0xb8,
+	0xad, 0x52, 0x8a, 0xb6, 0x86, 0x0d, 0x32, 0xe4, 0xdb, 0xbc, 0xe1, 0xce, 0x30, 0xee, 0x03, 0x6a,
+	0x8f, 0xe4, 0x90, 0x4d, 0xce, 0x21, 0x14, 0x49, 0x90, 0x83, 0xcb, 0x1d, 0xce, 0x41, 0xf5, 0x96,
+	0xc9, 0xff, 0x08, 0x20, 0x31, 0x64, 0xcb, 0x3d, 0x7a, 0x87, 0xba, 0xed, 0x10, 0x6b, 0xf2, 0x7f,
+	0x25, 0x82, 0x5a, 0x00, 0xa6, 0xda, 0xd3, 0x0d, 0xd5, 0xd1, 0x89, 0x51, 0xc9, 0xb1, 0x93, 0x2a,
+	0x47, 0x4e, 0x2a, 0xaf, 0x2c, 0x76, 0x4c, 0x7b, 0x98, 0x07, 0xaa, 0x84, 0x50, 0xf2, 0x13, 0xd8,
+	0x4a, 0x4c, 0x89, 0x2b, 0xf2, 0x09, 0xac, 0xb8, 0xb5, 0x47, 0x2b, 0x21, 0x5b, 0x2f, 0x35, 0x2f,
+	0xcd, 0xe0, 0x6f, 0x85, 0xab, 0x51, 0xf1, 0x40, 0xf2, 0x5f, 0x59, 0xd8, 0x7c, 0x88, 0xd9, 0x54,
+	0x64, 0x05, 0x2a, 0x43, 0x46, 0xd7, 0x78, 0x29, 0x67, 0x74, 0x0d, 0x5d, 0x84, 0x55, 0xaf, 0xd8,
+	0x9d, 0x89, 0x89, 0xb9, 0x1c, 0x25, 0x6e, 0xfb, 0x72, 0x62, 0xb2, 0xdd, 0x09, 0xf4, 0x65, 0x5a,
+	0xac, 0x2a, 0xa2, 0x2f, 0x2d, 0xda, 0x84, 0xbc, 0xab, 0x64, 0x8e, 0x41, 0xf3, 0xda, 0x94, 0x7c,
+	0xf9, 0xa8, 0x7c, 0x5b, 0x20, 0x1a, 0xf8, 0xa8, 0x3d, 0x56, 0x07, 0x23, 0x5c, 0x29, 0x30, 0xba,
+	0xa2, 0x81, 0x8f, 0x1e, 0xd1, 0x31, 0xba, 0x0c, 0xeb, 0x7c, 0x93, 0x34, 0xdc, 0xd5, 0x87, 0xea,
+	0xc0, 0xae, 0xac, 0xd4, 0x84, 0xfa, 0x9a, 0x52, 0x76, 0xcd, 0x07, 0xdc, 0x8a, 0xde, 0x82, 0x92,
+	0x43, 0x82, 0x45, 0x45, 0xb6, 0x08, 0x1c, 0xe2, 0x2f, 0x08, 0x97, 0xbc, 0x18, 0x2d, 0x79, 0xf4,
+	0x0e, 0x94, 0x4d, 0x8b, 0x98, 0xc4, 0xc6, 0xed, 0x3e, 0xd6, 0x7b, 0x7d, 0xa7, 0x02, 0x35, 0xa1,
+	0x9e, 0x55, 0xd6, 0xb8, 0xf5, 0x90, 0x19, 0xd1, 0x7b, 0xb0, 0x81, 0x9f, 0x3e, 0xc5, 0x5d, 0x47,
+	0x1f, 0xfb, 0x0b, 0x4b, 0x6c, 0xe1, 0xba, 0x6f, 0xe7, 0x4b, 0xcf, 0x83, 0xa8, 0x9a, 0xa6, 0x45,
+	0xc6, 0xd8, 0xb2, 0x2b, 0xab, 0xb5, 0x6c, 0x5d, 0x54, 0x02, 0x03, 0xaa, 0xc0, 0xca, 0x10, 0x0f,
+	0x3b, 0x74, 0x6e, 0x8d, 0xcd, 0x79, 0x43, 0x8a, 0x73, 0xfa, 0x16, 0xb6, 0xfb, 0x64, 0xa0, 0x55,
+	0xca, 0x4c, 0xa8, 0xc0, 0x20, 0x7f, 0x06, 0x17, 0xdd, 0xb2, 0x4f, 0xd8, 0x4a, 0x3b, 0xe5, 0xf9,
+	0x97, 0x0d, 0x90, 0xe7, 0x91, 0xf1, 0x93, 0x77, 0x18, 0x3f, 0x79, 0x8d, 0x39, 0x3d, 0x38, 0x81,
+	0x29, 0x38, 0x83, 0x2a, 0xac, 0x79, 0xbd, 0xf4, 0x9e, 0x45, 0x46, 0x66, 0x58, 0x05, 0x61, 0x8e,
+	0x0a, 0x99, 0x98, 0x0a, 0x14, 0x47, 0x55, 0xa4, 0xc5, 0xc6, 0x2b, 0x91, 0x0f, 0xe5, 0x3b, 0x70,
+	0x8e, 0xa5, 0x14, 0xf1, 0x93, 0x56, 0x97, 0xaf, 0x41, 0x4a, 0x22, 0xe1, 0x7a, 0x7c, 0x0c, 0xf9,
+	0x1e, 0x35, 0xf0, 0x1b, 0xe9, 0xf2, 0x6c, 0x35, 0xa2, 0x78, 0x17, 0x25, 0xff, 0x9a, 0x81, 0xb2,
+	0x37, 0x71, 0xbb, 0x4b, 0x4b, 0x1f, 0x21, 0xc8, 0xb1, 0x52, 0x73, 0xef, 0x21, 0xf6, 0x3b, 0x28,
+	0xa2, 0xcc, 0xac, 0x22, 0x8a, 0xf5, 0xa0, 0x4d, 0xc8, 0xbb, 0x05, 0x94, 0x63, 0x29, 0xe5, 0xc7,
+	0xb3, 0xaa, 0x27, 0xbf, 0x4c, 0xf5, 0x14, 0xa6, 0xaa, 0x27, 0xb4, 0x57, 0x2b, 0x73, 0xf6, 0xaa,
+	0x18, 0xdf, 0x2b, 0x7a, 0x1d, 0xb9, 0xa7, 0x82, 0x06, 0x2d, 0xba, 0xd3, 0xdc, 0x72, 0x5f, 0x93,
+	0xff, 0x10, 0x60, 0xc3, 0x97, 0x83, 0x95, 0x87, 0x3a, 0x88, 0x35, 0x18, 0x21, 0xde, 0x60, 0x42,
+	0xdb, 0x9f, 0x89, 0x6c, 0x3f, 0xfa, 0x14, 0x0a, 0x2a, 0xd3, 0x94, 0xa9, 0x53, 0x6a, 0xd6, 0x17,
+	0x6f, 0x8e, 0xbb, 0x07, 0x0a, 0xc7, 0x45, 0xcb, 0x36, 0x17, 0x2b, 0x5b, 0xf9, 0x1e, 0x5c, 0x88,
+	0x9c, 0x0c, 0x2f, 0xe2, 0xd4, 0xa5, 0xf7, 0x2d, 0x54, 0x67, 0x11, 0xf9, 0x65, 0xc7, 0xfd, 0xaa,
+	0x03, 0xb7, 0x3a, 0x4a, 0xcd, 0xf7, 0x97, 0xc8, 0x86, 0x43, 0x94, 0x00, 0x2c, 0xdf, 0x04, 0xf1,
+	0x73, 0x5f, 0x3b, 0x04, 0x39, 0x3f, 0x2a, 0x51, 0x61, 0xbf, 0xa9, 0x9e, 0x5d, 0x0b, 0xb3, 0xbe,
+	0xe8, 0x9e, 0x36, 0x6f, 0x28, 0x3f, 0x81, 0x37, 0x59, 0x98, 0x1e, 0x5e, 0x0f, 0x9a, 0x4c, 0xf4,
+	0xce, 0x13, 0x4e, 0x75, 0xe7, 0x75, 0xa0, 0x32, 0x4d, 0xcf, 0xf3, 0xbf, 0x0b, 0xab, 0xbe, 0x92,
+	0xba, 0xdf, 0x7b, 0xde, 0x9e, 0x2d, 0x81, 0x9f, 0xa3, 0x52, 0x1a, 0x04, 0x7c, 0xf2, 0xcf, 0x02,
+	0x6f, 0x09, 0x6c, 0x8e, 0x77, 0xa6, 0xb4, 0xfb, 0x15, 0xcb, 0x36, 0x73, 0xaa, 0x6c, 0xbf, 0xe2,
+	0x6d, 0x25, 0x16, 0x08, 0xcf, 0xf7, 0x43, 0x28, 0xf2, 0x3b, 0xd6, 0xcb, 0xb5, 0x3a, 0xff, 0x86,
+	0x57, 0xfc, 0xf5, 0xf2, 0x9f, 0x5e, 0x8e, 0xec, 0xb9, 0x72, 0xda, 0x1c, 0xd3, 0x3d, 0x87, 0xa2,
+	0x8a, 0x64, 0x5f, 0x49, 0x91, 0x58, 0xd8, 0xaf, 0xae, 0x48, 0xf3, 0x25, 0x40, 0x9e, 0x51, 0x23,
+	0xec, 0x7f, 0xc6, 0x5c, 0x99, 0x7d, 0x76, 0xa6, 0x3f, 0x48, 0xa4, 0xed, 0x25, 0x57, 0xf3, 0x60,
+	0x7f, 0x14, 0x60, 0x3d, 0xf6, 0xbd, 0x80, 0xae, 0x2d, 0xa2, 0x48, 0xfc, 0x34, 0x91, 0x3e, 0x48,
+	0x0b, 0xe3, 0x21, 0x18, 0x20, 0x06, 0x9b, 0xb9, 0xb3, 0x0c, 0x49, 0xe8, 0xf5, 0x2f, 0xed, 0x2e,
+	0x0f, 0x08, 0xfc, 0xf9, 0x2f, 0xf7, 0x85, 0xfe, 0xe2, 0x5f, 0x1b, 0xd2, 0xee, 0xf2, 0x00, 0xee,
+	0xef, 0x07, 0x28, 0x47, 0x1f, 0xc7, 0x68, 0x7f, 0x01, 0x47, 0xe2, 0xe7, 0x81, 0x74, 0x2d, 0x25,
+	0x8a, 0xbb, 0xff, 0x5d, 0x80, 0xb3, 0x89, 0x2f, 0x25, 0x74, 0x6b, 0x91, 0x74, 0x73, 0x1e, 0x6b,
+	0xd2, 0x47, 0xa7, 0x03, 0xf3, 0xa0, 0xbe, 0x8f, 0x3f, 0xa9, 0xae, 0x2e, 0xa0, 0x4b, 0x7a, 0x18,
+	0x49, 0xfb, 0xe9, 0x40, 0xdc, 0xf7, 0x4f, 0x02, 0xbc, 0x36, 0x75, 0x7f, 0xa1, 0xeb, 0x4b, 0x72,
+	0xc5, 0xaf, 0x4e, 0xe9, 0x46, 0x7a, 0x20, 0x0f, 0xc4, 0x81, 0x52, 0xe8, 0x06, 0x41, 0x7b, 0x0b,
+	0x88, 0xa6, 0x2f, 0x33, 0xa9, 0x99, 0x06, 0x12, 0x48, 0x1f, 0xe9, 0xe4, 0x0b, 0xa5, 0x4f, 0xba,
+	0x80, 0xa4, 0xfd, 0x74, 0xa0, 0xc0, 0x77, 0xa4, 0x67, 0x2e, 0xf4, 0x9d, 0x74, 0x31, 0x48, 0xfb,
+	0xe9, 0x40, 0xae, 0xef, 0xd6, 0x37, 0x7f, 0x1f, 0x57, 0x85, 0x17, 0xc7, 0x55, 0xe1, 0xdf, 0xe3,
+	0xaa, 0xf0, 0xdb, 0x49, 0xf5, 0xcc, 0x8b, 0x93, 0xea, 0x99, 0x97, 0x27, 0xd5, 0x33, 0x8f, 0x5b,
+	0x3d, 0xdd, 0xe9, 0x8f, 0x3a, 0xb4, 0x31, 0xef, 0x44, 0xff, 0x60, 0xb2, 0x87, 0xc4, 0xde, 0xa6,
+	0xa6, 0xed, 0x21, 0xd1, 0x46, 0x03, 0x1c, 0xfa, 0xcf, 0xca, 0xec, 0xdc, 0xf2, 0x07, 0x66, 0xa7,
+	0x53, 0x60, 0x7f, 0x3e, 0x5d, 0xfd, 0x6f, 0x00, 0xe1, 0xfd, 0xd6, 0x2c, 0xe2, 0x12, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	ProxyByOperator(ctx context.Context, in *QueryProxyByOperatorRequest, opts ...grpc.CallOption) (*QueryProxyByOperatorResponse, error)
+	ProxyHash(ctx context.Context, in *QueryProxyHashRequest, opts ...grpc.CallOption) (*QueryProxyHashResponse, error)
+	AssetHash(ctx context.Context, in *QueryAssetHashRequest, opts ...grpc.CallOption) (*QueryAssetHashResponse, error)
+	BindingHistory(ctx context.Context, in *QueryBindingHistoryRequest, opts ...grpc.CallOption) (*QueryBindingHistoryResponse, error)
+	PendingBindingChanges(ctx context.Context, in *QueryPendingBindingChangesRequest, opts ...grpc.CallOption) (*QueryPendingBindingChangesResponse, error)
+	OperatorGroup(ctx context.Context, in *QueryOperatorGroupRequest, opts ...grpc.CallOption) (*QueryOperatorGroupResponse, error)
+	OperatorApprovals(ctx context.Context, in *QueryOperatorApprovalsRequest, opts ...grpc.CallOption) (*QueryOperatorApprovalsResponse, error)
+	LockProxies(ctx context.Context, in *QueryLockProxiesRequest, opts ...grpc.CallOption) (*QueryLockProxiesResponse, error)
+	ProxyBindings(ctx context.Context, in *QueryProxyBindingsRequest, opts ...grpc.CallOption) (*QueryProxyBindingsResponse, error)
+	AssetBindings(ctx context.Context, in *QueryAssetBindingsRequest, opts ...grpc.CallOption) (*QueryAssetBindingsResponse, error)
+}
+
+type queryClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewQueryClient(cc *grpc.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/polynetwork.lockproxy.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProxyByOperator(ctx context.Context, in *QueryProxyByOperatorRequest, opts ...grpc.CallOption) (*QueryProxyByOperatorResponse, error) {
+	out := new(QueryProxyByOperatorResponse)
+	err := c.cc.Invoke(ctx, "/polynetwork.lockproxy.v1.Query/ProxyByOperator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProxyHash(ctx context.Context, in *QueryProxyHashRequest, opts ...grpc.CallOption) (*QueryProxyHashResponse, error) {
+	out := new(QueryProxyHashResponse)
+	err := c.cc.Invoke(ctx, "/polynetwork.lockproxy.v1.Query/ProxyHash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AssetHash(ctx context.Context, in *QueryAssetHashRequest, opts ...grpc.CallOption) (*QueryAssetHashResponse, error) {
+	out := new(QueryAssetHashResponse)
+	err := c.cc.Invoke(ctx, "/polynetwork.lockproxy.v1.Query/AssetHash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BindingHistory(ctx context.Context, in *QueryBindingHistoryRequest, opts ...grpc.CallOption) (*QueryBindingHistoryResponse, error) {
+	out := new(QueryBindingHistoryResponse)
+	err := c.cc.Invoke(ctx, "/polynetwork.lockproxy.v1.Query/BindingHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingBindingChanges(ctx context.Context, in *QueryPendingBindingChangesRequest, opts ...grpc.CallOption) (*QueryPendingBindingChangesResponse, error) {
+	out := new(QueryPendingBindingChangesResponse)
+	err := c.cc.Invoke(ctx, "/polynetwork.lockproxy.v1.Query/PendingBindingChanges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OperatorGroup(ctx context.Context, in *QueryOperatorGroupRequest, opts ...grpc.CallOption) (*QueryOperatorGroupResponse, error) {
+	out := new(QueryOperatorGroupResponse)
+	err := c.cc.Invoke(ctx, "/polynetwork.lockproxy.v1.Query/OperatorGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OperatorApprovals(ctx context.Context, in *QueryOperatorApprovalsRequest, opts ...grpc.CallOption) (*QueryOperatorApprovalsResponse, error) {
+	out := new(QueryOperatorApprovalsResponse)
+	err := c.cc.Invoke(ctx, "/polynetwork.lockproxy.v1.Query/OperatorApprovals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LockProxies(ctx context.Context, in *QueryLockProxiesRequest, opts ...grpc.CallOption) (*QueryLockProxiesResponse, error) {
+	out := new(QueryLockProxiesResponse)
+	err := c.cc.Invoke(ctx, "/polynetwork.lockproxy.v1.Query/LockProxies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProxyBindings(ctx context.Context, in *QueryProxyBindingsRequest, opts ...grpc.CallOption) (*QueryProxyBindingsResponse, error) {
+	out := new(QueryProxyBindingsResponse)
+	err := c.cc.Invoke(ctx, "/polynetwork.lockproxy.v1.Query/ProxyBindings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AssetBindings(ctx context.Context, in *QueryAssetBindingsRequest, opts ...grpc.CallOption) (*QueryAssetBindingsResponse, error) {
+	out := new(QueryAssetBindingsResponse)
+	err := c.cc.Invoke(ctx, "/polynetwork.lockproxy.v1.Query/AssetBindings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	ProxyByOperator(context.Context, *QueryProxyByOperatorRequest) (*QueryProxyByOperatorResponse, error)
+	ProxyHash(context.Context, *QueryProxyHashRequest) (*QueryProxyHashResponse, error)
+	AssetHash(context.Context, *QueryAssetHashRequest) (*QueryAssetHashResponse, error)
+	BindingHistory(context.Context, *QueryBindingHistoryRequest) (*QueryBindingHistoryResponse, error)
+	PendingBindingChanges(context.Context, *QueryPendingBindingChangesRequest) (*QueryPendingBindingChangesResponse, error)
+	OperatorGroup(context.Context, *QueryOperatorGroupRequest) (*QueryOperatorGroupResponse, error)
+	OperatorApprovals(context.Context, *QueryOperatorApprovalsRequest) (*QueryOperatorApprovalsResponse, error)
+	LockProxies(context.Context, *QueryLockProxiesRequest) (*QueryLockProxiesResponse, error)
+	ProxyBindings(context.Context, *QueryProxyBindingsRequest) (*QueryProxyBindingsResponse, error)
+	AssetBindings(context.Context, *QueryAssetBindingsRequest) (*QueryAssetBindingsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) ProxyByOperator(ctx context.Context, req *QueryProxyByOperatorRequest) (*QueryProxyByOperatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProxyByOperator not implemented")
+}
+func (*UnimplementedQueryServer) ProxyHash(ctx context.Context, req *QueryProxyHashRequest) (*QueryProxyHashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProxyHash not implemented")
+}
+func (*UnimplementedQueryServer) AssetHash(ctx context.Context, req *QueryAssetHashRequest) (*QueryAssetHashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssetHash not implemented")
+}
+func (*UnimplementedQueryServer) BindingHistory(ctx context.Context, req *QueryBindingHistoryRequest) (*QueryBindingHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BindingHistory not implemented")
+}
+func (*UnimplementedQueryServer) PendingBindingChanges(ctx context.Context, req *QueryPendingBindingChangesRequest) (*QueryPendingBindingChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingBindingChanges not implemented")
+}
+func (*UnimplementedQueryServer) OperatorGroup(ctx context.Context, req *QueryOperatorGroupRequest) (*QueryOperatorGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperatorGroup not implemented")
+}
+func (*UnimplementedQueryServer) OperatorApprovals(ctx context.Context, req *QueryOperatorApprovalsRequest) (*QueryOperatorApprovalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperatorApprovals not implemented")
+}
+func (*UnimplementedQueryServer) LockProxies(ctx context.Context, req *QueryLockProxiesRequest) (*QueryLockProxiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockProxies not implemented")
+}
+func (*UnimplementedQueryServer) ProxyBindings(ctx context.Context, req *QueryProxyBindingsRequest) (*QueryProxyBindingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProxyBindings not implemented")
+}
+func (*UnimplementedQueryServer) AssetBindings(ctx context.Context, req *QueryAssetBindingsRequest) (*QueryAssetBindingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssetBindings not implemented")
+}
+
+func RegisterQueryServer(s *grpc.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/polynetwork.lockproxy.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProxyByOperator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProxyByOperatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProxyByOperator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/polynetwork.lockproxy.v1.Query/ProxyByOperator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProxyByOperator(ctx, req.(*QueryProxyByOperatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProxyHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProxyHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProxyHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/polynetwork.lockproxy.v1.Query/ProxyHash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProxyHash(ctx, req.(*QueryProxyHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AssetHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAssetHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AssetHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/polynetwork.lockproxy.v1.Query/AssetHash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AssetHash(ctx, req.(*QueryAssetHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BindingHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBindingHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BindingHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/polynetwork.lockproxy.v1.Query/BindingHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BindingHistory(ctx, req.(*QueryBindingHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingBindingChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingBindingChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingBindingChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/polynetwork.lockproxy.v1.Query/PendingBindingChanges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingBindingChanges(ctx, req.(*QueryPendingBindingChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OperatorGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOperatorGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OperatorGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/polynetwork.lockproxy.v1.Query/OperatorGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OperatorGroup(ctx, req.(*QueryOperatorGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OperatorApprovals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOperatorApprovalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OperatorApprovals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/polynetwork.lockproxy.v1.Query/OperatorApprovals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OperatorApprovals(ctx, req.(*QueryOperatorApprovalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LockProxies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLockProxiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LockProxies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/polynetwork.lockproxy.v1.Query/LockProxies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LockProxies(ctx, req.(*QueryLockProxiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProxyBindings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProxyBindingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProxyBindings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/polynetwork.lockproxy.v1.Query/ProxyBindings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProxyBindings(ctx, req.(*QueryProxyBindingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AssetBindings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAssetBindingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AssetBindings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/polynetwork.lockproxy.v1.Query/AssetBindings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AssetBindings(ctx, req.(*QueryAssetBindingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "polynetwork.lockproxy.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "ProxyByOperator",
+			Handler:    _Query_ProxyByOperator_Handler,
+		},
+		{
+			MethodName: "ProxyHash",
+			Handler:    _Query_ProxyHash_Handler,
+		},
+		{
+			MethodName: "AssetHash",
+			Handler:    _Query_AssetHash_Handler,
+		},
+		{
+			MethodName: "BindingHistory",
+			Handler:    _Query_BindingHistory_Handler,
+		},
+		{
+			MethodName: "PendingBindingChanges",
+			Handler:    _Query_PendingBindingChanges_Handler,
+		},
+		{
+			MethodName: "OperatorGroup",
+			Handler:    _Query_OperatorGroup_Handler,
+		},
+		{
+			MethodName: "OperatorApprovals",
+			Handler:    _Query_OperatorApprovals_Handler,
+		},
+		{
+			MethodName: "LockProxies",
+			Handler:    _Query_LockProxies_Handler,
+		},
+		{
+			MethodName: "ProxyBindings",
+			Handler:    _Query_ProxyBindings_Handler,
+		},
+		{
+			MethodName: "AssetBindings",
+			Handler:    _Query_AssetBindings_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "polynetwork/lockproxy/v1/query.proto",
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Guardian) > 0 {
+		i -= len(m.Guardian)
+		copy(dAtA[i:], m.Guardian)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Guardian)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BindingChangeDelay != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BindingChangeDelay))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProxyByOperatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProxyByOperatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProxyByOperatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProxyByOperatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProxyByOperatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProxyByOperatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LockProxyHash) > 0 {
+		i -= len(m.LockProxyHash)
+		copy(dAtA[i:], m.LockProxyHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.LockProxyHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProxyHashRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProxyHashRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProxyHashRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.LockProxyHash) > 0 {
+		i -= len(m.LockProxyHash)
+		copy(dAtA[i:], m.LockProxyHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.LockProxyHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProxyHashResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProxyHashResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProxyHashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProxyHash) > 0 {
+		i -= len(m.ProxyHash)
+		copy(dAtA[i:], m.ProxyHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProxyHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAssetHashRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAssetHashRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAssetHashRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.SourceAssetDenom) > 0 {
+		i -= len(m.SourceAssetDenom)
+		copy(dAtA[i:], m.SourceAssetDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SourceAssetDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.LockProxyHash) > 0 {
+		i -= len(m.LockProxyHash)
+		copy(dAtA[i:], m.LockProxyHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.LockProxyHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAssetHashResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAssetHashResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAssetHashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AssetHash) > 0 {
+		i -= len(m.AssetHash)
+		copy(dAtA[i:], m.AssetHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AssetHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBindingHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBindingHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBindingHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.SourceAssetDenom) > 0 {
+		i -= len(m.SourceAssetDenom)
+		copy(dAtA[i:], m.SourceAssetDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SourceAssetDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.LockProxyHash) > 0 {
+		i -= len(m.LockProxyHash)
+		copy(dAtA[i:], m.LockProxyHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.LockProxyHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBindingHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBindingHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBindingHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingBindingChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingBindingChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingBindingChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Threshold != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Members[iNdEx])
+			copy(dAtA[i:], m.Members[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Members[iNdEx])))
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.Approvers) > 0 {
+		for iNdEx := len(m.Approvers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Approvers[iNdEx])
+			copy(dAtA[i:], m.Approvers[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Approvers[iNdEx])))
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.EffectiveHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EffectiveHeight))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.ProposeHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposeHeight))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.ToDecimals != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToDecimals))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.SourceDecimals != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SourceDecimals))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.NewValue) > 0 {
+		i -= len(m.NewValue)
+		copy(dAtA[i:], m.NewValue)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.NewValue)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.LockProxy) > 0 {
+		i -= len(m.LockProxy)
+		copy(dAtA[i:], m.LockProxy)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.LockProxy)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BindingType) > 0 {
+		i -= len(m.BindingType)
+		copy(dAtA[i:], m.BindingType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BindingType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingBindingChangesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingBindingChangesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingBindingChangesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LockProxyHash) > 0 {
+		i -= len(m.LockProxyHash)
+		copy(dAtA[i:], m.LockProxyHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.LockProxyHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingBindingChangesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingBindingChangesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingBindingChangesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OperatorGroup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OperatorGroup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperatorGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Threshold != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Members[iNdEx])
+			copy(dAtA[i:], m.Members[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Members[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOperatorGroupRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOperatorGroupRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOperatorGroupRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LockProxyHash) > 0 {
+		i -= len(m.LockProxyHash)
+		copy(dAtA[i:], m.LockProxyHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.LockProxyHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOperatorGroupResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOperatorGroupResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOperatorGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Group != nil {
+		{
+			size, err := m.Group.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OperatorAction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OperatorAction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperatorAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PendingId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PendingId))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Threshold != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Members[iNdEx])
+			copy(dAtA[i:], m.Members[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Members[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.ToDecimals != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToDecimals))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.SourceDecimals != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SourceDecimals))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ChainId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OperatorApproval) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OperatorApproval) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OperatorApproval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Approvers) > 0 {
+		for iNdEx := len(m.Approvers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Approvers[iNdEx])
+			copy(dAtA[i:], m.Approvers[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Approvers[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Action != nil {
+		{
+			size, err := m.Action.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Version != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.LockProxy) > 0 {
+		i -= len(m.LockProxy)
+		copy(dAtA[i:], m.LockProxy)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.LockProxy)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOperatorApprovalsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOperatorApprovalsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOperatorApprovalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LockProxyHash) > 0 {
+		i -= len(m.LockProxyHash)
+		copy(dAtA[i:], m.LockProxyHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.LockProxyHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOperatorApprovalsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOperatorApprovalsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOperatorApprovalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Approvals) > 0 {
+		for iNdEx := len(m.Approvals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Approvals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LockProxy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LockProxy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LockProxy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLockProxiesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLockProxiesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLockProxiesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLockProxiesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLockProxiesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLockProxiesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LockProxies) > 0 {
+		for iNdEx := len(m.LockProxies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockProxies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProxyBindingsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProxyBindingsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProxyBindingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.LockProxyHash) > 0 {
+		i -= len(m.LockProxyHash)
+		copy(dAtA[i:], m.LockProxyHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.LockProxyHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProxyBindingsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProxyBindingsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProxyBindingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Bindings) > 0 {
+		for iNdEx := len(m.Bindings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bindings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAssetBindingsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAssetBindingsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAssetBindingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SourceAssetDenom) > 0 {
+		i -= len(m.SourceAssetDenom)
+		copy(dAtA[i:], m.SourceAssetDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SourceAssetDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.LockProxyHash) > 0 {
+		i -= len(m.LockProxyHash)
+		copy(dAtA[i:], m.LockProxyHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.LockProxyHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAssetBindingsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAssetBindingsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAssetBindingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Bindings) > 0 {
+		for iNdEx := len(m.Bindings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bindings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BindingChangeDelay != 0 {
+		n += 1 + sovQuery(uint64(m.BindingChangeDelay))
+	}
+	l = len(m.Guardian)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProxyByOperatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProxyByOperatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LockProxyHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProxyHashRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LockProxyHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	return n
+}
+
+func (m *QueryProxyHashResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProxyHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAssetHashRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LockProxyHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.SourceAssetDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	return n
+}
+
+func (m *QueryAssetHashResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AssetHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBindingHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LockProxyHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.SourceAssetDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBindingHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PendingBindingChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	l = len(m.BindingType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.LockProxy)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	l = len(m.NewValue)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.SourceDecimals != 0 {
+		n += 1 + sovQuery(uint64(m.SourceDecimals))
+	}
+	if m.ToDecimals != 0 {
+		n += 1 + sovQuery(uint64(m.ToDecimals))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ProposeHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ProposeHeight))
+	}
+	if m.EffectiveHeight != 0 {
+		n += 1 + sovQuery(uint64(m.EffectiveHeight))
+	}
+	if len(m.Approvers) > 0 {
+		for _, s := range m.Approvers {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Members) > 0 {
+		for _, s := range m.Members {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovQuery(uint64(m.Threshold))
+	}
+	return n
+}
+
+func (m *QueryPendingBindingChangesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LockProxyHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingBindingChangesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *OperatorGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Members) > 0 {
+		for _, s := range m.Members {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovQuery(uint64(m.Threshold))
+	}
+	if m.Version != 0 {
+		n += 1 + sovQuery(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *QueryOperatorGroupRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LockProxyHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOperatorGroupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Group != nil {
+		l = m.Group.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *OperatorAction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovQuery(uint64(m.ChainId))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.SourceDecimals != 0 {
+		n += 1 + sovQuery(uint64(m.SourceDecimals))
+	}
+	if m.ToDecimals != 0 {
+		n += 1 + sovQuery(uint64(m.ToDecimals))
+	}
+	if len(m.Members) > 0 {
+		for _, s := range m.Members {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovQuery(uint64(m.Threshold))
+	}
+	if m.PendingId != 0 {
+		n += 1 + sovQuery(uint64(m.PendingId))
+	}
+	return n
+}
+
+func (m *OperatorApproval) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LockProxy)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovQuery(uint64(m.Version))
+	}
+	if m.Action != nil {
+		l = m.Action.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Approvers) > 0 {
+		for _, s := range m.Approvers {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryOperatorApprovalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LockProxyHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOperatorApprovalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Approvals) > 0 {
+		for _, e := range m.Approvals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LockProxy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLockProxiesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLockProxiesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LockProxies) > 0 {
+		for _, e := range m.LockProxies {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryProxyBindingsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LockProxyHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProxyBindingsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Bindings) > 0 {
+		for _, e := range m.Bindings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAssetBindingsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LockProxyHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.SourceAssetDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAssetBindingsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Bindings) > 0 {
+		for _, e := range m.Bindings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BindingChangeDelay", wireType)
+			}
+			m.BindingChangeDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BindingChangeDelay |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardian", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Guardian = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProxyByOperatorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProxyByOperatorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProxyByOperatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProxyByOperatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProxyByOperatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProxyByOperatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockProxyHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockProxyHash = append(m.LockProxyHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.LockProxyHash == nil {
+				m.LockProxyHash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProxyHashRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProxyHashRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProxyHashRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockProxyHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockProxyHash = append(m.LockProxyHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.LockProxyHash == nil {
+				m.LockProxyHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProxyHashResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProxyHashResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProxyHashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProxyHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProxyHash = append(m.ProxyHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProxyHash == nil {
+				m.ProxyHash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAssetHashRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAssetHashRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAssetHashRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockProxyHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockProxyHash = append(m.LockProxyHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.LockProxyHash == nil {
+				m.LockProxyHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceAssetDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceAssetDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAssetHashResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAssetHashResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAssetHashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetHash = append(m.AssetHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.AssetHash == nil {
+				m.AssetHash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBindingHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBindingHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBindingHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockProxyHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockProxyHash = append(m.LockProxyHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.LockProxyHash == nil {
+				m.LockProxyHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceAssetDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceAssetDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &pb.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBindingHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBindingHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBindingHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Changes = append(m.Changes, &pb.BindingChange{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingBindingChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingBindingChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingBindingChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BindingType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BindingType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockProxy", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockProxy = append(m.LockProxy[:0], dAtA[iNdEx:postIndex]...)
+			if m.LockProxy == nil {
+				m.LockProxy = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewValue", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewValue = append(m.NewValue[:0], dAtA[iNdEx:postIndex]...)
+			if m.NewValue == nil {
+				m.NewValue = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceDecimals", wireType)
+			}
+			m.SourceDecimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceDecimals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToDecimals", wireType)
+			}
+			m.ToDecimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToDecimals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposeHeight", wireType)
+			}
+			m.ProposeHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposeHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveHeight", wireType)
+			}
+			m.EffectiveHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EffectiveHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approvers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Approvers = append(m.Approvers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingBindingChangesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingBindingChangesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingBindingChangesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockProxyHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockProxyHash = append(m.LockProxyHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.LockProxyHash == nil {
+				m.LockProxyHash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingBindingChangesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingBindingChangesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingBindingChangesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Changes = append(m.Changes, &PendingBindingChange{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OperatorGroup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OperatorGroup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OperatorGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOperatorGroupRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOperatorGroupRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOperatorGroupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockProxyHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockProxyHash = append(m.LockProxyHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.LockProxyHash == nil {
+				m.LockProxyHash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOperatorGroupResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOperatorGroupResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOperatorGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Group == nil {
+				m.Group = &OperatorGroup{}
+			}
+			if err := m.Group.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OperatorAction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OperatorAction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OperatorAction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceDecimals", wireType)
+			}
+			m.SourceDecimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceDecimals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToDecimals", wireType)
+			}
+			m.ToDecimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToDecimals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingId", wireType)
+			}
+			m.PendingId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OperatorApproval) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OperatorApproval: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OperatorApproval: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockProxy", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockProxy = append(m.LockProxy[:0], dAtA[iNdEx:postIndex]...)
+			if m.LockProxy == nil {
+				m.LockProxy = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Action == nil {
+				m.Action = &OperatorAction{}
+			}
+			if err := m.Action.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approvers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Approvers = append(m.Approvers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOperatorApprovalsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOperatorApprovalsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOperatorApprovalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockProxyHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockProxyHash = append(m.LockProxyHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.LockProxyHash == nil {
+				m.LockProxyHash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOperatorApprovalsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOperatorApprovalsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOperatorApprovalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approvals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Approvals = append(m.Approvals, &OperatorApproval{})
+			if err := m.Approvals[len(m.Approvals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LockProxy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LockProxy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LockProxy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLockProxiesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLockProxiesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLockProxiesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &pb.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLockProxiesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLockProxiesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLockProxiesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockProxies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockProxies = append(m.LockProxies, &LockProxy{})
+			if err := m.LockProxies[len(m.LockProxies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProxyBindingsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProxyBindingsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProxyBindingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockProxyHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockProxyHash = append(m.LockProxyHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.LockProxyHash == nil {
+				m.LockProxyHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &pb.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProxyBindingsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProxyBindingsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProxyBindingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bindings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bindings = append(m.Bindings, &pb.Binding{})
+			if err := m.Bindings[len(m.Bindings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAssetBindingsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAssetBindingsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAssetBindingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockProxyHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockProxyHash = append(m.LockProxyHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.LockProxyHash == nil {
+				m.LockProxyHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceAssetDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceAssetDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &pb.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAssetBindingsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAssetBindingsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAssetBindingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bindings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bindings = append(m.Bindings, &pb.Binding{})
+			if err := m.Bindings[len(m.Bindings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,38 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: polynetwork/lockproxy/v1/state.proto
+
+package lockproxypb
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+func init() {
+	proto.RegisterFile("polynetwork/lockproxy/v1/state.proto", fileDescriptor_4c04503dcba70f38)
+}
+
+var fileDescriptor_4c04503dcba70f38 = []byte{
+	// 142 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x29, 0xc8, 0xcf, 0xa9,
+	0xcc, 0x4b, 0x2d, 0x29, 0xcf, 0x2f, 0xca, 0xd6, 0xcf, 0xc9, 0x4f, 0xce, 0x2e, 0x28, 0xca, 0xaf,
+	0xa8, 0xd4, 0x2f, 0x33, 0xd4, 0x2f, 0x2e, 0x49, 0x2c, 0x49, 0xd5, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
+	0x17, 0x92, 0x40, 0x52, 0xa5, 0x07, 0x57, 0xa5, 0x57, 0x66, 0xe8, 0x14, 0x73, 0xe2, 0x91, 0x1c,
+	0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1,
+	0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x4e, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9,
+	0xf9, 0xb9, 0xfa, 0xc8, 0x96, 0x24, 0xe7, 0x17, 0xe7, 0xe6, 0x17, 0xeb, 0x82, 0x84, 0x74, 0x73,
+	0xf3, 0x53, 0x4a, 0x73, 0x52, 0x91, 0xec, 0x2d, 0x48, 0xb2, 0x86, 0x73, 0x0a, 0x92, 0x92, 0xd8,
+	0xc0, 0xd6, 0x1b, 0x03, 0x06, 0x00, 0x55, 0x05, 0x4d, 0xb0, 0xa6, 0x00, 0x00, 0x00,
+}
@@ -0,0 +1,5518 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: polynetwork/lockproxy/v1/tx.proto
+
+package lockproxypb
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	pb "github.com/polynetwork/cosmos-poly-module/common/pb"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type MsgCreateLockProxy struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+}
+
+func (m *MsgCreateLockProxy) Reset()         { *m = MsgCreateLockProxy{} }
+func (m *MsgCreateLockProxy) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateLockProxy) ProtoMessage()    {}
+func (*MsgCreateLockProxy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1279d03cd0d7555, []int{0}
+}
+func (m *MsgCreateLockProxy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateLockProxy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateLockProxy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateLockProxy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateLockProxy.Merge(m, src)
+}
+func (m *MsgCreateLockProxy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateLockProxy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateLockProxy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateLockProxy proto.InternalMessageInfo
+
+func (m *MsgCreateLockProxy) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+type MsgCreateLockProxyResponse struct {
+}
+
+func (m *MsgCreateLockProxyResponse) Reset()         { *m = MsgCreateLockProxyResponse{} }
+func (m *MsgCreateLockProxyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateLockProxyResponse) ProtoMessage()    {}
+func (*MsgCreateLockProxyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1279d03cd0d7555, []int{1}
+}
+func (m *MsgCreateLockProxyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateLockProxyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateLockProxyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateLockProxyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateLockProxyResponse.Merge(m, src)
+}
+func (m *MsgCreateLockProxyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateLockProxyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateLockProxyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateLockProxyResponse proto.InternalMessageInfo
+
+type MsgCreateCoinAndDelegateToProxy struct {
+	Creator       string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Coin          *pb.Coin `protobuf:"bytes,2,opt,name=coin,proto3" json:"coin,omitempty"`
+	LockProxyHash []byte   `protobuf:"bytes,3,opt,name=lock_proxy_hash,json=lockProxyHash,proto3" json:"lock_proxy_hash,omitempty"`
+}
+
+func (m *MsgCreateCoinAndDelegateToProxy) Reset()         { *m = MsgCreateCoinAndDelegateToProxy{} }
+func (m *MsgCreateCoinAndDelegateToProxy) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateCoinAndDelegateToProxy) ProtoMessage()    {}
+func (*MsgCreateCoinAndDelegateToProxy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1279d03cd0d7555, []int{2}
+}
+func (m *MsgCreateCoinAndDelegateToProxy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateCoinAndDelegateToProxy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateCoinAndDelegateToProxy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateCoinAndDelegateToProxy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateCoinAndDelegateToProxy.Merge(m, src)
+}
+func (m *MsgCreateCoinAndDelegateToProxy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateCoinAndDelegateToProxy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateCoinAndDelegateToProxy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateCoinAndDelegateToProxy proto.InternalMessageInfo
+
+func (m *MsgCreateCoinAndDelegateToProxy) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgCreateCoinAndDelegateToProxy) GetCoin() *pb.Coin {
+	if m != nil {
+		return m.Coin
+	}
+	return nil
+}
+
+func (m *MsgCreateCoinAndDelegateToProxy) GetLockProxyHash() []byte {
+	if m != nil {
+		return m.LockProxyHash
+	}
+	return nil
+}
+
+type MsgCreateCoinAndDelegateToProxyResponse struct {
+}
+
+func (m *MsgCreateCoinAndDelegateToProxyResponse) Reset() {
+	*m = MsgCreateCoinAndDelegateToProxyResponse{}
+}
+func (m *MsgCreateCoinAndDelegateToProxyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateCoinAndDelegateToProxyResponse) ProtoMessage()    {}
+func (*MsgCreateCoinAndDelegateToProxyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1279d03cd0d7555, []int{3}
+}
+func (m *MsgCreateCoinAndDelegateToProxyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateCoinAndDelegateToProxyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateCoinAndDelegateToProxyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateCoinAndDelegateToProxyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateCoinAndDelegateToProxyResponse.Merge(m, src)
+}
+func (m *MsgCreateCoinAndDelegateToProxyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateCoinAndDelegateToProxyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateCoinAndDelegateToProxyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateCoinAndDelegateToProxyResponse proto.InternalMessageInfo
+
+type MsgBindProxyHash struct {
+	Operator         string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	ToChainId        uint64 `protobuf:"varint,2,opt,name=to_chain_id,json=toChainId,proto3" json:"to_chain_id,omitempty"`
+	ToChainProxyHash []byte `protobuf:"bytes,3,opt,name=to_chain_proxy_hash,json=toChainProxyHash,proto3" json:"to_chain_proxy_hash,omitempty"`
+}
+
+func (m *MsgBindProxyHash) Reset()         { *m = MsgBindProxyHash{} }
+func (m *MsgBindProxyHash) String() string { return proto.CompactTextString(m) }
+func (*MsgBindProxyHash) ProtoMessage()    {}
+func (*MsgBindProxyHash) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1279d03cd0d7555, []int{4}
+}
+func (m *MsgBindProxyHash) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBindProxyHash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBindProxyHash.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBindProxyHash) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBindProxyHash.Merge(m, src)
+}
+func (m *MsgBindProxyHash) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBindProxyHash) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBindProxyHash.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBindProxyHash proto.InternalMessageInfo
+
+func (m *MsgBindProxyHash) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *MsgBindProxyHash) GetToChainId() uint64 {
+	if m != nil {
+		return m.ToChainId
+	}
+	return 0
+}
+
+func (m *MsgBindProxyHash) GetToChainProxyHash() []byte {
+	if m != nil {
+		return m.ToChainProxyHash
+	}
+	return nil
+}
+
+type MsgBindProxyHashResponse struct {
+}
+
+func (m *MsgBindProxyHashResponse) Reset()         { *m = MsgBindProxyHashResponse{} }
+func (m *MsgBindProxyHashResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBindProxyHashResponse) ProtoMessage()    {}
+func (*MsgBindProxyHashResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1279d03cd0d7555, []int{5}
+}
+func (m *MsgBindProxyHashResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBindProxyHashResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBindProxyHashResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBindProxyHashResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBindProxyHashResponse.Merge(m, src)
+}
+func (m *MsgBindProxyHashResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBindProxyHashResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBindProxyHashResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBindProxyHashResponse proto.InternalMessageInfo
+
+type MsgBindAssetHash struct {
+	Operator         string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	SourceAssetDenom string `protobuf:"bytes,2,opt,name=source_asset_denom,json=sourceAssetDenom,proto3" json:"source_asset_denom,omitempty"`
+	ToChainId        uint64 `protobuf:"varint,3,opt,name=to_chain_id,json=toChainId,proto3" json:"to_chain_id,omitempty"`
+	ToAssetHash      []byte `protobuf:"bytes,4,opt,name=to_asset_hash,json=toAssetHash,proto3" json:"to_asset_hash,omitempty"`
+	SourceDecimals   uint32 `protobuf:"varint,5,opt,name=source_decimals,json=sourceDecimals,proto3" json:"source_decimals,omitempty"`
+	ToDecimals       uint32 `protobuf:"varint,6,opt,name=to_decimals,json=toDecimals,proto3" json:"to_decimals,omitempty"`
+}
+
+func (m *MsgBindAssetHash) Reset()         { *m = MsgBindAssetHash{} }
+func (m *MsgBindAssetHash) String() string { return proto.CompactTextString(m) }
+func (*MsgBindAssetHash) ProtoMessage()    {}
+func (*MsgBindAssetHash) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1279d03cd0d7555, []int{6}
+}
+func (m *MsgBindAssetHash) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBindAssetHash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBindAssetHash.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBindAssetHash) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBindAssetHash.Merge(m, src)
+}
+func (m *MsgBindAssetHash) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBindAssetHash) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBindAssetHash.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBindAssetHash proto.InternalMessageInfo
+
+func (m *MsgBindAssetHash) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *MsgBindAssetHash) GetSourceAssetDenom() string {
+	if m != nil {
+		return m.SourceAssetDenom
+	}
+	return ""
+}
+
+func (m *MsgBindAssetHash) GetToChainId() uint64 {
+	if m != nil {
+		return m.ToChainId
+	}
+	return 0
+}
+
+func (m *MsgBindAssetHash) GetToAssetHash() []byte {
+	if m != nil {
+		return m.ToAssetHash
+	}
+	return nil
+}
+
+func (m *MsgBindAssetHash) GetSourceDecimals() uint32 {
+	if m != nil {
+		return m.SourceDecimals
+	}
+	return 0
+}
+
+func (m *MsgBindAssetHash) GetToDecimals() uint32 {
+	if m != nil {
+		return m.ToDecimals
+	}
+	return 0
+}
+
+type MsgBindAssetHashResponse struct {
+}
+
+func (m *MsgBindAssetHashResponse) Reset()         { *m = MsgBindAssetHashResponse{} }
+func (m *MsgBindAssetHashResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBindAssetHashResponse) ProtoMessage()    {}
+func (*MsgBindAssetHashResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1279d03cd0d7555, []int{7}
+}
+func (m *MsgBindAssetHashResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBindAssetHashResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBindAssetHashResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBindAssetHashResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBindAssetHashResponse.Merge(m, src)
+}
+func (m *MsgBindAssetHashResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBindAssetHashResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBindAssetHashResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBindAssetHashResponse proto.InternalMessageInfo
+
+type MsgUnbindProxyHash struct {
+	Operator  string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	ToChainId uint64 `protobuf:"varint,2,opt,name=to_chain_id,json=toChainId,proto3" json:"to_chain_id,omitempty"`
+}
+
+func (m *MsgUnbindProxyHash) Reset()         { *m = MsgUnbindProxyHash{} }
+func (m *MsgUnbindProxyHash) String() string { return proto.CompactTextString(m) }
+func (*MsgUnbindProxyHash) ProtoMessage()    {}
+func (*MsgUnbindProxyHash) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1279d03cd0d7555, []int{8}
+}
+func (m *MsgUnbindProxyHash) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnbindProxyHash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnbindProxyHash.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnbindProxyHash) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnbindProxyHash.Merge(m, src)
+}
+func (m *MsgUnbindProxyHash) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnbindProxyHash) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnbindProxyHash.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnbindProxyHash proto.InternalMessageInfo
+
+func (m *MsgUnbindProxyHash) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *MsgUnbindProxyHash) GetToChainId() uint64 {
+	if m != nil {
+		return m.ToChainId
+	}
+	return 0
+}
+
+type MsgUnbindProxyHashResponse struct {
+}
+
+func (m *MsgUnbindProxyHashResponse) Reset()         { *m = MsgUnbindProxyHashResponse{} }
+func (m *MsgUnbindProxyHashResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnbindProxyHashResponse) ProtoMessage()    {}
+func (*MsgUnbindProxyHashResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1279d03cd0d7555, []int{9}
+}
+func (m *MsgUnbindProxyHashResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnbindProxyHashResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnbindProxyHashResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnbindProxyHashResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnbindProxyHashResponse.Merge(m, src)
+}
+func (m *MsgUnbindProxyHashResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnbindProxyHashResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnbindProxyHashResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnbindProxyHashResponse proto.InternalMessageInfo
+
+type MsgUnbindAssetHash struct {
+	Operator         string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	SourceAssetDenom string `protobuf:"bytes,2,opt,name=source_asset_denom,json=sourceAssetDenom,proto3" json:"source_asset_denom,omitempty"`
+	ToChainId        uint64 `protobuf:"varint,3,opt,name=to_chain_id,json=toChainId,proto3" json:"to_chain_id,omitempty"`
+}
+
+func (m *MsgUnbindAssetHash) Reset()         { *m = MsgUnbindAssetHash{} }
+func (m *MsgUnbindAssetHash) String() string { return proto.CompactTextString(m) }
+func (*MsgUnbindAssetHash) ProtoMessage()    {}
+func (*MsgUnbindAssetHash) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1279d03cd0d7555, []int{10}
+}
+func (m *MsgUnbindAssetHash) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnbindAssetHash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnbindAssetHash.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnbindAssetHash) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnbindAssetHash.Merge(m, src)
+}
+func (m *MsgUnbindAssetHash) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnbindAssetHash) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnbindAssetHash.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnbindAssetHash proto.InternalMessageInfo
+
+func (m *MsgUnbindAssetHash) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *MsgUnbindAssetHash) GetSourceAssetDenom() string {
+	if m != nil {
+		return m.SourceAssetDenom
+	}
+	return ""
+}
+
+func (m *MsgUnbindAssetHash) GetToChainId() uint64 {
+	if m != nil {
+		return m.ToChainId
+	}
+	return 0
+}
+
+type MsgUnbindAssetHashResponse struct {
+}
+
+func (m *MsgUnbindAssetHashResponse) Reset()         { *m = MsgUnbindAssetHashResponse{} }
+func (m *MsgUnbindAssetHashResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnbindAssetHashResponse) ProtoMessage()    {}
+func (*MsgUnbindAssetHashResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1279d03cd0d7555, []int{11}
+}
+func (m *MsgUnbindAssetHashResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnbindAssetHashResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnbindAssetHashResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnbindAssetHashResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnbindAssetHashResponse.Merge(m, src)
+}
+func (m *MsgUnbindAssetHashResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnbindAssetHashResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnbindAssetHashResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnbindAssetHashResponse proto.InternalMessageInfo
+
+type MsgSettleAssetDecimals struct {
+	Operator         string `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	SourceAssetDenom string `protobuf:"bytes,2,opt,name=source_asset_denom,json=sourceAssetDenom,proto3" json:"source_asset_denom,omitempty"`
+	ChainId          uint64 `protobuf:"varint,3,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (m *MsgSettleAssetDecimals) Reset()         { *m = MsgSettleAssetDecimals{} }
+func (m *MsgSettleAssetDecimals) String() string { return proto.CompactTextString(m) }
+func (*MsgSettleAssetDecimals) ProtoMessage()    {}
+func (*MsgSettleAssetDecimals) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1279d03cd0d7555, []int{12}
+}
+func (m *MsgSettleAssetDecimals) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSettleAssetDecimals) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSettleAssetDecimals.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSettleAssetDecimals) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSettleAssetDecimals.Merge(m, src)
+}
+func (m *MsgSettleAssetDecimals) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSettleAssetDecimals) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSettleAssetDecimals.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSettleAssetDecimals proto.InternalMessageInfo
+
+func (m *MsgSettleAssetDecimals) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *MsgSettleAssetDecimals) GetSourceAssetDenom() string {
+	if m != nil {
+		return m.SourceAssetDenom
+	}
+	return ""
+}
+
+func (m *MsgSettleAssetDecimals) GetChainId() uint64 {
+	if m != nil {
+		return m.ChainId
+	}
+	return 0
+}
+
+type MsgSettleAssetDecimalsResponse struct {
+}
+
+func (m *MsgSettleAssetDecimalsResponse) Reset()         { *m = MsgSettleAssetDecimalsResponse{} }
+func (m *MsgSettleAssetDecimalsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSettleAssetDecimalsResponse) ProtoMessage()    {}
+func (*MsgSettleAssetDecimalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1279d03cd0d7555, []int{13}
+}
+func (m *MsgSettleAssetDecimalsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSettleAssetDecimalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSettleAssetDecimalsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSettleAssetDecimalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSettleAssetDecimalsResponse.Merge(m, src)
+}
+func (m *MsgSettleAssetDecimalsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSettleAssetDecimalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSettleAssetDecimalsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSettleAssetDecimalsResponse proto.InternalMessageInfo
+
+type MsgCancelBindingChange struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Id     uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgCancelBindingChange) Reset()         { *m = MsgCancelBindingChange{} }
+func (m *MsgCancelBindingChange) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelBindingChange) ProtoMessage()    {}
+func (*MsgCancelBindingChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1279d03cd0d7555, []int{14}
+}
+func (m *MsgCancelBindingChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelBindingChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelBindingChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelBindingChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelBindingChange.Merge(m, src)
+}
+func (m *MsgCancelBindingChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelBindingChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelBindingChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelBindingChange proto.InternalMessageInfo
+
+func (m *MsgCancelBindingChange) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgCancelBindingChange) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type MsgCancelBindingChangeResponse struct {
+}
+
+func (m *MsgCancelBindingChangeResponse) Reset()         { *m = MsgCancelBindingChangeResponse{} }
+func (m *MsgCancelBindingChangeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelBindingChangeResponse) ProtoMessage()    {}
+func (*MsgCancelBindingChangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1279d03cd0d7555, []int{15}
+}
+func (m *MsgCancelBindingChangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelBindingChangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelBindingChangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelBindingChangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelBindingChangeResponse.Merge(m, src)
+}
+func (m *MsgCancelBindingChangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelBindingChangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelBindingChangeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelBindingChangeResponse proto.InternalMessageInfo
+
+type MsgApproveOperatorAction struct {
+	Member        string          `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	LockProxyHash []byte          `protobuf:"bytes,2,opt,name=lock_proxy_hash,json=lockProxyHash,proto3" json:"lock_proxy_hash,omitempty"`
+	Action        *OperatorAction `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+}
+
+func (m *MsgApproveOperatorAction) Reset()         { *m = MsgApproveOperatorAction{} }
+func (m *MsgApproveOperatorAction) String() string { return proto.CompactTextString(m) }
+func (*MsgApproveOperatorAction) ProtoMessage()    {}
+func (*MsgApproveOperatorAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1279d03cd0d7555, []int{16}
+}
+func (m *MsgApproveOperatorAction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgApproveOperatorAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApproveOperatorAction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgApproveOperatorAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveOperatorAction.Merge(m, src)
+}
+func (m *MsgApproveOperatorAction) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgApproveOperatorAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveOperatorAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgApproveOperatorAction proto.InternalMessageInfo
+
+func (m *MsgApproveOperatorAction) GetMember() string {
+	if m != nil {
+		return m.Member
+	}
+	return ""
+}
+
+func (m *MsgApproveOperatorAction) GetLockProxyHash() []byte {
+	if m != nil {
+		return m.LockProxyHash
+	}
+	return nil
+}
+
+func (m *MsgApproveOperatorAction) GetAction() *OperatorAction {
+	if m != nil {
+		return m.Action
+	}
+	return nil
+}
+
+type MsgApproveOperatorActionResponse struct {
+}
+
+func (m *MsgApproveOperatorActionResponse) Reset()         { *m = MsgApproveOperatorActionResponse{} }
+func (m *MsgApproveOperatorActionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgApproveOperatorActionResponse) ProtoMessage()    {}
+func (*MsgApproveOperatorActionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1279d03cd0d7555, []int{17}
+}
+func (m *MsgApproveOperatorActionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgApproveOperatorActionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApproveOperatorActionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgApproveOperatorActionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveOperatorActionResponse.Merge(m, src)
+}
+func (m *MsgApproveOperatorActionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgApproveOperatorActionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveOperatorActionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgApproveOperatorActionResponse proto.InternalMessageInfo
+
+type MsgTransferOwnership struct {
+	Member        string   `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	LockProxyHash []byte   `protobuf:"bytes,2,opt,name=lock_proxy_hash,json=lockProxyHash,proto3" json:"lock_proxy_hash,omitempty"`
+	Members       []string `protobuf:"bytes,3,rep,name=members,proto3" json:"members,omitempty"`
+	Threshold     uint64   `protobuf:"varint,4,opt,name=threshold,proto3" json:"threshold,omitempty"`
+}
+
+func (m *MsgTransferOwnership) Reset()         { *m = MsgTransferOwnership{} }
+func (m *MsgTransferOwnership) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferOwnership) ProtoMessage()    {}
+func (*MsgTransferOwnership) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1279d03cd0d7555, []int{18}
+}
+func (m *MsgTransferOwnership) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferOwnership) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferOwnership.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferOwnership) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferOwnership.Merge(m, src)
+}
+func (m *MsgTransferOwnership) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferOwnership) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferOwnership.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferOwnership proto.InternalMessageInfo
+
+func (m *MsgTransferOwnership) GetMember() string {
+	if m != nil {
+		return m.Member
+	}
+	return ""
+}
+
+func (m *MsgTransferOwnership) GetLockProxyHash() []byte {
+	if m != nil {
+		return m.LockProxyHash
+	}
+	return nil
+}
+
+func (m *MsgTransferOwnership) GetMembers() []string {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
+func (m *MsgTransferOwnership) GetThreshold() uint64 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+type MsgTransferOwnershipResponse struct {
+}
+
+func (m *MsgTransferOwnershipResponse) Reset()         { *m = MsgTransferOwnershipResponse{} }
+func (m *MsgTransferOwnershipResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferOwnershipResponse) ProtoMessage()    {}
+func (*MsgTransferOwnershipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1279d03cd0d7555, []int{19}
+}
+func (m *MsgTransferOwnershipResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferOwnershipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferOwnershipResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferOwnershipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferOwnershipResponse.Merge(m, src)
+}
+func (m *MsgTransferOwnershipResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferOwnershipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferOwnershipResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferOwnershipResponse proto.InternalMessageInfo
+
+type MsgSetUnlockActionOptIn struct {
+	Receiver string `protobuf:"bytes,1,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	Action   string `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	OptIn    bool   `protobuf:"varint,3,opt,name=opt_in,json=optIn,proto3" json:"opt_in,omitempty"`
+}
+
+func (m *MsgSetUnlockActionOptIn) Reset()         { *m = MsgSetUnlockActionOptIn{} }
+func (m *MsgSetUnlockActionOptIn) String() string { return proto.CompactTextString(m) }
+func (*MsgSetUnlockActionOptIn) ProtoMessage()    {}
+func (*MsgSetUnlockActionOptIn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1279d03cd0d7555, []int{20}
+}
+func (m *MsgSetUnlockActionOptIn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetUnlockActionOptIn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetUnlockActionOptIn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetUnlockActionOptIn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetUnlockActionOptIn.Merge(m, src)
+}
+func (m *MsgSetUnlockActionOptIn) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetUnlockActionOptIn) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetUnlockActionOptIn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetUnlockActionOptIn proto.InternalMessageInfo
+
+func (m *MsgSetUnlockActionOptIn) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *MsgSetUnlockActionOptIn) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *MsgSetUnlockActionOptIn) GetOptIn() bool {
+	if m != nil {
+		return m.OptIn
+	}
+	return false
+}
+
+type MsgSetUnlockActionOptInResponse struct {
+}
+
+func (m *MsgSetUnlockActionOptInResponse) Reset()         { *m = MsgSetUnlockActionOptInResponse{} }
+func (m *MsgSetUnlockActionOptInResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetUnlockActionOptInResponse) ProtoMessage()    {}
+func (*MsgSetUnlockActionOptInResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1279d03cd0d7555, []int{21}
+}
+func (m *MsgSetUnlockActionOptInResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetUnlockActionOptInResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetUnlockActionOptInResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetUnlockActionOptInResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetUnlockActionOptInResponse.Merge(m, src)
+}
+func (m *MsgSetUnlockActionOptInResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetUnlockActionOptInResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetUnlockActionOptInResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetUnlockActionOptInResponse proto.InternalMessageInfo
+
+type MsgLock struct {
+	LockProxyHash    []byte `protobuf:"bytes,1,opt,name=lock_proxy_hash,json=lockProxyHash,proto3" json:"lock_proxy_hash,omitempty"`
+	FromAddress      string `protobuf:"bytes,2,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty"`
+	SourceAssetDenom string `protobuf:"bytes,3,opt,name=source_asset_denom,json=sourceAssetDenom,proto3" json:"source_asset_denom,omitempty"`
+	ToChainId        uint64 `protobuf:"varint,4,opt,name=to_chain_id,json=toChainId,proto3" json:"to_chain_id,omitempty"`
+	ToAddressBs      []byte `protobuf:"bytes,5,opt,name=to_address_bs,json=toAddressBs,proto3" json:"to_address_bs,omitempty"`
+	Value            string `protobuf:"bytes,6,opt,name=value,proto3" json:"value,omitempty"`
+	Action           []byte `protobuf:"bytes,7,opt,name=action,proto3" json:"action,omitempty"`
+}
+
+func (m *MsgLock) Reset()         { *m = MsgLock{} }
+func (m *MsgLock) String() string { return proto.CompactTextString(m) }
+func (*MsgLock) ProtoMessage()    {}
+func (*MsgLock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1279d03cd0d7555, []int{22}
+}
+func (m *MsgLock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgLock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgLock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLock.Merge(m, src)
+}
+func (m *MsgLock) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgLock) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgLock proto.InternalMessageInfo
+
+func (m *MsgLock) GetLockProxyHash() []byte {
+	if m != nil {
+		return m.LockProxyHash
+	}
+	return nil
+}
+
+func (m *MsgLock) GetFromAddress() string {
+	if m != nil {
+		return m.FromAddress
+	}
+	return ""
+}
+
+func (m *MsgLock) GetSourceAssetDenom() string {
+	if m != nil {
+		return m.SourceAssetDenom
+	}
+	return ""
+}
+
+func (m *MsgLock) GetToChainId() uint64 {
+	if m != nil {
+		return m.ToChainId
+	}
+	return 0
+}
+
+func (m *MsgLock) GetToAddressBs() []byte {
+	if m != nil {
+		return m.ToAddressBs
+	}
+	return nil
+}
+
+func (m *MsgLock) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *MsgLock) GetAction() []byte {
+	if m != nil {
+		return m.Action
+	}
+	return nil
+}
+
+type MsgLockResponse struct {
+}
+
+func (m *MsgLockResponse) Reset()         { *m = MsgLockResponse{} }
+func (m *MsgLockResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgLockResponse) ProtoMessage()    {}
+func (*MsgLockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1279d03cd0d7555, []int{23}
+}
+func (m *MsgLockResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgLockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLockResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgLockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLockResponse.Merge(m, src)
+}
+func (m *MsgLockResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgLockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLockResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgLockResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgCreateLockProxy)(nil), "polynetwork.lockproxy.v1.MsgCreateLockProxy")
+	proto.RegisterType((*MsgCreateLockProxyResponse)(nil), "polynetwork.lockproxy.v1.MsgCreateLockProxyResponse")
+	proto.RegisterType((*MsgCreateCoinAndDelegateToProxy)(nil), "polynetwork.lockproxy.v1.MsgCreateCoinAndDelegateToProxy")
+	proto.RegisterType((*MsgCreateCoinAndDelegateToProxyResponse)(nil), "polynetwork.lockproxy.v1.MsgCreateCoinAndDelegateToProxyResponse")
+	proto.RegisterType((*MsgBindProxyHash)(nil), "polynetwork.lockproxy.v1.MsgBindProxyHash")
+	proto.RegisterType((*MsgBindProxyHashResponse)(nil), "polynetwork.lockproxy.v1.MsgBindProxyHashResponse")
+	proto.RegisterType((*MsgBindAssetHash)(nil), "polynetwork.lockproxy.v1.MsgBindAssetHash")
+	proto.RegisterType((*MsgBindAssetHashResponse)(nil), "polynetwork.lockproxy.v1.MsgBindAssetHashResponse")
+	proto.RegisterType((*MsgUnbindProxyHash)(nil), "polynetwork.lockproxy.v1.MsgUnbindProxyHash")
+	proto.RegisterType((*MsgUnbindProxyHashResponse)(nil), "polynetwork.lockproxy.v1.MsgUnbindProxyHashResponse")
+	proto.RegisterType((*MsgUnbindAssetHash)(nil), "polynetwork.lockproxy.v1.MsgUnbindAssetHash")
+	proto.RegisterType((*MsgUnbindAssetHashResponse)(nil), "polynetwork.lockproxy.v1.MsgUnbindAssetHashResponse")
+	proto.RegisterType((*MsgSettleAssetDecimals)(nil), "polynetwork.lockproxy.v1.MsgSettleAssetDecimals")
+	proto.RegisterType((*MsgSettleAssetDecimalsResponse)(nil), "polynetwork.lockproxy.v1.MsgSettleAssetDecimalsResponse")
+	proto.RegisterType((*MsgCancelBindingChange)(nil), "polynetwork.lockproxy.v1.MsgCancelBindingChange")
+	proto.RegisterType((*MsgCancelBindingChangeResponse)(nil), "polynetwork.lockproxy.v1.MsgCancelBindingChangeResponse")
+	proto.RegisterType((*MsgApproveOperatorAction)(nil), "polynetwork.lockproxy.v1.MsgApproveOperatorAction")
+	proto.RegisterType((*MsgApproveOperatorActionResponse)(nil), "polynetwork.lockproxy.v1.MsgApproveOperatorActionResponse")
+	proto.RegisterType((*MsgTransferOwnership)(nil), "polynetwork.lockproxy.v1.MsgTransferOwnership")
+	proto.RegisterType((*MsgTransferOwnershipResponse)(nil), "polynetwork.lockproxy.v1.MsgTransferOwnershipResponse")
+	proto.RegisterType((*MsgSetUnlockActionOptIn)(nil), "polynetwork.lockproxy.v1.MsgSetUnlockActionOptIn")
+	proto.RegisterType((*MsgSetUnlockActionOptInResponse)(nil), "polynetwork.lockproxy.v1.MsgSetUnlockActionOptInResponse")
+	proto.RegisterType((*MsgLock)(nil), "polynetwork.lockproxy.v1.MsgLock")
+	proto.RegisterType((*MsgLockResponse)(nil), "polynetwork.lockproxy.v1.MsgLockResponse")
+}
+
+func init() { proto.RegisterFile("polynetwork/lockproxy/v1/tx.proto", fileDescriptor_e1279d03cd0d7555) }
+
+var fileDescriptor_e1279d03cd0d7555 = []byte{
+	// 1039 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0x4d, 0x6f, 0xdb, 0x46,
+	0x10, 0x35, 0x2d, 0xd9, 0xb2, 0x46, 0x71, 0xe4, 0x30, 0x4e, 0xca, 0xb2, 0x86, 0x2c, 0x13, 0x45,
+	0xa3, 0x14, 0xb1, 0x54, 0xab, 0x46, 0x91, 0xb4, 0x97, 0xc8, 0xf2, 0xa1, 0x01, 0x2a, 0x38, 0x60,
+	0x9d, 0x4b, 0x51, 0x40, 0xa0, 0xc8, 0x0d, 0x45, 0x98, 0xda, 0x65, 0xb9, 0x2b, 0x25, 0x46, 0x91,
+	0x02, 0x05, 0x0a, 0xf4, 0x56, 0xf4, 0xd0, 0x63, 0x7e, 0x50, 0x8f, 0xb9, 0xb5, 0xc7, 0xc2, 0xbe,
+	0xf5, 0xdc, 0x1f, 0x50, 0xec, 0x92, 0x5c, 0x7d, 0x51, 0x5f, 0x6d, 0x80, 0xdc, 0x34, 0xc3, 0x37,
+	0xf3, 0xde, 0xcc, 0x8e, 0x76, 0xb0, 0x70, 0x10, 0x10, 0xff, 0x12, 0x23, 0xf6, 0x82, 0x84, 0x17,
+	0x35, 0x9f, 0xd8, 0x17, 0x41, 0x48, 0x5e, 0x5e, 0xd6, 0x06, 0x47, 0x35, 0xf6, 0xb2, 0x1a, 0x84,
+	0x84, 0x11, 0x55, 0x1b, 0x81, 0x54, 0x25, 0xa4, 0x3a, 0x38, 0xd2, 0x8d, 0xd1, 0x60, 0x9b, 0xf4,
+	0x7a, 0x04, 0xf3, 0xc8, 0xe8, 0x57, 0x14, 0xad, 0x7f, 0x38, 0x93, 0xe0, 0xbb, 0x3e, 0x0a, 0x2f,
+	0x23, 0x94, 0x51, 0x05, 0xb5, 0x45, 0xdd, 0x66, 0x88, 0x2c, 0x86, 0xbe, 0x22, 0xf6, 0xc5, 0x53,
+	0x0e, 0x52, 0x35, 0xc8, 0xd9, 0xdc, 0x45, 0x42, 0x4d, 0x29, 0x2b, 0x95, 0xbc, 0x99, 0x98, 0xc6,
+	0x1e, 0xe8, 0xd3, 0x78, 0x13, 0xd1, 0x80, 0x60, 0x8a, 0x8c, 0xdf, 0x14, 0xd8, 0x97, 0x9f, 0x9b,
+	0xc4, 0xc3, 0x0d, 0xec, 0x9c, 0x22, 0x1f, 0xb9, 0x16, 0x43, 0xe7, 0x64, 0x41, 0x6e, 0xb5, 0x06,
+	0x59, 0x9b, 0x78, 0x58, 0x5b, 0x2f, 0x2b, 0x95, 0x42, 0xfd, 0x83, 0xea, 0x68, 0xf9, 0x71, 0x69,
+	0x83, 0xa3, 0x2a, 0x4f, 0x6b, 0x0a, 0xa0, 0xfa, 0x11, 0x14, 0x79, 0x61, 0x6d, 0x51, 0x59, 0xbb,
+	0x6b, 0xd1, 0xae, 0x96, 0x29, 0x2b, 0x95, 0x1b, 0xe6, 0xb6, 0x9f, 0x48, 0xfb, 0xd2, 0xa2, 0x5d,
+	0xe3, 0x3e, 0xdc, 0x5b, 0xa0, 0x4a, 0x56, 0xf0, 0x0a, 0x76, 0x5a, 0xd4, 0x3d, 0xf1, 0xb0, 0x23,
+	0xc3, 0x55, 0x1d, 0xb6, 0x48, 0x80, 0xc2, 0x11, 0xc9, 0xd2, 0x56, 0x4b, 0x50, 0x60, 0xa4, 0x6d,
+	0x77, 0x2d, 0x0f, 0xb7, 0x3d, 0x47, 0x48, 0xcf, 0x9a, 0x79, 0x46, 0x9a, 0xdc, 0xf3, 0xc4, 0x51,
+	0x0f, 0xe1, 0xb6, 0xfc, 0x3e, 0x25, 0x73, 0x27, 0xc6, 0x0d, 0x95, 0xea, 0xa0, 0x4d, 0xd2, 0x4b,
+	0x69, 0x7f, 0x2b, 0x52, 0x5b, 0x83, 0x52, 0xc4, 0x16, 0x6a, 0x7b, 0x00, 0x2a, 0x25, 0xfd, 0xd0,
+	0x46, 0x6d, 0x8b, 0xe3, 0xdb, 0x0e, 0xc2, 0xa4, 0x27, 0x24, 0xe6, 0xcd, 0x9d, 0xe8, 0x8b, 0x48,
+	0x74, 0xca, 0xfd, 0x93, 0x95, 0x64, 0x26, 0x2b, 0x31, 0x60, 0x9b, 0x91, 0x38, 0x93, 0xa8, 0x21,
+	0x2b, 0x6a, 0x28, 0x30, 0x32, 0x54, 0x73, 0x0f, 0x8a, 0x31, 0xa3, 0x83, 0x6c, 0xaf, 0x67, 0xf9,
+	0x54, 0xdb, 0x28, 0x2b, 0x95, 0x6d, 0xf3, 0x66, 0xe4, 0x3e, 0x8d, 0xbd, 0xea, 0xbe, 0x20, 0x93,
+	0xa0, 0x4d, 0x01, 0x02, 0x46, 0x12, 0xc0, 0x48, 0x23, 0x64, 0x76, 0xd9, 0x88, 0xa7, 0x62, 0x66,
+	0x9f, 0xe1, 0xce, 0xdb, 0x3a, 0xa5, 0x78, 0xaa, 0x27, 0x32, 0x4a, 0xbe, 0x1f, 0x46, 0xf8, 0xde,
+	0x41, 0xe7, 0xc7, 0xd4, 0x4d, 0x77, 0xe3, 0x15, 0xdc, 0x6d, 0x51, 0xf7, 0x6b, 0xc4, 0x98, 0x9f,
+	0x24, 0x8d, 0x9b, 0xfc, 0xf6, 0x14, 0xbe, 0x0f, 0x5b, 0x13, 0xf2, 0x72, 0x76, 0x2c, 0xae, 0x0c,
+	0xa5, 0x74, 0x7a, 0x29, 0xf0, 0xb1, 0x10, 0xd8, 0xb4, 0xb0, 0x8d, 0x7c, 0x7e, 0xa0, 0x1e, 0x76,
+	0x9b, 0x5d, 0x0b, 0xbb, 0x48, 0xbd, 0x0b, 0x9b, 0xd4, 0x73, 0x31, 0x4a, 0xe4, 0xc5, 0x96, 0x7a,
+	0x13, 0xd6, 0xe5, 0x29, 0xad, 0x7b, 0x09, 0x47, 0x4a, 0x06, 0xc9, 0xf1, 0x5a, 0x11, 0xf3, 0xd2,
+	0x08, 0x82, 0x90, 0x0c, 0xd0, 0x59, 0x5c, 0x65, 0xc3, 0x66, 0x1e, 0xc1, 0x9c, 0xa6, 0x87, 0x7a,
+	0x9d, 0x21, 0x4d, 0x64, 0xa5, 0x5d, 0x1f, 0xeb, 0x29, 0xd7, 0x87, 0xfa, 0x18, 0x36, 0x2d, 0x91,
+	0x49, 0xd4, 0x5e, 0xa8, 0x57, 0xaa, 0xb3, 0x2e, 0xe6, 0xea, 0x38, 0xb3, 0x19, 0xc7, 0x19, 0x06,
+	0x94, 0x67, 0xa9, 0x93, 0x25, 0xfc, 0xa2, 0xc0, 0x6e, 0x8b, 0xba, 0xe7, 0xa1, 0x85, 0xe9, 0x73,
+	0x14, 0x9e, 0xbd, 0xc0, 0x28, 0xa4, 0x5d, 0x2f, 0xf8, 0xdf, 0xf2, 0x35, 0xc8, 0x45, 0x11, 0x54,
+	0xcb, 0x94, 0x33, 0xfc, 0xc2, 0x8d, 0x4d, 0x75, 0x0f, 0xf2, 0xac, 0x1b, 0x22, 0xda, 0x25, 0xbe,
+	0xa3, 0x65, 0xe3, 0xb1, 0x4b, 0x1c, 0x46, 0x09, 0xf6, 0xd2, 0xf4, 0x48, 0xc1, 0x0e, 0xbc, 0x17,
+	0x9d, 0xfc, 0x33, 0xcc, 0x09, 0xa3, 0x72, 0xce, 0x02, 0xf6, 0x04, 0xf3, 0xc9, 0x0b, 0x91, 0x8d,
+	0xbc, 0x81, 0x14, 0x2d, 0x6d, 0x5e, 0x4e, 0xdc, 0xcd, 0x68, 0xda, 0x62, 0x4b, 0xbd, 0x03, 0x9b,
+	0x24, 0x60, 0x6d, 0x2f, 0xea, 0xf2, 0x96, 0xb9, 0x41, 0x78, 0x2a, 0xe3, 0x00, 0xf6, 0x67, 0xb0,
+	0x48, 0x21, 0xff, 0x28, 0x90, 0x6b, 0x51, 0x97, 0xaf, 0xa3, 0xb4, 0xa6, 0x28, 0x69, 0x4d, 0x39,
+	0x80, 0x1b, 0xcf, 0x43, 0xd2, 0x6b, 0x5b, 0x8e, 0x13, 0x22, 0x4a, 0x63, 0x2d, 0x05, 0xee, 0x6b,
+	0x44, 0xae, 0x19, 0x7f, 0x91, 0xcc, 0x72, 0x7f, 0xe2, 0xec, 0x8c, 0xeb, 0x33, 0xca, 0xdd, 0xee,
+	0x44, 0x17, 0x63, 0x74, 0x7d, 0x46, 0xbe, 0x13, 0xaa, 0xee, 0xc2, 0xc6, 0xc0, 0xf2, 0xfb, 0x48,
+	0xdc, 0x87, 0x79, 0x33, 0x32, 0x46, 0x1a, 0x96, 0x13, 0x21, 0xc9, 0x50, 0xdd, 0x82, 0x62, 0x5c,
+	0x75, 0xd2, 0x89, 0xfa, 0x1f, 0x05, 0xc8, 0xb4, 0xa8, 0xab, 0xf6, 0xa1, 0x38, 0xb9, 0xd2, 0x1f,
+	0xcc, 0x1e, 0xda, 0xe9, 0x85, 0xae, 0x1f, 0xaf, 0x82, 0x4e, 0xe8, 0xd5, 0xd7, 0x0a, 0xec, 0xcd,
+	0xdd, 0xfd, 0x8f, 0x96, 0x48, 0x9b, 0x1e, 0xaa, 0x37, 0xfe, 0x73, 0xa8, 0x94, 0x47, 0x60, 0x7b,
+	0x7c, 0xb1, 0x7f, 0x3c, 0x37, 0xe7, 0x18, 0x56, 0xaf, 0x2f, 0x8f, 0x9d, 0x24, 0x1c, 0xee, 0x8c,
+	0xc5, 0x84, 0x12, 0xab, 0xd7, 0x97, 0xc7, 0x4a, 0xc2, 0x3e, 0x14, 0x27, 0xd7, 0xe2, 0xfc, 0x73,
+	0x9f, 0x40, 0xeb, 0xc7, 0xab, 0xa0, 0xa7, 0x69, 0x87, 0x95, 0x2e, 0x43, 0x3b, 0xac, 0xf5, 0x78,
+	0x15, 0xb4, 0xa4, 0xfd, 0x51, 0x81, 0xdb, 0x69, 0x7b, 0xef, 0x93, 0xb9, 0xd9, 0x52, 0x22, 0xf4,
+	0x87, 0xab, 0x46, 0x8c, 0x69, 0x48, 0x5b, 0x6d, 0xf3, 0x35, 0xa4, 0x44, 0xe8, 0x0f, 0x57, 0x8d,
+	0x90, 0x1a, 0x7e, 0x56, 0xe0, 0x4e, 0xfa, 0xe6, 0x9b, 0x3f, 0x43, 0xa9, 0x31, 0xfa, 0xe7, 0xab,
+	0xc7, 0x48, 0x25, 0xdf, 0xc3, 0xad, 0xe9, 0xfd, 0x55, 0x9d, 0x9b, 0x70, 0x0a, 0xaf, 0x7f, 0xb6,
+	0x1a, 0x5e, 0x92, 0xff, 0xa4, 0xc0, 0x6e, 0xea, 0x36, 0x3a, 0x5a, 0x74, 0xba, 0x53, 0x21, 0xfa,
+	0xa3, 0x95, 0x43, 0xa4, 0x8c, 0x73, 0xc8, 0x8a, 0x4d, 0x74, 0x30, 0x37, 0x05, 0x87, 0xe8, 0xf7,
+	0x17, 0x42, 0x92, 0xac, 0x27, 0xdf, 0xfe, 0x7e, 0x55, 0x52, 0xde, 0x5c, 0x95, 0x94, 0xbf, 0xae,
+	0x4a, 0xca, 0xaf, 0xd7, 0xa5, 0xb5, 0x37, 0xd7, 0xa5, 0xb5, 0x3f, 0xaf, 0x4b, 0x6b, 0xdf, 0x9c,
+	0xb8, 0x1e, 0xeb, 0xf6, 0x3b, 0xfc, 0x85, 0x54, 0x1b, 0x7f, 0x16, 0xd2, 0x1e, 0xa1, 0x87, 0xdc,
+	0x75, 0xd8, 0x23, 0x4e, 0xdf, 0x47, 0x23, 0xaf, 0xc0, 0xa0, 0xf3, 0x85, 0x34, 0x82, 0x4e, 0x67,
+	0x53, 0x3c, 0x06, 0x3f, 0xfd, 0x77, 0x00, 0x6d, 0xc8, 0x63, 0x71, 0x95, 0x0e, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	CreateLockProxy(ctx context.Context, in *MsgCreateLockProxy, opts ...grpc.CallOption) (*MsgCreateLockProxyResponse, error)
+	CreateCoinAndDelegateToProxy(ctx context.Context, in *MsgCreateCoinAndDelegateToProxy, opts ...grpc.CallOption) (*MsgCreateCoinAndDelegateToProxyResponse, error)
+	BindProxyHash(ctx context.Context, in *MsgBindProxyHash, opts ...grpc.CallOption) (*MsgBindProxyHashResponse, error)
+	BindAssetHash(ctx context.Context, in *MsgBindAssetHash, opts ...grpc.CallOption) (*MsgBindAssetHashResponse, error)
+	UnbindProxyHash(ctx context.Context, in *MsgUnbindProxyHash, opts ...grpc.CallOption) (*MsgUnbindProxyHashResponse, error)
+	UnbindAssetHash(ctx context.Context, in *MsgUnbindAssetHash, opts ...grpc.CallOption) (*MsgUnbindAssetHashResponse, error)
+	SettleAssetDecimals(ctx context.Context, in *MsgSettleAssetDecimals, opts ...grpc.CallOption) (*MsgSettleAssetDecimalsResponse, error)
+	CancelBindingChange(ctx context.Context, in *MsgCancelBindingChange, opts ...grpc.CallOption) (*MsgCancelBindingChangeResponse, error)
+	ApproveOperatorAction(ctx context.Context, in *MsgApproveOperatorAction, opts ...grpc.CallOption) (*MsgApproveOperatorActionResponse, error)
+	TransferOwnership(ctx context.Context, in *MsgTransferOwnership, opts ...grpc.CallOption) (*MsgTransferOwnershipResponse, error)
+	SetUnlockActionOptIn(ctx context.Context, in *MsgSetUnlockActionOptIn, opts ...grpc.CallOption) (*MsgSetUnlockActionOptInResponse, error)
+	Lock(ctx context.Context, in *MsgLock, opts ...grpc.CallOption) (*MsgLockResponse, error)
+}
+
+type msgClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewMsgClient(cc *grpc.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) CreateLockProxy(ctx context.Context, in *MsgCreateLockProxy, opts ...grpc.CallOption) (*MsgCreateLockProxyResponse, error) {
+	out := new(MsgCreateLockProxyResponse)
+	err := c.cc.Invoke(ctx, "/polynetwork.lockproxy.v1.Msg/CreateLockProxy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateCoinAndDelegateToProxy(ctx context.Context, in *MsgCreateCoinAndDelegateToProxy, opts ...grpc.CallOption) (*MsgCreateCoinAndDelegateToProxyResponse, error) {
+	out := new(MsgCreateCoinAndDelegateToProxyResponse)
+	err := c.cc.Invoke(ctx, "/polynetwork.lockproxy.v1.Msg/CreateCoinAndDelegateToProxy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BindProxyHash(ctx context.Context, in *MsgBindProxyHash, opts ...grpc.CallOption) (*MsgBindProxyHashResponse, error) {
+	out := new(MsgBindProxyHashResponse)
+	err := c.cc.Invoke(ctx, "/polynetwork.lockproxy.v1.Msg/BindProxyHash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BindAssetHash(ctx context.Context, in *MsgBindAssetHash, opts ...grpc.CallOption) (*MsgBindAssetHashResponse, error) {
+	out := new(MsgBindAssetHashResponse)
+	err := c.cc.Invoke(ctx, "/polynetwork.lockproxy.v1.Msg/BindAssetHash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnbindProxyHash(ctx context.Context, in *MsgUnbindProxyHash, opts ...grpc.CallOption) (*MsgUnbindProxyHashResponse, error) {
+	out := new(MsgUnbindProxyHashResponse)
+	err := c.cc.Invoke(ctx, "/polynetwork.lockproxy.v1.Msg/UnbindProxyHash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnbindAssetHash(ctx context.Context, in *MsgUnbindAssetHash, opts ...grpc.CallOption) (*MsgUnbindAssetHashResponse, error) {
+	out := new(MsgUnbindAssetHashResponse)
+	err := c.cc.Invoke(ctx, "/polynetwork.lockproxy.v1.Msg/UnbindAssetHash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SettleAssetDecimals(ctx context.Context, in *MsgSettleAssetDecimals, opts ...grpc.CallOption) (*MsgSettleAssetDecimalsResponse, error) {
+	out := new(MsgSettleAssetDecimalsResponse)
+	err := c.cc.Invoke(ctx, "/polynetwork.lockproxy.v1.Msg/SettleAssetDecimals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelBindingChange(ctx context.Context, in *MsgCancelBindingChange, opts ...grpc.CallOption) (*MsgCancelBindingChangeResponse, error) {
+	out := new(MsgCancelBindingChangeResponse)
+	err := c.cc.Invoke(ctx, "/polynetwork.lockproxy.v1.Msg/CancelBindingChange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ApproveOperatorAction(ctx context.Context, in *MsgApproveOperatorAction, opts ...grpc.CallOption) (*MsgApproveOperatorActionResponse, error) {
+	out := new(MsgApproveOperatorActionResponse)
+	err := c.cc.Invoke(ctx, "/polynetwork.lockproxy.v1.Msg/ApproveOperatorAction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TransferOwnership(ctx context.Context, in *MsgTransferOwnership, opts ...grpc.CallOption) (*MsgTransferOwnershipResponse, error) {
+	out := new(MsgTransferOwnershipResponse)
+	err := c.cc.Invoke(ctx, "/polynetwork.lockproxy.v1.Msg/TransferOwnership", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetUnlockActionOptIn(ctx context.Context, in *MsgSetUnlockActionOptIn, opts ...grpc.CallOption) (*MsgSetUnlockActionOptInResponse, error) {
+	out := new(MsgSetUnlockActionOptInResponse)
+	err := c.cc.Invoke(ctx, "/polynetwork.lockproxy.v1.Msg/SetUnlockActionOptIn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Lock(ctx context.Context, in *MsgLock, opts ...grpc.CallOption) (*MsgLockResponse, error) {
+	out := new(MsgLockResponse)
+	err := c.cc.Invoke(ctx, "/polynetwork.lockproxy.v1.Msg/Lock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	CreateLockProxy(context.Context, *MsgCreateLockProxy) (*MsgCreateLockProxyResponse, error)
+	CreateCoinAndDelegateToProxy(context.Context, *MsgCreateCoinAndDelegateToProxy) (*MsgCreateCoinAndDelegateToProxyResponse, error)
+	BindProxyHash(context.Context, *MsgBindProxyHash) (*MsgBindProxyHashResponse, error)
+	BindAssetHash(context.Context, *MsgBindAssetHash) (*MsgBindAssetHashResponse, error)
+	UnbindProxyHash(context.Context, *MsgUnbindProxyHash) (*MsgUnbindProxyHashResponse, error)
+	UnbindAssetHash(context.Context, *MsgUnbindAssetHash) (*MsgUnbindAssetHashResponse, error)
+	SettleAssetDecimals(context.Context, *MsgSettleAssetDecimals) (*MsgSettleAssetDecimalsResponse, error)
+	CancelBindingChange(context.Context, *MsgCancelBindingChange) (*MsgCancelBindingChangeResponse, error)
+	ApproveOperatorAction(context.Context, *MsgApproveOperatorAction) (*MsgApproveOperatorActionResponse, error)
+	TransferOwnership(context.Context, *MsgTransferOwnership) (*MsgTransferOwnershipResponse, error)
+	SetUnlockActionOptIn(context.Context, *MsgSetUnlockActionOptIn) (*MsgSetUnlockActionOptInResponse, error)
+	Lock(context.Context, *MsgLock) (*MsgLockResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) CreateLockProxy(ctx context.Context, req *MsgCreateLockProxy) (*MsgCreateLockProxyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateLockProxy not implemented")
+}
+func (*UnimplementedMsgServer) CreateCoinAndDelegateToProxy(ctx context.Context, req *MsgCreateCoinAndDelegateToProxy) (*MsgCreateCoinAndDelegateToProxyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCoinAndDelegateToProxy not implemented")
+}
+func (*UnimplementedMsgServer) BindProxyHash(ctx context.Context, req *MsgBindProxyHash) (*MsgBindProxyHashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BindProxyHash not implemented")
+}
+func (*UnimplementedMsgServer) BindAssetHash(ctx context.Context, req *MsgBindAssetHash) (*MsgBindAssetHashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BindAssetHash not implemented")
+}
+func (*UnimplementedMsgServer) UnbindProxyHash(ctx context.Context, req *MsgUnbindProxyHash) (*MsgUnbindProxyHashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbindProxyHash not implemented")
+}
+func (*UnimplementedMsgServer) UnbindAssetHash(ctx context.Context, req *MsgUnbindAssetHash) (*MsgUnbindAssetHashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbindAssetHash not implemented")
+}
+func (*UnimplementedMsgServer) SettleAssetDecimals(ctx context.Context, req *MsgSettleAssetDecimals) (*MsgSettleAssetDecimalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SettleAssetDecimals not implemented")
+}
+func (*UnimplementedMsgServer) CancelBindingChange(ctx context.Context, req *MsgCancelBindingChange) (*MsgCancelBindingChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelBindingChange not implemented")
+}
+func (*UnimplementedMsgServer) ApproveOperatorAction(ctx context.Context, req *MsgApproveOperatorAction) (*MsgApproveOperatorActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveOperatorAction not implemented")
+}
+func (*UnimplementedMsgServer) TransferOwnership(ctx context.Context, req *MsgTransferOwnership) (*MsgTransferOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferOwnership not implemented")
+}
+func (*UnimplementedMsgServer) SetUnlockActionOptIn(ctx context.Context, req *MsgSetUnlockActionOptIn) (*MsgSetUnlockActionOptInResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUnlockActionOptIn not implemented")
+}
+func (*UnimplementedMsgServer) Lock(ctx context.Context, req *MsgLock) (*MsgLockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Lock not implemented")
+}
+
+func RegisterMsgServer(s *grpc.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_CreateLockProxy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateLockProxy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateLockProxy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/polynetwork.lockproxy.v1.Msg/CreateLockProxy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateLockProxy(ctx, req.(*MsgCreateLockProxy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateCoinAndDelegateToProxy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateCoinAndDelegateToProxy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateCoinAndDelegateToProxy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/polynetwork.lockproxy.v1.Msg/CreateCoinAndDelegateToProxy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateCoinAndDelegateToProxy(ctx, req.(*MsgCreateCoinAndDelegateToProxy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BindProxyHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBindProxyHash)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BindProxyHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/polynetwork.lockproxy.v1.Msg/BindProxyHash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BindProxyHash(ctx, req.(*MsgBindProxyHash))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BindAssetHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBindAssetHash)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BindAssetHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/polynetwork.lockproxy.v1.Msg/BindAssetHash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BindAssetHash(ctx, req.(*MsgBindAssetHash))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnbindProxyHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnbindProxyHash)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnbindProxyHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/polynetwork.lockproxy.v1.Msg/UnbindProxyHash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnbindProxyHash(ctx, req.(*MsgUnbindProxyHash))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnbindAssetHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnbindAssetHash)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnbindAssetHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/polynetwork.lockproxy.v1.Msg/UnbindAssetHash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnbindAssetHash(ctx, req.(*MsgUnbindAssetHash))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SettleAssetDecimals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSettleAssetDecimals)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SettleAssetDecimals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/polynetwork.lockproxy.v1.Msg/SettleAssetDecimals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SettleAssetDecimals(ctx, req.(*MsgSettleAssetDecimals))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelBindingChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelBindingChange)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelBindingChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/polynetwork.lockproxy.v1.Msg/CancelBindingChange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelBindingChange(ctx, req.(*MsgCancelBindingChange))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ApproveOperatorAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgApproveOperatorAction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ApproveOperatorAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/polynetwork.lockproxy.v1.Msg/ApproveOperatorAction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ApproveOperatorAction(ctx, req.(*MsgApproveOperatorAction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TransferOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferOwnership)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/polynetwork.lockproxy.v1.Msg/TransferOwnership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferOwnership(ctx, req.(*MsgTransferOwnership))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetUnlockActionOptIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetUnlockActionOptIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetUnlockActionOptIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/polynetwork.lockproxy.v1.Msg/SetUnlockActionOptIn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetUnlockActionOptIn(ctx, req.(*MsgSetUnlockActionOptIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Lock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLock)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Lock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/polynetwork.lockproxy.v1.Msg/Lock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Lock(ctx, req.(*MsgLock))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "polynetwork.lockproxy.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateLockProxy",
+			Handler:    _Msg_CreateLockProxy_Handler,
+		},
+		{
+			MethodName: "CreateCoinAndDelegateToProxy",
+			Handler:    _Msg_CreateCoinAndDelegateToProxy_Handler,
+		},
+		{
+			MethodName: "BindProxyHash",
+			Handler:    _Msg_BindProxyHash_Handler,
+		},
+		{
+			MethodName: "BindAssetHash",
+			Handler:    _Msg_BindAssetHash_Handler,
+		},
+		{
+			MethodName: "UnbindProxyHash",
+			Handler:    _Msg_UnbindProxyHash_Handler,
+		},
+		{
+			MethodName: "UnbindAssetHash",
+			Handler:    _Msg_UnbindAssetHash_Handler,
+		},
+		{
+			MethodName: "SettleAssetDecimals",
+			Handler:    _Msg_SettleAssetDecimals_Handler,
+		},
+		{
+			MethodName: "CancelBindingChange",
+			Handler:    _Msg_CancelBindingChange_Handler,
+		},
+		{
+			MethodName: "ApproveOperatorAction",
+			Handler:    _Msg_ApproveOperatorAction_Handler,
+		},
+		{
+			MethodName: "TransferOwnership",
+			Handler:    _Msg_TransferOwnership_Handler,
+		},
+		{
+			MethodName: "SetUnlockActionOptIn",
+			Handler:    _Msg_SetUnlockActionOptIn_Handler,
+		},
+		{
+			MethodName: "Lock",
+			Handler:    _Msg_Lock_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "polynetwork/lockproxy/v1/tx.proto",
+}
+
+func (m *MsgCreateLockProxy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateLockProxy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateLockProxy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateLockProxyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateLockProxyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateLockProxyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateCoinAndDelegateToProxy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateCoinAndDelegateToProxy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateCoinAndDelegateToProxy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LockProxyHash) > 0 {
+		i -= len(m.LockProxyHash)
+		copy(dAtA[i:], m.LockProxyHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.LockProxyHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Coin != nil {
+		{
+			size, err := m.Coin.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateCoinAndDelegateToProxyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateCoinAndDelegateToProxyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateCoinAndDelegateToProxyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBindProxyHash) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBindProxyHash) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBindProxyHash) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ToChainProxyHash) > 0 {
+		i -= len(m.ToChainProxyHash)
+		copy(dAtA[i:], m.ToChainProxyHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ToChainProxyHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ToChainId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ToChainId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBindProxyHashResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBindProxyHashResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBindProxyHashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBindAssetHash) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBindAssetHash) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBindAssetHash) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ToDecimals != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ToDecimals))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.SourceDecimals != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SourceDecimals))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ToAssetHash) > 0 {
+		i -= len(m.ToAssetHash)
+		copy(dAtA[i:], m.ToAssetHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ToAssetHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ToChainId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ToChainId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.SourceAssetDenom) > 0 {
+		i -= len(m.SourceAssetDenom)
+		copy(dAtA[i:], m.SourceAssetDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SourceAssetDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBindAssetHashResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBindAssetHashResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBindAssetHashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnbindProxyHash) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnbindProxyHash) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnbindProxyHash) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ToChainId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ToChainId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnbindProxyHashResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnbindProxyHashResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnbindProxyHashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnbindAssetHash) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnbindAssetHash) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnbindAssetHash) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ToChainId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ToChainId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.SourceAssetDenom) > 0 {
+		i -= len(m.SourceAssetDenom)
+		copy(dAtA[i:], m.SourceAssetDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SourceAssetDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnbindAssetHashResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnbindAssetHashResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnbindAssetHashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSettleAssetDecimals) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSettleAssetDecimals) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSettleAssetDecimals) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChainId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ChainId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.SourceAssetDenom) > 0 {
+		i -= len(m.SourceAssetDenom)
+		copy(dAtA[i:], m.SourceAssetDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SourceAssetDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSettleAssetDecimalsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSettleAssetDecimalsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSettleAssetDecimalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelBindingChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelBindingChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelBindingChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelBindingChangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelBindingChangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelBindingChangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApproveOperatorAction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveOperatorAction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveOperatorAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Action != nil {
+		{
+			size, err := m.Action.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.LockProxyHash) > 0 {
+		i -= len(m.LockProxyHash)
+		copy(dAtA[i:], m.LockProxyHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.LockProxyHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Member) > 0 {
+		i -= len(m.Member)
+		copy(dAtA[i:], m.Member)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Member)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApproveOperatorActionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveOperatorActionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveOperatorActionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferOwnership) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferOwnership) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferOwnership) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Threshold != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Members[iNdEx])
+			copy(dAtA[i:], m.Members[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Members[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.LockProxyHash) > 0 {
+		i -= len(m.LockProxyHash)
+		copy(dAtA[i:], m.LockProxyHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.LockProxyHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Member) > 0 {
+		i -= len(m.Member)
+		copy(dAtA[i:], m.Member)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Member)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferOwnershipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferOwnershipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferOwnershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetUnlockActionOptIn) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetUnlockActionOptIn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetUnlockActionOptIn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OptIn {
+		i--
+		if m.OptIn {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Action) > 0 {
+		i -= len(m.Action)
+		copy(dAtA[i:], m.Action)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Action)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetUnlockActionOptInResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetUnlockActionOptInResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetUnlockActionOptInResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Action) > 0 {
+		i -= len(m.Action)
+		copy(dAtA[i:], m.Action)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Action)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ToAddressBs) > 0 {
+		i -= len(m.ToAddressBs)
+		copy(dAtA[i:], m.ToAddressBs)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ToAddressBs)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ToChainId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ToChainId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.SourceAssetDenom) > 0 {
+		i -= len(m.SourceAssetDenom)
+		copy(dAtA[i:], m.SourceAssetDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SourceAssetDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.LockProxyHash) > 0 {
+		i -= len(m.LockProxyHash)
+		copy(dAtA[i:], m.LockProxyHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.LockProxyHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateLockProxy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateLockProxyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateCoinAndDelegateToProxy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Coin != nil {
+		l = m.Coin.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.LockProxyHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateCoinAndDelegateToProxyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgBindProxyHash) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ToChainId != 0 {
+		n += 1 + sovTx(uint64(m.ToChainId))
+	}
+	l = len(m.ToChainProxyHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBindProxyHashResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgBindAssetHash) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SourceAssetDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ToChainId != 0 {
+		n += 1 + sovTx(uint64(m.ToChainId))
+	}
+	l = len(m.ToAssetHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SourceDecimals != 0 {
+		n += 1 + sovTx(uint64(m.SourceDecimals))
+	}
+	if m.ToDecimals != 0 {
+		n += 1 + sovTx(uint64(m.ToDecimals))
+	}
+	return n
+}
+
+func (m *MsgBindAssetHashResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnbindProxyHash) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ToChainId != 0 {
+		n += 1 + sovTx(uint64(m.ToChainId))
+	}
+	return n
+}
+
+func (m *MsgUnbindProxyHashResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnbindAssetHash) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SourceAssetDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ToChainId != 0 {
+		n += 1 + sovTx(uint64(m.ToChainId))
+	}
+	return n
+}
+
+func (m *MsgUnbindAssetHashResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSettleAssetDecimals) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SourceAssetDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ChainId != 0 {
+		n += 1 + sovTx(uint64(m.ChainId))
+	}
+	return n
+}
+
+func (m *MsgSettleAssetDecimalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelBindingChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelBindingChangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgApproveOperatorAction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Member)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.LockProxyHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Action != nil {
+		l = m.Action.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgApproveOperatorActionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTransferOwnership) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Member)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.LockProxyHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Members) > 0 {
+		for _, s := range m.Members {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovTx(uint64(m.Threshold))
+	}
+	return n
+}
+
+func (m *MsgTransferOwnershipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetUnlockActionOptIn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Action)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.OptIn {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetUnlockActionOptInResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgLock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LockProxyHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SourceAssetDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ToChainId != 0 {
+		n += 1 + sovTx(uint64(m.ToChainId))
+	}
+	l = len(m.ToAddressBs)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Action)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgLockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateLockProxy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateLockProxy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateLockProxy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateLockProxyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateLockProxyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateLockProxyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateCoinAndDelegateToProxy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateCoinAndDelegateToProxy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateCoinAndDelegateToProxy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Coin == nil {
+				m.Coin = &pb.Coin{}
+			}
+			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockProxyHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockProxyHash = append(m.LockProxyHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.LockProxyHash == nil {
+				m.LockProxyHash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateCoinAndDelegateToProxyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateCoinAndDelegateToProxyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateCoinAndDelegateToProxyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBindProxyHash) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBindProxyHash: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBindProxyHash: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToChainId", wireType)
+			}
+			m.ToChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToChainProxyHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToChainProxyHash = append(m.ToChainProxyHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.ToChainProxyHash == nil {
+				m.ToChainProxyHash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBindProxyHashResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBindProxyHashResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBindProxyHashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBindAssetHash) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBindAssetHash: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBindAssetHash: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceAssetDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceAssetDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToChainId", wireType)
+			}
+			m.ToChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAssetHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAssetHash = append(m.ToAssetHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.ToAssetHash == nil {
+				m.ToAssetHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceDecimals", wireType)
+			}
+			m.SourceDecimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceDecimals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToDecimals", wireType)
+			}
+			m.ToDecimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToDecimals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBindAssetHashResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBindAssetHashResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBindAssetHashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnbindProxyHash) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnbindProxyHash: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnbindProxyHash: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToChainId", wireType)
+			}
+			m.ToChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnbindProxyHashResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnbindProxyHashResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnbindProxyHashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnbindAssetHash) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnbindAssetHash: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnbindAssetHash: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceAssetDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceAssetDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToChainId", wireType)
+			}
+			m.ToChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnbindAssetHashResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnbindAssetHashResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnbindAssetHashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSettleAssetDecimals) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSettleAssetDecimals: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSettleAssetDecimals: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceAssetDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceAssetDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			m.ChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSettleAssetDecimalsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSettleAssetDecimalsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSettleAssetDecimalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelBindingChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelBindingChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelBindingChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelBindingChangeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelBindingChangeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelBindingChangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgApproveOperatorAction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgApproveOperatorAction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgApproveOperatorAction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Member = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockProxyHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockProxyHash = append(m.LockProxyHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.LockProxyHash == nil {
+				m.LockProxyHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Action == nil {
+				m.Action = &OperatorAction{}
+			}
+			if err := m.Action.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgApproveOperatorActionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgApproveOperatorActionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgApproveOperatorActionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferOwnership) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferOwnership: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferOwnership: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Member", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Member = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockProxyHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockProxyHash = append(m.LockProxyHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.LockProxyHash == nil {
+				m.LockProxyHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferOwnershipResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferOwnershipResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferOwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetUnlockActionOptIn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetUnlockActionOptIn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetUnlockActionOptIn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Action = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptIn", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OptIn = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetUnlockActionOptInResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetUnlockActionOptInResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetUnlockActionOptInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockProxyHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockProxyHash = append(m.LockProxyHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.LockProxyHash == nil {
+				m.LockProxyHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceAssetDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceAssetDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToChainId", wireType)
+			}
+			m.ToChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddressBs", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddressBs = append(m.ToAddressBs[:0], dAtA[iNdEx:postIndex]...)
+			if m.ToAddressBs == nil {
+				m.ToAddressBs = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Action = append(m.Action[:0], dAtA[iNdEx:postIndex]...)
+			if m.Action == nil {
+				m.Action = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLockResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLockResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
@@ -19,9 +19,12 @@ package simapp
 
 import (
 	"github.com/polynetwork/cosmos-poly-module/btcx"
+	btcxclient "github.com/polynetwork/cosmos-poly-module/btcx/client"
 	"github.com/polynetwork/cosmos-poly-module/ccm"
+	ccmclient "github.com/polynetwork/cosmos-poly-module/ccm/client"
 	"github.com/polynetwork/cosmos-poly-module/ft"
 	"github.com/polynetwork/cosmos-poly-module/headersync"
+	headersyncclient "github.com/polynetwork/cosmos-poly-module/headersync/client"
 	"github.com/polynetwork/cosmos-poly-module/lockproxy"
 	"io"
 	"os"
@@ -33,6 +36,7 @@ import (
 
 	bam "github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/version"
@@ -77,6 +81,9 @@ var (
 		distr.AppModuleBasic{},
 		gov.NewAppModuleBasic(
 			paramsclient.ProposalHandler, distr.ProposalHandler, upgradeclient.ProposalHandler,
+			headersyncclient.ProposalHandler, headersyncclient.UnfreezeChainProposalHandler,
+			ccmclient.SetChainInfoProposalHandler, ccmclient.RemoveChainInfoProposalHandler,
+			btcxclient.UpdateRedeemScriptProposalHandler,
 		),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
@@ -102,6 +109,7 @@ var (
 		btcx.ModuleName:           {supply.Burner, supply.Minter},
 		lockproxy.ModuleName:      {supply.Minter},
 		ft.ModuleName:             {supply.Burner, supply.Minter},
+		headersync.ModuleName:     nil,
 	}
 
 	// module accounts that are allowed to receive tokens
@@ -130,6 +138,9 @@ type SimApp struct {
 	*bam.BaseApp
 	cdc *codec.Codec
 
+	// commit multi-store shared with BaseApp, the gRPC query services read the committed state from it
+	cms store.CommitMultiStore
+
 	invCheckPeriod uint
 
 	// keys to access the substores
@@ -172,6 +183,10 @@ func NewSimApp(
 
 	cdc := MakeCodec()
 
+	// the multi-store is set ahead of the other options so that they configure the same store
+	cms := store.NewCommitMultiStore(db)
+	baseAppOptions = append([]func(*bam.BaseApp){func(bApp *bam.BaseApp) { bApp.SetCMS(cms) }}, baseAppOptions...)
+
 	bApp := bam.NewBaseApp(appName, logger, db, auth.DefaultTxDecoder(cdc), baseAppOptions...)
 	bApp.SetCommitMultiStoreTracer(traceStore)
 	bApp.SetAppVersion(version.Version)
@@ -188,6 +203,7 @@ func NewSimApp(
 	app := &SimApp{
 		BaseApp:        bApp,
 		cdc:            cdc,
+		cms:            cms,
 		invCheckPeriod: invCheckPeriod,
 		keys:           keys,
 		tkeys:          tkeys,
@@ -206,6 +222,9 @@ func NewSimApp(
 	app.subspaces[crisis.ModuleName] = app.ParamsKeeper.Subspace(crisis.DefaultParamspace)
 	app.subspaces[evidence.ModuleName] = app.ParamsKeeper.Subspace(evidence.DefaultParamspace)
 	app.subspaces[ccm.ModuleName] = app.ParamsKeeper.Subspace(ccm.DefaultParamspace)
+	app.subspaces[headersync.ModuleName] = app.ParamsKeeper.Subspace(headersync.DefaultParamspace)
+	app.subspaces[lockproxy.ModuleName] = app.ParamsKeeper.Subspace(lockproxy.DefaultParamspace)
+	app.subspaces[btcx.ModuleName] = app.ParamsKeeper.Subspace(btcx.DefaultParamspace)
 
 	// add keepers
 	app.AccountKeeper = auth.NewAccountKeeper(
@@ -245,12 +264,21 @@ func NewSimApp(
 	evidenceKeeper.SetRouter(evidenceRouter)
 	app.EvidenceKeeper = *evidenceKeeper
 
+	app.HeaderSyncKeeper = headersync.NewKeeper(app.cdc, keys[headersync.StoreKey], app.subspaces[headersync.ModuleName], app.SupplyKeeper)
+	app.CcmKeeper = ccm.NewKeeper(app.cdc, keys[ccm.StoreKey], app.subspaces[ccm.ModuleName], app.HeaderSyncKeeper, app.SupplyKeeper)
+	app.BtcxKeeper = btcx.NewKeeper(app.cdc, keys[btcx.StoreKey], app.subspaces[btcx.ModuleName], app.AccountKeeper, app.BankKeeper, app.SupplyKeeper, app.CcmKeeper)
+	// NOTE: the cross chain hooks are registered before the btcx keeper is copied into the proposal handler
+	app.BtcxKeeper.SetHooks(app.CcmKeeper.Hooks())
+
 	// register the proposal types
 	govRouter := gov.NewRouter()
 	govRouter.AddRoute(gov.RouterKey, gov.ProposalHandler).
 		AddRoute(params.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper)).
 		AddRoute(distr.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)).
-		AddRoute(upgrade.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper))
+		AddRoute(upgrade.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper)).
+		AddRoute(headersync.RouterKey, headersync.NewProposalHandler(app.HeaderSyncKeeper)).
+		AddRoute(ccm.RouterKey, ccm.NewProposalHandler(app.CcmKeeper)).
+		AddRoute(btcx.RouterKey, btcx.NewProposalHandler(app.BtcxKeeper))
 	app.GovKeeper = gov.NewKeeper(
 		app.cdc, keys[gov.StoreKey], app.subspaces[gov.ModuleName], app.SupplyKeeper,
 		&stakingKeeper, govRouter,
@@ -262,17 +290,23 @@ func NewSimApp(
 		staking.NewMultiStakingHooks(app.DistrKeeper.Hooks(), app.SlashingKeeper.Hooks()),
 	)
 
-	app.HeaderSyncKeeper = headersync.NewKeeper(app.cdc, keys[headersync.StoreKey])
-	app.CcmKeeper = ccm.NewKeeper(app.cdc, keys[ccm.StoreKey], app.subspaces[ccm.ModuleName], app.HeaderSyncKeeper, app.SupplyKeeper)
-	app.BtcxKeeper = btcx.NewKeeper(app.cdc, keys[btcx.StoreKey], app.AccountKeeper, app.BankKeeper, app.SupplyKeeper, app.CcmKeeper)
-	app.LockProxyKeeper = lockproxy.NewKeeper(app.cdc, keys[lockproxy.StoreKey], app.AccountKeeper, app.SupplyKeeper, app.CcmKeeper)
+	app.LockProxyKeeper = lockproxy.NewKeeper(app.cdc, keys[lockproxy.StoreKey], app.subspaces[lockproxy.ModuleName], app.AccountKeeper, app.SupplyKeeper, app.CcmKeeper)
+	app.LockProxyKeeper.RegisterUnlockAction(lockproxy.UnlockActionSend, lockproxy.NewSendUnlockAction(app.BankKeeper))
+	app.LockProxyKeeper.RegisterUnlockAction(lockproxy.UnlockActionDelegate, lockproxy.NewDelegateUnlockAction(app.StakingKeeper))
 	app.FtKeeper = ft.NewKeeper(app.cdc, keys[ft.StoreKey], app.AccountKeeper, app.BankKeeper, app.SupplyKeeper, app.CcmKeeper)
+	// register the cross chain hooks indexing the unlocks received by each account
+	// NOTE: the hooks are registered before mounting the unlock keepers, ccm keeps copies of them
+	app.LockProxyKeeper.SetHooks(app.CcmKeeper.Hooks())
+	app.FtKeeper.SetHooks(app.CcmKeeper.Hooks())
 	app.CcmKeeper.MountUnlockKeeperMap(map[string]ccm.UnlockKeeper{
 		btcx.StoreKey:      app.BtcxKeeper,
 		ft.StoreKey:        app.FtKeeper,
 		lockproxy.StoreKey: app.LockProxyKeeper,
 	})
 
+	// register the upgrade handlers migrating the stores of the poly modules
+	app.registerUpgradeHandlers()
+
 	// NOTE: Any module instantiated in the module manager that is later modified
 	// must be passed by reference here.
 	app.mm = module.NewManager(
@@ -299,7 +333,7 @@ func NewSimApp(
 	// there is nothing left over in the validator fee pool, so as to keep the
 	// CanWithdrawInvariant invariant.
 	app.mm.SetOrderBeginBlockers(upgrade.ModuleName, mint.ModuleName, distr.ModuleName, slashing.ModuleName, evidence.ModuleName)
-	app.mm.SetOrderEndBlockers(crisis.ModuleName, gov.ModuleName, staking.ModuleName)
+	app.mm.SetOrderEndBlockers(crisis.ModuleName, gov.ModuleName, staking.ModuleName, lockproxy.ModuleName)
 
 	// NOTE: The genutils moodule must occur after staking so that pools are
 	// properly initialized with tokens from genesis accounts.
@@ -307,6 +341,7 @@ func NewSimApp(
 		auth.ModuleName, distr.ModuleName, staking.ModuleName, bank.ModuleName,
 		slashing.ModuleName, gov.ModuleName, mint.ModuleName, supply.ModuleName,
 		crisis.ModuleName, genutil.ModuleName, evidence.ModuleName,
+		headersync.ModuleName, lockproxy.ModuleName, btcx.ModuleName,
 	)
 
 	app.mm.RegisterInvariants(&app.CrisisKeeper)
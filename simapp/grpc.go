@@ -0,0 +1,81 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package simapp
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/polynetwork/cosmos-poly-module/common"
+)
+
+// queryServiceRegistrar is implemented by the modules serving a gRPC query service
+type queryServiceRegistrar interface {
+	RegisterQueryService(server *grpc.Server)
+}
+
+// NewGRPCServer returns a gRPC server serving the query services of the app modules,
+// every query is answered against the latest committed state
+func (app *SimApp) NewGRPCServer(opts ...grpc.ServerOption) *grpc.Server {
+	opts = append(opts, grpc.UnaryInterceptor(app.queryInterceptor))
+	server := grpc.NewServer(opts...)
+	app.RegisterGRPCQueryServices(server)
+	return server
+}
+
+// RegisterGRPCQueryServices registers the gRPC query service of every module that has one
+func (app *SimApp) RegisterGRPCQueryServices(server *grpc.Server) {
+	names := make([]string, 0, len(app.mm.Modules))
+	for name := range app.mm.Modules {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if registrar, ok := app.mm.Modules[name].(queryServiceRegistrar); ok {
+			registrar.RegisterQueryService(server)
+		}
+	}
+}
+
+// queryInterceptor attaches a sdk.Context over a cache of the latest committed state to the request,
+// the same way BaseApp serves the legacy custom queries
+func (app *SimApp) queryInterceptor(
+	goCtx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler,
+) (res interface{}, err error) {
+	height := app.LastBlockHeight()
+	cacheMS, err := app.cms.CacheMultiStoreWithVersion(height)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, fmt.Sprintf("failed to load state at height %d: %s", height, err))
+	}
+	ctx := sdk.NewContext(cacheMS, abci.Header{Height: height}, true, app.Logger()).WithContext(goCtx)
+
+	defer func() {
+		if r := recover(); r != nil {
+			res, err = nil, status.Error(codes.Internal, fmt.Sprintf("panic in %s: %v", info.FullMethod, r))
+		}
+	}()
+	return handler(common.WrapSDKContext(ctx), req)
+}
@@ -0,0 +1,66 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package simapp
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/polynetwork/cosmos-poly-module/ccm"
+	ccmpb "github.com/polynetwork/cosmos-poly-module/ccm/pb"
+)
+
+func TestGRPCQueryServices(t *testing.T) {
+	app := Setup(false)
+
+	ctx := app.BaseApp.NewContext(false, abci.Header{Height: 1})
+	app.CcmKeeper.SetParams(ctx, ccm.Params{ChainIdInPolyNet: 5})
+	app.Commit()
+
+	// changes not committed yet are not visible to the query services
+	app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 2}})
+	app.CcmKeeper.SetParams(app.BaseApp.NewContext(false, abci.Header{Height: 2}), ccm.Params{ChainIdInPolyNet: 6})
+
+	listener := bufconn.Listen(1024 * 1024)
+	server := app.NewGRPCServer()
+	go server.Serve(listener)
+	defer server.Stop()
+
+	conn, err := grpc.Dial("bufnet", grpc.WithInsecure(), grpc.WithDialer(func(string, time.Duration) (net.Conn, error) {
+		return listener.Dial()
+	}))
+	require.NoError(t, err)
+	defer conn.Close()
+
+	client := ccmpb.NewQueryClient(conn)
+	res, err := client.Params(context.Background(), &ccmpb.QueryParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), res.Params.ChainIdInPolyNet)
+
+	// no cross chain tx has been created yet
+	_, err = client.CrossChainTxStatus(context.Background(), &ccmpb.QueryCrossChainTxStatusRequest{CrossChainId: 0})
+	require.Error(t, err)
+}
@@ -0,0 +1,77 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package simapp
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+)
+
+func TestOpenAPIDocumentsCoverRESTRoutes(t *testing.T) {
+	modules := []string{"ccm", "headersync", "lockproxy", "ft", "btcx"}
+	r := mux.NewRouter()
+	ModuleBasics.RegisterRESTRoutes(context.CLIContext{}, r)
+
+	documented := make(map[string]bool)
+	for _, module := range modules {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/"+module+"/openapi.json", nil))
+		require.Equal(t, http.StatusOK, w.Code, module)
+
+		var doc struct {
+			Paths map[string]map[string]json.RawMessage `json:"paths"`
+		}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &doc), module)
+		for path, operations := range doc.Paths {
+			for method := range operations {
+				documented[strings.ToUpper(method)+" "+path] = true
+			}
+		}
+	}
+
+	registered := make(map[string]bool)
+	require.NoError(t, r.Walk(func(route *mux.Route, router *mux.Router, ancestors []*mux.Route) error {
+		path, err := route.GetPathTemplate()
+		if err != nil {
+			return nil
+		}
+		methods, _ := route.GetMethods()
+		for _, method := range methods {
+			registered[method+" "+path] = true
+		}
+		for _, module := range modules {
+			if strings.HasPrefix(path, "/"+module+"/") && !strings.HasSuffix(path, "/openapi.json") {
+				for _, method := range methods {
+					require.True(t, documented[method+" "+path], "%s %s is not documented", method, path)
+				}
+			}
+		}
+		return nil
+	}))
+	for route := range documented {
+		require.True(t, registered[route], "%s is documented but not registered", route)
+	}
+}
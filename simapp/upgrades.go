@@ -0,0 +1,40 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package simapp
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/upgrade"
+	"github.com/polynetwork/cosmos-poly-module/btcx"
+	"github.com/polynetwork/cosmos-poly-module/lockproxy"
+)
+
+// ProtobufStoreUpgrade is the name of the upgrade switching the records stored by the poly modules from amino
+// and zero-copy encoding to protobuf, the plan of it is submitted through a software upgrade proposal
+const ProtobufStoreUpgrade = "protobuf-store"
+
+func (app *SimApp) registerUpgradeHandlers() {
+	app.UpgradeKeeper.SetUpgradeHandler(ProtobufStoreUpgrade, func(ctx sdk.Context, plan upgrade.Plan) {
+		app.HeaderSyncKeeper.MigrateStoreToProtobuf(ctx)
+		app.CcmKeeper.MigrateStoreToProtobuf(ctx)
+		app.CcmKeeper.IndexDenomCreators(ctx)
+		// the params of lockproxy and btcx are introduced by the upgrade, set them so they read and export as a whole
+		app.LockProxyKeeper.SetParams(ctx, lockproxy.DefaultParams())
+		app.BtcxKeeper.SetParams(ctx, btcx.DefaultParams())
+	})
+}
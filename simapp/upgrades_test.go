@@ -0,0 +1,44 @@
+/*
+ * Copyright (C) 2020 The poly network Authors
+ * This file is part of The poly network library.
+ *
+ * The  poly network  is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The  poly network  is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The poly network .  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package simapp
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/x/upgrade"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/polynetwork/cosmos-poly-module/btcx"
+	"github.com/polynetwork/cosmos-poly-module/lockproxy"
+)
+
+func TestProtobufStoreUpgradeSetsParams(t *testing.T) {
+	app := Setup(true)
+	ctx := app.BaseApp.NewContext(true, abci.Header{Height: 10})
+
+	// the chain upgraded has no lockproxy and btcx params yet
+	require.Panics(t, func() { lockproxy.ExportGenesis(ctx, app.LockProxyKeeper) })
+	require.Panics(t, func() { btcx.ExportGenesis(ctx, app.BtcxKeeper) })
+
+	app.UpgradeKeeper.ApplyUpgrade(ctx, upgrade.Plan{Name: ProtobufStoreUpgrade, Height: ctx.BlockHeight()})
+	lockproxyGenesis := lockproxy.ExportGenesis(ctx, app.LockProxyKeeper)
+	require.Equal(t, lockproxy.DefaultParams().BindingChangeDelay, lockproxyGenesis.Params.BindingChangeDelay)
+	require.True(t, lockproxyGenesis.Params.Guardian.Empty())
+	require.Equal(t, btcx.NewGenesisState(btcx.DefaultParams()), btcx.ExportGenesis(ctx, app.BtcxKeeper))
+}